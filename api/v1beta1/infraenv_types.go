@@ -110,9 +110,13 @@ type Proxy struct {
 type InfraEnvStatus struct {
 	// ISODownloadURL specifies an HTTP/S URL that contains a discovery ISO containing the
 	// configuration from this InfraEnv.
-	ISODownloadURL string                   `json:"isoDownloadURL,omitempty"`
-	CreatedTime    *metav1.Time             `json:"createdTime,omitempty"`
-	Conditions     []conditionsv1.Condition `json:"conditions,omitempty"`
+	ISODownloadURL string `json:"isoDownloadURL,omitempty"`
+	// IPXEScriptURL specifies an HTTP/S URL that contains an iPXE script booting the discovery
+	// image of this InfraEnv over the network.
+	// +optional
+	IPXEScriptURL string                   `json:"ipxeScriptURL,omitempty"`
+	CreatedTime   *metav1.Time             `json:"createdTime,omitempty"`
+	Conditions    []conditionsv1.Condition `json:"conditions,omitempty"`
 	// AgentLabelSelector specifies the label that will be applied to Agents that boot from the
 	// installation media of this InfraEnv. This is how a user would identify which agents are
 	// associated with a particular InfraEnv.
@@ -13,12 +13,16 @@ import (
 	rtclient "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/openshift/assisted-service/client/audit"
+	"github.com/openshift/assisted-service/client/cluster_bundles"
 	"github.com/openshift/assisted-service/client/events"
 	"github.com/openshift/assisted-service/client/installer"
 	"github.com/openshift/assisted-service/client/managed_domains"
 	"github.com/openshift/assisted-service/client/manifests"
 	"github.com/openshift/assisted-service/client/operators"
+	"github.com/openshift/assisted-service/client/role_bindings"
 	"github.com/openshift/assisted-service/client/versions"
+	"github.com/openshift/assisted-service/client/webhooks"
 )
 
 const (
@@ -63,22 +67,30 @@ func New(c Config) *AssistedInstall {
 
 	cli := new(AssistedInstall)
 	cli.Transport = transport
+	cli.Audit = audit.New(transport, strfmt.Default, c.AuthInfo)
+	cli.ClusterBundles = cluster_bundles.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Events = events.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Installer = installer.New(transport, strfmt.Default, c.AuthInfo)
 	cli.ManagedDomains = managed_domains.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Manifests = manifests.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Operators = operators.New(transport, strfmt.Default, c.AuthInfo)
+	cli.RoleBindings = role_bindings.New(transport, strfmt.Default, c.AuthInfo)
 	cli.Versions = versions.New(transport, strfmt.Default, c.AuthInfo)
+	cli.Webhooks = webhooks.New(transport, strfmt.Default, c.AuthInfo)
 	return cli
 }
 
 // AssistedInstall is a client for assisted install
 type AssistedInstall struct {
+	Audit          *audit.Client
+	ClusterBundles *cluster_bundles.Client
 	Events         *events.Client
 	Installer      *installer.Client
 	ManagedDomains *managed_domains.Client
 	Manifests      *manifests.Client
 	Operators      *operators.Client
+	RoleBindings   *role_bindings.Client
 	Versions       *versions.Client
+	Webhooks       *webhooks.Client
 	Transport      runtime.ClientTransport
 }
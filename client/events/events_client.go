@@ -23,6 +23,13 @@ type API interface {
 	/*
 	   V2ListEvents Lists events for a cluster.*/
 	V2ListEvents(ctx context.Context, params *V2ListEventsParams) (*V2ListEventsOK, error)
+	/*
+	   V2StreamEvents Streams the events and the installation progress updates of a cluster, host or infra-env as Server-Sent Events.
+	   Events are sent with the 'event' type and carry their sequence number as the SSE id, so a client that reconnects
+	   with the Last-Event-ID header first receives the events it missed. Progress updates are sent with the
+	   'cluster-progress' and 'host-progress' types and are not replayed.
+	*/
+	V2StreamEvents(ctx context.Context, params *V2StreamEventsParams) (*V2StreamEventsOK, error)
 }
 
 // New creates a new events API client.
@@ -92,3 +99,32 @@ func (a *Client) V2ListEvents(ctx context.Context, params *V2ListEventsParams) (
 	return result.(*V2ListEventsOK), nil
 
 }
+
+/*
+V2StreamEvents Streams the events and the installation progress updates of a cluster, host or infra-env as Server-Sent Events.
+Events are sent with the 'event' type and carry their sequence number as the SSE id, so a client that reconnects
+with the Last-Event-ID header first receives the events it missed. Progress updates are sent with the
+'cluster-progress' and 'host-progress' types and are not replayed.
+
+*/
+func (a *Client) V2StreamEvents(ctx context.Context, params *V2StreamEventsParams) (*V2StreamEventsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2StreamEvents",
+		Method:             "GET",
+		PathPattern:        "/v2/events/stream",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2StreamEventsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2StreamEventsOK), nil
+
+}
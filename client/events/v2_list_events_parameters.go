@@ -90,6 +90,58 @@ type V2ListEventsParams struct {
 	*/
 	InfraEnvID *strfmt.UUID
 
+	/* Limit.
+
+	   The maximal number of events to return. All matching events are returned if not set.
+	*/
+	Limit *int64
+
+	/* Message.
+
+	   Return only events whose message contains this text, ignoring case.
+	*/
+	Message *string
+
+	/* Names.
+
+	   A comma-separated list of event names.
+	*/
+	Names []string
+
+	/* Offset.
+
+	   The number of matching events to skip before starting to return events.
+	*/
+	Offset *int64
+
+	/* Order.
+
+	   The order by which the events are sorted according to their time.
+	*/
+	Order *string
+
+	/* Severities.
+
+	   A comma-separated list of event severities.
+	*/
+	Severities []string
+
+	/* Since.
+
+	   Return only events that occurred at or after this time.
+
+	   Format: date-time
+	*/
+	Since *strfmt.DateTime
+
+	/* Until.
+
+	   Return only events that occurred at or before this time.
+
+	   Format: date-time
+	*/
+	Until *strfmt.DateTime
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -187,6 +239,94 @@ func (o *V2ListEventsParams) SetInfraEnvID(infraEnvID *strfmt.UUID) {
 	o.InfraEnvID = infraEnvID
 }
 
+// WithLimit adds the limit to the v2 list events params
+func (o *V2ListEventsParams) WithLimit(limit *int64) *V2ListEventsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the v2 list events params
+func (o *V2ListEventsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithMessage adds the message to the v2 list events params
+func (o *V2ListEventsParams) WithMessage(message *string) *V2ListEventsParams {
+	o.SetMessage(message)
+	return o
+}
+
+// SetMessage adds the message to the v2 list events params
+func (o *V2ListEventsParams) SetMessage(message *string) {
+	o.Message = message
+}
+
+// WithNames adds the names to the v2 list events params
+func (o *V2ListEventsParams) WithNames(names []string) *V2ListEventsParams {
+	o.SetNames(names)
+	return o
+}
+
+// SetNames adds the names to the v2 list events params
+func (o *V2ListEventsParams) SetNames(names []string) {
+	o.Names = names
+}
+
+// WithOffset adds the offset to the v2 list events params
+func (o *V2ListEventsParams) WithOffset(offset *int64) *V2ListEventsParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the v2 list events params
+func (o *V2ListEventsParams) SetOffset(offset *int64) {
+	o.Offset = offset
+}
+
+// WithOrder adds the order to the v2 list events params
+func (o *V2ListEventsParams) WithOrder(order *string) *V2ListEventsParams {
+	o.SetOrder(order)
+	return o
+}
+
+// SetOrder adds the order to the v2 list events params
+func (o *V2ListEventsParams) SetOrder(order *string) {
+	o.Order = order
+}
+
+// WithSeverities adds the severities to the v2 list events params
+func (o *V2ListEventsParams) WithSeverities(severities []string) *V2ListEventsParams {
+	o.SetSeverities(severities)
+	return o
+}
+
+// SetSeverities adds the severities to the v2 list events params
+func (o *V2ListEventsParams) SetSeverities(severities []string) {
+	o.Severities = severities
+}
+
+// WithSince adds the since to the v2 list events params
+func (o *V2ListEventsParams) WithSince(since *strfmt.DateTime) *V2ListEventsParams {
+	o.SetSince(since)
+	return o
+}
+
+// SetSince adds the since to the v2 list events params
+func (o *V2ListEventsParams) SetSince(since *strfmt.DateTime) {
+	o.Since = since
+}
+
+// WithUntil adds the until to the v2 list events params
+func (o *V2ListEventsParams) WithUntil(until *strfmt.DateTime) *V2ListEventsParams {
+	o.SetUntil(until)
+	return o
+}
+
+// SetUntil adds the until to the v2 list events params
+func (o *V2ListEventsParams) SetUntil(until *strfmt.DateTime) {
+	o.Until = until
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *V2ListEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -257,6 +397,130 @@ func (o *V2ListEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 		}
 	}
 
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Message != nil {
+
+		// query param message
+		var qrMessage string
+
+		if o.Message != nil {
+			qrMessage = *o.Message
+		}
+		qMessage := qrMessage
+		if qMessage != "" {
+
+			if err := r.SetQueryParam("message", qMessage); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Names != nil {
+
+		// binding items for names
+		joinedNames := o.bindParamNames(reg)
+
+		// query array param names
+		if err := r.SetQueryParam("names", joinedNames...); err != nil {
+			return err
+		}
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int64
+
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt64(qrOffset)
+		if qOffset != "" {
+
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Order != nil {
+
+		// query param order
+		var qrOrder string
+
+		if o.Order != nil {
+			qrOrder = *o.Order
+		}
+		qOrder := qrOrder
+		if qOrder != "" {
+
+			if err := r.SetQueryParam("order", qOrder); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Severities != nil {
+
+		// binding items for severities
+		joinedSeverities := o.bindParamSeverities(reg)
+
+		// query array param severities
+		if err := r.SetQueryParam("severities", joinedSeverities...); err != nil {
+			return err
+		}
+	}
+
+	if o.Since != nil {
+
+		// query param since
+		var qrSince strfmt.DateTime
+
+		if o.Since != nil {
+			qrSince = *o.Since
+		}
+		qSince := qrSince.String()
+		if qSince != "" {
+
+			if err := r.SetQueryParam("since", qSince); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Until != nil {
+
+		// query param until
+		var qrUntil strfmt.DateTime
+
+		if o.Until != nil {
+			qrUntil = *o.Until
+		}
+		qUntil := qrUntil.String()
+		if qUntil != "" {
+
+			if err := r.SetQueryParam("until", qUntil); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -279,3 +543,37 @@ func (o *V2ListEventsParams) bindParamCategories(formats strfmt.Registry) []stri
 
 	return categoriesIS
 }
+
+// bindParamV2ListEvents binds the parameter names
+func (o *V2ListEventsParams) bindParamNames(formats strfmt.Registry) []string {
+	namesIR := o.Names
+
+	var namesIC []string
+	for _, namesIIR := range namesIR { // explode []string
+
+		namesIIV := namesIIR // string as string
+		namesIC = append(namesIC, namesIIV)
+	}
+
+	// items.CollectionFormat: ""
+	namesIS := swag.JoinByFormat(namesIC, "")
+
+	return namesIS
+}
+
+// bindParamV2ListEvents binds the parameter severities
+func (o *V2ListEventsParams) bindParamSeverities(formats strfmt.Registry) []string {
+	severitiesIR := o.Severities
+
+	var severitiesIC []string
+	for _, severitiesIIR := range severitiesIR { // explode []string
+
+		severitiesIIV := severitiesIIR // string as string
+		severitiesIC = append(severitiesIC, severitiesIIV)
+	}
+
+	// items.CollectionFormat: ""
+	severitiesIS := swag.JoinByFormat(severitiesIC, "")
+
+	return severitiesIS
+}
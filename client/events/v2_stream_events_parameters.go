@@ -0,0 +1,306 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewV2StreamEventsParams creates a new V2StreamEventsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2StreamEventsParams() *V2StreamEventsParams {
+	return &V2StreamEventsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2StreamEventsParamsWithTimeout creates a new V2StreamEventsParams object
+// with the ability to set a timeout on a request.
+func NewV2StreamEventsParamsWithTimeout(timeout time.Duration) *V2StreamEventsParams {
+	return &V2StreamEventsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2StreamEventsParamsWithContext creates a new V2StreamEventsParams object
+// with the ability to set a context for a request.
+func NewV2StreamEventsParamsWithContext(ctx context.Context) *V2StreamEventsParams {
+	return &V2StreamEventsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2StreamEventsParamsWithHTTPClient creates a new V2StreamEventsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2StreamEventsParamsWithHTTPClient(client *http.Client) *V2StreamEventsParams {
+	return &V2StreamEventsParams{
+		HTTPClient: client,
+	}
+}
+
+/* V2StreamEventsParams contains all the parameters to send to the API endpoint
+   for the v2 stream events operation.
+
+   Typically these are written to a http.Request.
+*/
+type V2StreamEventsParams struct {
+
+	/* LastEventID.
+
+	   The id of the last event received by the client. The events that followed it are sent before any live update.
+	*/
+	LastEventID *int64
+
+	/* ClusterID.
+
+	   The cluster to stream events for.
+
+	   Format: uuid
+	*/
+	ClusterID *strfmt.UUID
+
+	/* HostID.
+
+	   A host to stream events for.
+
+	   Format: uuid
+	*/
+	HostID *strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env to stream events for.
+
+	   Format: uuid
+	*/
+	InfraEnvID *strfmt.UUID
+
+	/* Severities.
+
+	   A comma-separated list of event severities.
+	*/
+	Severities []string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 stream events params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2StreamEventsParams) WithDefaults() *V2StreamEventsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 stream events params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2StreamEventsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 stream events params
+func (o *V2StreamEventsParams) WithTimeout(timeout time.Duration) *V2StreamEventsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 stream events params
+func (o *V2StreamEventsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 stream events params
+func (o *V2StreamEventsParams) WithContext(ctx context.Context) *V2StreamEventsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 stream events params
+func (o *V2StreamEventsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 stream events params
+func (o *V2StreamEventsParams) WithHTTPClient(client *http.Client) *V2StreamEventsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 stream events params
+func (o *V2StreamEventsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLastEventID adds the lastEventID to the v2 stream events params
+func (o *V2StreamEventsParams) WithLastEventID(lastEventID *int64) *V2StreamEventsParams {
+	o.SetLastEventID(lastEventID)
+	return o
+}
+
+// SetLastEventID adds the lastEventId to the v2 stream events params
+func (o *V2StreamEventsParams) SetLastEventID(lastEventID *int64) {
+	o.LastEventID = lastEventID
+}
+
+// WithClusterID adds the clusterID to the v2 stream events params
+func (o *V2StreamEventsParams) WithClusterID(clusterID *strfmt.UUID) *V2StreamEventsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 stream events params
+func (o *V2StreamEventsParams) SetClusterID(clusterID *strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithHostID adds the hostID to the v2 stream events params
+func (o *V2StreamEventsParams) WithHostID(hostID *strfmt.UUID) *V2StreamEventsParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the v2 stream events params
+func (o *V2StreamEventsParams) SetHostID(hostID *strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 stream events params
+func (o *V2StreamEventsParams) WithInfraEnvID(infraEnvID *strfmt.UUID) *V2StreamEventsParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 stream events params
+func (o *V2StreamEventsParams) SetInfraEnvID(infraEnvID *strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WithSeverities adds the severities to the v2 stream events params
+func (o *V2StreamEventsParams) WithSeverities(severities []string) *V2StreamEventsParams {
+	o.SetSeverities(severities)
+	return o
+}
+
+// SetSeverities adds the severities to the v2 stream events params
+func (o *V2StreamEventsParams) SetSeverities(severities []string) {
+	o.Severities = severities
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2StreamEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.LastEventID != nil {
+
+		// header param Last-Event-ID
+		if err := r.SetHeaderParam("Last-Event-ID", swag.FormatInt64(*o.LastEventID)); err != nil {
+			return err
+		}
+	}
+
+	if o.ClusterID != nil {
+
+		// query param cluster_id
+		var qrClusterID strfmt.UUID
+
+		if o.ClusterID != nil {
+			qrClusterID = *o.ClusterID
+		}
+		qClusterID := qrClusterID.String()
+		if qClusterID != "" {
+
+			if err := r.SetQueryParam("cluster_id", qClusterID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.HostID != nil {
+
+		// query param host_id
+		var qrHostID strfmt.UUID
+
+		if o.HostID != nil {
+			qrHostID = *o.HostID
+		}
+		qHostID := qrHostID.String()
+		if qHostID != "" {
+
+			if err := r.SetQueryParam("host_id", qHostID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.InfraEnvID != nil {
+
+		// query param infra_env_id
+		var qrInfraEnvID strfmt.UUID
+
+		if o.InfraEnvID != nil {
+			qrInfraEnvID = *o.InfraEnvID
+		}
+		qInfraEnvID := qrInfraEnvID.String()
+		if qInfraEnvID != "" {
+
+			if err := r.SetQueryParam("infra_env_id", qInfraEnvID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Severities != nil {
+
+		// binding items for severities
+		joinedSeverities := o.bindParamSeverities(reg)
+
+		// query array param severities
+		if err := r.SetQueryParam("severities", joinedSeverities...); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindParamV2StreamEvents binds the parameter severities
+func (o *V2StreamEventsParams) bindParamSeverities(formats strfmt.Registry) []string {
+	severitiesIR := o.Severities
+
+	var severitiesIC []string
+	for _, severitiesIIR := range severitiesIR { // explode []string
+
+		severitiesIIV := severitiesIIR // string as string
+		severitiesIC = append(severitiesIC, severitiesIIV)
+	}
+
+	// items.CollectionFormat: ""
+	severitiesIS := swag.JoinByFormat(severitiesIC, "")
+
+	return severitiesIS
+}
@@ -18,6 +18,9 @@ import (
 
 // API is the interface of the installer client
 type API interface {
+	/*
+	   ApplyStaticNetworkConfigTemplate Renders the static network configuration of the hosts of the infra-env from a template and a table of per-host values, validates it and stores it in the infra-env.*/
+	ApplyStaticNetworkConfigTemplate(ctx context.Context, params *ApplyStaticNetworkConfigTemplateParams) (*ApplyStaticNetworkConfigTemplateCreated, error)
 	/*
 	   BindHost Bind host to a cluster*/
 	BindHost(ctx context.Context, params *BindHostParams) (*BindHostOK, error)
@@ -234,15 +237,35 @@ type API interface {
 	/*
 	   V2DeregisterHost Deregisters an OpenShift host.*/
 	V2DeregisterHost(ctx context.Context, params *V2DeregisterHostParams) (*V2DeregisterHostNoContent, error)
+	/*
+	   V2DownloadClusterConnectivityMatrix Downloads the connectivity matrix of the cluster as a CSV file, one row per connectivity check.*/
+	V2DownloadClusterConnectivityMatrix(ctx context.Context, params *V2DownloadClusterConnectivityMatrixParams, writer io.Writer) (*V2DownloadClusterConnectivityMatrixOK, error)
+	/*
+	   V2DownloadClusterDryRun Downloads the tarball of the install config, manifests and ignition files rendered by the last dry-run installation of the cluster.*/
+	V2DownloadClusterDryRun(ctx context.Context, params *V2DownloadClusterDryRunParams, writer io.Writer) (*V2DownloadClusterDryRunOK, error)
 	/*
 	   V2DownloadHostIgnition Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned*/
 	V2DownloadHostIgnition(ctx context.Context, params *V2DownloadHostIgnitionParams, writer io.Writer) (*V2DownloadHostIgnitionOK, error)
 	/*
-	   V2DownloadInfraEnvFiles Downloads the customized ignition file for this host*/
+	   V2DownloadInfraEnvFiles Downloads the customized ignition file for this host, or the artifacts for booting the discovery image
+	   over the network. The initrd.img has the discovery ignition embedded, and the ipxe-script boots the
+	   vmlinuz, initrd.img and rootfs.img of this infra-env.
+	*/
 	V2DownloadInfraEnvFiles(ctx context.Context, params *V2DownloadInfraEnvFilesParams, writer io.Writer) (*V2DownloadInfraEnvFilesOK, error)
+	/*
+	   V2DryRunInstallCluster Renders the install config, manifests and ignition files of the cluster as an installation would, without
+	   installing it. The hosts are not moved to installing and the state of the cluster is not changed. The rendered
+	   files can be downloaded with v2DownloadClusterDryRun once the dry run succeeded.
+	*/
+	V2DryRunInstallCluster(ctx context.Context, params *V2DryRunInstallClusterParams) (*V2DryRunInstallClusterOK, error)
 	/*
 	   V2GetCluster Retrieves the details of the OpenShift cluster.*/
 	V2GetCluster(ctx context.Context, params *V2GetClusterParams) (*V2GetClusterOK, error)
+	/*
+	   V2GetClusterConnectivityMatrix Retrieves the pairwise L2 and L3 connectivity between the hosts of the cluster, per outgoing interface and
+	   address family, as reported by the last connectivity checks of the hosts.
+	*/
+	V2GetClusterConnectivityMatrix(ctx context.Context, params *V2GetClusterConnectivityMatrixParams) (*V2GetClusterConnectivityMatrixOK, error)
 	/*
 	   V2GetClusterInstallConfig Get the cluster's install config YAML.*/
 	V2GetClusterInstallConfig(ctx context.Context, params *V2GetClusterInstallConfigParams) (*V2GetClusterInstallConfigOK, error)
@@ -252,6 +275,9 @@ type API interface {
 	/*
 	   V2GetHostIgnition Fetch the ignition file for this host as a string. In case of unbound host produces an error*/
 	V2GetHostIgnition(ctx context.Context, params *V2GetHostIgnitionParams) (*V2GetHostIgnitionOK, error)
+	/*
+	   V2GetHostInventoryDiff Compares two snapshots of the inventory of the host.*/
+	V2GetHostInventoryDiff(ctx context.Context, params *V2GetHostInventoryDiffParams) (*V2GetHostInventoryDiffOK, error)
 	/*
 	   V2GetNextSteps Retrieves the next operations that the host agent needs to perform.*/
 	V2GetNextSteps(ctx context.Context, params *V2GetNextStepsParams) (*V2GetNextStepsOK, error)
@@ -273,6 +299,9 @@ type API interface {
 	/*
 	   V2ListFeatureSupportLevels Retrieves the support levels for features for each OpenShift version.*/
 	V2ListFeatureSupportLevels(ctx context.Context, params *V2ListFeatureSupportLevelsParams) (*V2ListFeatureSupportLevelsOK, error)
+	/*
+	   V2ListHostInventoryHistory Retrieves the snapshots of the inventory of the host, a snapshot being recorded every time the hardware reported by the host changes.*/
+	V2ListHostInventoryHistory(ctx context.Context, params *V2ListHostInventoryHistoryParams) (*V2ListHostInventoryHistoryOK, error)
 	/*
 	   V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env.*/
 	V2ListHosts(ctx context.Context, params *V2ListHostsParams) (*V2ListHostsOK, error)
@@ -320,6 +349,9 @@ type API interface {
 	/*
 	   V2UploadClusterIngressCert Transfer the ingress certificate for the cluster.*/
 	V2UploadClusterIngressCert(ctx context.Context, params *V2UploadClusterIngressCertParams) (*V2UploadClusterIngressCertCreated, error)
+	/*
+	   V2ValidateClusterInstallConfig Validate install config overrides against the install config schema of the OpenShift version of the cluster, and preview the changes they make to the install config. The overrides are not saved.*/
+	V2ValidateClusterInstallConfig(ctx context.Context, params *V2ValidateClusterInstallConfigParams) (*V2ValidateClusterInstallConfigOK, error)
 }
 
 // New creates a new installer API client.
@@ -340,6 +372,31 @@ type Client struct {
 	authInfo  runtime.ClientAuthInfoWriter
 }
 
+/*
+ApplyStaticNetworkConfigTemplate Renders the static network configuration of the hosts of the infra-env from a template and a table of per-host values, validates it and stores it in the infra-env.
+*/
+func (a *Client) ApplyStaticNetworkConfigTemplate(ctx context.Context, params *ApplyStaticNetworkConfigTemplateParams) (*ApplyStaticNetworkConfigTemplateCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ApplyStaticNetworkConfigTemplate",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/static-network-config-template",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ApplyStaticNetworkConfigTemplateReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ApplyStaticNetworkConfigTemplateCreated), nil
+
+}
+
 /*
 BindHost Bind host to a cluster
 */
@@ -2102,6 +2159,56 @@ func (a *Client) V2DeregisterHost(ctx context.Context, params *V2DeregisterHostP
 
 }
 
+/*
+V2DownloadClusterConnectivityMatrix Downloads the connectivity matrix of the cluster as a CSV file, one row per connectivity check.
+*/
+func (a *Client) V2DownloadClusterConnectivityMatrix(ctx context.Context, params *V2DownloadClusterConnectivityMatrixParams, writer io.Writer) (*V2DownloadClusterConnectivityMatrixOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DownloadClusterConnectivityMatrix",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/downloads/connectivity-matrix",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DownloadClusterConnectivityMatrixReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DownloadClusterConnectivityMatrixOK), nil
+
+}
+
+/*
+V2DownloadClusterDryRun Downloads the tarball of the install config, manifests and ignition files rendered by the last dry-run installation of the cluster.
+*/
+func (a *Client) V2DownloadClusterDryRun(ctx context.Context, params *V2DownloadClusterDryRunParams, writer io.Writer) (*V2DownloadClusterDryRunOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DownloadClusterDryRun",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/downloads/dry-run",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DownloadClusterDryRunReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DownloadClusterDryRunOK), nil
+
+}
+
 /*
 V2DownloadHostIgnition Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned
 */
@@ -2128,7 +2235,10 @@ func (a *Client) V2DownloadHostIgnition(ctx context.Context, params *V2DownloadH
 }
 
 /*
-V2DownloadInfraEnvFiles Downloads the customized ignition file for this host
+V2DownloadInfraEnvFiles Downloads the customized ignition file for this host, or the artifacts for booting the discovery image
+over the network. The initrd.img has the discovery ignition embedded, and the ipxe-script boots the
+vmlinuz, initrd.img and rootfs.img of this infra-env.
+
 */
 func (a *Client) V2DownloadInfraEnvFiles(ctx context.Context, params *V2DownloadInfraEnvFilesParams, writer io.Writer) (*V2DownloadInfraEnvFilesOK, error) {
 
@@ -2152,6 +2262,34 @@ func (a *Client) V2DownloadInfraEnvFiles(ctx context.Context, params *V2Download
 
 }
 
+/*
+V2DryRunInstallCluster Renders the install config, manifests and ignition files of the cluster as an installation would, without
+installing it. The hosts are not moved to installing and the state of the cluster is not changed. The rendered
+files can be downloaded with v2DownloadClusterDryRun once the dry run succeeded.
+
+*/
+func (a *Client) V2DryRunInstallCluster(ctx context.Context, params *V2DryRunInstallClusterParams) (*V2DryRunInstallClusterOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DryRunInstallCluster",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/actions/dry-run-install",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DryRunInstallClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DryRunInstallClusterOK), nil
+
+}
+
 /*
 V2GetCluster Retrieves the details of the OpenShift cluster.
 */
@@ -2177,6 +2315,33 @@ func (a *Client) V2GetCluster(ctx context.Context, params *V2GetClusterParams) (
 
 }
 
+/*
+V2GetClusterConnectivityMatrix Retrieves the pairwise L2 and L3 connectivity between the hosts of the cluster, per outgoing interface and
+address family, as reported by the last connectivity checks of the hosts.
+
+*/
+func (a *Client) V2GetClusterConnectivityMatrix(ctx context.Context, params *V2GetClusterConnectivityMatrixParams) (*V2GetClusterConnectivityMatrixOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetClusterConnectivityMatrix",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/connectivity-matrix",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterConnectivityMatrixReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterConnectivityMatrixOK), nil
+
+}
+
 /*
 V2GetClusterInstallConfig Get the cluster's install config YAML.
 */
@@ -2252,6 +2417,31 @@ func (a *Client) V2GetHostIgnition(ctx context.Context, params *V2GetHostIgnitio
 
 }
 
+/*
+V2GetHostInventoryDiff Compares two snapshots of the inventory of the host.
+*/
+func (a *Client) V2GetHostInventoryDiff(ctx context.Context, params *V2GetHostInventoryDiffParams) (*V2GetHostInventoryDiffOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetHostInventoryDiff",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/inventory-history/diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetHostInventoryDiffReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetHostInventoryDiffOK), nil
+
+}
+
 /*
 V2GetNextSteps Retrieves the next operations that the host agent needs to perform.
 */
@@ -2427,6 +2617,31 @@ func (a *Client) V2ListFeatureSupportLevels(ctx context.Context, params *V2ListF
 
 }
 
+/*
+V2ListHostInventoryHistory Retrieves the snapshots of the inventory of the host, a snapshot being recorded every time the hardware reported by the host changes.
+*/
+func (a *Client) V2ListHostInventoryHistory(ctx context.Context, params *V2ListHostInventoryHistoryParams) (*V2ListHostInventoryHistoryOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListHostInventoryHistory",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/inventory-history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListHostInventoryHistoryReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListHostInventoryHistoryOK), nil
+
+}
+
 /*
 V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env.
 */
@@ -2803,3 +3018,28 @@ func (a *Client) V2UploadClusterIngressCert(ctx context.Context, params *V2Uploa
 	return result.(*V2UploadClusterIngressCertCreated), nil
 
 }
+
+/*
+V2ValidateClusterInstallConfig Validate install config overrides against the install config schema of the OpenShift version of the cluster, and preview the changes they make to the install config. The overrides are not saved.
+*/
+func (a *Client) V2ValidateClusterInstallConfig(ctx context.Context, params *V2ValidateClusterInstallConfigParams) (*V2ValidateClusterInstallConfigOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ValidateClusterInstallConfig",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/install-config/validate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ValidateClusterInstallConfigReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ValidateClusterInstallConfigOK), nil
+
+}
@@ -66,12 +66,24 @@ type V2ListClustersParams struct {
 	*/
 	AmsSubscriptionIds []string
 
+	/* Continue.
+
+	   The token returned in the X-Continue header of a previous response, used to retrieve the next page.
+	*/
+	Continue *string
+
 	/* GetUnregisteredClusters.
 
 	   Whether to return clusters that have been unregistered.
 	*/
 	GetUnregisteredClusters *bool
 
+	/* Limit.
+
+	   The maximal number of Clusters to return. All matching Clusters are returned if not set.
+	*/
+	Limit *int64
+
 	/* OpenshiftClusterID.
 
 	   A specific cluster to retrieve.
@@ -80,6 +92,42 @@ type V2ListClustersParams struct {
 	*/
 	OpenshiftClusterID *strfmt.UUID
 
+	/* OpenshiftVersion.
+
+	   If set, returned Clusters are filtered to those with the given OpenShift version.
+	*/
+	OpenshiftVersion *string
+
+	/* Order.
+
+	   The direction in which the returned Clusters are sorted.
+	*/
+	Order *string
+
+	/* Owner.
+
+	   If set, returned Clusters are filtered to those owned by the given user name.
+	*/
+	Owner *string
+
+	/* PlatformType.
+
+	   If set, returned Clusters are filtered to those with the given platform type, one of the values of the platform_type definition.
+	*/
+	PlatformType *string
+
+	/* SortBy.
+
+	   The field used to sort the returned Clusters.
+	*/
+	SortBy *string
+
+	/* Status.
+
+	   If non-empty, returned Clusters are filtered to those in one of the given statuses.
+	*/
+	Status []string
+
 	/* WithHosts.
 
 	   Include hosts in the returned list.
@@ -164,6 +212,17 @@ func (o *V2ListClustersParams) SetAmsSubscriptionIds(amsSubscriptionIds []string
 	o.AmsSubscriptionIds = amsSubscriptionIds
 }
 
+// WithContinue adds the continueVar to the v2 list clusters params
+func (o *V2ListClustersParams) WithContinue(continueVar *string) *V2ListClustersParams {
+	o.SetContinue(continueVar)
+	return o
+}
+
+// SetContinue adds the continue to the v2 list clusters params
+func (o *V2ListClustersParams) SetContinue(continueVar *string) {
+	o.Continue = continueVar
+}
+
 // WithGetUnregisteredClusters adds the getUnregisteredClusters to the v2 list clusters params
 func (o *V2ListClustersParams) WithGetUnregisteredClusters(getUnregisteredClusters *bool) *V2ListClustersParams {
 	o.SetGetUnregisteredClusters(getUnregisteredClusters)
@@ -175,6 +234,17 @@ func (o *V2ListClustersParams) SetGetUnregisteredClusters(getUnregisteredCluster
 	o.GetUnregisteredClusters = getUnregisteredClusters
 }
 
+// WithLimit adds the limit to the v2 list clusters params
+func (o *V2ListClustersParams) WithLimit(limit *int64) *V2ListClustersParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the v2 list clusters params
+func (o *V2ListClustersParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
 // WithOpenshiftClusterID adds the openshiftClusterID to the v2 list clusters params
 func (o *V2ListClustersParams) WithOpenshiftClusterID(openshiftClusterID *strfmt.UUID) *V2ListClustersParams {
 	o.SetOpenshiftClusterID(openshiftClusterID)
@@ -186,6 +256,72 @@ func (o *V2ListClustersParams) SetOpenshiftClusterID(openshiftClusterID *strfmt.
 	o.OpenshiftClusterID = openshiftClusterID
 }
 
+// WithOpenshiftVersion adds the openshiftVersion to the v2 list clusters params
+func (o *V2ListClustersParams) WithOpenshiftVersion(openshiftVersion *string) *V2ListClustersParams {
+	o.SetOpenshiftVersion(openshiftVersion)
+	return o
+}
+
+// SetOpenshiftVersion adds the openshiftVersion to the v2 list clusters params
+func (o *V2ListClustersParams) SetOpenshiftVersion(openshiftVersion *string) {
+	o.OpenshiftVersion = openshiftVersion
+}
+
+// WithOrder adds the order to the v2 list clusters params
+func (o *V2ListClustersParams) WithOrder(order *string) *V2ListClustersParams {
+	o.SetOrder(order)
+	return o
+}
+
+// SetOrder adds the order to the v2 list clusters params
+func (o *V2ListClustersParams) SetOrder(order *string) {
+	o.Order = order
+}
+
+// WithOwner adds the owner to the v2 list clusters params
+func (o *V2ListClustersParams) WithOwner(owner *string) *V2ListClustersParams {
+	o.SetOwner(owner)
+	return o
+}
+
+// SetOwner adds the owner to the v2 list clusters params
+func (o *V2ListClustersParams) SetOwner(owner *string) {
+	o.Owner = owner
+}
+
+// WithPlatformType adds the platformType to the v2 list clusters params
+func (o *V2ListClustersParams) WithPlatformType(platformType *string) *V2ListClustersParams {
+	o.SetPlatformType(platformType)
+	return o
+}
+
+// SetPlatformType adds the platformType to the v2 list clusters params
+func (o *V2ListClustersParams) SetPlatformType(platformType *string) {
+	o.PlatformType = platformType
+}
+
+// WithSortBy adds the sortBy to the v2 list clusters params
+func (o *V2ListClustersParams) WithSortBy(sortBy *string) *V2ListClustersParams {
+	o.SetSortBy(sortBy)
+	return o
+}
+
+// SetSortBy adds the sortBy to the v2 list clusters params
+func (o *V2ListClustersParams) SetSortBy(sortBy *string) {
+	o.SortBy = sortBy
+}
+
+// WithStatus adds the status to the v2 list clusters params
+func (o *V2ListClustersParams) WithStatus(status []string) *V2ListClustersParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the v2 list clusters params
+func (o *V2ListClustersParams) SetStatus(status []string) {
+	o.Status = status
+}
+
 // WithWithHosts adds the withHosts to the v2 list clusters params
 func (o *V2ListClustersParams) WithWithHosts(withHosts bool) *V2ListClustersParams {
 	o.SetWithHosts(withHosts)
@@ -216,6 +352,23 @@ func (o *V2ListClustersParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 		}
 	}
 
+	if o.Continue != nil {
+
+		// query param continue
+		var qrContinue string
+
+		if o.Continue != nil {
+			qrContinue = *o.Continue
+		}
+		qContinue := qrContinue
+		if qContinue != "" {
+
+			if err := r.SetQueryParam("continue", qContinue); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.GetUnregisteredClusters != nil {
 
 		// header param get_unregistered_clusters
@@ -224,6 +377,23 @@ func (o *V2ListClustersParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 		}
 	}
 
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.OpenshiftClusterID != nil {
 
 		// query param openshift_cluster_id
@@ -241,6 +411,102 @@ func (o *V2ListClustersParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 		}
 	}
 
+	if o.OpenshiftVersion != nil {
+
+		// query param openshift_version
+		var qrOpenshiftVersion string
+
+		if o.OpenshiftVersion != nil {
+			qrOpenshiftVersion = *o.OpenshiftVersion
+		}
+		qOpenshiftVersion := qrOpenshiftVersion
+		if qOpenshiftVersion != "" {
+
+			if err := r.SetQueryParam("openshift_version", qOpenshiftVersion); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Order != nil {
+
+		// query param order
+		var qrOrder string
+
+		if o.Order != nil {
+			qrOrder = *o.Order
+		}
+		qOrder := qrOrder
+		if qOrder != "" {
+
+			if err := r.SetQueryParam("order", qOrder); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Owner != nil {
+
+		// query param owner
+		var qrOwner string
+
+		if o.Owner != nil {
+			qrOwner = *o.Owner
+		}
+		qOwner := qrOwner
+		if qOwner != "" {
+
+			if err := r.SetQueryParam("owner", qOwner); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.PlatformType != nil {
+
+		// query param platform_type
+		var qrPlatformType string
+
+		if o.PlatformType != nil {
+			qrPlatformType = *o.PlatformType
+		}
+		qPlatformType := qrPlatformType
+		if qPlatformType != "" {
+
+			if err := r.SetQueryParam("platform_type", qPlatformType); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.SortBy != nil {
+
+		// query param sort_by
+		var qrSortBy string
+
+		if o.SortBy != nil {
+			qrSortBy = *o.SortBy
+		}
+		qSortBy := qrSortBy
+		if qSortBy != "" {
+
+			if err := r.SetQueryParam("sort_by", qSortBy); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Status != nil {
+
+		// binding items for status
+		joinedStatus := o.bindParamStatus(reg)
+
+		// query array param status
+		if err := r.SetQueryParam("status", joinedStatus...); err != nil {
+			return err
+		}
+	}
+
 	// query param with_hosts
 	qrWithHosts := o.WithHosts
 	qWithHosts := swag.FormatBool(qrWithHosts)
@@ -271,3 +537,20 @@ func (o *V2ListClustersParams) bindParamAmsSubscriptionIds(formats strfmt.Regist
 
 	return amsSubscriptionIdsIS
 }
+
+// bindParamV2ListClusters binds the parameter status
+func (o *V2ListClustersParams) bindParamStatus(formats strfmt.Registry) []string {
+	statusIR := o.Status
+
+	var statusIC []string
+	for _, statusIIR := range statusIR { // explode []string
+
+		statusIIV := statusIIR // string as string
+		statusIC = append(statusIC, statusIIV)
+	}
+
+	// items.CollectionFormat: ""
+	statusIS := swag.JoinByFormat(statusIC, "")
+
+	return statusIS
+}
@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/openshift/assisted-service/models"
 )
@@ -74,6 +76,15 @@ func NewV2ListClustersOK() *V2ListClustersOK {
 Success.
 */
 type V2ListClustersOK struct {
+
+	/* A token to retrieve the next page, empty if this is the last page.
+	 */
+	XContinue string
+
+	/* The total number of Clusters matching the filters.
+	 */
+	XTotalCount int64
+
 	Payload models.ClusterList
 }
 
@@ -86,6 +97,24 @@ func (o *V2ListClustersOK) GetPayload() models.ClusterList {
 
 func (o *V2ListClustersOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Continue
+	hdrXContinue := response.GetHeader("X-Continue")
+
+	if hdrXContinue != "" {
+		o.XContinue = hdrXContinue
+	}
+
+	// hydrates response header X-Total-Count
+	hdrXTotalCount := response.GetHeader("X-Total-Count")
+
+	if hdrXTotalCount != "" {
+		valxTotalCount, err := swag.ConvertInt64(hdrXTotalCount)
+		if err != nil {
+			return errors.InvalidType("X-Total-Count", "header", "int64", hdrXTotalCount)
+		}
+		o.XTotalCount = valxTotalCount
+	}
+
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
 		return err
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewV2ListHostsParams creates a new V2ListHostsParams object,
@@ -59,6 +60,12 @@ func NewV2ListHostsParamsWithHTTPClient(client *http.Client) *V2ListHostsParams
 */
 type V2ListHostsParams struct {
 
+	/* Continue.
+
+	   The token returned in the X-Continue header of a previous response, used to retrieve the next page.
+	*/
+	Continue *string
+
 	/* InfraEnvID.
 
 	   The infra-env that the hosts are asociated with.
@@ -67,6 +74,36 @@ type V2ListHostsParams struct {
 	*/
 	InfraEnvID strfmt.UUID
 
+	/* Limit.
+
+	   The maximal number of Hosts to return. All matching Hosts are returned if not set.
+	*/
+	Limit *int64
+
+	/* Order.
+
+	   The direction in which the returned Hosts are sorted.
+	*/
+	Order *string
+
+	/* Role.
+
+	   If set, returned Hosts are filtered to those with the given role.
+	*/
+	Role *string
+
+	/* SortBy.
+
+	   The field used to sort the returned Hosts.
+	*/
+	SortBy *string
+
+	/* Status.
+
+	   If non-empty, returned Hosts are filtered to those in one of the given statuses.
+	*/
+	Status []string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -120,6 +157,17 @@ func (o *V2ListHostsParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithContinue adds the continueVar to the v2 list hosts params
+func (o *V2ListHostsParams) WithContinue(continueVar *string) *V2ListHostsParams {
+	o.SetContinue(continueVar)
+	return o
+}
+
+// SetContinue adds the continue to the v2 list hosts params
+func (o *V2ListHostsParams) SetContinue(continueVar *string) {
+	o.Continue = continueVar
+}
+
 // WithInfraEnvID adds the infraEnvID to the v2 list hosts params
 func (o *V2ListHostsParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2ListHostsParams {
 	o.SetInfraEnvID(infraEnvID)
@@ -131,6 +179,61 @@ func (o *V2ListHostsParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
 	o.InfraEnvID = infraEnvID
 }
 
+// WithLimit adds the limit to the v2 list hosts params
+func (o *V2ListHostsParams) WithLimit(limit *int64) *V2ListHostsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the v2 list hosts params
+func (o *V2ListHostsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithOrder adds the order to the v2 list hosts params
+func (o *V2ListHostsParams) WithOrder(order *string) *V2ListHostsParams {
+	o.SetOrder(order)
+	return o
+}
+
+// SetOrder adds the order to the v2 list hosts params
+func (o *V2ListHostsParams) SetOrder(order *string) {
+	o.Order = order
+}
+
+// WithRole adds the role to the v2 list hosts params
+func (o *V2ListHostsParams) WithRole(role *string) *V2ListHostsParams {
+	o.SetRole(role)
+	return o
+}
+
+// SetRole adds the role to the v2 list hosts params
+func (o *V2ListHostsParams) SetRole(role *string) {
+	o.Role = role
+}
+
+// WithSortBy adds the sortBy to the v2 list hosts params
+func (o *V2ListHostsParams) WithSortBy(sortBy *string) *V2ListHostsParams {
+	o.SetSortBy(sortBy)
+	return o
+}
+
+// SetSortBy adds the sortBy to the v2 list hosts params
+func (o *V2ListHostsParams) SetSortBy(sortBy *string) {
+	o.SortBy = sortBy
+}
+
+// WithStatus adds the status to the v2 list hosts params
+func (o *V2ListHostsParams) WithStatus(status []string) *V2ListHostsParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the v2 list hosts params
+func (o *V2ListHostsParams) SetStatus(status []string) {
+	o.Status = status
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *V2ListHostsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -139,13 +242,126 @@ func (o *V2ListHostsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 	}
 	var res []error
 
+	if o.Continue != nil {
+
+		// query param continue
+		var qrContinue string
+
+		if o.Continue != nil {
+			qrContinue = *o.Continue
+		}
+		qContinue := qrContinue
+		if qContinue != "" {
+
+			if err := r.SetQueryParam("continue", qContinue); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param infra_env_id
 	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
 		return err
 	}
 
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Order != nil {
+
+		// query param order
+		var qrOrder string
+
+		if o.Order != nil {
+			qrOrder = *o.Order
+		}
+		qOrder := qrOrder
+		if qOrder != "" {
+
+			if err := r.SetQueryParam("order", qOrder); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Role != nil {
+
+		// query param role
+		var qrRole string
+
+		if o.Role != nil {
+			qrRole = *o.Role
+		}
+		qRole := qrRole
+		if qRole != "" {
+
+			if err := r.SetQueryParam("role", qRole); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.SortBy != nil {
+
+		// query param sort_by
+		var qrSortBy string
+
+		if o.SortBy != nil {
+			qrSortBy = *o.SortBy
+		}
+		qSortBy := qrSortBy
+		if qSortBy != "" {
+
+			if err := r.SetQueryParam("sort_by", qSortBy); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Status != nil {
+
+		// binding items for status
+		joinedStatus := o.bindParamStatus(reg)
+
+		// query array param status
+		if err := r.SetQueryParam("status", joinedStatus...); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
+
+// bindParamV2ListHosts binds the parameter status
+func (o *V2ListHostsParams) bindParamStatus(formats strfmt.Registry) []string {
+	statusIR := o.Status
+
+	var statusIC []string
+	for _, statusIIR := range statusIR { // explode []string
+
+		statusIIV := statusIIR // string as string
+		statusIC = append(statusIC, statusIIV)
+	}
+
+	// items.CollectionFormat: ""
+	statusIS := swag.JoinByFormat(statusIC, "")
+
+	return statusIS
+}
@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/openshift/assisted-service/models"
 )
@@ -80,6 +82,15 @@ func NewV2ListHostsOK() *V2ListHostsOK {
 Success.
 */
 type V2ListHostsOK struct {
+
+	/* A token to retrieve the next page, empty if this is the last page.
+	 */
+	XContinue string
+
+	/* The total number of Hosts matching the filters.
+	 */
+	XTotalCount int64
+
 	Payload models.HostList
 }
 
@@ -92,6 +103,24 @@ func (o *V2ListHostsOK) GetPayload() models.HostList {
 
 func (o *V2ListHostsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Continue
+	hdrXContinue := response.GetHeader("X-Continue")
+
+	if hdrXContinue != "" {
+		o.XContinue = hdrXContinue
+	}
+
+	// hydrates response header X-Total-Count
+	hdrXTotalCount := response.GetHeader("X-Total-Count")
+
+	if hdrXTotalCount != "" {
+		valxTotalCount, err := swag.ConvertInt64(hdrXTotalCount)
+		if err != nil {
+			return errors.InvalidType("X-Total-Count", "header", "int64", hdrXTotalCount)
+		}
+		o.XTotalCount = valxTotalCount
+	}
+
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
 		return err
@@ -15,9 +15,11 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/kelseyhightower/envconfig"
+	"github.com/openshift/assisted-service/internal/audit"
 	"github.com/openshift/assisted-service/internal/bminventory"
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
+	"github.com/openshift/assisted-service/internal/clusterbundle"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/connectivity"
 	"github.com/openshift/assisted-service/internal/controller/controllers"
@@ -42,9 +44,11 @@ import (
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/handler"
 	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/rolebindings"
 	"github.com/openshift/assisted-service/internal/spec"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/internal/versions"
+	"github.com/openshift/assisted-service/internal/webhooks"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/app"
 	"github.com/openshift/assisted-service/pkg/auth"
@@ -141,6 +145,8 @@ var Options struct {
 	LivenessValidationTimeout      time.Duration `envconfig:"LIVENESS_VALIDATION_TIMEOUT" default:"5m"`
 	V1APIEnabled                   bool          `envconfig:"V1_API_ENABLED" default:"true"`
 	ApproveCsrsRequeueDuration     time.Duration `envconfig:"APPROVE_CSRS_REQUEUE_DURATION" default:"1m"`
+	WebhooksConfig                 webhooks.Config
+	AuditConfig                    audit.Config
 }
 
 func InitLogs() *logrus.Entry {
@@ -245,7 +251,11 @@ func main() {
 	usageManager := usage.NewManager(log)
 
 	crdEventsHandler := createCRDEventsHandler()
-	eventsHandler := createEventsHandler(crdEventsHandler, db, log)
+	webhooksManager := webhooks.NewManager(db, log.WithField("pkg", "webhooks"), Options.WebhooksConfig)
+	webhooksManager.Start()
+	defer webhooksManager.Stop()
+	auditManager := audit.NewManager(db, log.WithField("pkg", "audit"))
+	eventsHandler := createEventsHandler(crdEventsHandler, webhooksManager, db, log)
 
 	prometheusRegistry := prometheus.DefaultRegisterer
 	metricsManager := metrics.NewMetricsManager(prometheusRegistry, eventsHandler)
@@ -290,6 +300,7 @@ func main() {
 	hwValidator := hardware.NewValidator(log.WithField("pkg", "validators"), Options.HWValidatorConfig, operatorsManager)
 	connectivityValidator := connectivity.NewValidator(log.WithField("pkg", "validators"))
 	Options.InstructionConfig.DisabledSteps = disableFreeAddressesIfNeeded(Options.EnableKubeAPI, Options.InstructionConfig.DisabledSteps)
+	Options.HostConfig.DisabledSteps = Options.InstructionConfig.DisabledSteps
 	instructionApi := hostcommands.NewInstructionManager(log.WithField("pkg", "instructions"), db, hwValidator,
 		releaseHandler, Options.InstructionConfig, connectivityValidator, eventsHandler, versionHandler)
 
@@ -379,7 +390,7 @@ func main() {
 
 	if Options.EnableDeregisterInactiveGC || Options.EnableDeletedUnregisteredGC {
 		gc := garbagecollector.NewGarbageCollectors(Options.GCConfig, db, log.WithField("pkg", "garbage_collector"),
-			hostApi, clusterApi, infraEnvApi, objectHandler, lead)
+			hostApi, clusterApi, infraEnvApi, objectHandler, lead, auditManager)
 
 		// In operator-deployment, ClusterDeployment is responsible for managing the lifetime of the cluster resource.
 		if !Options.EnableKubeAPI && Options.EnableDeregisterInactiveGC {
@@ -422,18 +433,30 @@ func main() {
 		lead, pullSecretValidator, versionHandler, isoEditorFactory, crdUtils, ignitionBuilder, hwValidator, dnsApi, installConfigBuilder, staticNetworkConfig,
 		Options.GCConfig, providerRegistry)
 
-	events := events.NewApi(eventsHandler, logrus.WithField("pkg", "eventsApi"))
+	eventsStreamer := events.NewStreamer(db, log.WithField("pkg", "events-stream"))
+	eventsStreamer.Start()
+	defer eventsStreamer.Stop()
+	events := events.NewApi(eventsHandler, eventsStreamer, logrus.WithField("pkg", "eventsApi"))
 	expirer := imgexpirer.NewManager(objectHandler, eventsHandler, Options.BMConfig.ImageExpirationTime, lead, Options.EnableKubeAPI)
 	imageExpirationMonitor := thread.New(
 		log.WithField("pkg", "image-expiration-monitor"), "Image Expiration Monitor", Options.ImageExpirationInterval, expirer.ExpirationTask)
 	imageExpirationMonitor.Start()
 	defer imageExpirationMonitor.Stop()
 
+	webhookDeliveryWorker := thread.New(
+		log.WithField("pkg", "webhook-delivery"), "Webhook Delivery Worker", Options.WebhooksConfig.DeliveryInterval, webhooksManager.DeliveryTask)
+	webhookDeliveryWorker.Start()
+	defer webhookDeliveryWorker.Stop()
+
 	//Set inner handler chain. Inner handlers requires access to the Route
 	innerHandler := func() func(http.Handler) http.Handler {
 		return func(h http.Handler) http.Handler {
 			wrapped := metrics.WithMatchedRoute(log.WithField("pkg", "matched-h"), prometheusRegistry)(h)
 
+			if Options.AuditConfig.Enabled {
+				wrapped = app.WithAuditMiddleware(auditManager, Options.Auth.AuthType, Options.AuditConfig.IncludeAgentOperations)(wrapped)
+			}
+
 			if Options.EnableElasticAPM {
 				// For APM metrics, we only want to trace openapi (internal) requests.
 				// We are generating our own transaction name since we are wrapping the lower
@@ -449,13 +472,14 @@ func main() {
 	}
 
 	operatorsHandler := handler.NewHandler(operatorsManager, log.WithField("pkg", "operators"), db, eventsHandler, clusterApi)
+	clusterBundlesHandler := clusterbundle.NewHandler(db, log.WithField("pkg", "cluster-bundles"), bm, manifestsApi, objectHandler)
 	h, err := restapi.Handler(restapi.Config{
 		AuthAgentAuth:       authHandler.AuthAgentAuth,
 		AuthUserAuth:        authHandler.AuthUserAuth,
 		AuthURLAuth:         authHandler.AuthURLAuth,
 		AuthImageAuth:       authHandler.AuthImageAuth,
 		APIKeyAuthenticator: authHandler.CreateAuthenticator(),
-		Authorizer:          authzHandler.CreateAuthorizer(),
+		Authorizer:          app.WithAuditAuthorizer(authzHandler.CreateAuthorizer()),
 		InstallerAPI:        bm,
 		EventsAPI:           events,
 		Logger:              log.Printf,
@@ -464,6 +488,10 @@ func main() {
 		InnerMiddleware:     innerHandler(),
 		ManifestsAPI:        manifestsApi,
 		OperatorsAPI:        operatorsHandler,
+		WebhooksAPI:         webhooksManager,
+		ClusterBundlesAPI:   clusterBundlesHandler,
+		AuditAPI:            auditManager,
+		RoleBindingsAPI:     rolebindings.NewManager(db, log.WithField("pkg", "role-bindings")),
 	})
 	failOnError(err, "Failed to init rest handler")
 
@@ -478,6 +506,7 @@ func main() {
 		h = app.SetupCORSMiddleware(h, allowedDomains)
 	}
 
+	h = app.WithImageTokenQueryMiddleware(h)
 	h = app.WithMetricsResponderMiddleware(h)
 	h = app.WithHealthMiddleware(h, []*thread.Thread{hostStateMonitor, clusterStateMonitor},
 		log.WithField("pkg", "healthcheck"), Options.LivenessValidationTimeout)
@@ -493,14 +522,15 @@ func main() {
 	go func() {
 		if Options.EnableKubeAPI {
 			failOnError((&controllers.InfraEnvReconciler{
-				Client:           ctrlMgr.GetClient(),
-				APIReader:        ctrlMgr.GetAPIReader(),
-				Config:           Options.InfraEnvConfig,
-				Log:              log,
-				Installer:        bm,
-				CRDEventsHandler: crdEventsHandler,
-				ServiceBaseURL:   Options.BMConfig.ServiceBaseURL,
-				AuthType:         Options.Auth.AuthType,
+				Client:              ctrlMgr.GetClient(),
+				APIReader:           ctrlMgr.GetAPIReader(),
+				Config:              Options.InfraEnvConfig,
+				Log:                 log,
+				Installer:           bm,
+				CRDEventsHandler:    crdEventsHandler,
+				ServiceBaseURL:      Options.BMConfig.ServiceBaseURL,
+				AuthType:            Options.Auth.AuthType,
+				ImageExpirationTime: Options.BMConfig.ImageExpirationTime,
 			}).SetupWithManager(ctrlMgr), "unable to create controller InfraEnv")
 
 			failOnError((&controllers.ClusterDeploymentsReconciler{
@@ -700,8 +730,8 @@ func autoMigrationWithLeader(migrationLeader leader.ElectorInterface, db *gorm.D
 	})
 }
 
-func createEventsHandler(crdEventsHandler controllers.CRDEventsHandler, db *gorm.DB, log logrus.FieldLogger) eventsapi.Handler {
-	eventsHandler := events.New(db, log.WithField("pkg", "events"))
+func createEventsHandler(crdEventsHandler controllers.CRDEventsHandler, webhooksManager *webhooks.Manager, db *gorm.DB, log logrus.FieldLogger) eventsapi.Handler {
+	eventsHandler := webhooks.NewEventsWrapper(events.New(db, log.WithField("pkg", "events")), webhooksManager)
 
 	if crdEventsHandler != nil {
 		return controllers.NewControllerEventsWrapper(crdEventsHandler, eventsHandler, db, log)
@@ -0,0 +1,114 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/audit"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Config struct {
+	Enabled                bool `envconfig:"AUDIT_ENABLED" default:"true"`
+	IncludeAgentOperations bool `envconfig:"AUDIT_INCLUDE_AGENT_OPERATIONS" default:"false"`
+}
+
+//go:generate mockgen -package=audit -destination=mock_audit_api.go . API
+type API interface {
+	restapi.AuditAPI
+	// Record stores the audit record of an API call
+	Record(ctx context.Context, record *models.AuditRecord)
+	// DeleteExpiredRecords permanently deletes the audit records of the API calls made before olderThan
+	DeleteExpiredRecords(ctx context.Context, olderThan strfmt.DateTime) error
+}
+
+// Manager stores the audit records of the mutating API calls and lists them to the admins
+type Manager struct {
+	db  *gorm.DB
+	log logrus.FieldLogger
+}
+
+var _ API = &Manager{}
+
+func NewManager(db *gorm.DB, log logrus.FieldLogger) *Manager {
+	return &Manager{
+		db:  db,
+		log: log,
+	}
+}
+
+func (m *Manager) Record(ctx context.Context, record *models.AuditRecord) {
+	log := logutil.FromContext(ctx, m.log)
+	if err := m.db.Create(record).Error; err != nil {
+		log.WithError(err).Errorf("failed to store the audit record of %s %s", *record.Method, *record.Path)
+	}
+}
+
+func (m *Manager) DeleteExpiredRecords(ctx context.Context, olderThan strfmt.DateTime) error {
+	log := logutil.FromContext(ctx, m.log)
+	result := m.db.Where("created_at < ?", time.Time(olderThan)).Delete(&models.AuditRecord{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		log.Infof("Deleted %d audit records of API calls made before %s", result.RowsAffected, olderThan)
+	}
+	return nil
+}
+
+func (m *Manager) V2ListAuditRecords(ctx context.Context, params operations.V2ListAuditRecordsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+
+	db := m.db.Model(&models.AuditRecord{})
+	if params.ClusterID != nil {
+		db = db.Where("cluster_id = ?", params.ClusterID.String())
+	}
+	if params.HostID != nil {
+		db = db.Where("host_id = ?", params.HostID.String())
+	}
+	if params.InfraEnvID != nil {
+		db = db.Where("infra_env_id = ?", params.InfraEnvID.String())
+	}
+	if params.UserName != nil {
+		db = db.Where("user_name = ?", *params.UserName)
+	}
+	if params.OperationID != nil {
+		db = db.Where("operation_id = ?", *params.OperationID)
+	}
+	if params.Since != nil {
+		db = db.Where("created_at >= ?", time.Time(*params.Since))
+	}
+	if params.Until != nil {
+		db = db.Where("created_at <= ?", time.Time(*params.Until))
+	}
+	// the filtered query is shared by the count and the list queries
+	db = db.Session(&gorm.Session{})
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		log.WithError(err).Error("failed to count audit records")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	records := models.AuditRecordList{}
+	db = db.Order("created_at DESC")
+	if params.Offset != nil {
+		db = db.Offset(int(*params.Offset))
+	}
+	if params.Limit != nil {
+		db = db.Limit(int(*params.Limit))
+	}
+	if err := db.Find(&records).Error; err != nil {
+		log.WithError(err).Error("failed to list audit records")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return operations.NewV2ListAuditRecordsOK().WithPayload(records).WithXTotalCount(total)
+}
@@ -0,0 +1,127 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/audit"
+	"gorm.io/gorm"
+)
+
+func TestAudit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "audit tests")
+}
+
+var _ = Describe("Audit", func() {
+	var (
+		db        *gorm.DB
+		dbName    string
+		manager   *Manager
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		manager = NewManager(db, common.GetTestLog())
+		clusterID = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	record := func(userName, operationID string, clusterID *strfmt.UUID, createdAt time.Time) {
+		id := strfmt.UUID(uuid.New().String())
+		manager.Record(ctx, &models.AuditRecord{
+			ID:          &id,
+			CreatedAt:   createdAt,
+			UserName:    userName,
+			OperationID: swag.String(operationID),
+			Method:      swag.String(http.MethodPatch),
+			Path:        swag.String("/api/assisted-install/v2/clusters"),
+			ClusterID:   clusterID,
+			StatusCode:  swag.Int64(http.StatusCreated),
+			Result:      swag.String(models.AuditRecordResultSucceeded),
+		})
+	}
+
+	list := func(params operations.V2ListAuditRecordsParams) (models.AuditRecordList, int64) {
+		reply := manager.V2ListAuditRecords(ctx, params)
+		Expect(reply).To(BeAssignableToTypeOf(&operations.V2ListAuditRecordsOK{}))
+		ok := reply.(*operations.V2ListAuditRecordsOK)
+		return ok.Payload, ok.XTotalCount
+	}
+
+	Context("V2ListAuditRecords", func() {
+		var now time.Time
+
+		BeforeEach(func() {
+			now = time.Now()
+			otherClusterID := strfmt.UUID(uuid.New().String())
+			record("alice", "V2UpdateCluster", &clusterID, now.Add(-3*time.Hour))
+			record("bob", "v2InstallCluster", &clusterID, now.Add(-2*time.Hour))
+			record("alice", "V2UpdateCluster", &otherClusterID, now.Add(-time.Hour))
+		})
+
+		It("lists all the records, most recent first", func() {
+			records, total := list(operations.V2ListAuditRecordsParams{})
+			Expect(total).To(Equal(int64(3)))
+			Expect(records).To(HaveLen(3))
+			Expect(records[0].CreatedAt).To(BeTemporally(">", records[1].CreatedAt))
+			Expect(records[1].CreatedAt).To(BeTemporally(">", records[2].CreatedAt))
+		})
+
+		It("filters by cluster, user and operation", func() {
+			records, total := list(operations.V2ListAuditRecordsParams{ClusterID: &clusterID})
+			Expect(total).To(Equal(int64(2)))
+			Expect(records).To(HaveLen(2))
+
+			records, _ = list(operations.V2ListAuditRecordsParams{ClusterID: &clusterID, UserName: swag.String("alice")})
+			Expect(records).To(HaveLen(1))
+			Expect(swag.StringValue(records[0].OperationID)).To(Equal("V2UpdateCluster"))
+
+			records, _ = list(operations.V2ListAuditRecordsParams{OperationID: swag.String("v2InstallCluster")})
+			Expect(records).To(HaveLen(1))
+			Expect(records[0].UserName).To(Equal("bob"))
+		})
+
+		It("filters by time", func() {
+			since := strfmt.DateTime(now.Add(-150 * time.Minute))
+			until := strfmt.DateTime(now.Add(-90 * time.Minute))
+			records, _ := list(operations.V2ListAuditRecordsParams{Since: &since, Until: &until})
+			Expect(records).To(HaveLen(1))
+			Expect(records[0].UserName).To(Equal("bob"))
+		})
+
+		It("pages the records", func() {
+			records, total := list(operations.V2ListAuditRecordsParams{Limit: swag.Int64(2), Offset: swag.Int64(1)})
+			Expect(total).To(Equal(int64(3)))
+			Expect(records).To(HaveLen(2))
+			Expect(records[0].UserName).To(Equal("bob"))
+		})
+	})
+
+	It("deletes the expired records", func() {
+		record("alice", "V2UpdateCluster", &clusterID, time.Now().Add(-100*24*time.Hour))
+		record("alice", "V2UpdateCluster", &clusterID, time.Now())
+
+		Expect(manager.DeleteExpiredRecords(ctx, strfmt.DateTime(time.Now().Add(-90*24*time.Hour)))).To(Succeed())
+
+		records, total := list(operations.V2ListAuditRecordsParams{})
+		Expect(total).To(Equal(int64(1)))
+		Expect(records[0].CreatedAt).To(BeTemporally(">", time.Now().Add(-time.Hour)))
+	})
+})
@@ -16,6 +16,7 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
@@ -47,6 +48,8 @@ import (
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/ocs"
+	"github.com/openshift/assisted-service/internal/provider/nutanix"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/internal/versions"
@@ -81,6 +84,8 @@ const (
 	MediaDisconnected int64 = 256
 	// 125 is the generic exit code for cases the error is in podman / docker and not the container we tried to run
 	ContainerAlreadyRunningExitCode = 125
+	// 127 is the exit code of podman when the command can't be found in the container
+	CommandNotFoundExitCode = 127
 )
 
 type Config struct {
@@ -135,6 +140,7 @@ type InstallerInternals interface {
 	GetClusterByKubeKey(key types.NamespacedName) (*common.Cluster, error)
 	GetHostByKubeKey(key types.NamespacedName) (*common.Host, error)
 	InstallClusterInternal(ctx context.Context, params installer.V2InstallClusterParams) (*common.Cluster, error)
+	DryRunInstallClusterInternal(ctx context.Context, params installer.V2DryRunInstallClusterParams) (*models.DryRunResult, error)
 	DeregisterClusterInternal(ctx context.Context, params installer.V2DeregisterClusterParams) error
 	DeregisterHostInternal(ctx context.Context, params installer.DeregisterHostParams) error
 	V2DeregisterHostInternal(ctx context.Context, params installer.V2DeregisterHostParams) error
@@ -378,9 +384,15 @@ func (b *bareMetalInventory) setDefaultRegisterClusterParams(_ context.Context,
 	if params.NewClusterParams.Hyperthreading == nil {
 		params.NewClusterParams.Hyperthreading = swag.String(models.ClusterHyperthreadingAll)
 	}
+	if params.NewClusterParams.RoleAssignmentStrategy == nil {
+		params.NewClusterParams.RoleAssignmentStrategy = swag.String(models.ClusterRoleAssignmentStrategyFirstFit)
+	}
 	if params.NewClusterParams.SchedulableMasters == nil {
 		params.NewClusterParams.SchedulableMasters = swag.Bool(false)
 	}
+	if params.NewClusterParams.Fips == nil {
+		params.NewClusterParams.Fips = swag.Bool(false)
+	}
 	if params.NewClusterParams.Platform == nil {
 		params.NewClusterParams.Platform = &models.Platform{
 			Type: common.PlatformTypePtr(models.PlatformTypeBaremetal),
@@ -445,6 +457,12 @@ func (b *bareMetalInventory) validateRegisterClusterInternalParams(params *insta
 		}
 	}
 
+	if platform := params.NewClusterParams.Platform; platform != nil && common.PlatformTypeValue(platform.Type) == models.PlatformTypeNutanix {
+		if err = nutanix.ValidatePlatformParams(platform); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
 	if params.NewClusterParams.AdditionalNtpSource != nil {
 		ntpSource := swag.StringValue(params.NewClusterParams.AdditionalNtpSource)
 
@@ -506,6 +524,12 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	if swag.BoolValue(params.NewClusterParams.Fips) {
+		if err = common.ValidateFIPSSupport(swag.StringValue(params.NewClusterParams.OpenshiftVersion), cpuArchitecture); err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
 	releaseImage, err := b.versionsHandler.GetReleaseImage(
 		swag.StringValue(params.NewClusterParams.OpenshiftVersion), cpuArchitecture)
 	if err != nil {
@@ -541,41 +565,47 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 
 	cluster := common.Cluster{
 		Cluster: models.Cluster{
-			ID:                    &id,
-			Href:                  swag.String(url.String()),
-			Kind:                  swag.String(models.ClusterKindCluster),
-			BaseDNSDomain:         params.NewClusterParams.BaseDNSDomain,
-			IngressVip:            params.NewClusterParams.IngressVip,
-			Name:                  swag.StringValue(params.NewClusterParams.Name),
-			OpenshiftVersion:      *releaseImage.Version,
-			OcpReleaseImage:       *releaseImage.URL,
-			SSHPublicKey:          params.NewClusterParams.SSHPublicKey,
-			UserName:              ocm.UserNameFromContext(ctx),
-			OrgID:                 ocm.OrgIDFromContext(ctx),
-			EmailDomain:           ocm.EmailDomainFromContext(ctx),
-			HTTPProxy:             swag.StringValue(params.NewClusterParams.HTTPProxy),
-			HTTPSProxy:            swag.StringValue(params.NewClusterParams.HTTPSProxy),
-			NoProxy:               swag.StringValue(params.NewClusterParams.NoProxy),
-			VipDhcpAllocation:     params.NewClusterParams.VipDhcpAllocation,
-			NetworkType:           params.NewClusterParams.NetworkType,
-			UserManagedNetworking: params.NewClusterParams.UserManagedNetworking,
-			AdditionalNtpSource:   swag.StringValue(params.NewClusterParams.AdditionalNtpSource),
-			MonitoredOperators:    monitoredOperators,
-			HighAvailabilityMode:  params.NewClusterParams.HighAvailabilityMode,
-			Hyperthreading:        swag.StringValue(params.NewClusterParams.Hyperthreading),
-			SchedulableMasters:    params.NewClusterParams.SchedulableMasters,
-			Platform:              params.NewClusterParams.Platform,
-			ClusterNetworks:       params.NewClusterParams.ClusterNetworks,
-			ServiceNetworks:       params.NewClusterParams.ServiceNetworks,
-			MachineNetworks:       params.NewClusterParams.MachineNetworks,
-			CPUArchitecture:       cpuArchitecture,
-			IgnitionEndpoint:      params.NewClusterParams.IgnitionEndpoint,
+			ID:                     &id,
+			Href:                   swag.String(url.String()),
+			Kind:                   swag.String(models.ClusterKindCluster),
+			BaseDNSDomain:          params.NewClusterParams.BaseDNSDomain,
+			IngressVip:             params.NewClusterParams.IngressVip,
+			Name:                   swag.StringValue(params.NewClusterParams.Name),
+			OpenshiftVersion:       *releaseImage.Version,
+			OcpReleaseImage:        *releaseImage.URL,
+			SSHPublicKey:           params.NewClusterParams.SSHPublicKey,
+			UserName:               ocm.UserNameFromContext(ctx),
+			OrgID:                  ocm.OrgIDFromContext(ctx),
+			EmailDomain:            ocm.EmailDomainFromContext(ctx),
+			HTTPProxy:              swag.StringValue(params.NewClusterParams.HTTPProxy),
+			HTTPSProxy:             swag.StringValue(params.NewClusterParams.HTTPSProxy),
+			NoProxy:                swag.StringValue(params.NewClusterParams.NoProxy),
+			VipDhcpAllocation:      params.NewClusterParams.VipDhcpAllocation,
+			NetworkType:            params.NewClusterParams.NetworkType,
+			UserManagedNetworking:  params.NewClusterParams.UserManagedNetworking,
+			AdditionalNtpSource:    swag.StringValue(params.NewClusterParams.AdditionalNtpSource),
+			MonitoredOperators:     monitoredOperators,
+			HighAvailabilityMode:   params.NewClusterParams.HighAvailabilityMode,
+			Hyperthreading:         swag.StringValue(params.NewClusterParams.Hyperthreading),
+			RoleAssignmentStrategy: swag.StringValue(params.NewClusterParams.RoleAssignmentStrategy),
+			SchedulableMasters:     params.NewClusterParams.SchedulableMasters,
+			Fips:                   params.NewClusterParams.Fips,
+			Platform:               params.NewClusterParams.Platform,
+			ClusterNetworks:        params.NewClusterParams.ClusterNetworks,
+			ServiceNetworks:        params.NewClusterParams.ServiceNetworks,
+			MachineNetworks:        params.NewClusterParams.MachineNetworks,
+			CPUArchitecture:        cpuArchitecture,
+			IgnitionEndpoint:       params.NewClusterParams.IgnitionEndpoint,
 		},
 		KubeKeyName:             kubeKey.Name,
 		KubeKeyNamespace:        kubeKey.Namespace,
 		TriggerMonitorTimestamp: time.Now(),
 	}
 
+	if err = setOCSExternalClusterDetails(&cluster, monitoredOperators); err != nil {
+		return nil, err
+	}
+
 	createNetworkParamsCompatibilityPropagation(params)
 
 	// TODO MGMT-7365: Deprecate single network
@@ -1019,7 +1049,7 @@ func (b *bareMetalInventory) updateImageInfoPostUpload(ctx context.Context, infr
 	return nil
 }
 
-func (b *bareMetalInventory) updateExternalImageInfo(ctx context.Context, infraEnv *common.InfraEnv, infraEnvProxyHash string, imageType models.ImageType) error {
+func (b *bareMetalInventory) updateExternalImageInfo(ctx context.Context, infraEnv *common.InfraEnv, infraEnvProxyHash string, imageType models.ImageType, kernelArguments []string) error {
 	updates := map[string]interface{}{}
 
 	// this is updated before now for the v2 (infraEnv) case, but not in the cluster ISO case so we need to check if we should save it here
@@ -1032,6 +1062,7 @@ func (b *bareMetalInventory) updateExternalImageInfo(ctx context.Context, infraE
 		prevType    string
 		prevVersion string
 		prevArch    string
+		prevKargs   string
 	)
 	if infraEnv.DownloadURL != "" {
 		currentURL, err := url.Parse(infraEnv.DownloadURL)
@@ -1042,7 +1073,9 @@ func (b *bareMetalInventory) updateExternalImageInfo(ctx context.Context, infraE
 		prevType = vals.Get("type")
 		prevVersion = vals.Get("version")
 		prevArch = vals.Get("arch")
+		prevKargs = vals.Get("kargs")
 	}
+	kargs := strings.Join(kernelArguments, " ")
 
 	updates["type"] = imageType
 	infraEnv.Type = common.ImageTypePtr(imageType)
@@ -1064,9 +1097,9 @@ func (b *bareMetalInventory) updateExternalImageInfo(ctx context.Context, infraE
 		arch = *osImage.CPUArchitecture
 	}
 
-	if string(imageType) != prevType || version != prevVersion || arch != prevArch || !infraEnv.Generated {
+	if string(imageType) != prevType || version != prevVersion || arch != prevArch || kargs != prevKargs || !infraEnv.Generated {
 		var expiresAt *strfmt.DateTime
-		infraEnv.DownloadURL, expiresAt, err = b.generateImageDownloadURL(ctx, infraEnv.ID.String(), string(imageType), version, arch, infraEnv.ImageTokenKey)
+		infraEnv.DownloadURL, expiresAt, err = b.generateImageDownloadURL(ctx, infraEnv.ID.String(), string(imageType), version, arch, kargs, infraEnv.ImageTokenKey)
 		if err != nil {
 			return errors.Wrap(err, "failed to create download URL")
 		}
@@ -1166,9 +1199,15 @@ func (b *bareMetalInventory) createAndUploadNewImage(ctx context.Context, log lo
 		return err
 	}
 
+	kernelArguments, err := b.discoveryKernelArguments(infraEnv)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get kernel arguments for infra env %s", infraEnv.ID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
 	// return without generating the image if we're using the image service or if the image has already been generated
 	if b.ImageServiceBaseURL != "" {
-		if err = b.updateExternalImageInfo(ctx, infraEnv, infraEnvProxyHash, imageType); err != nil {
+		if err = b.updateExternalImageInfo(ctx, infraEnv, infraEnvProxyHash, imageType, kernelArguments); err != nil {
 			return err
 		}
 
@@ -1183,6 +1222,10 @@ func (b *bareMetalInventory) createAndUploadNewImage(ctx context.Context, log lo
 		return nil
 	}
 
+	if err = b.validateFIPSImageType(len(kernelArguments) > 0, imageType); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
 	// Setting ImageInfo.Type at this point in order to pass it to FormatDiscoveryIgnitionFile without saving it to the DB.
 	// Saving it to the DB will be done after a successful image generation by updateImageInfoPostUpload
 	infraEnv.Type = common.ImageTypePtr(imageType)
@@ -1196,7 +1239,7 @@ func (b *bareMetalInventory) createAndUploadNewImage(ctx context.Context, log lo
 	objectPrefix := fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, infraEnv.ID.String())
 
 	if imageType == models.ImageTypeMinimalIso {
-		if err := b.generateClusterMinimalISO(ctx, log, infraEnv, ignitionConfig, objectPrefix); err != nil {
+		if err := b.generateClusterMinimalISO(ctx, log, infraEnv, ignitionConfig, objectPrefix, kernelArguments); err != nil {
 			log.WithError(err).Errorf("Failed to generate minimal ISO for cluster %s", infraEnv.ID)
 			eventgen.SendGenerateMinimalIsoFailedEvent(ctx, b.eventsHandler, *infraEnv.ID)
 
@@ -1249,7 +1292,7 @@ func (b *bareMetalInventory) getIgnitionConfigForLogging(ctx context.Context, in
 }
 
 func (b *bareMetalInventory) generateClusterMinimalISO(ctx context.Context, log logrus.FieldLogger,
-	infraEnv *common.InfraEnv, ignitionConfig, objectPrefix string) error {
+	infraEnv *common.InfraEnv, ignitionConfig, objectPrefix string, kernelArguments []string) error {
 
 	baseISOName, err := b.objectHandler.GetMinimalIsoObjectName(infraEnv.OpenshiftVersion, infraEnv.CPUArchitecture)
 	if err != nil {
@@ -1282,7 +1325,7 @@ func (b *bareMetalInventory) generateClusterMinimalISO(ctx context.Context, log
 			HTTPSProxy: httpsProxy,
 			NoProxy:    noProxy,
 		}
-		clusterISOPath, createError = editor.CreateClusterMinimalISO(ignitionConfig, netFiles, &infraEnvProxyInfo)
+		clusterISOPath, createError = editor.CreateClusterMinimalISO(ignitionConfig, netFiles, &infraEnvProxyInfo, kernelArguments)
 		return createError
 	})
 
@@ -1300,6 +1343,21 @@ func (b *bareMetalInventory) generateClusterMinimalISO(ctx context.Context, log
 	return os.Remove(clusterISOPath)
 }
 
+// discoveryKernelArguments returns the kernel arguments the discovery image adds for the cluster the infra-env is bound to
+func (b *bareMetalInventory) discoveryKernelArguments(infraEnv *common.InfraEnv) ([]string, error) {
+	if infraEnv.ClusterID == "" {
+		return nil, nil
+	}
+	cluster, err := common.GetClusterFromDB(b.db, infraEnv.ClusterID, common.SkipEagerLoading)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get cluster %s", infraEnv.ClusterID)
+	}
+	if swag.BoolValue(cluster.Fips) {
+		return []string{common.FIPSKernelArgument}, nil
+	}
+	return nil, nil
+}
+
 func getImageName(infraEnvID *strfmt.UUID) string {
 	return fmt.Sprintf("%s.iso", fmt.Sprintf(s3wrapper.DiscoveryImageTemplate, infraEnvID.String()))
 }
@@ -1746,6 +1804,21 @@ func (b *bareMetalInventory) UpdateClusterInstallConfigInternal(ctx context.Cont
 	return &cluster, nil
 }
 
+func (b *bareMetalInventory) ValidateClusterInstallConfigInternal(ctx context.Context, params installer.V2ValidateClusterInstallConfigParams) (*models.InstallConfigOverridesValidation, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	cluster, err := common.GetClusterFromDB(b.db, params.ClusterID, common.UseEagerLoading)
+	if err != nil {
+		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	return b.installConfigBuilder.ValidateInstallConfigOverrides(cluster, params.InstallConfigParams)
+}
+
 func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, cluster common.Cluster) error {
 	log := logutil.FromContext(ctx, b.log)
 
@@ -1771,6 +1844,132 @@ func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, c
 	return nil
 }
 
+// DryRunInstallClusterInternal renders the install config and ignition files of the cluster without installing it. The
+// files are rendered from an in-memory copy of the cluster prepared for installation, so the cluster and its hosts stay
+// in their current state.
+func (b *bareMetalInventory) DryRunInstallClusterInternal(ctx context.Context, params installer.V2DryRunInstallClusterParams) (*models.DryRunResult, error) {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster *common.Cluster
+	var err error
+
+	log.Infof("dry-run installation of cluster %s", params.ClusterID)
+	if cluster, err = common.GetClusterFromDBWithoutDisabledHosts(b.db, params.ClusterID); err != nil {
+		return nil, common.NewApiError(http.StatusNotFound, err)
+	}
+
+	result := &models.DryRunResult{ClusterID: &params.ClusterID}
+	if ok, reason := b.clusterApi.IsReadyForInstallation(cluster); !ok {
+		result.ValidationErrors = dryRunValidationErrors(cluster)
+		return b.dryRunFailed(ctx, result, errors.Errorf("Cluster is not ready for installation, %s", reason)), nil
+	}
+
+	prepared, err := dryRunPreparedCluster(cluster)
+	if err != nil {
+		return b.dryRunFailed(ctx, result, errors.Wrap(err, "failed to prepare the cluster for installation")), nil
+	}
+
+	if err = b.clusterApi.GenerateAdditionalManifests(ctx, prepared); err != nil {
+		log.WithError(err).Errorf("Failed to generated additional cluster manifest")
+		return b.dryRunFailed(ctx, result, errors.Wrap(err, "failed to generate additional cluster manifests")), nil
+	}
+
+	cfg, err := b.installConfigBuilder.GetInstallConfig(prepared, b.Config.InstallRHCa, ignition.RedhatRootCA)
+	if err != nil {
+		result.ValidationErrors = append(result.ValidationErrors, err.Error())
+		return b.dryRunFailed(ctx, result, errors.Wrap(err, "failed to get install config")), nil
+	}
+
+	releaseImage, err := b.versionsHandler.GetReleaseImage(prepared.OpenshiftVersion, prepared.CPUArchitecture)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	result.InstallerOutput, err = b.generator.DryRunInstallConfig(ctx, *prepared, cfg, *releaseImage.URL)
+	if err != nil {
+		log.WithError(err).Errorf("failed dry-run generation of install config for cluster %s", params.ClusterID)
+		return b.dryRunFailed(ctx, result, err), nil
+	}
+
+	result.Status = swag.String(models.DryRunResultStatusSucceeded)
+	eventgen.SendClusterDryRunInstallationSucceededEvent(ctx, b.eventsHandler, params.ClusterID)
+	log.Infof("Successfully completed dry-run installation of cluster %s", params.ClusterID)
+	return result, nil
+}
+
+// dryRunFailed marks the dry-run result as failed. The error is reported as the installer output when the installer
+// did not run.
+func (b *bareMetalInventory) dryRunFailed(ctx context.Context, result *models.DryRunResult, err error) *models.DryRunResult {
+	logutil.FromContext(ctx, b.log).WithError(err).Warnf("Dry-run installation of cluster %s failed", result.ClusterID.String())
+	result.Status = swag.String(models.DryRunResultStatusFailed)
+	if result.InstallerOutput == "" {
+		result.InstallerOutput = err.Error()
+	}
+	eventgen.SendClusterDryRunInstallationFailedEvent(ctx, b.eventsHandler, *result.ClusterID, err.Error())
+	return result
+}
+
+// dryRunPreparedCluster returns a copy of the cluster with the roles and the bootstrap host that its preparation for
+// installation would set
+func dryRunPreparedCluster(cluster *common.Cluster) (*common.Cluster, error) {
+	prepared := *cluster
+	prepared.Hosts = make([]*models.Host, 0, len(cluster.Hosts))
+	var bootstrap *models.Host
+	hasBootstrap := false
+	for _, h := range cluster.Hosts {
+		host := *h
+		host.Role = common.GetEffectiveRole(h)
+		if host.Role == models.HostRoleAutoAssign {
+			return nil, errors.Errorf("No role was suggested for host %s", hostutil.GetHostnameForMsg(h))
+		}
+		hasBootstrap = hasBootstrap || host.Bootstrap
+		// The last known master is selected as the bootstrap, as done when installing
+		if host.Role == models.HostRoleMaster && swag.StringValue(host.Status) == models.HostStatusKnown {
+			bootstrap = &host
+		}
+		prepared.Hosts = append(prepared.Hosts, &host)
+	}
+	if !hasBootstrap {
+		if bootstrap == nil {
+			return nil, errors.Errorf("Cluster have no master hosts that can operate as bootstrap")
+		}
+		bootstrap.Bootstrap = true
+	}
+	return &prepared, nil
+}
+
+// dryRunValidationErrors returns the messages of the failed validations of the cluster and its hosts
+func dryRunValidationErrors(c *common.Cluster) []string {
+	var ret []string
+	var clusterValidations clusterPkg.ValidationsStatus
+	if c.ValidationsInfo != "" && json.Unmarshal([]byte(c.ValidationsInfo), &clusterValidations) == nil {
+		categories := funk.Keys(clusterValidations).([]string)
+		sort.Strings(categories)
+		for _, category := range categories {
+			for _, v := range clusterValidations[category] {
+				if v.Status == clusterPkg.ValidationFailure {
+					ret = append(ret, v.Message)
+				}
+			}
+		}
+	}
+	for _, h := range c.Hosts {
+		var hostValidations host.ValidationsStatus
+		if h.ValidationsInfo == "" || json.Unmarshal([]byte(h.ValidationsInfo), &hostValidations) != nil {
+			continue
+		}
+		categories := funk.Keys(hostValidations).([]string)
+		sort.Strings(categories)
+		for _, category := range categories {
+			for _, v := range hostValidations[category] {
+				if v.Status == host.ValidationFailure {
+					ret = append(ret, fmt.Sprintf("Host %s: %s", hostutil.GetHostnameForMsg(h), v.Message))
+				}
+			}
+		}
+	}
+	return ret
+}
+
 func (b *bareMetalInventory) refreshClusterHosts(ctx context.Context, cluster *common.Cluster, tx *gorm.DB, log logrus.FieldLogger) error {
 	err := b.setMajorityGroupForCluster(cluster.ID, tx)
 	if err != nil {
@@ -1968,7 +2167,9 @@ func (b *bareMetalInventory) updateClusterInternal(ctx context.Context, v1Params
 			OlmOperators:             v1Params.ClusterUpdateParams.OlmOperators,
 			Platform:                 v1Params.ClusterUpdateParams.Platform,
 			PullSecret:               v1Params.ClusterUpdateParams.PullSecret,
+			RoleAssignmentStrategy:   v1Params.ClusterUpdateParams.RoleAssignmentStrategy,
 			SchedulableMasters:       v1Params.ClusterUpdateParams.SchedulableMasters,
+			Fips:                     v1Params.ClusterUpdateParams.Fips,
 			ServiceNetworkCidr:       v1Params.ClusterUpdateParams.ServiceNetworkCidr,
 			ServiceNetworks:          v1Params.ClusterUpdateParams.ServiceNetworks,
 			SSHPublicKey:             v1Params.ClusterUpdateParams.SSHPublicKey,
@@ -2265,6 +2466,8 @@ func (b *bareMetalInventory) updateNonDhcpNetworkParams(updates map[string]inter
 		targetConfiguration.MachineNetworks = params.ClusterUpdateParams.MachineNetworks
 	}
 	reqDualStack := network.CheckIfClusterIsDualStack(&targetConfiguration)
+	// Hosts in routed L3 subnets need several machine networks, which can't be calculated from the VIPs
+	reqMultipleMachineNetworks := len(targetConfiguration.MachineNetworks) > 1
 
 	if params.ClusterUpdateParams.APIVip != nil {
 		updates["api_vip"] = *params.ClusterUpdateParams.APIVip
@@ -2276,7 +2479,7 @@ func (b *bareMetalInventory) updateNonDhcpNetworkParams(updates map[string]inter
 	}
 	if params.ClusterUpdateParams.MachineNetworks != nil &&
 		common.IsSliceNonEmpty(params.ClusterUpdateParams.MachineNetworks) &&
-		!reqDualStack {
+		!reqDualStack && !reqMultipleMachineNetworks {
 		err := errors.New("Setting Machine network CIDR is forbidden when cluster is not in vip-dhcp-allocation mode")
 		log.WithError(err).Warnf("Set Machine Network CIDR")
 		return common.NewApiError(http.StatusBadRequest, err)
@@ -2294,18 +2497,15 @@ func (b *bareMetalInventory) updateNonDhcpNetworkParams(updates map[string]inter
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
 	if interactivity == Interactive && (params.ClusterUpdateParams.APIVip != nil || params.ClusterUpdateParams.IngressVip != nil) {
-		var primaryMachineNetworkCidr string
 		matchRequired := apiVip != "" || ingressVip != ""
 
 		// We want to calculate Machine Network based on the API/Ingress VIPs only in case of the
-		// single-stack cluster. Autocalculation is not supported for dual-stack in which we
-		// require that user explictly provides all the Machine Networks.
-		if reqDualStack {
+		// single-stack cluster with a single Machine Network. Autocalculation is not supported for
+		// dual-stack or multi-subnet clusters in which we require that user explictly provides all
+		// the Machine Networks.
+		if reqDualStack || reqMultipleMachineNetworks {
 			if params.ClusterUpdateParams.MachineNetworks != nil {
 				cluster.MachineNetworks = params.ClusterUpdateParams.MachineNetworks
-				primaryMachineNetworkCidr = string(params.ClusterUpdateParams.MachineNetworks[0].Cidr)
-			} else {
-				primaryMachineNetworkCidr = network.GetPrimaryMachineCidrForUserManagedNetwork(cluster, log)
 			}
 
 			err = network.VerifyMachineNetworksDualStack(targetConfiguration.MachineNetworks, reqDualStack)
@@ -2314,6 +2514,7 @@ func (b *bareMetalInventory) updateNonDhcpNetworkParams(updates map[string]inter
 				return common.NewApiError(http.StatusBadRequest, err)
 			}
 		} else {
+			var primaryMachineNetworkCidr string
 			primaryMachineNetworkCidr, err = network.CalculateMachineNetworkCIDR(apiVip, ingressVip, cluster.Hosts, matchRequired)
 			if err != nil {
 				return common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "Calculate machine network CIDR"))
@@ -2333,7 +2534,7 @@ func (b *bareMetalInventory) updateNonDhcpNetworkParams(updates map[string]inter
 			}
 		}
 
-		err = network.VerifyVips(cluster.Hosts, primaryMachineNetworkCidr, apiVip, ingressVip, false, log)
+		err = network.VerifyVips(cluster.Hosts, cluster.MachineNetworks, apiVip, ingressVip, false, log)
 		if err != nil {
 			log.WithError(err).Warnf("Verify VIPs")
 			return common.NewApiError(http.StatusBadRequest, err)
@@ -2406,6 +2607,7 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 	optionalParam(params.ClusterUpdateParams.NoProxy, "no_proxy", updates)
 	optionalParam(params.ClusterUpdateParams.SSHPublicKey, "ssh_public_key", updates)
 	optionalParam(params.ClusterUpdateParams.Hyperthreading, "hyperthreading", updates)
+	optionalParam(params.ClusterUpdateParams.RoleAssignmentStrategy, "role_assignment_strategy", updates)
 
 	b.setProxyUsage(params.ClusterUpdateParams.HTTPProxy, params.ClusterUpdateParams.HTTPSProxy, params.ClusterUpdateParams.NoProxy, usages)
 
@@ -2451,6 +2653,27 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 		b.setUsage(value, usage.SchedulableMasters, nil, usages)
 	}
 
+	if params.ClusterUpdateParams.Fips != nil {
+		value := swag.BoolValue(params.ClusterUpdateParams.Fips)
+		if value {
+			if err = common.ValidateFIPSSupport(cluster.OpenshiftVersion, cluster.CPUArchitecture); err != nil {
+				return common.NewApiError(http.StatusBadRequest, err)
+			}
+			if err = b.validateInfraEnvsFIPSImageType(db, cluster); err != nil {
+				return err
+			}
+		}
+		if value != swag.BoolValue(cluster.Fips) {
+			// The kernel arguments of the discovery images depend on FIPS mode, so the images can't be reused
+			err = db.Model(&common.InfraEnv{}).Where("cluster_id = ?", cluster.ID.String()).Update("generated", false).Error
+			if err != nil {
+				return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to update infra-envs of cluster: %s", params.ClusterID))
+			}
+		}
+		updates["fips"] = value
+		b.setUsage(value, usage.FIPSUsage, nil, usages)
+	}
+
 	if params.ClusterUpdateParams.DiskEncryption != nil {
 		if params.ClusterUpdateParams.DiskEncryption.EnableOn != nil {
 			updates["disk_encryption_enable_on"] = params.ClusterUpdateParams.DiskEncryption.EnableOn
@@ -2642,7 +2865,7 @@ func (b *bareMetalInventory) updateProviderParams(params installer.V2UpdateClust
 		err := b.providerRegistry.SetPlatformValuesInDBUpdates(
 			common.PlatformTypeValue(params.ClusterUpdateParams.Platform.Type), params.ClusterUpdateParams.Platform, updates)
 		if err != nil {
-			return fmt.Errorf("failed setting platform values, error is: %w", err)
+			return common.NewApiError(http.StatusBadRequest, fmt.Errorf("failed setting platform values, error is: %w", err))
 		}
 		err = b.providerRegistry.SetPlatformUsages(
 			common.PlatformTypeValue(params.ClusterUpdateParams.Platform.Type), params.ClusterUpdateParams.Platform, usages, b.usageApi)
@@ -2898,6 +3121,7 @@ func (b *bareMetalInventory) setDefaultUsage(cluster *models.Cluster) error {
 	b.setOperatorsUsage(olmOperators, []*models.MonitoredOperator{}, usages)
 	b.setNetworkTypeUsage(cluster.NetworkType, usages)
 	b.setUsage(network.CheckIfClusterModelIsDualStack(cluster), usage.DualStackUsage, nil, usages)
+	b.setUsage(swag.BoolValue(cluster.Fips), usage.FIPSUsage, nil, usages)
 	b.setDiskEncryptionUsage(cluster, cluster.DiskEncryption, usages)
 	//write all the usages to the cluster object
 	err := b.providerRegistry.SetPlatformUsages(common.PlatformTypeValue(cluster.Platform.Type), cluster.Platform, usages, b.usageApi)
@@ -3119,6 +3343,16 @@ func (b *bareMetalInventory) updateOperatorsData(_ context.Context, cluster *com
 		return err
 	}
 
+	if err = setOCSExternalClusterDetails(cluster, updateOLMOperators); err != nil {
+		return err
+	}
+	if err = db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).
+		Update("ocs_external_cluster_details", cluster.OCSExternalClusterDetails).Error; err != nil {
+		err = errors.Wrapf(err, "failed to update the OCS external cluster details of cluster %s", params.ClusterID)
+		log.Error(err)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
 	for _, updatedOperator := range updateOLMOperators {
 		updatedOperator.ClusterID = *cluster.ID
 		if err = db.Save(updatedOperator).Error; err != nil {
@@ -3235,21 +3469,22 @@ func (b *bareMetalInventory) ListClusters(ctx context.Context, params installer.
 		OpenshiftClusterID:      params.OpenshiftClusterID,
 		WithHosts:               params.WithHosts,
 	}
-	clusters, err := b.listClustersInternal(ctx, v2Params)
+	clusters, _, _, err := b.listClustersInternal(ctx, v2Params)
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
 	return installer.NewListClustersOK().WithPayload(clusters)
 }
 
-func (b *bareMetalInventory) listClustersInternal(ctx context.Context, params installer.V2ListClustersParams) ([]*models.Cluster, error) {
+// listClustersInternal returns the clusters matching the filters of the request, along with the total count of
+// matching clusters and the continue token of the next page, if one was requested
+func (b *bareMetalInventory) listClustersInternal(ctx context.Context, params installer.V2ListClustersParams) ([]*models.Cluster, int64, string, error) {
 	log := logutil.FromContext(ctx, b.log)
 	db := b.db
-	if swag.BoolValue(params.GetUnregisteredClusters) {
-		if !identity.IsAdmin(ctx) {
-			return nil, common.NewApiError(http.StatusForbidden, errors.New("only admin users are allowed to get unregistered clusters"))
-		}
-		db = db.Unscoped()
+	// the count and the list queries include the deleted clusters alike
+	includeDeleted := common.DeleteRecordsState(swag.BoolValue(params.GetUnregisteredClusters))
+	if includeDeleted == common.IncludeDeletedRecords && !identity.IsAdmin(ctx) {
+		return nil, 0, "", common.NewApiError(http.StatusForbidden, errors.New("only admin users are allowed to get unregistered clusters"))
 	}
 	var dbClusters []*common.Cluster
 	var clusters []*models.Cluster
@@ -3267,15 +3502,69 @@ func (b *bareMetalInventory) listClustersInternal(ctx context.Context, params in
 		whereCondition = append(whereCondition, fmt.Sprintf("ams_subscription_id IN %s", common.ToSqlList(params.AmsSubscriptionIds)))
 	}
 
-	dbClusters, err := common.GetClustersFromDBWhere(db, common.UseEagerLoading,
-		common.DeleteRecordsState(swag.BoolValue(params.GetUnregisteredClusters)), strings.Join(whereCondition, " AND "))
+	if len(params.Status) > 0 {
+		db = db.Where("status IN (?)", params.Status)
+	}
+
+	if params.OpenshiftVersion != nil {
+		db = db.Where("openshift_version = ?", *params.OpenshiftVersion)
+	}
+
+	if params.PlatformType != nil {
+		if err := models.PlatformType(*params.PlatformType).Validate(strfmt.Default); err != nil {
+			return nil, 0, "", common.NewApiError(http.StatusBadRequest, errors.Wrapf(err, "invalid platform type %s", *params.PlatformType))
+		}
+		db = db.Where("platform_type = ?", *params.PlatformType)
+	}
+
+	if params.Owner != nil {
+		db = db.Where("user_name = ?", *params.Owner)
+	}
+
+	// the filtered query is shared by the count and the list queries
+	db = db.Session(&gorm.Session{})
+
+	page := common.PageRequest{SortBy: params.SortBy, Order: params.Order, Limit: params.Limit, Continue: params.Continue}
+	var total int64
+	if page.IsPaged() {
+		var err error
+		if total, err = common.CountClustersWhere(db, includeDeleted, strings.Join(whereCondition, " AND ")); err != nil {
+			log.WithError(err).Error("Failed to count clusters in db")
+			return nil, 0, "", common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
+	db, err := common.ApplyPageRequest(db, page)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	dbClusters, err = common.GetClustersFromDBWhere(db, common.UseEagerLoading, includeDeleted, strings.Join(whereCondition, " AND "))
 	if err != nil {
 		log.WithError(err).Error("Failed to list clusters in db")
-		return nil, common.NewApiError(http.StatusInternalServerError, err)
+		return nil, 0, "", common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if !page.IsPaged() {
+		total = int64(len(dbClusters))
+	}
+
+	count, next, err := common.NextPage(page, len(dbClusters), func(i int, sortBy string) (interface{}, strfmt.UUID) {
+		c := dbClusters[i]
+		switch sortBy {
+		case common.SortByUpdatedAt:
+			return c.UpdatedAt, *c.ID
+		case common.SortByStatus:
+			return c.Status, *c.ID
+		default:
+			return c.CreatedAt, *c.ID
+		}
+	})
+	if err != nil {
+		return nil, 0, "", common.NewApiError(http.StatusInternalServerError, err)
 	}
 
 	// we need to fetch Hosts association to allow AfterFind hook to run
-	for _, c := range dbClusters {
+	for _, c := range dbClusters[:count] {
 		if !params.WithHosts {
 			c.Hosts = []*models.Host{}
 		}
@@ -3285,7 +3574,7 @@ func (b *bareMetalInventory) listClustersInternal(ctx context.Context, params in
 		}
 		clusters = append(clusters, &c.Cluster)
 	}
-	return clusters, nil
+	return clusters, total, next, nil
 }
 
 func (b *bareMetalInventory) GetCluster(ctx context.Context, params installer.GetClusterParams) middleware.Responder {
@@ -3574,6 +3863,12 @@ func (b *bareMetalInventory) handleReplyError(params installer.V2PostStepReplyPa
 			return err
 		}
 		return b.processDiskSpeedCheckResponse(ctx, h, stepReply, exitCode)
+	case models.StepTypeTangConnectivityCheck:
+		// The agent image doesn't contain the Tang connectivity check, so the servers can't be checked from this host
+		if exitCode == CommandNotFoundExitCode {
+			log.Warnf("The agent of host %s does not support the Tang connectivity check: %s", h.ID, params.Reply.Error)
+			return b.hostApi.UpdateTangConnectivityReport(ctx, h, host.TangConnectivityCheckUnsupported)
+		}
 	}
 	return nil
 }
@@ -3778,11 +4073,98 @@ func (b *bareMetalInventory) processImageAvailabilityResponse(ctx context.Contex
 	return nil
 }
 
+// applyClusterBundleHost applies the configuration of the imported cluster bundle host with a MAC address of the
+// inventory, if any. Failures are only logged, since the inventory of the host was already updated.
+func (b *bareMetalInventory) applyClusterBundleHost(ctx context.Context, host *models.Host, inventoryStr string) {
+	if host.ClusterID == nil {
+		return
+	}
+	log := logutil.FromContext(ctx, b.log)
+
+	var bundleHosts []*common.ClusterBundleHost
+	if err := b.db.Where("cluster_id = ? and host_id is null", host.ClusterID.String()).Find(&bundleHosts).Error; err != nil {
+		log.WithError(err).Warnf("failed to get bundle hosts of cluster %s", host.ClusterID)
+		return
+	}
+	if len(bundleHosts) == 0 {
+		return
+	}
+
+	var inventory models.Inventory
+	if err := json.Unmarshal([]byte(inventoryStr), &inventory); err != nil {
+		log.WithError(err).Warnf("failed to unmarshal inventory of host %s", host.ID)
+		return
+	}
+	var bundleHost *common.ClusterBundleHost
+	for _, intf := range inventory.Interfaces {
+		for _, h := range bundleHosts {
+			if strings.EqualFold(intf.MacAddress, h.MacAddress) {
+				bundleHost = h
+			}
+		}
+	}
+	if bundleHost == nil {
+		return
+	}
+
+	// Claim the configuration, so it is applied once even if the host sends its inventory again meanwhile
+	reply := b.db.Model(&common.ClusterBundleHost{}).
+		Where("cluster_id = ? and mac_address = ? and host_id is null", bundleHost.ClusterID.String(), bundleHost.MacAddress).
+		Update("host_id", host.ID)
+	if reply.Error != nil || reply.RowsAffected == 0 {
+		return
+	}
+	log.Infof("Applying bundle configuration of %s to host %s", bundleHost.MacAddress, host.ID)
+
+	updateParams := &models.HostUpdateParams{}
+	if bundleHost.Role != "" {
+		updateParams.HostRole = swag.String(string(bundleHost.Role))
+	}
+	if bundleHost.Hostname != "" {
+		updateParams.HostName = swag.String(bundleHost.Hostname)
+	}
+	if updateParams.HostRole != nil || updateParams.HostName != nil {
+		if _, err := b.V2UpdateHostInternal(ctx, installer.V2UpdateHostParams{
+			HostID:           *host.ID,
+			InfraEnvID:       host.InfraEnvID,
+			HostUpdateParams: updateParams,
+		}); err != nil {
+			log.WithError(err).Warnf("failed to apply bundle role and hostname to host %s", host.ID)
+		}
+	}
+
+	if bundleHost.IgnitionConfigOverrides != "" {
+		if _, err := b.V2UpdateHostIgnitionInternal(ctx, installer.V2UpdateHostIgnitionParams{
+			HostID:             *host.ID,
+			InfraEnvID:         host.InfraEnvID,
+			HostIgnitionParams: &models.HostIgnitionParams{Config: bundleHost.IgnitionConfigOverrides},
+		}); err != nil {
+			log.WithError(err).Warnf("failed to apply bundle ignition config overrides to host %s", host.ID)
+		}
+	}
+
+	var installerArgs []string
+	if err := json.Unmarshal([]byte(bundleHost.InstallerArgs), &installerArgs); err != nil {
+		log.WithError(err).Warnf("failed to unmarshal bundle installer args of host %s", host.ID)
+	} else if len(installerArgs) > 0 {
+		if _, err := b.V2UpdateHostInstallerArgsInternal(ctx, installer.V2UpdateHostInstallerArgsParams{
+			HostID:              *host.ID,
+			InfraEnvID:          host.InfraEnvID,
+			InstallerArgsParams: &models.InstallerArgsParams{Args: installerArgs},
+		}); err != nil {
+			log.WithError(err).Warnf("failed to apply bundle installer args to host %s", host.ID)
+		}
+	}
+}
+
 func handleReplyByType(params installer.V2PostStepReplyParams, b *bareMetalInventory, ctx context.Context, host models.Host, stepReply string) error {
 	var err error
 	switch params.Reply.StepType {
 	case models.StepTypeInventory:
 		err = b.hostApi.UpdateInventory(ctx, &host, stepReply)
+		if err == nil {
+			b.applyClusterBundleHost(ctx, &host, stepReply)
+		}
 	case models.StepTypeConnectivityCheck:
 		err = b.hostApi.UpdateConnectivityReport(ctx, &host, stepReply)
 	case models.StepTypeAPIVipConnectivityCheck:
@@ -3799,6 +4181,8 @@ func handleReplyByType(params installer.V2PostStepReplyParams, b *bareMetalInven
 		err = b.processDiskSpeedCheckResponse(ctx, &host, stepReply, 0)
 	case models.StepTypeDomainResolution:
 		err = b.updateDomainNameResolutionResponse(ctx, &host, stepReply)
+	case models.StepTypeTangConnectivityCheck:
+		err = b.hostApi.UpdateTangConnectivityReport(ctx, &host, stepReply)
 	}
 	return err
 }
@@ -3859,6 +4243,8 @@ func filterReplyByType(params installer.V2PostStepReplyParams) (string, error) {
 		stepReply, err = filterReply(&models.DiskSpeedCheckResponse{}, params.Reply.Output)
 	case models.StepTypeDomainResolution:
 		stepReply, err = filterReply(&models.DomainResolutionResponse{}, params.Reply.Output)
+	case models.StepTypeTangConnectivityCheck:
+		stepReply, err = filterReply(&models.TangConnectivityResponse{}, params.Reply.Output)
 	}
 
 	return stepReply, err
@@ -4560,6 +4946,25 @@ func setPullSecret(cluster *common.Cluster, pullSecret string) {
 	}
 }
 
+// setOCSExternalClusterDetails moves the RHCS cluster details out of the OCS operator properties, as the operators
+// are returned by the API and the details hold Ceph keys
+func setOCSExternalClusterDetails(cluster *common.Cluster, operators []*models.MonitoredOperator) error {
+	details := ""
+	for _, operator := range operators {
+		if operator.Name != ocs.Operator.Name {
+			continue
+		}
+		properties, operatorDetails, err := ocs.ExtractExternalClusterDetails(operator.Properties, cluster.OCSExternalClusterDetails)
+		if err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		operator.Properties = properties
+		details = operatorDetails
+	}
+	cluster.OCSExternalClusterDetails = details
+	return nil
+}
+
 func setInfraEnvPullSecret(infraEnv *common.InfraEnv, pullSecret string) {
 	infraEnv.PullSecret = pullSecret
 	if pullSecret != "" {
@@ -5194,7 +5599,8 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
-	err = b.validateClusterInfraEnvRegister(params.InfraenvCreateParams.ClusterID, params.InfraenvCreateParams.CPUArchitecture)
+	err = b.validateClusterInfraEnvRegister(params.InfraenvCreateParams.ClusterID, params.InfraenvCreateParams.CPUArchitecture,
+		params.InfraenvCreateParams.ImageType)
 	if err != nil {
 		return nil, err
 	}
@@ -5327,7 +5733,7 @@ func (b *bareMetalInventory) getOsImageOrLatest(version string, cpuArch string)
 	return osImage, nil
 }
 
-func (b *bareMetalInventory) validateClusterInfraEnvRegister(clusterId *strfmt.UUID, arch string) error {
+func (b *bareMetalInventory) validateClusterInfraEnvRegister(clusterId *strfmt.UUID, arch string, imageType models.ImageType) error {
 	if clusterId != nil {
 		cluster, err := common.GetClusterFromDB(b.db, *clusterId, common.SkipEagerLoading)
 		if err != nil {
@@ -5340,6 +5746,34 @@ func (b *bareMetalInventory) validateClusterInfraEnvRegister(clusterId *strfmt.U
 				cluster.CPUArchitecture)
 			return common.NewApiError(http.StatusBadRequest, err)
 		}
+
+		if err = b.validateFIPSImageType(swag.BoolValue(cluster.Fips), imageType); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+	return nil
+}
+
+// validateInfraEnvsFIPSImageType checks that the image types of the infra-envs of the cluster can be generated in FIPS mode
+func (b *bareMetalInventory) validateInfraEnvsFIPSImageType(db *gorm.DB, cluster *common.Cluster) error {
+	var imageTypes []models.ImageType
+	if err := db.Model(&common.InfraEnv{}).Where("cluster_id = ?", cluster.ID.String()).Distinct().Pluck("type", &imageTypes).Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to get infra-envs of cluster %s", cluster.ID))
+	}
+	for _, imageType := range imageTypes {
+		if err := b.validateFIPSImageType(true, imageType); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+	return nil
+}
+
+// validateFIPSImageType rejects the full ISO of the clusters installed in FIPS mode when the service generates the
+// images itself, as it can only add the FIPS kernel argument to the minimal ISO
+func (b *bareMetalInventory) validateFIPSImageType(fips bool, imageType models.ImageType) error {
+	if fips && b.ImageServiceBaseURL == "" && imageType == models.ImageTypeFullIso {
+		return errors.Errorf("Image type %s can't be generated for a cluster installed in FIPS mode, use image type %s instead",
+			imageType, models.ImageTypeMinimalIso)
 	}
 	return nil
 }
@@ -5407,6 +5841,16 @@ func (b *bareMetalInventory) UpdateInfraEnvInternal(ctx context.Context, params
 		}
 	}
 
+	if params.InfraEnvUpdateParams.ImageType != "" && infraEnv.ClusterID != "" {
+		var cluster *common.Cluster
+		if cluster, err = common.GetClusterFromDB(b.db, infraEnv.ClusterID, common.SkipEagerLoading); err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to get cluster %s", infraEnv.ClusterID))
+		}
+		if err = b.validateFIPSImageType(swag.BoolValue(cluster.Fips), params.InfraEnvUpdateParams.ImageType); err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
 	err = b.updateInfraEnvData(ctx, infraEnv, params, b.db, log)
 	if err != nil {
 		log.WithError(err).Error("updateInfraEnvData")
@@ -5851,6 +6295,36 @@ func (b *bareMetalInventory) V2GetHost(ctx context.Context, params installer.V2G
 	return installer.NewV2GetHostOK().WithPayload(&host.Host)
 }
 
+func (b *bareMetalInventory) V2ListHostInventoryHistory(ctx context.Context, params installer.V2ListHostInventoryHistoryParams) middleware.Responder {
+	host, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return installer.NewV2ListHostInventoryHistoryNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+		}
+		return common.GenerateErrorResponder(err)
+	}
+	snapshots, err := b.hostApi.GetInventoryHistory(ctx, &host.Host)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ListHostInventoryHistoryOK().WithPayload(snapshots)
+}
+
+func (b *bareMetalInventory) V2GetHostInventoryDiff(ctx context.Context, params installer.V2GetHostInventoryDiffParams) middleware.Responder {
+	host, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return installer.NewV2GetHostInventoryDiffNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+		}
+		return common.GenerateErrorResponder(err)
+	}
+	diff, err := b.hostApi.GetInventoryDiff(ctx, &host.Host, params.From, params.To)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2GetHostInventoryDiffOK().WithPayload(diff)
+}
+
 func (b *bareMetalInventory) V2UpdateHostInstallProgress(ctx context.Context, params installer.V2UpdateHostInstallProgressParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("Update host %s install progress", params.HostID)
@@ -5999,12 +6473,56 @@ func (b *bareMetalInventory) V2ListHosts(ctx context.Context, params installer.V
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
-	hosts, err := common.GetInfraEnvHostsFromDB(b.db, params.InfraEnvID)
+	db := b.db.Where("infra_env_id = ?", params.InfraEnvID)
+	if len(params.Status) > 0 {
+		db = db.Where("status IN (?)", params.Status)
+	}
+	if params.Role != nil {
+		db = db.Where("role = ?", *params.Role)
+	}
+	// the filtered query is shared by the count and the list queries
+	db = db.Session(&gorm.Session{})
+
+	page := common.PageRequest{SortBy: params.SortBy, Order: params.Order, Limit: params.Limit, Continue: params.Continue}
+	var total int64
+	if page.IsPaged() {
+		if err = db.Model(&common.Host{}).Count(&total).Error; err != nil {
+			log.WithError(err).Errorf("failed to count hosts for infra-env %s", params.InfraEnvID)
+			return installer.NewV2ListHostsInternalServerError().
+				WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+		}
+	}
+
+	db, err = common.ApplyPageRequest(db, page)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	hosts, err := common.GetHostsFromDBWhere(db)
 	if err != nil {
 		log.WithError(err).Errorf("failed to get list of hosts for infra-env %s", params.InfraEnvID)
 		return installer.NewV2ListHostsInternalServerError().
 			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
+	if !page.IsPaged() {
+		total = int64(len(hosts))
+	}
+
+	count, next, err := common.NextPage(page, len(hosts), func(i int, sortBy string) (interface{}, strfmt.UUID) {
+		h := hosts[i]
+		switch sortBy {
+		case common.SortByUpdatedAt:
+			return h.UpdatedAt, *h.ID
+		case common.SortByStatus:
+			return h.Status, *h.ID
+		default:
+			return h.CreatedAt, *h.ID
+		}
+	})
+	if err != nil {
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
+	}
+	hosts = hosts[:count]
 
 	for _, h := range hosts {
 		if err := b.customizeHost(nil, &h.Host); err != nil {
@@ -6014,7 +6532,7 @@ func (b *bareMetalInventory) V2ListHosts(ctx context.Context, params installer.V
 		h.FreeAddresses = ""
 	}
 
-	return installer.NewV2ListHostsOK().WithPayload(common.ToModelsHosts(hosts))
+	return installer.NewV2ListHostsOK().WithPayload(common.ToModelsHosts(hosts)).WithXTotalCount(total).WithXContinue(next)
 }
 
 func (b *bareMetalInventory) V2DeregisterHost(ctx context.Context, params installer.V2DeregisterHostParams) middleware.Responder {
@@ -6116,16 +6634,162 @@ func (b *bareMetalInventory) V2DownloadInfraEnvFiles(ctx context.Context, params
 		b.log.WithError(err).Errorf("Failed to get infra env %s", params.InfraEnvID)
 		return common.GenerateErrorResponder(err)
 	}
-	if params.FileName == "discovery.ign" {
+	var (
+		respBody      io.ReadCloser
+		contentLength int64
+	)
+	switch params.FileName {
+	case "discovery.ign":
 		cfg, err2 := b.IgnitionBuilder.FormatDiscoveryIgnitionFile(ctx, infraEnv, b.IgnitionConfig, false, b.authHandler.AuthType())
 		if err2 != nil {
 			b.log.WithError(err).Error("Failed to format ignition config")
 			return common.GenerateErrorResponder(err)
 		}
-		return filemiddleware.NewResponder(installer.NewV2DownloadInfraEnvFilesOK().WithPayload(ioutil.NopCloser(strings.NewReader(cfg))), params.FileName, int64(len(cfg)))
-	} else {
-		return common.GenerateErrorResponder(common.NewApiError(http.StatusBadRequest, err))
+		respBody, contentLength = ioutil.NopCloser(strings.NewReader(cfg)), int64(len(cfg))
+	case "vmlinuz":
+		respBody, contentLength, err = b.downloadPXEArtifact(ctx, infraEnv, isoeditor.PXEKernelPath)
+	case "initrd.img":
+		respBody, contentLength, err = b.downloadPXEInitrd(ctx, infraEnv)
+	case "rootfs.img":
+		respBody, contentLength, err = b.downloadPXEArtifact(ctx, infraEnv, isoeditor.PXERootfsPath)
+	case "ipxe-script":
+		var script string
+		script, err = b.generateIPXEScript(infraEnv)
+		respBody, contentLength = ioutil.NopCloser(strings.NewReader(script)), int64(len(script))
+	default:
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusBadRequest, errors.Errorf("unsupported file %s", params.FileName)))
+	}
+	if err != nil {
+		return common.GenerateErrorResponder(err)
 	}
+	return filemiddleware.NewResponder(installer.NewV2DownloadInfraEnvFilesOK().WithPayload(respBody), params.FileName, contentLength)
+}
+
+// getBaseISOPath returns the local path of the RHCOS live ISO of the infra-env, which holds its network boot artifacts
+func (b *bareMetalInventory) getBaseISOPath(ctx context.Context, infraEnv *common.InfraEnv) (string, error) {
+	log := logutil.FromContext(ctx, b.log)
+	baseISOName, err := b.objectHandler.GetBaseIsoObject(infraEnv.OpenshiftVersion, infraEnv.CPUArchitecture)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to get source object name for infraEnv %s with ocp version %s", infraEnv.ID, infraEnv.OpenshiftVersion)
+		return "", common.NewApiError(http.StatusInternalServerError, err)
+	}
+	isoPath, err := s3wrapper.GetFile(ctx, b.objectHandler, baseISOName, b.ISOCacheDir, true)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to download base ISO %s", baseISOName)
+		return "", common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return isoPath, nil
+}
+
+func (b *bareMetalInventory) downloadPXEArtifact(ctx context.Context, infraEnv *common.InfraEnv, filePath string) (io.ReadCloser, int64, error) {
+	isoPath, err := b.getBaseISOPath(ctx, infraEnv)
+	if err != nil {
+		return nil, 0, err
+	}
+	reader, size, err := isoeditor.PXEArtifact(isoPath, filePath)
+	if err != nil {
+		logutil.FromContext(ctx, b.log).WithError(err).Errorf("Failed to read %s from ISO %s", filePath, isoPath)
+		return nil, 0, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return reader, size, nil
+}
+
+// downloadPXEInitrd returns the initrd of the infra-env with the discovery ignition, static network config and
+// proxy settings embedded the same way they are in the minimal ISO
+func (b *bareMetalInventory) downloadPXEInitrd(ctx context.Context, infraEnv *common.InfraEnv) (io.ReadCloser, int64, error) {
+	log := logutil.FromContext(ctx, b.log)
+	ignitionConfig, err := b.IgnitionBuilder.FormatDiscoveryIgnitionFile(ctx, infraEnv, b.IgnitionConfig, false, b.authHandler.AuthType())
+	if err != nil {
+		log.WithError(err).Error("Failed to format ignition config")
+		return nil, 0, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	var netFiles []staticnetworkconfig.StaticNetworkConfigData
+	if infraEnv.StaticNetworkConfig != "" {
+		netFiles, err = b.staticNetworkConfig.GenerateStaticNetworkConfigData(ctx, infraEnv.StaticNetworkConfig)
+		if err != nil {
+			log.WithError(err).Errorf("Failed to create static network config data")
+			return nil, 0, common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
+	httpProxy, httpsProxy, noProxy := common.GetProxyConfigs(infraEnv.Proxy)
+	infraEnvProxyInfo := isoeditor.ClusterProxyInfo{
+		HTTPProxy:  httpProxy,
+		HTTPSProxy: httpsProxy,
+		NoProxy:    noProxy,
+	}
+
+	isoPath, err := b.getBaseISOPath(ctx, infraEnv)
+	if err != nil {
+		return nil, 0, err
+	}
+	reader, size, err := isoeditor.PXEInitrd(isoPath, ignitionConfig, netFiles, &infraEnvProxyInfo)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to create initrd for infraEnv %s", infraEnv.ID)
+		return nil, 0, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return reader, size, nil
+}
+
+// generateIPXEScript returns an iPXE script booting the network boot artifacts of the infra-env
+func (b *bareMetalInventory) generateIPXEScript(infraEnv *common.InfraEnv) (string, error) {
+	urls := make(map[string]string)
+	for _, fileName := range []string{"vmlinuz", "initrd.img", "rootfs.img"} {
+		fileURL, err := b.infraEnvFileURL(infraEnv, fileName)
+		if err != nil {
+			return "", common.NewApiError(http.StatusInternalServerError, err)
+		}
+		urls[fileName] = fileURL
+	}
+
+	kernelArguments, err := b.discoveryKernelArguments(infraEnv)
+	if err != nil {
+		return "", common.NewApiError(http.StatusInternalServerError, err)
+	}
+	var extraArguments string
+	if len(kernelArguments) > 0 {
+		extraArguments = " " + strings.Join(kernelArguments, " ")
+	}
+
+	return fmt.Sprintf(`#!ipxe
+initrd --name initrd %s
+kernel %s initrd=initrd coreos.live.rootfs_url=%s random.trust_cpu=on ignition.firstboot ignition.platform.id=metal%s
+boot
+`, urls["initrd.img"], urls["vmlinuz"], urls["rootfs.img"], extraArguments), nil
+}
+
+func (b *bareMetalInventory) infraEnvFileURL(infraEnv *common.InfraEnv, fileName string) (string, error) {
+	return InfraEnvFileURL(b.Config.ServiceBaseURL, b.authHandler.AuthType(), infraEnv, fileName, b.Config.ImageExpirationTime)
+}
+
+// InfraEnvFileURL returns the URL downloading the file of the infra-env. It is signed with the infra-env key when using
+// local authentication, and with a token of the image token key of the infra-env, valid for tokenExpiration, when using RHSSO.
+func InfraEnvFileURL(baseURL string, authType auth.AuthType, infraEnv *common.InfraEnv, fileName string, tokenExpiration time.Duration) (string, error) {
+	builder := &installer.V2DownloadInfraEnvFilesURL{InfraEnvID: *infraEnv.ID, FileName: fileName}
+	fileURL, err := builder.Build()
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to generate URL of %s", fileName)
+	}
+	downloadURL := fmt.Sprintf("%s%s", baseURL, fileURL.RequestURI())
+	switch authType {
+	case auth.TypeLocal:
+		downloadURL, err = gencrypto.SignURL(downloadURL, infraEnv.ID.String(), gencrypto.InfraEnvKey)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to sign URL of %s", fileName)
+		}
+	case auth.TypeRHSSO:
+		var token string
+		token, err = gencrypto.JWTForSymmetricKey([]byte(infraEnv.ImageTokenKey), tokenExpiration, infraEnv.ID.String())
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to generate token for infraEnv %s", infraEnv.ID)
+		}
+		downloadURL, err = gencrypto.SignURLWithToken(downloadURL, "image_token", token)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to sign URL of %s with token", fileName)
+		}
+	}
+	return downloadURL, nil
 }
 
 func (b *bareMetalInventory) V2DownloadClusterCredentials(ctx context.Context, params installer.V2DownloadClusterCredentialsParams) middleware.Responder {
@@ -6239,6 +6903,15 @@ func (b *bareMetalInventory) V2UpdateHostInternal(ctx context.Context, params in
 	if err != nil {
 		return nil, err
 	}
+	err = b.updateHostFailureDomain(ctx, host, params.HostUpdateParams.FailureDomain, tx)
+	if err != nil {
+		return nil, err
+	}
+	// Updating the host means that the user reviewed it, its current hardware is the reference of the drift detection
+	if err = b.hostApi.ReviewInventory(ctx, &host.Host, tx); err != nil {
+		log.WithError(err).Errorf("failed to review the inventory of host %s, infra env %s", host.ID, host.InfraEnvID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
 
 	//get bound cluster
 	if host.ClusterID != nil {
@@ -6371,6 +7044,21 @@ func (b *bareMetalInventory) updateHostMachineConfigPoolName(ctx context.Context
 	return nil
 }
 
+func (b *bareMetalInventory) updateHostFailureDomain(ctx context.Context, host *common.Host, failureDomain *models.FailureDomain, db *gorm.DB) error {
+	log := logutil.FromContext(ctx, b.log)
+	if failureDomain == nil {
+		log.Infof("No request for failure domain update for host %s", host.ID)
+		return nil
+	}
+	err := b.hostApi.UpdateFailureDomain(ctx, db, &host.Host, failureDomain)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to set failure domain of host <%s> infra env <%s>",
+			host.ID, host.InfraEnvID)
+		return common.NewApiError(http.StatusConflict, err)
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) updateHostIgnitionEndpointToken(ctx context.Context, host *common.Host, token *string, db *gorm.DB) error {
 	log := logutil.FromContext(ctx, b.log)
 	if token == nil {
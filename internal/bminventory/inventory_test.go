@@ -10,6 +10,7 @@ import (
 	"io/ioutil"
 	"mime/multipart"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
 	"reflect"
@@ -22,6 +23,7 @@ import (
 	"github.com/cavaliercoder/go-cpio"
 	ign_3_1 "github.com/coreos/ignition/v2/config/v3_1"
 	ign_3_1_types "github.com/coreos/ignition/v2/config/v3_1/types"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -48,6 +50,7 @@ import (
 	installcfg "github.com/openshift/assisted-service/internal/installcfg/builder"
 	"github.com/openshift/assisted-service/internal/isoeditor"
 	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/internal/provider/vsphere"
@@ -432,6 +435,89 @@ var _ = Describe("V2GetHost", func() {
 	})
 })
 
+var _ = Describe("V2HostInventoryHistory", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		dbName     string
+		hostID     strfmt.UUID
+		infraEnvId strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		infraEnvId = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+
+		hostObj := models.Host{
+			ID:         &hostID,
+			InfraEnvID: infraEnvId,
+			Status:     swag.String("discovering"),
+		}
+		Expect(db.Create(&hostObj).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("list history of unknown host", func() {
+		response := bm.V2ListHostInventoryHistory(ctx, installer.V2ListHostInventoryHistoryParams{
+			InfraEnvID: infraEnvId,
+			HostID:     strfmt.UUID(uuid.New().String()),
+		})
+		Expect(response).Should(BeAssignableToTypeOf(&installer.V2ListHostInventoryHistoryNotFound{}))
+	})
+
+	It("list history", func() {
+		snapshotID := strfmt.UUID(uuid.New().String())
+		history := models.HostInventorySnapshotList{{ID: &snapshotID, HostID: &hostID, InfraEnvID: &infraEnvId}}
+		mockHostApi.EXPECT().GetInventoryHistory(gomock.Any(), gomock.Any()).Return(history, nil).Times(1)
+		response := bm.V2ListHostInventoryHistory(ctx, installer.V2ListHostInventoryHistoryParams{
+			InfraEnvID: infraEnvId,
+			HostID:     hostID,
+		})
+		Expect(response).Should(BeAssignableToTypeOf(&installer.V2ListHostInventoryHistoryOK{}))
+		Expect(response.(*installer.V2ListHostInventoryHistoryOK).Payload).To(Equal(history))
+	})
+
+	It("diff snapshots", func() {
+		from := strfmt.UUID(uuid.New().String())
+		to := strfmt.UUID(uuid.New().String())
+		diff := &models.HostInventoryDiff{
+			FromSnapshotID: from,
+			ToSnapshotID:   to,
+			Changes:        []*models.HostInventoryChange{{Attribute: swag.String("cpu.count"), From: "8", To: "4", Critical: true}},
+		}
+		mockHostApi.EXPECT().GetInventoryDiff(gomock.Any(), gomock.Any(), from, to).Return(diff, nil).Times(1)
+		response := bm.V2GetHostInventoryDiff(ctx, installer.V2GetHostInventoryDiffParams{
+			InfraEnvID: infraEnvId,
+			HostID:     hostID,
+			From:       from,
+			To:         to,
+		})
+		Expect(response).Should(BeAssignableToTypeOf(&installer.V2GetHostInventoryDiffOK{}))
+		Expect(response.(*installer.V2GetHostInventoryDiffOK).Payload).To(Equal(diff))
+	})
+
+	It("diff unknown snapshot", func() {
+		mockHostApi.EXPECT().GetInventoryDiff(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, common.NewApiError(http.StatusNotFound, errors.New("inventory snapshot was not found"))).Times(1)
+		response := bm.V2GetHostInventoryDiff(ctx, installer.V2GetHostInventoryDiffParams{
+			InfraEnvID: infraEnvId,
+			HostID:     hostID,
+			From:       strfmt.UUID(uuid.New().String()),
+			To:         strfmt.UUID(uuid.New().String()),
+		})
+		Expect(response).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+		Expect(response.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+	})
+})
+
 var _ = Describe("RegisterHost", func() {
 	var (
 		bm     *bareMetalInventory
@@ -1422,6 +1508,58 @@ var _ = Describe("PostStepReply", func() {
 		})
 	})
 
+	Context("Tang connectivity check", func() {
+		var (
+			clusterId *strfmt.UUID
+			hostId    *strfmt.UUID
+		)
+
+		BeforeEach(func() {
+			clusterId = strToUUID(uuid.New().String())
+			hostId = strToUUID(uuid.New().String())
+
+			host := models.Host{
+				ID:         hostId,
+				InfraEnvID: *clusterId,
+				ClusterID:  clusterId,
+				Status:     swag.String("known"),
+			}
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		})
+
+		It("stores only the fields of the response", func() {
+			mockHostApi.EXPECT().UpdateTangConnectivityReport(gomock.Any(), gomock.Any(),
+				`{"tang_servers":[{"thumbprints":["thumbprint1"],"url":"http://tang.example.com:7500"}]}`).Return(nil)
+
+			params := installer.V2PostStepReplyParams{
+				InfraEnvID: *clusterId,
+				HostID:     *hostId,
+				Reply: &models.StepReply{
+					Output:   `{"tang_servers":[{"url":"http://tang.example.com:7500","thumbprints":["thumbprint1"],"unexpected":"value"}]}`,
+					StepType: models.StepTypeTangConnectivityCheck,
+				},
+			}
+			reply := bm.V2PostStepReply(ctx, params)
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyNoContent()))
+		})
+
+		It("marks the check unsupported when the agent image doesn't contain it", func() {
+			mockHostApi.EXPECT().UpdateTangConnectivityReport(gomock.Any(), gomock.Any(), host.TangConnectivityCheckUnsupported).Return(nil)
+
+			params := installer.V2PostStepReplyParams{
+				InfraEnvID: *clusterId,
+				HostID:     *hostId,
+				Reply: &models.StepReply{
+					ExitCode: CommandNotFoundExitCode,
+					Error:    "executable file `tang_connectivity_check` not found in $PATH",
+					StepType: models.StepTypeTangConnectivityCheck,
+				},
+			}
+			reply := bm.V2PostStepReply(ctx, params)
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyNoContent()))
+		})
+	})
+
 	Context("Image availability", func() {
 		var (
 			clusterId *strfmt.UUID
@@ -2597,6 +2735,24 @@ var _ = Describe("cluster", func() {
 			verifyApiErrorString(reply, http.StatusBadRequest,
 				"VIP DHCP Allocation cannot be enabled on single node OpenShift")
 		})
+		It("create nutanix cluster fail, missing Prism Central credentials", func() {
+			errStr := "the Nutanix Prism Central username and password must be provided"
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterRegistrationFailedEventName),
+				eventstest.WithMessageContainsMatcher(errStr),
+				eventstest.WithSeverityMatcher(models.EventSeverityError))).Times(1)
+			clusterParams := getDefaultClusterCreateParams()
+			clusterParams.Platform = &models.Platform{
+				Type: common.PlatformTypePtr(models.PlatformTypeNutanix),
+				Nutanix: &models.NutanixPlatform{
+					PrismCentralAddress: swag.String("prism-central.example.com"),
+				},
+			}
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+				NewClusterParams: clusterParams,
+			})
+			verifyApiErrorString(reply, http.StatusBadRequest, errStr)
+		})
 	})
 	It("create non ha cluster success, release version is ci-release and greater than minimal", func() {
 		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
@@ -4762,6 +4918,146 @@ var _ = Describe("cluster", func() {
 			Expect(count).To(Equal(int64(1)))
 		})
 
+		Context("dry-run", func() {
+			mockDryRunPreparation := func() {
+				mockClusterIsReadyForInstallationSuccess()
+				mockGenerateAdditionalManifestsSuccess()
+				mockGetInstallConfigSuccess(mockInstallConfigBuilder)
+				mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil).Times(1)
+			}
+
+			expectDryRunEvent := func(name string) {
+				mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+					eventstest.WithNameMatcher(name),
+					eventstest.WithClusterIdMatcher(clusterID.String()))).Times(1)
+			}
+
+			It("renders the installation files without installing the cluster", func() {
+				mockDryRunPreparation()
+				mockGenerator.EXPECT().DryRunInstallConfig(gomock.Any(), gomock.Any(), []byte("some string"), gomock.Any()).
+					Return("openshift-install create ignition-configs\n", nil).Times(1)
+				expectDryRunEvent(eventgen.ClusterDryRunInstallationSucceededEventName)
+
+				reply := bm.V2DryRunInstallCluster(ctx, installer.V2DryRunInstallClusterParams{ClusterID: clusterID})
+				Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2DryRunInstallClusterOK()))
+				result := reply.(*installer.V2DryRunInstallClusterOK).Payload
+				Expect(swag.StringValue(result.Status)).To(Equal(models.DryRunResultStatusSucceeded))
+				Expect(result.InstallerOutput).To(Equal("openshift-install create ignition-configs\n"))
+				Expect(result.ValidationErrors).To(BeEmpty())
+
+				c, err := common.GetClusterFromDB(db, clusterID, common.UseEagerLoading)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(swag.StringValue(c.Status)).To(Equal(models.ClusterStatusReady))
+				for _, h := range c.Hosts {
+					Expect(swag.StringValue(h.Status)).To(Equal(models.HostStatusKnown))
+				}
+			})
+
+			It("reports the validation errors when the cluster is not ready", func() {
+				setIsReadyForInstallationFalse(mockClusterApi)
+				expectDryRunEvent(eventgen.ClusterDryRunInstallationFailedEventName)
+
+				validationsInfo := `{"network":[{"id":"api-vip-defined","status":"failure","message":"The API virtual IP is undefined"}]}`
+				Expect(db.Model(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).UpdateColumn("validations_info", validationsInfo).Error).ToNot(HaveOccurred())
+				hostValidationsInfo := `{"hardware":[{"id":"has-min-cpu-cores","status":"failure","message":"Insufficient CPU cores"},{"id":"has-memory-for-role","status":"success","message":"Sufficient RAM"}]}`
+				Expect(db.Model(&models.Host{}).Where("id = ?", masterHostId1).UpdateColumn("validations_info", hostValidationsInfo).Error).ToNot(HaveOccurred())
+
+				reply := bm.V2DryRunInstallCluster(ctx, installer.V2DryRunInstallClusterParams{ClusterID: clusterID})
+				Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2DryRunInstallClusterOK()))
+				result := reply.(*installer.V2DryRunInstallClusterOK).Payload
+				Expect(swag.StringValue(result.Status)).To(Equal(models.DryRunResultStatusFailed))
+				Expect(result.ValidationErrors).To(ConsistOf("The API virtual IP is undefined", "Host hostname0: Insufficient CPU cores"))
+				Expect(result.InstallerOutput).To(ContainSubstring("cluster is not ready to install"))
+			})
+
+			It("renders the files of the prepared cluster without changing it with the cluster manager", func() {
+				mockManifestsGenerator := network.NewMockManifestsGeneratorAPI(ctrl)
+				mockManifestsGenerator.EXPECT().AddChronyManifest(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				mockManifestsGenerator.EXPECT().AddTelemeterManifest(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				mockManifestsGenerator.EXPECT().AddSchedulableMastersManifest(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				mockManifestsGenerator.EXPECT().AddDiskEncryptionManifest(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				mockManifestsGenerator.EXPECT().AddNodeTopologyLabelsManifest(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				mockOperators := operators.NewMockAPI(ctrl)
+				mockOperators.EXPECT().GenerateManifests(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
+					db, mockEvents, nil, nil, mockManifestsGenerator, nil, mockOperators, nil, nil, nil, nil)
+
+				Expect(db.Model(&models.Host{}).Where("id = ?", masterHostId1).Updates(map[string]interface{}{
+					"role": models.HostRoleAutoAssign, "suggested_role": models.HostRoleMaster}).Error).ToNot(HaveOccurred())
+				before, err := common.GetClusterFromDB(db, clusterID, common.UseEagerLoading)
+				Expect(err).ToNot(HaveOccurred())
+
+				mockGetInstallConfigSuccess(mockInstallConfigBuilder)
+				mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil).Times(1)
+				var rendered common.Cluster
+				mockGenerator.EXPECT().DryRunInstallConfig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, c common.Cluster, _ []byte, _ string) (string, error) {
+						rendered = c
+						return "", nil
+					}).Times(1)
+				expectDryRunEvent(eventgen.ClusterDryRunInstallationSucceededEventName)
+
+				reply := bm.V2DryRunInstallCluster(ctx, installer.V2DryRunInstallClusterParams{ClusterID: clusterID})
+				Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2DryRunInstallClusterOK()))
+				Expect(swag.StringValue(reply.(*installer.V2DryRunInstallClusterOK).Payload.Status)).To(Equal(models.DryRunResultStatusSucceeded))
+
+				Expect(rendered.Hosts).To(HaveLen(3))
+				bootstraps := 0
+				for _, h := range rendered.Hosts {
+					Expect(h.Role).To(Equal(models.HostRoleMaster))
+					if h.Bootstrap {
+						bootstraps++
+					}
+				}
+				Expect(bootstraps).To(Equal(1))
+
+				after, err := common.GetClusterFromDB(db, clusterID, common.UseEagerLoading)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(swag.StringValue(after.Status)).To(Equal(models.ClusterStatusReady))
+				Expect(after.StatusInfo).To(Equal(before.StatusInfo))
+				Expect(after.InstallStartedAt).To(Equal(before.InstallStartedAt))
+				for _, h := range after.Hosts {
+					Expect(swag.StringValue(h.Status)).To(Equal(models.HostStatusKnown))
+					Expect(h.Bootstrap).To(BeFalse())
+					if h.ID.String() == masterHostId1.String() {
+						Expect(h.Role).To(Equal(models.HostRoleAutoAssign))
+					}
+				}
+			})
+
+			It("reports the output of the installer when the files could not be rendered", func() {
+				mockDryRunPreparation()
+				mockGenerator.EXPECT().DryRunInstallConfig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("FATAL failed to fetch Master Machines", errors.New("error running openshift-install ignition-configs")).Times(1)
+				expectDryRunEvent(eventgen.ClusterDryRunInstallationFailedEventName)
+
+				reply := bm.V2DryRunInstallCluster(ctx, installer.V2DryRunInstallClusterParams{ClusterID: clusterID})
+				Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2DryRunInstallClusterOK()))
+				result := reply.(*installer.V2DryRunInstallClusterOK).Payload
+				Expect(swag.StringValue(result.Status)).To(Equal(models.DryRunResultStatusFailed))
+				Expect(result.InstallerOutput).To(Equal("FATAL failed to fetch Master Machines"))
+			})
+
+			It("cluster doesn't exist", func() {
+				reply := bm.V2DryRunInstallCluster(ctx, installer.V2DryRunInstallClusterParams{ClusterID: strfmt.UUID(uuid.New().String())})
+				verifyApiError(reply, http.StatusNotFound)
+			})
+
+			It("downloads the tarball of the last dry run", func() {
+				mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), generator.DryRunObjectName(clusterID)).Return(true, nil).Times(1)
+
+				reply := bm.V2DownloadClusterDryRun(ctx, installer.V2DownloadClusterDryRunParams{ClusterID: clusterID})
+				Expect(reply).Should(BeAssignableToTypeOf(filemiddleware.NewResponder(nil, "", 0)))
+			})
+
+			It("fails to download the tarball when there was no dry run", func() {
+				mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), generator.DryRunObjectName(clusterID)).Return(false, nil).Times(1)
+
+				reply := bm.V2DownloadClusterDryRun(ctx, installer.V2DownloadClusterDryRunParams{ClusterID: clusterID})
+				verifyApiError(reply, http.StatusNotFound)
+			})
+		})
+
 		Context("CancelInstallation", func() {
 			BeforeEach(func() {
 				mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -5337,6 +5633,66 @@ var _ = Describe("[V2ClusterUpdate] cluster", func() {
 			Expect(actual.Payload.SchedulableMasters).To(Equal(swag.Bool(true)))
 		})
 
+		Context("Update FIPS", func() {
+			var infraEnvID strfmt.UUID
+
+			BeforeEach(func() {
+				clusterID = strfmt.UUID(uuid.New().String())
+				err := db.Create(&common.Cluster{Cluster: models.Cluster{
+					ID:               &clusterID,
+					OpenshiftVersion: "4.9.0",
+					CPUArchitecture:  common.DefaultCPUArchitecture,
+				}}).Error
+				Expect(err).ShouldNot(HaveOccurred())
+				infraEnvID = strfmt.UUID(uuid.New().String())
+				err = db.Create(&common.InfraEnv{
+					Generated: true,
+					InfraEnv:  models.InfraEnv{ID: &infraEnvID, ClusterID: clusterID},
+				}).Error
+				Expect(err).ShouldNot(HaveOccurred())
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+			})
+
+			It("enables FIPS mode and discards the discovery images", func() {
+				mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						Fips: swag.Bool(true),
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				actual := reply.(*installer.V2UpdateClusterCreated)
+				Expect(actual.Payload.Fips).To(Equal(swag.Bool(true)))
+
+				infraEnv, err := common.GetInfraEnvFromDB(db, infraEnvID)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(infraEnv.Generated).To(BeFalse())
+			})
+
+			It("rejects an unsupported CPU architecture", func() {
+				Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID).Update("cpu_architecture", "arm64").Error).ShouldNot(HaveOccurred())
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						Fips: swag.Bool(true),
+					},
+				})
+				verifyApiErrorString(reply, http.StatusBadRequest, "FIPS mode is not supported for CPU architecture arm64")
+			})
+
+			It("rejects a full ISO infra-env when the service generates the images", func() {
+				Expect(db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvID).Update("type", models.ImageTypeFullIso).Error).ShouldNot(HaveOccurred())
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						Fips: swag.Bool(true),
+					},
+				})
+				verifyApiErrorString(reply, http.StatusBadRequest, "Image type full-iso can't be generated for a cluster installed in FIPS mode")
+			})
+		})
+
 		Context("Update Proxy", func() {
 			//const emptyProxyHash = "d41d8cd98f00b204e9800998ecf8427e"
 			BeforeEach(func() {
@@ -5755,6 +6111,37 @@ var _ = Describe("[V2ClusterUpdate] cluster", func() {
 					actual := reply.(*installer.V2UpdateClusterCreated)
 					Expect(len(actual.Payload.MachineNetworks)).To(Equal(2))
 				})
+				It("Multiple machine network CIDRs in non dhcp for multi-subnet", func() {
+					mockSuccess(1)
+
+					apiVip := "1.2.3.100"
+					ingressVip := "1.2.3.101"
+					reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.V2ClusterUpdateParams{
+							APIVip:          &apiVip,
+							IngressVip:      &ingressVip,
+							MachineNetworks: []*models.MachineNetwork{{Cidr: "10.11.0.0/16"}, {Cidr: "1.2.3.0/24"}, {Cidr: "7.8.9.0/24"}},
+						},
+					})
+					Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+					actual := reply.(*installer.V2UpdateClusterCreated)
+					Expect(len(actual.Payload.MachineNetworks)).To(Equal(3))
+				})
+				It("VIPs in a machine network not shared by all the masters for multi-subnet", func() {
+					apiVip := "10.11.12.15"
+					ingressVip := "10.11.12.16"
+					reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+						ClusterID: clusterID,
+						ClusterUpdateParams: &models.V2ClusterUpdateParams{
+							APIVip:          &apiVip,
+							IngressVip:      &ingressVip,
+							MachineNetworks: []*models.MachineNetwork{{Cidr: "10.11.0.0/16"}, {Cidr: "1.2.3.0/24"}},
+						},
+					})
+					verifyApiErrorString(reply, http.StatusBadRequest,
+						"api-vip <10.11.12.15> belongs to machine-network-cidr <10.11.0.0/16> which is not shared by all the masters, missing: hostname2")
+				})
 				It("Wrong order of machine network CIDRs in non dhcp for dual-stack", func() {
 					mockSuccess(1)
 
@@ -6626,6 +7013,35 @@ var _ = Describe("infraEnvs", func() {
 				})
 				verifyApiError(resp, http.StatusNotFound)
 			})
+
+			It("pages through hosts", func() {
+				mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+				params := installer.V2ListHostsParams{
+					InfraEnvID: infraEnvId2,
+					Limit:      swag.Int64(2),
+				}
+				resp := bm.V2ListHosts(ctx, params)
+				reply := resp.(*installer.V2ListHostsOK)
+				Expect(reply.Payload).To(HaveLen(2))
+				Expect(reply.XTotalCount).To(Equal(int64(3)))
+				Expect(reply.XContinue).NotTo(BeEmpty())
+
+				params.Continue = swag.String(reply.XContinue)
+				resp = bm.V2ListHosts(ctx, params)
+				reply = resp.(*installer.V2ListHostsOK)
+				Expect(reply.Payload).To(HaveLen(1))
+				Expect(reply.XContinue).To(BeEmpty())
+			})
+
+			It("filters by role", func() {
+				resp := bm.V2ListHosts(ctx, installer.V2ListHostsParams{
+					InfraEnvID: infraEnvId1,
+					Role:       swag.String(string(models.HostRoleMaster)),
+				})
+				reply := resp.(*installer.V2ListHostsOK)
+				Expect(reply.Payload).To(BeEmpty())
+				Expect(reply.XTotalCount).To(BeZero())
+			})
 		})
 	})
 
@@ -6773,6 +7189,34 @@ var _ = Describe("infraEnvs", func() {
 			verifyApiErrorString(reply, http.StatusBadRequest, "CPU architecture doesn't match")
 		})
 
+		It("Create with ClusterID - full ISO of a cluster installed in FIPS mode", func() {
+			clusterID := strfmt.UUID(uuid.New().String())
+			err := db.Create(&common.Cluster{Cluster: models.Cluster{
+				ID:               &clusterID,
+				OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+				CPUArchitecture:  common.DefaultCPUArchitecture,
+				Fips:             swag.Bool(true),
+			}}).Error
+			Expect(err).ShouldNot(HaveOccurred())
+
+			mockVersions.EXPECT().GetOsImage(gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.OsImage, nil).Times(1)
+			mockEvents.EXPECT().SendInfraEnvEvent(ctx, eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.InfraEnvRegistrationFailedEventName),
+				eventstest.WithMessageContainsMatcher("can't be generated for a cluster installed in FIPS mode"))).Times(1)
+
+			reply := bm.RegisterInfraEnv(ctx, installer.RegisterInfraEnvParams{
+				InfraenvCreateParams: &models.InfraEnvCreateParams{
+					Name:             swag.String("some-infra-env-name"),
+					OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+					PullSecret:       swag.String("{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dG9rZW46dGVzdAo=\",\"email\":\"coyote@acme.com\"}}}"),
+					ClusterID:        &clusterID,
+					ImageType:        models.ImageTypeFullIso,
+				},
+			})
+			verifyApiErrorString(reply, http.StatusBadRequest,
+				"Image type full-iso can't be generated for a cluster installed in FIPS mode, use image type minimal-iso instead")
+		})
+
 		It("Invalid Ignition", func() {
 			mockVersions.EXPECT().GetOsImage(gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.OsImage, nil).Times(1)
 			MinimalOpenShiftVersionForNoneHA := "4.8.0-fc.0"
@@ -7056,6 +7500,56 @@ var _ = Describe("infraEnvs", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(i.StaticNetworkConfig).To(Equal(staticNetworkFormatRes))
 			})
+			It("Apply StaticNetwork template", func() {
+				mockInfraEnvUpdateSuccess()
+				staticNetworkFormatRes := "static network format result"
+				template := &models.StaticNetworkConfigTemplate{
+					NetworkYamlTemplate: swag.String("interfaces: []"),
+					HostsCsv:            swag.String("mac\n52:54:00:00:00:01\n"),
+					MacInterfaceColumns: map[string]string{"eth0": "mac"},
+				}
+				staticNetworkConfig := []*models.HostStaticNetworkConfig{{
+					NetworkYaml:     "interfaces: []",
+					MacInterfaceMap: models.MacInterfaceMap{{MacAddress: "52:54:00:00:00:01", LogicalNicName: "eth0"}},
+				}}
+				mockStaticNetworkConfig.EXPECT().RenderStaticNetworkConfigTemplate(gomock.Any(), template).Return(staticNetworkConfig, nil, nil).Times(1)
+				mockStaticNetworkConfig.EXPECT().ValidateStaticConfigParams(gomock.Any(), staticNetworkConfig).Return(nil).Times(1)
+				mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(staticNetworkConfig).Return(staticNetworkFormatRes, nil).Times(1)
+				reply := bm.ApplyStaticNetworkConfigTemplate(ctx, installer.ApplyStaticNetworkConfigTemplateParams{
+					InfraEnvID:                  *i.ID,
+					StaticNetworkConfigTemplate: template,
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewApplyStaticNetworkConfigTemplateCreated()))
+				var err error
+				i, err = bm.GetInfraEnvInternal(ctx, installer.GetInfraEnvParams{InfraEnvID: *i.ID})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(i.StaticNetworkConfig).To(Equal(staticNetworkFormatRes))
+			})
+			It("Apply StaticNetwork template with invalid rows", func() {
+				rowErrors := []*models.StaticNetworkConfigTemplateRowError{{Row: 3, Reason: "MAC address 52:54:00:00:00:01 is already used on row 2"}}
+				mockStaticNetworkConfig.EXPECT().RenderStaticNetworkConfigTemplate(gomock.Any(), gomock.Any()).Return(nil, rowErrors, nil).Times(1)
+				reply := bm.ApplyStaticNetworkConfigTemplate(ctx, installer.ApplyStaticNetworkConfigTemplateParams{
+					InfraEnvID:                  *i.ID,
+					StaticNetworkConfigTemplate: &models.StaticNetworkConfigTemplate{},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewApplyStaticNetworkConfigTemplateBadRequest()))
+				payload := reply.(*installer.ApplyStaticNetworkConfigTemplateBadRequest).Payload
+				Expect(swag.StringValue(payload.Reason)).To(Equal("The static network configuration of 1 hosts is invalid"))
+				Expect(payload.RowErrors).To(Equal(rowErrors))
+				var err error
+				i, err = bm.GetInfraEnvInternal(ctx, installer.GetInfraEnvParams{InfraEnvID: *i.ID})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(i.StaticNetworkConfig).To(BeEmpty())
+			})
+			It("Apply StaticNetwork template to a missing infraEnv", func() {
+				reply := bm.ApplyStaticNetworkConfigTemplate(ctx, installer.ApplyStaticNetworkConfigTemplateParams{
+					InfraEnvID:                  strfmt.UUID(uuid.New().String()),
+					StaticNetworkConfigTemplate: &models.StaticNetworkConfigTemplate{},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewApplyStaticNetworkConfigTemplateNotFound()))
+				payload := reply.(*installer.ApplyStaticNetworkConfigTemplateNotFound).Payload
+				Expect(swag.StringValue(payload.Code)).To(Equal(strconv.Itoa(http.StatusNotFound)))
+			})
 		})
 
 		Context("check pull secret", func() {
@@ -7200,6 +7694,34 @@ var _ = Describe("infraEnvs", func() {
 				Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusConflict)))
 			})
 
+			It("full ISO can't be generated for a cluster installed in FIPS mode", func() {
+				clusterID := strfmt.UUID(uuid.New().String())
+				Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, Fips: swag.Bool(true)}}).Error).ShouldNot(HaveOccurred())
+				Expect(db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvID).Updates(map[string]interface{}{
+					"cluster_id": clusterID, "type": models.ImageTypeFullIso}).Error).ShouldNot(HaveOccurred())
+				infraEnv, err := common.GetInfraEnvFromDB(db, infraEnvID)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				err = bm.GenerateInfraEnvISOInternal(ctx, infraEnv)
+				Expect(err).Should(HaveOccurred())
+				Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+				Expect(err.Error()).To(ContainSubstring("FIPS mode"))
+			})
+
+			It("rejects the full ISO image type for a cluster installed in FIPS mode", func() {
+				clusterID := strfmt.UUID(uuid.New().String())
+				Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, Fips: swag.Bool(true)}}).Error).ShouldNot(HaveOccurred())
+				Expect(db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvID).Update("cluster_id", clusterID).Error).ShouldNot(HaveOccurred())
+
+				_, err := bm.UpdateInfraEnvInternal(ctx, installer.UpdateInfraEnvParams{
+					InfraEnvID:           infraEnvID,
+					InfraEnvUpdateParams: &models.InfraEnvUpdateParams{ImageType: models.ImageTypeFullIso},
+				})
+				Expect(err).Should(HaveOccurred())
+				Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+				Expect(err.Error()).To(ContainSubstring("can't be generated for a cluster installed in FIPS mode"))
+			})
+
 			It("UpdateInternal GeneratedAt updated in response", func() {
 				mockInfraEnvUpdateSuccess()
 				reponse, err := bm.UpdateInfraEnvInternal(ctx, installer.UpdateInfraEnvParams{
@@ -7239,6 +7761,26 @@ var _ = Describe("infraEnvs", func() {
 					Expect(gotQuery.Get("version")).To(Equal(common.TestDefaultConfig.OpenShiftVersion))
 				})
 
+				It("passes the FIPS kernel argument to the image service for a cluster installed in FIPS mode", func() {
+					clusterID := strfmt.UUID(uuid.New().String())
+					Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, Fips: swag.Bool(true)}}).Error).ShouldNot(HaveOccurred())
+					Expect(db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvID).Update("cluster_id", clusterID).Error).ShouldNot(HaveOccurred())
+					mockVersions.EXPECT().GetOsImage(common.TestDefaultConfig.OpenShiftVersion, "").Return(common.TestDefaultConfig.OsImage, nil)
+					mockIgnitionBuilder.EXPECT().FormatDiscoveryIgnitionFile(gomock.Any(), gomock.Any(), bm.IgnitionConfig, true, bm.authHandler.AuthType()).Return("ignitionconfigforlogging", nil)
+					mockEvents.EXPECT().SendInfraEnvEvent(ctx, eventstest.NewEventMatcher(eventstest.WithNameMatcher(eventgen.ImageInfoUpdatedEventName)))
+
+					response, err := bm.UpdateInfraEnvInternal(ctx, installer.UpdateInfraEnvParams{
+						InfraEnvID:           infraEnvID,
+						InfraEnvUpdateParams: &models.InfraEnvUpdateParams{ImageType: models.ImageTypeFullIso},
+					})
+					Expect(err).ToNot(HaveOccurred())
+
+					u, err := url.Parse(response.DownloadURL)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(u.Query().Get("type")).To(Equal(string(models.ImageTypeFullIso)))
+					Expect(u.Query().Get("kargs")).To(Equal(common.FIPSKernelArgument))
+				})
+
 				Context("with rhsso auth", func() {
 					BeforeEach(func() {
 						_, cert := auth.GetTokenAndCert(false)
@@ -7527,6 +8069,7 @@ var _ = Describe("infraEnvs host", func() {
 				ClusterID:  &clusterID,
 			}).Error
 			Expect(err).ToNot(HaveOccurred())
+			mockHostApi.EXPECT().ReviewInventory(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 		})
 
 		It("update host role success", func() {
@@ -7565,6 +8108,35 @@ var _ = Describe("infraEnvs host", func() {
 			Expect(resp.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusInternalServerError)))
 		})
 
+		It("update host failure domain success", func() {
+			failureDomain := &models.FailureDomain{Rack: "r1", Chassis: "c1"}
+			mockHostApi.EXPECT().UpdateFailureDomain(gomock.Any(), gomock.Any(), gomock.Any(), failureDomain).Return(nil).Times(1)
+			mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+			mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			resp := bm.V2UpdateHost(ctx, installer.V2UpdateHostParams{
+				InfraEnvID: infraEnvID,
+				HostID:     hostID,
+				HostUpdateParams: &models.HostUpdateParams{
+					FailureDomain: failureDomain,
+				},
+			})
+			Expect(resp).Should(BeAssignableToTypeOf(installer.NewV2UpdateHostCreated()))
+		})
+
+		It("update host failure domain failure", func() {
+			mockHostApi.EXPECT().UpdateFailureDomain(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("some error")).Times(1)
+			resp := bm.V2UpdateHost(ctx, installer.V2UpdateHostParams{
+				InfraEnvID: infraEnvID,
+				HostID:     hostID,
+				HostUpdateParams: &models.HostUpdateParams{
+					FailureDomain: &models.FailureDomain{Rack: "r1"},
+				},
+			})
+			Expect(resp).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+			Expect(resp.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusConflict)))
+		})
+
 		It("update host name success", func() {
 			mockHostApi.EXPECT().UpdateRole(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 			mockHostApi.EXPECT().UpdateHostname(gomock.Any(), gomock.Any(), "somehostname", gomock.Any()).Return(nil).Times(1)
@@ -7842,6 +8414,102 @@ var _ = Describe("KubeConfig download", func() {
 
 })
 
+var _ = Describe("V2DownloadInfraEnvFiles", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		infraEnvID strfmt.UUID
+		dbName     string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		bm.ServiceBaseURL = "https://assisted.example.com"
+		infraEnv := common.InfraEnv{
+			InfraEnv: models.InfraEnv{
+				ID:               &infraEnvID,
+				OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+				CPUArchitecture:  common.TestDefaultConfig.CPUArchitecture,
+				Type:             common.ImageTypePtr(models.ImageTypeFullIso),
+			},
+		}
+		Expect(db.Create(&infraEnv).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("returns the iPXE script booting the infra-env artifacts", func() {
+		params := installer.V2DownloadInfraEnvFilesParams{InfraEnvID: infraEnvID, FileName: "ipxe-script"}
+		response := bm.V2DownloadInfraEnvFiles(ctx, params)
+		recorder := httptest.NewRecorder()
+		response.WriteResponse(recorder, runtime.ByteStreamProducer())
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		fileURL := fmt.Sprintf("https://assisted.example.com/api/assisted-install/v2/infra-envs/%s/downloads/files?file_name=", infraEnvID)
+		script := recorder.Body.String()
+		Expect(script).To(HavePrefix("#!ipxe\n"))
+		Expect(script).To(ContainSubstring(fmt.Sprintf("initrd --name initrd %sinitrd.img\n", fileURL)))
+		Expect(script).To(ContainSubstring(fmt.Sprintf("kernel %svmlinuz initrd=initrd coreos.live.rootfs_url=%srootfs.img ", fileURL, fileURL)))
+		Expect(script).To(HaveSuffix("boot\n"))
+	})
+
+	It("signs the artifact URLs with the image token when using RHSSO", func() {
+		_, cert := auth.GetTokenAndCert(false)
+		bm.authHandler = auth.NewRHSSOAuthenticator(&auth.Config{JwkCert: string(cert)}, nil, common.GetTestLog().WithField("pkg", "auth"), db)
+		bm.ImageExpirationTime = 4 * time.Hour
+		imageTokenKey, err := gencrypto.HMACKey(32)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvID).Update("image_token_key", imageTokenKey).Error).ShouldNot(HaveOccurred())
+
+		params := installer.V2DownloadInfraEnvFilesParams{InfraEnvID: infraEnvID, FileName: "ipxe-script"}
+		response := bm.V2DownloadInfraEnvFiles(ctx, params)
+		recorder := httptest.NewRecorder()
+		response.WriteResponse(recorder, runtime.ByteStreamProducer())
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		initrdLine := strings.Split(recorder.Body.String(), "\n")[1]
+		u, err := url.Parse(strings.TrimPrefix(initrdLine, "initrd --name initrd "))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(u.Query().Get("file_name")).To(Equal("initrd.img"))
+		_, err = bm.authHandler.AuthImageAuth(u.Query().Get("image_token"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("adds the FIPS kernel argument for a cluster installed in FIPS mode", func() {
+		clusterID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, Fips: swag.Bool(true)}}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvID).Update("cluster_id", clusterID).Error).ShouldNot(HaveOccurred())
+
+		params := installer.V2DownloadInfraEnvFilesParams{InfraEnvID: infraEnvID, FileName: "ipxe-script"}
+		response := bm.V2DownloadInfraEnvFiles(ctx, params)
+		recorder := httptest.NewRecorder()
+		response.WriteResponse(recorder, runtime.ByteStreamProducer())
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(ContainSubstring(" ignition.platform.id=metal fips=1\n"))
+	})
+
+	It("fails to download the kernel without the base ISO", func() {
+		mockS3Client.EXPECT().GetBaseIsoObject(common.TestDefaultConfig.OpenShiftVersion, common.TestDefaultConfig.CPUArchitecture).
+			Return("", errors.New("no such version")).Times(1)
+		params := installer.V2DownloadInfraEnvFilesParams{InfraEnvID: infraEnvID, FileName: "vmlinuz"}
+		response := bm.V2DownloadInfraEnvFiles(ctx, params)
+		verifyApiError(response, http.StatusInternalServerError)
+	})
+
+	It("fails for an unsupported file", func() {
+		params := installer.V2DownloadInfraEnvFilesParams{InfraEnvID: infraEnvID, FileName: "boot.iso"}
+		response := bm.V2DownloadInfraEnvFiles(ctx, params)
+		verifyApiError(response, http.StatusBadRequest)
+	})
+})
+
 var _ = Describe("DownloadMinimalInitrd", func() {
 	var (
 		bm        *bareMetalInventory
@@ -8300,6 +8968,136 @@ var _ = Describe("List clusters", func() {
 			Expect(len(payload)).Should(Equal(1))
 		})
 	})
+
+	Context("V2 list with filters and paging", func() {
+		BeforeEach(func() {
+			for i := 0; i != 4; i++ {
+				id := strfmt.UUID(uuid.New().String())
+				status := models.ClusterStatusReady
+				if i%2 == 0 {
+					status = models.ClusterStatusInstalled
+				}
+				Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+					ID:               &id,
+					OpenshiftVersion: "4.9",
+					Name:             fmt.Sprintf("cluster-%d", i),
+					Status:           swag.String(status),
+					UserName:         "paged-user",
+					Platform:         &models.Platform{Type: models.NewPlatformType(models.PlatformTypeVsphere)},
+				}}).Error).ShouldNot(HaveOccurred())
+			}
+		})
+
+		It("filters by status, version, platform and owner", func() {
+			resp := bm.V2ListClusters(ctx, installer.V2ListClustersParams{
+				Status:           []string{models.ClusterStatusReady},
+				OpenshiftVersion: swag.String("4.9"),
+				PlatformType:     swag.String(string(models.PlatformTypeVsphere)),
+				Owner:            swag.String("paged-user"),
+			})
+			reply := resp.(*installer.V2ListClustersOK)
+			Expect(reply.Payload).To(HaveLen(2))
+			Expect(reply.XTotalCount).To(Equal(int64(2)))
+			Expect(reply.XContinue).To(BeEmpty())
+			for _, cluster := range reply.Payload {
+				Expect(swag.StringValue(cluster.Status)).To(Equal(models.ClusterStatusReady))
+			}
+		})
+
+		It("filters by the nutanix platform", func() {
+			id := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+				ID:               &id,
+				OpenshiftVersion: "4.9",
+				Name:             "nutanix-cluster",
+				Status:           swag.String(models.ClusterStatusReady),
+				UserName:         "paged-user",
+				Platform:         &models.Platform{Type: models.NewPlatformType(models.PlatformTypeNutanix)},
+			}}).Error).ShouldNot(HaveOccurred())
+
+			resp := bm.V2ListClusters(ctx, installer.V2ListClustersParams{
+				PlatformType: swag.String(string(models.PlatformTypeNutanix)),
+			})
+			reply := resp.(*installer.V2ListClustersOK)
+			Expect(reply.Payload).To(HaveLen(1))
+			Expect(*reply.Payload[0].ID).To(Equal(id))
+		})
+
+		It("rejects an unknown platform type", func() {
+			resp := bm.V2ListClusters(ctx, installer.V2ListClustersParams{
+				PlatformType: swag.String("openstack"),
+			})
+			verifyApiErrorString(resp, http.StatusBadRequest, "invalid platform type openstack")
+		})
+
+		It("pages through clusters with a continue token", func() {
+			seen := map[strfmt.UUID]bool{}
+			params := installer.V2ListClustersParams{
+				Owner:  swag.String("paged-user"),
+				SortBy: swag.String(common.SortByCreatedAt),
+				Order:  swag.String(common.OrderDescending),
+				Limit:  swag.Int64(3),
+			}
+			resp := bm.V2ListClusters(ctx, params)
+			reply := resp.(*installer.V2ListClustersOK)
+			Expect(reply.Payload).To(HaveLen(3))
+			Expect(reply.XTotalCount).To(Equal(int64(4)))
+			Expect(reply.XContinue).NotTo(BeEmpty())
+			for _, cluster := range reply.Payload {
+				seen[*cluster.ID] = true
+			}
+
+			params.Continue = swag.String(reply.XContinue)
+			resp = bm.V2ListClusters(ctx, params)
+			reply = resp.(*installer.V2ListClustersOK)
+			Expect(reply.Payload).To(HaveLen(1))
+			Expect(reply.XTotalCount).To(Equal(int64(4)))
+			Expect(reply.XContinue).To(BeEmpty())
+			Expect(seen).NotTo(HaveKey(*reply.Payload[0].ID))
+		})
+
+		It("counts the soft-deleted clusters only when listing them", func() {
+			Expect(db.Where("name = ?", "cluster-0").Delete(&common.Cluster{}).Error).ShouldNot(HaveOccurred())
+			params := installer.V2ListClustersParams{
+				Owner: swag.String("paged-user"),
+				Limit: swag.Int64(2),
+			}
+			resp := bm.V2ListClusters(ctx, params)
+			reply := resp.(*installer.V2ListClustersOK)
+			Expect(reply.Payload).To(HaveLen(2))
+			Expect(reply.XTotalCount).To(Equal(int64(3)))
+
+			params.GetUnregisteredClusters = swag.Bool(true)
+			seen := 0
+			for {
+				resp = bm.V2ListClusters(ctx, params)
+				reply = resp.(*installer.V2ListClustersOK)
+				Expect(reply.XTotalCount).To(Equal(int64(4)))
+				seen += len(reply.Payload)
+				if reply.XContinue == "" {
+					break
+				}
+				params.Continue = swag.String(reply.XContinue)
+			}
+			Expect(seen).To(Equal(4))
+		})
+
+		It("rejects a malformed continue token", func() {
+			resp := bm.V2ListClusters(ctx, installer.V2ListClustersParams{Continue: swag.String("not-a-token")})
+			verifyApiError(resp, http.StatusBadRequest)
+		})
+
+		It("rejects a continue token issued for another sort field", func() {
+			resp := bm.V2ListClusters(ctx, installer.V2ListClustersParams{Limit: swag.Int64(1)})
+			reply := resp.(*installer.V2ListClustersOK)
+			Expect(reply.XContinue).NotTo(BeEmpty())
+			resp = bm.V2ListClusters(ctx, installer.V2ListClustersParams{
+				SortBy:   swag.String(common.SortByStatus),
+				Continue: swag.String(reply.XContinue),
+			})
+			verifyApiError(resp, http.StatusBadRequest)
+		})
+	})
 })
 
 var _ = Describe("Upload and Download logs test", func() {
@@ -8879,6 +9677,74 @@ var _ = Describe("UpdateClusterInstallConfig", func() {
 	})
 })
 
+var _ = Describe("ValidateClusterInstallConfig", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		c := common.Cluster{
+			Cluster: models.Cluster{
+				ID:                     &clusterID,
+				OpenshiftVersion:       common.TestDefaultConfig.OpenShiftVersion,
+				InstallConfigOverrides: `{"fips": true}`,
+			},
+		}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("returns the validation result without saving the overrides", func() {
+		override := `{"controlPlane": {"hyperthreading": "Disabled"}}`
+		params := installer.V2ValidateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: override,
+		}
+		result := &models.InstallConfigOverridesValidation{Valid: true, SchemaVersion: "4.6", Diff: "some diff"}
+		mockInstallConfigBuilder.EXPECT().ValidateInstallConfigOverrides(gomock.Any(), override).Return(result, nil).Times(1)
+		response := bm.V2ValidateClusterInstallConfig(ctx, params)
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2ValidateClusterInstallConfigOK{}))
+		Expect(response.(*installer.V2ValidateClusterInstallConfigOK).Payload).To(Equal(result))
+
+		var cluster common.Cluster
+		Expect(db.First(&cluster, "id = ?", clusterID).Error).ShouldNot(HaveOccurred())
+		Expect(cluster.InstallConfigOverrides).To(Equal(`{"fips": true}`))
+	})
+
+	It("returns not found with a non-existant cluster", func() {
+		params := installer.V2ValidateClusterInstallConfigParams{
+			ClusterID:           strfmt.UUID(uuid.New().String()),
+			InstallConfigParams: `{"fips": true}`,
+		}
+		response := bm.V2ValidateClusterInstallConfig(ctx, params)
+		verifyApiError(response, http.StatusNotFound)
+	})
+
+	It("returns bad request with malformed overrides", func() {
+		override := `{"controlPlane": {"hyperthreading": "Disabled"`
+		params := installer.V2ValidateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: override,
+		}
+		mockInstallConfigBuilder.EXPECT().ValidateInstallConfigOverrides(gomock.Any(), override).
+			Return(nil, common.NewApiError(http.StatusBadRequest, errors.New("some error"))).Times(1)
+		response := bm.V2ValidateClusterInstallConfig(ctx, params)
+		verifyApiError(response, http.StatusBadRequest)
+	})
+})
+
 var _ = Describe("GetDiscoveryIgnition", func() {
 	var (
 		bm        *bareMetalInventory
@@ -9732,6 +10598,45 @@ var _ = Describe("TestRegisterCluster", func() {
 		Expect(actual.Payload.SchedulableMasters).To(Equal(swag.Bool(false)))
 	})
 
+	It("FIPS default value", func() {
+		mockClusterRegisterSuccess(true)
+		mockAMSSubscription(ctx)
+
+		reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+			NewClusterParams: getDefaultClusterCreateParams(),
+		})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2RegisterClusterCreated()))
+		actual := reply.(*installer.V2RegisterClusterCreated)
+		Expect(actual.Payload.Fips).To(Equal(swag.Bool(false)))
+	})
+
+	It("FIPS enabled", func() {
+		mockClusterRegisterSuccess(true)
+		mockAMSSubscription(ctx)
+		clusterParams := getDefaultClusterCreateParams()
+		clusterParams.OpenshiftVersion = swag.String("4.9")
+		clusterParams.Fips = swag.Bool(true)
+		reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+			NewClusterParams: clusterParams,
+		})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2RegisterClusterCreated()))
+		actual := reply.(*installer.V2RegisterClusterCreated)
+		Expect(actual.Payload.Fips).To(Equal(swag.Bool(true)))
+	})
+
+	It("FIPS enabled with an unsupported release", func() {
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.ClusterRegistrationFailedEventName),
+			eventstest.WithMessageContainsMatcher("FIPS mode is not supported for OCP version"),
+			eventstest.WithSeverityMatcher(models.EventSeverityError))).Times(1)
+		clusterParams := getDefaultClusterCreateParams()
+		clusterParams.Fips = swag.Bool(true)
+		reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+			NewClusterParams: clusterParams,
+		})
+		verifyApiErrorString(reply, http.StatusBadRequest, "FIPS mode is not supported for OCP version 4.6")
+	})
+
 	It("SchedulableMasters non default value", func() {
 		mockClusterRegisterSuccess(true)
 		mockAMSSubscription(ctx)
@@ -12497,7 +13402,7 @@ var _ = Describe("Dual-stack cluster", func() {
 				verifyApiErrorString(reply, http.StatusBadRequest, errStr)
 			})
 			It("Single machine network", func() {
-				errStr := "Expected 2 machine networks, found 1"
+				errStr := "Expected at least 2 machine networks, found 1"
 				mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
 					eventstest.WithNameMatcher(eventgen.ClusterRegistrationFailedEventName),
 					eventstest.WithMessageContainsMatcher(errStr),
@@ -12555,7 +13460,7 @@ var _ = Describe("Dual-stack cluster", func() {
 				params.ClusterUpdateParams.ServiceNetworks = common.TestDualStackNetworking.ServiceNetworks
 				params.ClusterUpdateParams.MachineNetworks = common.TestIPv4Networking.MachineNetworks
 				reply := bm.UpdateCluster(ctx, params)
-				verifyApiErrorString(reply, http.StatusBadRequest, "Expected 2 machine networks, found 1")
+				verifyApiErrorString(reply, http.StatusBadRequest, "Expected at least 2 machine networks, found 1")
 			})
 		})
 	})
@@ -12639,6 +13544,73 @@ var _ = Describe("GetCredentials", func() {
 	})
 })
 
+var _ = Describe("V2GetClusterConnectivityMatrix", func() {
+
+	var (
+		ctx    = context.Background()
+		cfg    = Config{}
+		bm     *bareMetalInventory
+		db     *gorm.DB
+		dbName string
+		c      common.Cluster
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+
+		clusterID := strfmt.UUID(uuid.New().String())
+		c = common.Cluster{
+			Cluster: models.Cluster{
+				ID:   &clusterID,
+				Name: "mycluster",
+			},
+		}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+
+		hostID1 := strfmt.UUID(uuid.New().String())
+		hostID2 := strfmt.UUID(uuid.New().String())
+		report := models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{{
+			HostID:         hostID2,
+			L3Connectivity: []*models.L3Connectivity{{OutgoingNic: "eth0", RemoteIPAddress: "1.2.3.5", Successful: true, AverageRTTMs: 1.5}},
+		}}}
+		connectivity, err := json.Marshal(&report)
+		Expect(err).ToNot(HaveOccurred())
+		host1 := models.Host{ID: &hostID1, ClusterID: &clusterID, InfraEnvID: clusterID, RequestedHostname: "host1",
+			Status: swag.String(models.HostStatusKnown), Connectivity: string(connectivity)}
+		host2 := models.Host{ID: &hostID2, ClusterID: &clusterID, InfraEnvID: clusterID, RequestedHostname: "host2",
+			Status: swag.String(models.HostStatusKnown)}
+		Expect(db.Create(&host1).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&host2).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("returns the connectivity matrix of the cluster", func() {
+		reply := bm.V2GetClusterConnectivityMatrix(ctx, installer.V2GetClusterConnectivityMatrixParams{ClusterID: *c.ID})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2GetClusterConnectivityMatrixOK()))
+		matrix := reply.(*installer.V2GetClusterConnectivityMatrixOK).Payload
+		Expect(matrix).To(HaveLen(1))
+		Expect(matrix[0].SourceHostName).To(Equal("host1"))
+		Expect(matrix[0].TargetHostName).To(Equal("host2"))
+		Expect(matrix[0].AddressFamily).To(Equal(models.ConnectivityMatrixEntryAddressFamilyIPV4))
+		Expect(matrix[0].AverageRTTMs).To(Equal(1.5))
+	})
+
+	It("downloads the connectivity matrix of the cluster as CSV", func() {
+		reply := bm.V2DownloadClusterConnectivityMatrix(ctx, installer.V2DownloadClusterConnectivityMatrixParams{ClusterID: *c.ID})
+		Expect(reply).Should(BeAssignableToTypeOf(filemiddleware.NewResponder(nil, "", 0)))
+	})
+
+	It("cluster doesn't exist", func() {
+		reply := bm.V2GetClusterConnectivityMatrix(ctx, installer.V2GetClusterConnectivityMatrixParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+})
+
 var _ = Describe("AddReleaseImage", func() {
 	var (
 		cfg          = Config{}
@@ -13383,6 +14355,18 @@ var _ = Describe("GetInfraEnvDownloadURL", func() {
 			Expect(u.Query().Get("version")).To(Equal(common.TestDefaultConfig.OpenShiftVersion))
 			Expect(u.Path).To(Equal(fmt.Sprintf("%s/images/%s", imageServicePath, infraEnvID.String())))
 		})
+
+		It("adds the FIPS kernel argument for a cluster installed in FIPS mode", func() {
+			clusterID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, Fips: swag.Bool(true)}}).Error).To(Succeed())
+			Expect(db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvID).Update("cluster_id", clusterID).Error).To(Succeed())
+
+			payload := getNewURL()
+
+			u, err := url.Parse(payload.URL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(u.Query().Get("kargs")).To(Equal(common.FIPSKernelArgument))
+		})
 	})
 
 	Context("with local auth", func() {
@@ -13457,3 +14441,29 @@ var _ = Describe("GetInfraEnvDownloadURL", func() {
 		verifyApiError(resp, http.StatusBadRequest)
 	})
 })
+
+var _ = Describe("setOCSExternalClusterDetails", func() {
+	It("moves the RHCS cluster details out of the OCS operator properties", func() {
+		cluster := &common.Cluster{}
+		operators := []*models.MonitoredOperator{
+			{Name: "ocs", Properties: `{"deployment_profile": "external", "external_cluster_details": "[{}]"}`},
+			{Name: "lso", Properties: `{"external_cluster_details": "lso"}`},
+		}
+		Expect(setOCSExternalClusterDetails(cluster, operators)).To(Succeed())
+		Expect(cluster.OCSExternalClusterDetails).To(Equal("[{}]"))
+		Expect(operators[0].Properties).To(MatchJSON(`{"deployment_profile": "external"}`))
+		Expect(operators[1].Properties).To(Equal(`{"external_cluster_details": "lso"}`))
+	})
+
+	It("clears the RHCS cluster details when OCS is disabled", func() {
+		cluster := &common.Cluster{OCSExternalClusterDetails: "[{}]"}
+		Expect(setOCSExternalClusterDetails(cluster, []*models.MonitoredOperator{{Name: "lso"}})).To(Succeed())
+		Expect(cluster.OCSExternalClusterDetails).To(BeEmpty())
+	})
+
+	It("rejects OCS operator properties that are not valid", func() {
+		err := setOCSExternalClusterDetails(&common.Cluster{}, []*models.MonitoredOperator{{Name: "ocs", Properties: "{"}})
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+	})
+})
@@ -1,11 +1,14 @@
 package bminventory
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/runtime/middleware"
@@ -13,15 +16,18 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/google/uuid"
+	"github.com/kennygrant/sanitize"
 	"github.com/openshift/assisted-service/internal/common"
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/featuresupport"
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
 	"github.com/openshift/assisted-service/pkg/filemiddleware"
+	"github.com/openshift/assisted-service/pkg/generator"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/pkg/errors"
@@ -45,11 +51,11 @@ func (b *bareMetalInventory) V2RegisterCluster(ctx context.Context, params insta
 }
 
 func (b *bareMetalInventory) V2ListClusters(ctx context.Context, params installer.V2ListClustersParams) middleware.Responder {
-	clusters, err := b.listClustersInternal(ctx, params)
+	clusters, total, next, err := b.listClustersInternal(ctx, params)
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
-	return installer.NewV2ListClustersOK().WithPayload(clusters)
+	return installer.NewV2ListClustersOK().WithPayload(clusters).WithXTotalCount(total).WithXContinue(next)
 }
 
 func (b *bareMetalInventory) V2GetCluster(ctx context.Context, params installer.V2GetClusterParams) middleware.Responder {
@@ -89,6 +95,14 @@ func (b *bareMetalInventory) V2UpdateClusterInstallConfig(ctx context.Context, p
 	return installer.NewV2UpdateClusterInstallConfigCreated()
 }
 
+func (b *bareMetalInventory) V2ValidateClusterInstallConfig(ctx context.Context, params installer.V2ValidateClusterInstallConfigParams) middleware.Responder {
+	result, err := b.ValidateClusterInstallConfigInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ValidateClusterInstallConfigOK().WithPayload(result)
+}
+
 func (b *bareMetalInventory) V2InstallCluster(ctx context.Context, params installer.V2InstallClusterParams) middleware.Responder {
 	c, err := b.InstallClusterInternal(ctx, params)
 	if err != nil {
@@ -97,6 +111,78 @@ func (b *bareMetalInventory) V2InstallCluster(ctx context.Context, params instal
 	return installer.NewV2InstallClusterAccepted().WithPayload(&c.Cluster)
 }
 
+func (b *bareMetalInventory) V2DryRunInstallCluster(ctx context.Context, params installer.V2DryRunInstallClusterParams) middleware.Responder {
+	result, err := b.DryRunInstallClusterInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2DryRunInstallClusterOK().WithPayload(result)
+}
+
+func (b *bareMetalInventory) V2DownloadClusterDryRun(ctx context.Context, params installer.V2DownloadClusterDryRunParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := b.getCluster(ctx, params.ClusterID.String())
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	objectName := generator.DryRunObjectName(params.ClusterID)
+	exists, err := b.objectHandler.DoesObjectExist(ctx, objectName)
+	if err != nil {
+		log.WithError(err).Errorf("failed to find dry-run installation of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if !exists {
+		return common.NewApiError(http.StatusNotFound, errors.Errorf("No dry-run installation of cluster %s succeeded", params.ClusterID))
+	}
+
+	respBody, contentLength, err := b.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		log.WithError(err).Errorf("failed to download dry-run installation of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	fileName := fmt.Sprintf("%s_%s_dry-run.tar", sanitize.Name(cluster.Name), cluster.ID)
+	return filemiddleware.NewResponder(installer.NewV2DownloadClusterDryRunOK().WithPayload(respBody), fileName, contentLength)
+}
+
+func (b *bareMetalInventory) getClusterConnectivityMatrix(ctx context.Context, clusterID strfmt.UUID) (*common.Cluster, models.ConnectivityMatrix, error) {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := b.getCluster(ctx, clusterID.String(), common.UseEagerLoading)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	matrix, err := network.CreateConnectivityMatrix(cluster.Hosts)
+	if err != nil {
+		log.WithError(err).Errorf("failed to create connectivity matrix of cluster %s", clusterID)
+		return nil, nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return cluster, matrix, nil
+}
+
+func (b *bareMetalInventory) V2GetClusterConnectivityMatrix(ctx context.Context, params installer.V2GetClusterConnectivityMatrixParams) middleware.Responder {
+	_, matrix, err := b.getClusterConnectivityMatrix(ctx, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2GetClusterConnectivityMatrixOK().WithPayload(matrix)
+}
+
+func (b *bareMetalInventory) V2DownloadClusterConnectivityMatrix(ctx context.Context, params installer.V2DownloadClusterConnectivityMatrixParams) middleware.Responder {
+	cluster, matrix, err := b.getClusterConnectivityMatrix(ctx, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	var buf bytes.Buffer
+	if err = network.WriteConnectivityMatrixCSV(&buf, matrix); err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	fileName := fmt.Sprintf("%s_%s_connectivity-matrix.csv", sanitize.Name(cluster.Name), cluster.ID)
+	return filemiddleware.NewResponder(installer.NewV2DownloadClusterConnectivityMatrixOK().WithPayload(ioutil.NopCloser(&buf)),
+		fileName, int64(buf.Len()))
+}
+
 func (b *bareMetalInventory) V2CancelInstallation(ctx context.Context, params installer.V2CancelInstallationParams) middleware.Responder {
 	c, err := b.CancelInstallationInternal(ctx, params)
 	if err != nil {
@@ -430,6 +516,42 @@ func (b *bareMetalInventory) RegenerateInfraEnvSigningKey(ctx context.Context, p
 	return installer.NewRegenerateInfraEnvSigningKeyNoContent()
 }
 
+func (b *bareMetalInventory) ApplyStaticNetworkConfigTemplate(ctx context.Context, params installer.ApplyStaticNetworkConfigTemplateParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	if _, err := common.GetInfraEnvFromDB(b.db, params.InfraEnvID); err != nil {
+		log.WithError(err).Errorf("failed to get infraEnv: %s", params.InfraEnvID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return installer.NewApplyStaticNetworkConfigTemplateNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+		}
+		return installer.NewApplyStaticNetworkConfigTemplateInternalServerError().WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	staticNetworkConfig, rowErrors, err := b.staticNetworkConfig.RenderStaticNetworkConfigTemplate(ctx, params.StaticNetworkConfigTemplate)
+	if err != nil || len(rowErrors) > 0 {
+		reason := fmt.Sprintf("The static network configuration of %d hosts is invalid", len(rowErrors))
+		if err != nil {
+			reason = err.Error()
+		}
+		log.Errorf("Failed to apply the static network config template to infraEnv %s: %s", params.InfraEnvID, reason)
+		return installer.NewApplyStaticNetworkConfigTemplateBadRequest().WithPayload(&models.StaticNetworkConfigTemplateError{
+			Code:      swag.String(strconv.Itoa(http.StatusBadRequest)),
+			Reason:    swag.String(reason),
+			RowErrors: rowErrors,
+		})
+	}
+
+	i, err := b.UpdateInfraEnvInternal(ctx, installer.UpdateInfraEnvParams{
+		InfraEnvID:           params.InfraEnvID,
+		InfraEnvUpdateParams: &models.InfraEnvUpdateParams{StaticNetworkConfig: staticNetworkConfig},
+	})
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	log.Infof("Applied the static network config template of %d hosts to infraEnv %s", len(staticNetworkConfig), params.InfraEnvID)
+	return installer.NewApplyStaticNetworkConfigTemplateCreated().WithPayload(&i.InfraEnv)
+}
+
 func (b *bareMetalInventory) V2GetPresignedForClusterCredentials(ctx context.Context, params installer.V2GetPresignedForClusterCredentialsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 
@@ -487,7 +609,13 @@ func (b *bareMetalInventory) GetInfraEnvDownloadURL(ctx context.Context, params
 		return common.GenerateErrorResponder(errors.Errorf("OS image entry '%+v' missing OpenshiftVersion field", osImage))
 	}
 
-	newURL, expiresAt, err := b.generateImageDownloadURL(ctx, infraEnv.ID.String(), string(*infraEnv.Type), *osImage.OpenshiftVersion, infraEnv.CPUArchitecture, infraEnv.ImageTokenKey)
+	kernelArguments, err := b.discoveryKernelArguments(infraEnv)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	newURL, expiresAt, err := b.generateImageDownloadURL(ctx, infraEnv.ID.String(), string(*infraEnv.Type), *osImage.OpenshiftVersion, infraEnv.CPUArchitecture,
+		strings.Join(kernelArguments, " "), infraEnv.ImageTokenKey)
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
@@ -505,7 +633,9 @@ func (b *bareMetalInventory) GetInfraEnvDownloadURL(ctx context.Context, params
 	return installer.NewGetInfraEnvDownloadURLOK().WithPayload(&models.InfraEnvImageURL{URL: newURL, ExpiresAt: *expiresAt})
 }
 
-func (b *bareMetalInventory) generateImageDownloadURL(ctx context.Context, infraEnvID, imageType, version, arch, imageTokenKey string) (string, *strfmt.DateTime, error) {
+// generateImageDownloadURL returns the URL of the discovery image in the image service. The kernel arguments, separated
+// by spaces, are added to the kernel parameters of the image.
+func (b *bareMetalInventory) generateImageDownloadURL(ctx context.Context, infraEnvID, imageType, version, arch, kargs, imageTokenKey string) (string, *strfmt.DateTime, error) {
 	baseURL, err := url.Parse(b.ImageServiceBaseURL)
 	log := logutil.FromContext(ctx, b.log)
 	if err != nil {
@@ -520,6 +650,9 @@ func (b *bareMetalInventory) generateImageDownloadURL(ctx context.Context, infra
 	queryValues.Set("type", imageType)
 	queryValues.Set("version", version)
 	queryValues.Set("arch", arch)
+	if kargs != "" {
+		queryValues.Set("kargs", kargs)
+	}
 	downloadURL.RawQuery = queryValues.Encode()
 	urlString := downloadURL.String()
 
@@ -143,6 +143,21 @@ func (mr *MockInstallerInternalsMockRecorder) DownloadClusterFilesInternal(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadClusterFilesInternal", reflect.TypeOf((*MockInstallerInternals)(nil).DownloadClusterFilesInternal), arg0, arg1)
 }
 
+// DryRunInstallClusterInternal mocks base method.
+func (m *MockInstallerInternals) DryRunInstallClusterInternal(arg0 context.Context, arg1 installer.V2DryRunInstallClusterParams) (*models.DryRunResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunInstallClusterInternal", arg0, arg1)
+	ret0, _ := ret[0].(*models.DryRunResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRunInstallClusterInternal indicates an expected call of DryRunInstallClusterInternal.
+func (mr *MockInstallerInternalsMockRecorder) DryRunInstallClusterInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunInstallClusterInternal", reflect.TypeOf((*MockInstallerInternals)(nil).DryRunInstallClusterInternal), arg0, arg1)
+}
+
 // GetClusterByKubeKey mocks base method.
 func (m *MockInstallerInternals) GetClusterByKubeKey(arg0 types.NamespacedName) (*common.Cluster, error) {
 	m.ctrl.T.Helper()
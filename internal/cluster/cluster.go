@@ -22,6 +22,7 @@ import (
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/dns"
+	"github.com/openshift/assisted-service/internal/events"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
@@ -727,7 +728,15 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, clusterID strfmt.UU
 		"progress_total_percentage":            totalPercentage,
 	}
 
-	return m.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).UpdateColumns(updates).Error
+	if err = m.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).UpdateColumns(updates).Error; err != nil {
+		return err
+	}
+
+	progress := *cluster.Progress
+	progress.InstallingStagePercentage = installingStagePercentage
+	progress.TotalPercentage = totalPercentage
+	events.PublishClusterProgress(m.db, log, *cluster.ID, &progress)
+	return nil
 }
 
 func (m *Manager) UpdateFinalizingProgress(ctx context.Context, db *gorm.DB, clusterID strfmt.UUID) error {
@@ -1173,6 +1182,10 @@ func (m *Manager) GenerateAdditionalManifests(ctx context.Context, cluster *comm
 		return errors.Wrap(err, "failed to add disk encryption manifest")
 	}
 
+	if err := m.manifestsGeneratorAPI.AddNodeTopologyLabelsManifest(ctx, log, cluster); err != nil {
+		return errors.Wrap(err, "failed to add node topology labels manifest")
+	}
+
 	return nil
 }
 
@@ -234,11 +234,11 @@ func GetCluster(ctx context.Context, logger logrus.FieldLogger, db *gorm.DB, clu
 }
 
 func UpdateMachineCidr(db *gorm.DB, cluster *common.Cluster, machineCidr string) error {
-	// In case of dual-stack clusters the autocalculation feature is not supported. That means
-	// as soon as we detect that current Machine Network configuration indicates we have such
-	// a cluster, the function stops its execution.
+	// In case of dual-stack or multi-subnet clusters the autocalculation feature is not supported.
+	// That means as soon as we detect that current Machine Network configuration indicates we have
+	// such a cluster, the function stops its execution.
 	reqDualStack := network.CheckIfClusterIsDualStack(cluster)
-	if reqDualStack {
+	if reqDualStack || len(cluster.MachineNetworks) > 1 {
 		return nil
 	}
 
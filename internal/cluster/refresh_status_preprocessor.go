@@ -192,6 +192,16 @@ func newValidations(v *clusterValidator) []validation {
 			condition: v.isNtpServerConfigured,
 			formatter: v.printNtpServerConfigured,
 		},
+		{
+			id:        MastersSpreadAcrossFailureDomains,
+			condition: v.mastersSpreadAcrossFailureDomains,
+			formatter: v.printMastersSpreadAcrossFailureDomains,
+		},
+		{
+			id:        IsMTUConsistent,
+			condition: v.isMTUConsistent,
+			formatter: v.printIsMTUConsistent,
+		},
 	}
 	return ret
 }
@@ -119,6 +119,7 @@ func (r *registrar) DeregisterCluster(ctx context.Context, cluster *common.Clust
 		&models.ClusterNetwork{},
 		&models.ServiceNetwork{},
 		&models.MachineNetwork{},
+		&common.ClusterBundleHost{},
 	}); txErr != nil {
 		tx.Rollback()
 		return errors.Errorf("failed to delete cluster records %s", cluster.ID)
@@ -64,7 +64,8 @@ func NewClusterStateMachine(th *transitionHandler) stateswitch.StateMachine {
 	var vipsDefinedConditions = stateswitch.And(If(IsApiVipDefined), If(IsIngressVipDefined))
 	var requiredForInstall = stateswitch.And(If(IsMachineCidrEqualsToCalculatedCidr), If(IsApiVipValid), If(IsIngressVipValid), If(AllHostsAreReadyToInstall),
 		If(SufficientMastersCount), If(networkPrefixValid), If(noCidrOverlapping), If(IsNtpServerConfigured), If(IsOcsRequirementsSatisfied),
-		If(IsLsoRequirementsSatisfied), If(IsCnvRequirementsSatisfied), If(isNetworkTypeValid))
+		If(IsLsoRequirementsSatisfied), If(IsCnvRequirementsSatisfied), If(isNetworkTypeValid),
+		If(MastersSpreadAcrossFailureDomains), If(IsMTUConsistent))
 
 	// Refresh cluster status conditions - Non DHCP
 	var requiredInputFieldsExistNonDhcp = stateswitch.And(vipsDefinedConditions, pendingConditions)
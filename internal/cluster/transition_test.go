@@ -4021,6 +4021,255 @@ var _ = Describe("NTP refresh cluster", func() {
 	})
 })
 
+var _ = Describe("Failure domains refresh cluster", func() {
+	var (
+		ctx                                     = context.Background()
+		db                                      *gorm.DB
+		clusterId, hid1, hid2, hid3, hid4, hid5 strfmt.UUID
+		clusterApi                              *Manager
+		mockEvents                              *eventsapi.MockHandler
+		mockHostAPI                             *host.MockAPI
+		mockMetric                              *metrics.MockAPI
+		ctrl                                    *gomock.Controller
+		dbName                                  string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		mockHostAPI = host.NewMockAPI(ctrl)
+		mockMetric = metrics.NewMockAPI(ctrl)
+		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{}, nil, nil)
+		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog().WithField("pkg", "cluster-monitor"), db,
+			mockEvents, mockHostAPI, mockMetric, nil, nil, operatorsManager, nil, nil, nil, nil)
+
+		hid1 = strfmt.UUID(uuid.New().String())
+		hid2 = strfmt.UUID(uuid.New().String())
+		hid3 = strfmt.UUID(uuid.New().String())
+		hid4 = strfmt.UUID(uuid.New().String())
+		hid5 = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	tests := []struct {
+		name               string
+		srcState           string
+		dstState           string
+		racks              []string
+		powerDomains       []string
+		validationsChecker *validationsChecker
+	}{
+		{
+			name:     "ready to ready - no failure domains",
+			srcState: models.ClusterStatusReady,
+			dstState: models.ClusterStatusReady,
+			validationsChecker: makeJsonChecker(map[ValidationID]validationCheckResult{
+				MastersSpreadAcrossFailureDomains: {status: ValidationSuccess, messagePattern: "spread across the available failure domains"},
+			}),
+		},
+		{
+			name:     "ready to insufficient - masters in the same rack",
+			srcState: models.ClusterStatusReady,
+			dstState: models.ClusterStatusInsufficient,
+			racks:    []string{"r1", "r1", "r2", "r3", "r3"},
+			validationsChecker: makeJsonChecker(map[ValidationID]validationCheckResult{
+				MastersSpreadAcrossFailureDomains: {status: ValidationFailure, messagePattern: "not spread across the available racks, assign"},
+			}),
+		},
+		{
+			name:         "insufficient to ready - masters in distinct racks and power domains",
+			srcState:     models.ClusterStatusInsufficient,
+			dstState:     models.ClusterStatusReady,
+			racks:        []string{"r1", "r2", "r3", "r3", "r3"},
+			powerDomains: []string{"p1", "p2", "p1", "p2", "p2"},
+			validationsChecker: makeJsonChecker(map[ValidationID]validationCheckResult{
+				MastersSpreadAcrossFailureDomains: {status: ValidationSuccess, messagePattern: "spread across the available failure domains"},
+			}),
+		},
+		{
+			name:         "ready to insufficient - masters in the same power domain",
+			srcState:     models.ClusterStatusReady,
+			dstState:     models.ClusterStatusInsufficient,
+			racks:        []string{"r1", "r2", "r3", "r3", "r3"},
+			powerDomains: []string{"p1", "p1", "p1", "p2", "p2"},
+			validationsChecker: makeJsonChecker(map[ValidationID]validationCheckResult{
+				MastersSpreadAcrossFailureDomains: {status: ValidationFailure, messagePattern: "not spread across the available power domains"},
+			}),
+		},
+	}
+
+	for i := range tests {
+		t := tests[i]
+		It(t.name, func() {
+			cluster := common.Cluster{
+				Cluster: models.Cluster{
+					ClusterNetworks: common.TestIPv4Networking.ClusterNetworks,
+					ServiceNetworks: common.TestIPv4Networking.ServiceNetworks,
+					MachineNetworks: common.TestIPv4Networking.MachineNetworks,
+					APIVip:          common.TestIPv4Networking.APIVip,
+					IngressVip:      common.TestIPv4Networking.IngressVip,
+					ID:              &clusterId,
+					Status:          &t.srcState,
+					BaseDNSDomain:   "test.com",
+					PullSecretSet:   true,
+					NetworkType:     swag.String(models.ClusterNetworkTypeOVNKubernetes),
+				},
+			}
+			Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+			for i, hostID := range []*strfmt.UUID{&hid1, &hid2, &hid3, &hid4, &hid5} {
+				h := models.Host{ID: hostID, InfraEnvID: clusterId, ClusterID: &clusterId, Status: swag.String(models.HostStatusKnown),
+					Inventory: defaultInventoryWithTimestamp(1601909239), Role: models.HostRoleMaster, FailureDomain: &models.FailureDomain{}}
+				if i >= 3 {
+					h.Role = models.HostRoleWorker
+				}
+				if t.racks != nil {
+					h.FailureDomain.Rack = t.racks[i]
+				}
+				if t.powerDomains != nil {
+					h.FailureDomain.PowerDomain = t.powerDomains[i]
+				}
+				Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+			}
+			cluster = getClusterFromDB(clusterId, db)
+			if t.srcState != t.dstState {
+				mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+					eventstest.WithNameMatcher(eventgen.ClusterStatusUpdatedEventName),
+					eventstest.WithClusterIdMatcher(clusterId.String()))).Times(1)
+			}
+			mockHostAPI.EXPECT().IsRequireUserActionReset(gomock.Any()).Return(false).AnyTimes()
+
+			clusterAfterRefresh, err := clusterApi.RefreshStatus(ctx, &cluster, db)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(swag.StringValue(clusterAfterRefresh.Status)).To(Equal(t.dstState))
+			t.validationsChecker.check(clusterAfterRefresh.ValidationsInfo)
+		})
+	}
+})
+
+var _ = Describe("MTU refresh cluster", func() {
+	var (
+		ctx                         = context.Background()
+		db                          *gorm.DB
+		clusterId, hid1, hid2, hid3 strfmt.UUID
+		clusterApi                  *Manager
+		mockEvents                  *eventsapi.MockHandler
+		mockHostAPI                 *host.MockAPI
+		mockMetric                  *metrics.MockAPI
+		ctrl                        *gomock.Controller
+		dbName                      string
+	)
+
+	inventoryWithMTU := func(mtu int64) string {
+		inventory, err := common.UnmarshalInventory(defaultInventoryWithTimestamp(1601909239))
+		Expect(err).ToNot(HaveOccurred())
+		inventory.Interfaces[0].Mtu = mtu
+		b, err := common.MarshalInventory(inventory)
+		Expect(err).ToNot(HaveOccurred())
+		return b
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		mockHostAPI = host.NewMockAPI(ctrl)
+		mockMetric = metrics.NewMockAPI(ctrl)
+		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{}, nil, nil)
+		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog().WithField("pkg", "cluster-monitor"), db,
+			mockEvents, mockHostAPI, mockMetric, nil, nil, operatorsManager, nil, nil, nil, nil)
+
+		hid1 = strfmt.UUID(uuid.New().String())
+		hid2 = strfmt.UUID(uuid.New().String())
+		hid3 = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	tests := []struct {
+		name               string
+		srcState           string
+		dstState           string
+		mtus               []int64
+		validationsChecker *validationsChecker
+	}{
+		{
+			name:     "ready to ready - MTU not reported",
+			srcState: models.ClusterStatusReady,
+			dstState: models.ClusterStatusReady,
+			mtus:     []int64{0, 0, 0},
+			validationsChecker: makeJsonChecker(map[ValidationID]validationCheckResult{
+				IsMTUConsistent: {status: ValidationSuccess, messagePattern: "The hosts on the machine network have the same MTU"},
+			}),
+		},
+		{
+			name:     "ready to ready - same MTU",
+			srcState: models.ClusterStatusReady,
+			dstState: models.ClusterStatusReady,
+			mtus:     []int64{9000, 9000, 9000},
+			validationsChecker: makeJsonChecker(map[ValidationID]validationCheckResult{
+				IsMTUConsistent: {status: ValidationSuccess, messagePattern: "The hosts on the machine network have the same MTU"},
+			}),
+		},
+		{
+			name:     "ready to insufficient - MTU differs on one host",
+			srcState: models.ClusterStatusReady,
+			dstState: models.ClusterStatusInsufficient,
+			mtus:     []int64{9000, 1500, 9000},
+			validationsChecker: makeJsonChecker(map[ValidationID]validationCheckResult{
+				IsMTUConsistent: {status: ValidationFailure, messagePattern: "The MTU of most hosts on the machine network is 9000 but it differs on hosts"},
+			}),
+		},
+	}
+
+	for i := range tests {
+		t := tests[i]
+		It(t.name, func() {
+			cluster := common.Cluster{
+				Cluster: models.Cluster{
+					ClusterNetworks: common.TestIPv4Networking.ClusterNetworks,
+					ServiceNetworks: common.TestIPv4Networking.ServiceNetworks,
+					MachineNetworks: common.TestIPv4Networking.MachineNetworks,
+					APIVip:          common.TestIPv4Networking.APIVip,
+					IngressVip:      common.TestIPv4Networking.IngressVip,
+					ID:              &clusterId,
+					Status:          &t.srcState,
+					BaseDNSDomain:   "test.com",
+					PullSecretSet:   true,
+					NetworkType:     swag.String(models.ClusterNetworkTypeOVNKubernetes),
+				},
+			}
+			Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+			for i, hostID := range []*strfmt.UUID{&hid1, &hid2, &hid3} {
+				h := models.Host{ID: hostID, InfraEnvID: clusterId, ClusterID: &clusterId, Status: swag.String(models.HostStatusKnown),
+					Inventory: inventoryWithMTU(t.mtus[i]), Role: models.HostRoleMaster, RequestedHostname: fmt.Sprintf("master-%d", i)}
+				Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+			}
+			cluster = getClusterFromDB(clusterId, db)
+			if t.srcState != t.dstState {
+				mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+					eventstest.WithNameMatcher(eventgen.ClusterStatusUpdatedEventName),
+					eventstest.WithClusterIdMatcher(clusterId.String()))).Times(1)
+			}
+			mockHostAPI.EXPECT().IsRequireUserActionReset(gomock.Any()).Return(false).AnyTimes()
+
+			clusterAfterRefresh, err := clusterApi.RefreshStatus(ctx, &cluster, db)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(swag.StringValue(clusterAfterRefresh.Status)).To(Equal(t.dstState))
+			t.validationsChecker.check(clusterAfterRefresh.ValidationsInfo)
+		})
+	}
+})
+
 var _ = Describe("Single node", func() {
 	var (
 		ctx                         = context.Background()
@@ -30,15 +30,17 @@ const (
 	IsOcsRequirementsSatisfied          = ValidationID(models.ClusterValidationIDOcsRequirementsSatisfied)
 	IsLsoRequirementsSatisfied          = ValidationID(models.ClusterValidationIDLsoRequirementsSatisfied)
 	IsCnvRequirementsSatisfied          = ValidationID(models.ClusterValidationIDCnvRequirementsSatisfied)
+	MastersSpreadAcrossFailureDomains   = ValidationID(models.ClusterValidationIDMastersSpreadAcrossFailureDomains)
+	IsMTUConsistent                     = ValidationID(models.ClusterValidationIDMtuConsistent)
 )
 
 func (v ValidationID) Category() (string, error) {
 	switch v {
 	case IsMachineCidrDefined, IsMachineCidrEqualsToCalculatedCidr, IsApiVipDefined, IsApiVipValid, IsIngressVipDefined,
 		IsIngressVipValid, isClusterCidrDefined, isServiceCidrDefined, noCidrOverlapping, networkPrefixValid,
-		IsDNSDomainDefined, IsNtpServerConfigured, isNetworkTypeValid:
+		IsDNSDomainDefined, IsNtpServerConfigured, isNetworkTypeValid, IsMTUConsistent:
 		return "network", nil
-	case AllHostsAreReadyToInstall, SufficientMastersCount:
+	case AllHostsAreReadyToInstall, SufficientMastersCount, MastersSpreadAcrossFailureDomains:
 		return "hosts-data", nil
 	case IsPullSecretSet:
 		return "configuration", nil
@@ -737,15 +737,25 @@ var _ = Describe("Machine Network amount and order", func() {
 			valid:   false,
 		},
 		{
-			// Invalid because violates the "exactly 2 networks" constraint
+			// Several networks of the same family are allowed for multi-subnet clusters
 			element: []*models.MachineNetwork{{Cidr: "1.2.5.0/24"}, {Cidr: "1002:db8::/119"}, {Cidr: "1.2.6.0/24"}, {Cidr: "1.2.7.0/24"}},
-			valid:   false,
+			valid:   true,
 		},
 		{
-			// Invalid because violates the "exactly 2 networks" constraint
+			// Invalid because violates the "IPv4 subnet as the first one" constraint
 			element: []*models.MachineNetwork{{Cidr: "1002:db8::/119"}, {Cidr: "1.2.5.0/24"}, {Cidr: "1.2.6.0/24"}, {Cidr: "1.2.7.0/24"}},
 			valid:   false,
 		},
+		{
+			// Invalid because violates the "at least one IPv6 subnet" constraint
+			element: []*models.MachineNetwork{{Cidr: "1.2.5.0/24"}, {Cidr: "1.2.6.0/24"}},
+			valid:   false,
+		},
+		{
+			// Invalid because violates the "at least 2 networks" constraint
+			element: []*models.MachineNetwork{{Cidr: "1.2.5.0/24"}},
+			valid:   false,
+		},
 	}
 	for _, test := range tests {
 		t := test
@@ -2,12 +2,15 @@ package cluster
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
@@ -162,7 +165,17 @@ func (v *clusterValidator) isMachineCidrEqualsToCalculatedCidr(c *clusterPreproc
 	c.calculateCidr = cidr
 	return boolToValidationStatus(err == nil &&
 		network.IsMachineCidrAvailable(c.cluster) &&
-		network.GetMachineCidrById(c.cluster, 0) == cidr)
+		isMachineNetworkCidr(c.cluster, cidr))
+}
+
+// isMachineNetworkCidr checks whether the CIDR is one of the machine networks of the cluster
+func isMachineNetworkCidr(cluster *common.Cluster, cidr string) bool {
+	for _, machineNetwork := range cluster.MachineNetworks {
+		if string(machineNetwork.Cidr) == cidr {
+			return true
+		}
+	}
+	return false
 }
 
 func (v *clusterValidator) printIsMachineCidrEqualsToCalculatedCidr(context *clusterPreprocessContext, status ValidationStatus) string {
@@ -229,7 +242,7 @@ func (v *clusterValidator) isApiVipValid(c *clusterPreprocessContext) Validation
 	if c.cluster.APIVip == "" || !c.hasHostsWithInventories || !validationStatusToBool(v.isMachineCidrDefined(c)) {
 		return ValidationPending
 	}
-	err := network.VerifyVip(c.cluster.Hosts, network.GetMachineCidrById(c.cluster, 0), c.cluster.APIVip, ApiVipName,
+	err := network.VerifyVip(c.cluster.Hosts, c.cluster.MachineNetworks, c.cluster.APIVip, ApiVipName,
 		true, v.log)
 	return boolToValidationStatus(err == nil)
 }
@@ -346,7 +359,7 @@ func (v *clusterValidator) isIngressVipValid(c *clusterPreprocessContext) Valida
 	if c.cluster.IngressVip == "" || !c.hasHostsWithInventories || !validationStatusToBool(v.isMachineCidrDefined(c)) {
 		return ValidationPending
 	}
-	err := network.VerifyVip(c.cluster.Hosts, network.GetMachineCidrById(c.cluster, 0), c.cluster.IngressVip, IngressVipName,
+	err := network.VerifyVip(c.cluster.Hosts, c.cluster.MachineNetworks, c.cluster.IngressVip, IngressVipName,
 		true, v.log)
 	return boolToValidationStatus(err == nil)
 }
@@ -632,3 +645,117 @@ func (v *clusterValidator) printNtpServerConfigured(c *clusterPreprocessContext,
 		return fmt.Sprintf("Unexpected status %s", status)
 	}
 }
+
+// unspreadFailureDomains returns the kinds of failure domains that the masters are not spread across, although the
+// hosts of the cluster are labeled with enough distinct ones
+func unspreadFailureDomains(c *common.Cluster) []string {
+	kinds := []struct {
+		name  string
+		label func(*models.FailureDomain) string
+	}{
+		{"racks", func(f *models.FailureDomain) string { return f.Rack }},
+		{"chassis", func(f *models.FailureDomain) string { return f.Chassis }},
+		{"power domains", func(f *models.FailureDomain) string { return f.PowerDomain }},
+	}
+
+	hosts := make([]*models.Host, 0)
+	masters := make([]*models.Host, 0)
+	for _, h := range c.Hosts {
+		if swag.StringValue(h.Status) == models.HostStatusDisabled {
+			continue
+		}
+		hosts = append(hosts, h)
+		if common.GetEffectiveRole(h) == models.HostRoleMaster {
+			masters = append(masters, h)
+		}
+	}
+
+	distinct := func(hosts []*models.Host, label func(*models.FailureDomain) string) int {
+		values := map[string]bool{}
+		for _, h := range hosts {
+			if h.FailureDomain != nil && label(h.FailureDomain) != "" {
+				values[label(h.FailureDomain)] = true
+			}
+		}
+		return len(values)
+	}
+
+	unspread := make([]string, 0)
+	for _, kind := range kinds {
+		available := distinct(hosts, kind.label)
+		if available < 2 {
+			continue
+		}
+		required := len(masters)
+		if available < required {
+			required = available
+		}
+		if distinct(masters, kind.label) < required {
+			unspread = append(unspread, kind.name)
+		}
+	}
+	return unspread
+}
+
+func (v *clusterValidator) mastersSpreadAcrossFailureDomains(c *clusterPreprocessContext) ValidationStatus {
+	if swag.StringValue(c.cluster.HighAvailabilityMode) == models.ClusterHighAvailabilityModeNone {
+		return ValidationSuccess
+	}
+	return boolToValidationStatus(len(unspreadFailureDomains(c.cluster)) == 0)
+}
+
+func (v *clusterValidator) printMastersSpreadAcrossFailureDomains(c *clusterPreprocessContext, status ValidationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return "The control plane nodes are spread across the available failure domains."
+	case ValidationFailure:
+		return fmt.Sprintf("The control plane nodes are not spread across the available %s, assign the master role to hosts in distinct failure domains.",
+			strings.Join(unspreadFailureDomains(c.cluster), " and "))
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+// inconsistentMTUHosts returns the hosts whose MTU on the machine networks is not the one of most hosts, along with
+// the MTU of most hosts
+func inconsistentMTUHosts(c *common.Cluster) ([]string, int64, error) {
+	mtus, err := network.GetMachineNetworkMTUs(c)
+	if err != nil {
+		return nil, 0, err
+	}
+	majorityMTU := network.GetMajorityMTU(mtus)
+	hosts := make([]string, 0)
+	for _, h := range c.Hosts {
+		if mtu, ok := mtus[*h.ID]; ok && mtu != majorityMTU {
+			hosts = append(hosts, fmt.Sprintf("%s (%d)", hostutil.GetHostnameForMsg(h), mtu))
+		}
+	}
+	sort.Strings(hosts)
+	return hosts, majorityMTU, nil
+}
+
+func (v *clusterValidator) isMTUConsistent(c *clusterPreprocessContext) ValidationStatus {
+	if !network.IsMachineCidrAvailable(c.cluster) {
+		return ValidationSuccess
+	}
+	hosts, _, err := inconsistentMTUHosts(c.cluster)
+	if err != nil {
+		v.log.WithError(err).Errorf("failed to get the MTU of the hosts of cluster %s", c.clusterId)
+		return ValidationError
+	}
+	return boolToValidationStatus(len(hosts) == 0)
+}
+
+func (v *clusterValidator) printIsMTUConsistent(c *clusterPreprocessContext, status ValidationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return "The hosts on the machine network have the same MTU."
+	case ValidationFailure:
+		hosts, majorityMTU, _ := inconsistentMTUHosts(c.cluster)
+		return fmt.Sprintf("The MTU of most hosts on the machine network is %d but it differs on hosts %s.", majorityMTU, strings.Join(hosts, ", "))
+	case ValidationError:
+		return "Parse error while attempting to process the MTU of the hosts."
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
@@ -0,0 +1,335 @@
+package clusterbundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	openapierrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/ignition"
+	"github.com/openshift/assisted-service/models"
+	"github.com/thoas/go-funk"
+	"sigs.k8s.io/yaml"
+)
+
+// Version is the version of the bundle format. It is bumped whenever a change to the format is not backward compatible.
+const Version = "v1"
+
+// Bundle is the declarative definition of a cluster, exported from one environment to recreate the cluster in another
+type Bundle struct {
+	Version   string     `json:"version"`
+	Cluster   Cluster    `json:"cluster"`
+	Manifests []Manifest `json:"manifests,omitempty"`
+	// The hosts are keyed by the MAC address of one of their interfaces
+	Hosts map[string]Host `json:"hosts,omitempty"`
+}
+
+// Cluster holds the parameters the cluster is registered with, and the ones only set by updating it
+type Cluster struct {
+	models.ClusterCreateParams
+
+	// The pull secret is never exported. It shadows the one of the create params, and is provided on import.
+	PullSecret *string `json:"pull_secret,omitempty"`
+
+	APIVip                  string `json:"api_vip,omitempty"`
+	InstallConfigOverrides  string `json:"install_config_overrides,omitempty"`
+	IgnitionConfigOverrides string `json:"ignition_config_overrides,omitempty"`
+}
+
+type Manifest struct {
+	Folder   string `json:"folder"`
+	FileName string `json:"file_name"`
+	Content  string `json:"content"`
+}
+
+type Host struct {
+	Role                    models.HostRole `json:"role,omitempty"`
+	Hostname                string          `json:"hostname,omitempty"`
+	IgnitionConfigOverrides string          `json:"ignition_config_overrides,omitempty"`
+	InstallerArgs           []string        `json:"installer_args,omitempty"`
+}
+
+// Export returns the bundle of the cluster with its manifests
+func Export(cluster *common.Cluster, manifests []Manifest) *Bundle {
+	c := &cluster.Cluster
+	params := models.ClusterCreateParams{
+		Name:                  swag.String(c.Name),
+		OpenshiftVersion:      swag.String(c.OpenshiftVersion),
+		CPUArchitecture:       c.CPUArchitecture,
+		BaseDNSDomain:         c.BaseDNSDomain,
+		HighAvailabilityMode:  c.HighAvailabilityMode,
+		SchedulableMasters:    c.SchedulableMasters,
+		Platform:              redactPlatform(c.Platform),
+		NetworkType:           c.NetworkType,
+		UserManagedNetworking: c.UserManagedNetworking,
+		VipDhcpAllocation:     c.VipDhcpAllocation,
+		SSHPublicKey:          c.SSHPublicKey,
+		DiskEncryption:        c.DiskEncryption,
+		IgnitionEndpoint:      c.IgnitionEndpoint,
+		AdditionalNtpSource:   optionalString(c.AdditionalNtpSource),
+		Hyperthreading:        optionalString(c.Hyperthreading),
+		HTTPProxy:             optionalString(c.HTTPProxy),
+		HTTPSProxy:            optionalString(c.HTTPSProxy),
+		NoProxy:               optionalString(c.NoProxy),
+	}
+	for _, network := range c.ClusterNetworks {
+		params.ClusterNetworks = append(params.ClusterNetworks, &models.ClusterNetwork{Cidr: network.Cidr, HostPrefix: network.HostPrefix})
+	}
+	for _, network := range c.ServiceNetworks {
+		params.ServiceNetworks = append(params.ServiceNetworks, &models.ServiceNetwork{Cidr: network.Cidr})
+	}
+	for _, network := range c.MachineNetworks {
+		params.MachineNetworks = append(params.MachineNetworks, &models.MachineNetwork{Cidr: network.Cidr})
+	}
+	for _, operator := range c.MonitoredOperators {
+		if operator.OperatorType == models.OperatorTypeOlm {
+			params.OlmOperators = append(params.OlmOperators, &models.OperatorCreateParams{Name: operator.Name, Properties: operator.Properties})
+		}
+	}
+
+	bundle := &Bundle{
+		Version: Version,
+		Cluster: Cluster{
+			ClusterCreateParams:     params,
+			InstallConfigOverrides:  c.InstallConfigOverrides,
+			IgnitionConfigOverrides: c.IgnitionConfigOverrides,
+		},
+		Manifests: manifests,
+	}
+	// Allocated VIPs are not part of the definition of the cluster
+	if !swag.BoolValue(c.VipDhcpAllocation) && !swag.BoolValue(c.UserManagedNetworking) {
+		bundle.Cluster.APIVip = c.APIVip
+		bundle.Cluster.IngressVip = c.IngressVip
+	}
+
+	for _, h := range c.Hosts {
+		macAddress := hostMacAddress(h)
+		if macAddress == "" {
+			continue
+		}
+		host := Host{
+			Role:                    h.Role,
+			Hostname:                h.RequestedHostname,
+			IgnitionConfigOverrides: h.IgnitionConfigOverrides,
+		}
+		if h.InstallerArgs != "" {
+			_ = json.Unmarshal([]byte(h.InstallerArgs), &host.InstallerArgs)
+		}
+		if bundle.Hosts == nil {
+			bundle.Hosts = make(map[string]Host)
+		}
+		bundle.Hosts[macAddress] = host
+	}
+
+	return bundle
+}
+
+// redactPlatform returns a copy of the platform without its credentials, which are provided again on import
+func redactPlatform(platform *models.Platform) *models.Platform {
+	if platform == nil {
+		return nil
+	}
+	ret := *platform
+	if ret.Ovirt != nil {
+		ovirt := *ret.Ovirt
+		ovirt.Password = nil
+		ret.Ovirt = &ovirt
+	}
+	if ret.Nutanix != nil {
+		nutanix := *ret.Nutanix
+		nutanix.Password = nil
+		ret.Nutanix = &nutanix
+	}
+	return &ret
+}
+
+// SetPlatformPassword sets the password of the platform of the cluster, which is never exported
+func (b *Bundle) SetPlatformPassword(password strfmt.Password) {
+	platform := b.Cluster.Platform
+	if platform == nil || password == "" {
+		return
+	}
+	if platform.Ovirt != nil {
+		platform.Ovirt.Password = &password
+	}
+	if platform.Nutanix != nil {
+		platform.Nutanix.Password = &password
+	}
+}
+
+func missingPlatformPassword(platform *models.Platform) bool {
+	if platform == nil {
+		return false
+	}
+	switch common.PlatformTypeValue(platform.Type) {
+	case models.PlatformTypeOvirt:
+		return platform.Ovirt != nil && platform.Ovirt.Password == nil
+	case models.PlatformTypeNutanix:
+		return platform.Nutanix != nil && platform.Nutanix.Password == nil
+	}
+	return false
+}
+
+// Marshal returns the YAML document of the bundle
+func (b *Bundle) Marshal() ([]byte, error) {
+	return yaml.Marshal(b)
+}
+
+// Unmarshal parses the YAML document of a bundle. Unknown fields are rejected, so typos are not silently ignored.
+func Unmarshal(data []byte) (*Bundle, error) {
+	var bundle Bundle
+	if err := yaml.UnmarshalStrict(data, &bundle); err != nil {
+		return nil, err
+	}
+	return &bundle, nil
+}
+
+// Validate returns the errors of the individual fields of the bundle
+func (b *Bundle) Validate() []*models.ClusterBundleFieldError {
+	var fieldErrors []*models.ClusterBundleFieldError
+
+	if b.Version != Version {
+		fieldErrors = append(fieldErrors, fieldError("version", fmt.Sprintf("unsupported bundle version %q, expected %q", b.Version, Version)))
+	}
+
+	params := b.Cluster.ClusterCreateParams
+	params.PullSecret = b.Cluster.PullSecret
+	if err := params.Validate(strfmt.Default); err != nil {
+		fieldErrors = append(fieldErrors, validationErrors("cluster", err)...)
+	}
+	if missingPlatformPassword(b.Cluster.Platform) {
+		fieldErrors = append(fieldErrors, fieldError("cluster.platform.password", "the platform password is not exported and must be provided on import"))
+	}
+	if b.Cluster.APIVip != "" && net.ParseIP(b.Cluster.APIVip) == nil {
+		fieldErrors = append(fieldErrors, fieldError("cluster.api_vip", fmt.Sprintf("%s is not a valid IP address", b.Cluster.APIVip)))
+	}
+	if b.Cluster.InstallConfigOverrides != "" && !json.Valid([]byte(b.Cluster.InstallConfigOverrides)) {
+		fieldErrors = append(fieldErrors, fieldError("cluster.install_config_overrides", "install config overrides are not a valid JSON document"))
+	}
+	if b.Cluster.IgnitionConfigOverrides != "" {
+		if _, err := ignition.ParseToLatest([]byte(b.Cluster.IgnitionConfigOverrides)); err != nil {
+			fieldErrors = append(fieldErrors, fieldError("cluster.ignition_config_overrides", err.Error()))
+		}
+	}
+
+	for i, manifest := range b.Manifests {
+		if err := validateManifest(manifest); err != "" {
+			fieldErrors = append(fieldErrors, fieldError(fmt.Sprintf("manifests[%d]", i), err))
+		}
+	}
+
+	macAddresses := make([]string, 0, len(b.Hosts))
+	for macAddress := range b.Hosts {
+		macAddresses = append(macAddresses, macAddress)
+	}
+	sort.Strings(macAddresses)
+	for _, macAddress := range macAddresses {
+		fieldErrors = append(fieldErrors, validateHost(macAddress, b.Hosts[macAddress])...)
+	}
+
+	return fieldErrors
+}
+
+func validateManifest(manifest Manifest) string {
+	if !funk.ContainsString([]string{models.CreateManifestParamsFolderManifests, models.CreateManifestParamsFolderOpenshift}, manifest.Folder) {
+		return fmt.Sprintf("unsupported folder %q, expected %s or %s", manifest.Folder,
+			models.CreateManifestParamsFolderManifests, models.CreateManifestParamsFolderOpenshift)
+	}
+	if manifest.FileName == "" || strings.ContainsRune(manifest.FileName, os.PathSeparator) {
+		return fmt.Sprintf("invalid file name %q", manifest.FileName)
+	}
+	switch filepath.Ext(manifest.FileName) {
+	case ".yaml", ".yml":
+		var content map[string]interface{}
+		if yaml.Unmarshal([]byte(manifest.Content), &content) != nil {
+			return fmt.Sprintf("manifest %s has an invalid YAML format", manifest.FileName)
+		}
+	case ".json":
+		if !json.Valid([]byte(manifest.Content)) {
+			return fmt.Sprintf("manifest %s has an illegal JSON format", manifest.FileName)
+		}
+	default:
+		return fmt.Sprintf("manifest %s has an unsupported extension, only json, yaml and yml are supported", manifest.FileName)
+	}
+	return ""
+}
+
+func validateHost(macAddress string, host Host) []*models.ClusterBundleFieldError {
+	var fieldErrors []*models.ClusterBundleFieldError
+	field := fmt.Sprintf("hosts[%s]", macAddress)
+
+	if _, err := net.ParseMAC(macAddress); err != nil {
+		fieldErrors = append(fieldErrors, fieldError(field, fmt.Sprintf("%s is not a valid MAC address", macAddress)))
+	}
+	if host.Role != "" && !funk.Contains([]models.HostRole{models.HostRoleAutoAssign, models.HostRoleMaster, models.HostRoleWorker}, host.Role) {
+		fieldErrors = append(fieldErrors, fieldError(field+".role", fmt.Sprintf("unsupported role %q", host.Role)))
+	}
+	if host.Hostname != "" {
+		if err := hostutil.ValidateHostname(host.Hostname); err != nil {
+			fieldErrors = append(fieldErrors, fieldError(field+".hostname", err.Error()))
+		}
+	}
+	if host.IgnitionConfigOverrides != "" {
+		if _, err := ignition.ParseToLatest([]byte(host.IgnitionConfigOverrides)); err != nil {
+			fieldErrors = append(fieldErrors, fieldError(field+".ignition_config_overrides", err.Error()))
+		}
+	}
+	return fieldErrors
+}
+
+// validationErrors flattens the errors returned by the validation of a model to the fields they belong to
+func validationErrors(prefix string, err error) []*models.ClusterBundleFieldError {
+	switch e := err.(type) {
+	case *openapierrors.CompositeError:
+		var fieldErrors []*models.ClusterBundleFieldError
+		for _, inner := range e.Errors {
+			fieldErrors = append(fieldErrors, validationErrors(prefix, inner)...)
+		}
+		return fieldErrors
+	case *openapierrors.Validation:
+		return []*models.ClusterBundleFieldError{fieldError(prefix+"."+e.Name, e.Error())}
+	default:
+		return []*models.ClusterBundleFieldError{fieldError(prefix, err.Error())}
+	}
+}
+
+func fieldError(field, message string) *models.ClusterBundleFieldError {
+	return &models.ClusterBundleFieldError{Field: swag.String(field), Message: swag.String(message)}
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return swag.String(value)
+}
+
+// hostMacAddress returns the lowest MAC address of the interfaces of the host, so the same one is always exported
+func hostMacAddress(host *models.Host) string {
+	if host.Inventory == "" {
+		return ""
+	}
+	var inventory models.Inventory
+	if err := json.Unmarshal([]byte(host.Inventory), &inventory); err != nil {
+		return ""
+	}
+	var macAddresses []string
+	for _, intf := range inventory.Interfaces {
+		if intf.MacAddress != "" {
+			macAddresses = append(macAddresses, strings.ToLower(intf.MacAddress))
+		}
+	}
+	if len(macAddresses) == 0 {
+		return ""
+	}
+	sort.Strings(macAddresses)
+	return macAddresses[0]
+}
@@ -0,0 +1,240 @@
+package clusterbundle
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/manifests"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/filemiddleware"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_bundles"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	manifestsops "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	validationErrorCode = "ClusterBundleValidationError"
+	importErrorCode     = "ClusterBundleImportError"
+)
+
+var _ restapi.ClusterBundlesAPI = &Handler{}
+
+type Handler struct {
+	db            *gorm.DB
+	log           logrus.FieldLogger
+	installer     bminventory.InstallerInternals
+	manifestsAPI  manifestsapi.ClusterManifestsInternals
+	objectHandler s3wrapper.API
+}
+
+func NewHandler(db *gorm.DB, log logrus.FieldLogger, installer bminventory.InstallerInternals,
+	manifestsAPI manifestsapi.ClusterManifestsInternals, objectHandler s3wrapper.API) *Handler {
+	return &Handler{
+		db:            db,
+		log:           log,
+		installer:     installer,
+		manifestsAPI:  manifestsAPI,
+		objectHandler: objectHandler,
+	}
+}
+
+func (h *Handler) V2ExportClusterBundle(ctx context.Context, params operations.V2ExportClusterBundleParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+
+	cluster, err := h.installer.GetClusterInternal(ctx, installer.V2GetClusterParams{ClusterID: params.ClusterID})
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	clusterManifests, err := h.exportManifests(ctx, params.ClusterID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to export manifests of cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	data, err := Export(cluster, clusterManifests).Marshal()
+	if err != nil {
+		log.WithError(err).Errorf("failed to marshal bundle of cluster %s", params.ClusterID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	log.Infof("Exported bundle of cluster %s", params.ClusterID)
+	fileName := fmt.Sprintf("%s-bundle.yaml", cluster.Name)
+	return filemiddleware.NewResponder(operations.NewV2ExportClusterBundleOK().WithPayload(ioutil.NopCloser(bytes.NewReader(data))),
+		fileName, int64(len(data)))
+}
+
+func (h *Handler) exportManifests(ctx context.Context, clusterID strfmt.UUID) ([]Manifest, error) {
+	list, err := h.manifestsAPI.ListClusterManifestsInternal(ctx, manifestsops.ListClusterManifestsParams{ClusterID: clusterID})
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []Manifest
+	for _, manifest := range list {
+		objectName := manifests.GetManifestObjectName(clusterID, filepath.Join(manifest.Folder, manifest.FileName))
+		reader, _, err := h.objectHandler.Download(ctx, objectName)
+		if err != nil {
+			return nil, err
+		}
+		content, err := ioutil.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, Manifest{Folder: manifest.Folder, FileName: manifest.FileName, Content: string(content)})
+	}
+	return ret, nil
+}
+
+func (h *Handler) V2ImportClusterBundle(ctx context.Context, params operations.V2ImportClusterBundleParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	importParams := params.ImportParams
+
+	bundle, err := Unmarshal([]byte(swag.StringValue(importParams.Bundle)))
+	if err != nil {
+		return validationErrorResponse(fieldError("bundle", err.Error()))
+	}
+	if importParams.Name != "" {
+		bundle.Cluster.Name = swag.String(importParams.Name)
+	}
+	bundle.Cluster.PullSecret = importParams.PullSecret
+	bundle.SetPlatformPassword(importParams.PlatformPassword)
+	if fieldErrors := bundle.Validate(); len(fieldErrors) > 0 {
+		return validationErrorResponse(fieldErrors...)
+	}
+
+	// The VIPs can only be set together, once the cluster is registered
+	createParams := bundle.Cluster.ClusterCreateParams
+	createParams.PullSecret = bundle.Cluster.PullSecret
+	createParams.IngressVip = ""
+	cluster, err := h.installer.RegisterClusterInternal(ctx, nil, installer.V2RegisterClusterParams{NewClusterParams: &createParams},
+		common.SkipInfraEnvCreation)
+	if err != nil {
+		log.WithError(err).Error("failed to register cluster from bundle")
+		return importErrorResponse("cluster", err)
+	}
+
+	if field, err := h.configureCluster(ctx, *cluster.ID, bundle); err != nil {
+		log.WithError(err).Errorf("failed to configure cluster %s from bundle, deregistering it", cluster.ID)
+		if deregisterErr := h.installer.DeregisterClusterInternal(ctx, installer.V2DeregisterClusterParams{ClusterID: *cluster.ID}); deregisterErr != nil {
+			log.WithError(deregisterErr).Errorf("failed to deregister cluster %s", cluster.ID)
+		}
+		return importErrorResponse(field, err)
+	}
+
+	cluster, err = h.installer.GetClusterInternal(ctx, installer.V2GetClusterParams{ClusterID: *cluster.ID})
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	log.Infof("Imported cluster %s from bundle", cluster.ID)
+	return operations.NewV2ImportClusterBundleCreated().WithPayload(&cluster.Cluster)
+}
+
+// configureCluster applies the parts of the bundle which are not set on registration. It returns the field of the
+// bundle that failed to be applied.
+func (h *Handler) configureCluster(ctx context.Context, clusterID strfmt.UUID, bundle *Bundle) (string, error) {
+	if bundle.Cluster.APIVip != "" || bundle.Cluster.IngressVip != "" {
+		updateParams := &models.V2ClusterUpdateParams{
+			APIVip:     swag.String(bundle.Cluster.APIVip),
+			IngressVip: swag.String(bundle.Cluster.IngressVip),
+		}
+		if _, err := h.installer.UpdateClusterNonInteractive(ctx, installer.V2UpdateClusterParams{ClusterID: clusterID, ClusterUpdateParams: updateParams}); err != nil {
+			return "cluster.api_vip", err
+		}
+	}
+
+	if bundle.Cluster.InstallConfigOverrides != "" {
+		if _, err := h.installer.UpdateClusterInstallConfigInternal(ctx, installer.V2UpdateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: bundle.Cluster.InstallConfigOverrides,
+		}); err != nil {
+			return "cluster.install_config_overrides", err
+		}
+	}
+
+	if bundle.Cluster.IgnitionConfigOverrides != "" {
+		if err := h.installer.UpdateDiscoveryIgnitionInternal(ctx, installer.UpdateDiscoveryIgnitionParams{
+			ClusterID:               clusterID,
+			DiscoveryIgnitionParams: &models.DiscoveryIgnitionParams{Config: bundle.Cluster.IgnitionConfigOverrides},
+		}); err != nil {
+			return "cluster.ignition_config_overrides", err
+		}
+	}
+
+	for i, manifest := range bundle.Manifests {
+		if _, err := h.manifestsAPI.CreateClusterManifestInternal(ctx, manifestsops.CreateClusterManifestParams{
+			ClusterID: clusterID,
+			CreateManifestParams: &models.CreateManifestParams{
+				Folder:   swag.String(manifest.Folder),
+				FileName: swag.String(manifest.FileName),
+				Content:  swag.String(base64.StdEncoding.EncodeToString([]byte(manifest.Content))),
+			},
+		}); err != nil {
+			return fmt.Sprintf("manifests[%d]", i), err
+		}
+	}
+
+	for macAddress, host := range bundle.Hosts {
+		installerArgs, err := json.Marshal(host.InstallerArgs)
+		if err != nil {
+			return fmt.Sprintf("hosts[%s].installer_args", macAddress), err
+		}
+		bundleHost := common.ClusterBundleHost{
+			ClusterID:               clusterID,
+			MacAddress:              strings.ToLower(macAddress),
+			Role:                    host.Role,
+			Hostname:                host.Hostname,
+			IgnitionConfigOverrides: host.IgnitionConfigOverrides,
+			InstallerArgs:           string(installerArgs),
+		}
+		if err = h.db.Create(&bundleHost).Error; err != nil {
+			return fmt.Sprintf("hosts[%s]", macAddress), err
+		}
+	}
+
+	return "", nil
+}
+
+func validationErrorResponse(fieldErrors ...*models.ClusterBundleFieldError) middleware.Responder {
+	return operations.NewV2ImportClusterBundleBadRequest().WithPayload(&models.ClusterBundleValidationError{
+		Code:        swag.String(validationErrorCode),
+		Reason:      swag.String("The cluster bundle is not valid"),
+		FieldErrors: fieldErrors,
+	})
+}
+
+// importErrorResponse reports the errors caused by the content of the bundle as errors of the field they were applied
+// from, and any other error as is
+func importErrorResponse(field string, err error) middleware.Responder {
+	var apiErr *common.ApiErrorResponse
+	if errors.As(err, &apiErr) && apiErr.StatusCode() == http.StatusBadRequest {
+		return operations.NewV2ImportClusterBundleBadRequest().WithPayload(&models.ClusterBundleValidationError{
+			Code:        swag.String(importErrorCode),
+			Reason:      swag.String("The cluster bundle could not be applied"),
+			FieldErrors: []*models.ClusterBundleFieldError{fieldError(field, err.Error())},
+		})
+	}
+	return common.GenerateErrorResponder(err)
+}
@@ -0,0 +1,286 @@
+package clusterbundle
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/bminventory"
+	"github.com/openshift/assisted-service/internal/common"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/cluster_bundles"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+func TestClusterBundle(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cluster bundle tests")
+}
+
+const (
+	testInventory = `{"interfaces":[{"mac_address":"52:54:00:BB:00:02"},{"mac_address":"52:54:00:aa:00:01"}]}`
+	testManifest  = "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: test\n"
+)
+
+func testCluster() *common.Cluster {
+	clusterID := strfmt.UUID(uuid.New().String())
+	hostID := strfmt.UUID(uuid.New().String())
+	return &common.Cluster{PullSecret: `{"auths":{"cloud.openshift.com":{"auth":"dXNlcjpwYXNz"}}}`, Cluster: models.Cluster{
+		ID:                      &clusterID,
+		Name:                    "bundle",
+		OpenshiftVersion:        "4.9",
+		BaseDNSDomain:           "example.com",
+		APIVip:                  "1.2.3.10",
+		IngressVip:              "1.2.3.11",
+		HTTPProxy:               "http://proxy.example.com:3128",
+		InstallConfigOverrides:  `{"fips":true}`,
+		IgnitionConfigOverrides: `{"ignition":{"version":"3.1.0"}}`,
+		ClusterNetworks:         []*models.ClusterNetwork{{ClusterID: clusterID, Cidr: "10.128.0.0/14", HostPrefix: 23}},
+		ServiceNetworks:         []*models.ServiceNetwork{{ClusterID: clusterID, Cidr: "172.30.0.0/16"}},
+		MonitoredOperators: []*models.MonitoredOperator{
+			{Name: "console", OperatorType: models.OperatorTypeBuiltin},
+			{Name: "lso", OperatorType: models.OperatorTypeOlm},
+		},
+		Hosts: []*models.Host{{
+			ID:                &hostID,
+			Inventory:         testInventory,
+			Role:              models.HostRoleMaster,
+			RequestedHostname: "master-0",
+			InstallerArgs:     `["--append-karg","nameserver=8.8.8.8"]`,
+		}},
+	}}
+}
+
+var _ = Describe("Bundle", func() {
+	It("exports the definition of the cluster", func() {
+		bundle := Export(testCluster(), []Manifest{{Folder: "openshift", FileName: "test.yaml", Content: testManifest}})
+
+		Expect(bundle.Version).To(Equal(Version))
+		Expect(swag.StringValue(bundle.Cluster.Name)).To(Equal("bundle"))
+		Expect(bundle.Cluster.PullSecret).To(BeNil())
+		Expect(bundle.Cluster.APIVip).To(Equal("1.2.3.10"))
+		Expect(bundle.Cluster.IngressVip).To(Equal("1.2.3.11"))
+		Expect(swag.StringValue(bundle.Cluster.HTTPProxy)).To(Equal("http://proxy.example.com:3128"))
+		Expect(bundle.Cluster.HTTPSProxy).To(BeNil())
+		Expect(bundle.Cluster.ClusterNetworks).To(Equal([]*models.ClusterNetwork{{Cidr: "10.128.0.0/14", HostPrefix: 23}}))
+		Expect(bundle.Cluster.OlmOperators).To(Equal([]*models.OperatorCreateParams{{Name: "lso"}}))
+		Expect(bundle.Manifests).To(HaveLen(1))
+		Expect(bundle.Hosts).To(Equal(map[string]Host{
+			"52:54:00:aa:00:01": {
+				Role:          models.HostRoleMaster,
+				Hostname:      "master-0",
+				InstallerArgs: []string{"--append-karg", "nameserver=8.8.8.8"},
+			},
+		}))
+	})
+
+	It("does not export allocated VIPs", func() {
+		cluster := testCluster()
+		cluster.VipDhcpAllocation = swag.Bool(true)
+		bundle := Export(cluster, nil)
+		Expect(bundle.Cluster.APIVip).To(BeEmpty())
+		Expect(bundle.Cluster.IngressVip).To(BeEmpty())
+	})
+
+	It("does not export the pull secret", func() {
+		data, err := Export(testCluster(), nil).Marshal()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(data)).ToNot(ContainSubstring("pull_secret"))
+		Expect(string(data)).ToNot(ContainSubstring("dXNlcjpwYXNz"))
+	})
+
+	It("does not export the platform passwords", func() {
+		cluster := testCluster()
+		cluster.Platform = &models.Platform{
+			Type: common.PlatformTypePtr(models.PlatformTypeOvirt),
+			Ovirt: &models.OvirtPlatform{
+				Fqdn:     swag.String("ovirt.example.com"),
+				Username: swag.String("admin@internal"),
+				Password: (*strfmt.Password)(swag.String("ovirt-secret")),
+			},
+			Nutanix: &models.NutanixPlatform{
+				Username: swag.String("admin"),
+				Password: (*strfmt.Password)(swag.String("prism-secret")),
+			},
+		}
+		data, err := Export(cluster, nil).Marshal()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring("ovirt.example.com"))
+		Expect(string(data)).ToNot(ContainSubstring("password"))
+		Expect(string(data)).ToNot(ContainSubstring("ovirt-secret"))
+		Expect(string(data)).ToNot(ContainSubstring("prism-secret"))
+		Expect(cluster.Platform.Ovirt.Password.String()).To(Equal("ovirt-secret"))
+	})
+
+	It("round trips through YAML", func() {
+		bundle := Export(testCluster(), []Manifest{{Folder: "openshift", FileName: "test.yaml", Content: testManifest}})
+		data, err := bundle.Marshal()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		parsed, err := Unmarshal(data)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(parsed).To(Equal(bundle))
+	})
+
+	It("rejects unknown fields", func() {
+		_, err := Unmarshal([]byte("version: v1\ncluster:\n  nmae: typo\n"))
+		Expect(err).Should(HaveOccurred())
+	})
+
+	Context("Validate", func() {
+		var bundle *Bundle
+
+		BeforeEach(func() {
+			bundle = Export(testCluster(), []Manifest{{Folder: "openshift", FileName: "test.yaml", Content: testManifest}})
+			bundle.Cluster.PullSecret = swag.String(`{"auths":{}}`)
+		})
+
+		fields := func(fieldErrors []*models.ClusterBundleFieldError) []string {
+			var ret []string
+			for _, fieldError := range fieldErrors {
+				ret = append(ret, swag.StringValue(fieldError.Field))
+			}
+			return ret
+		}
+
+		It("accepts an exported bundle", func() {
+			Expect(bundle.Validate()).To(BeEmpty())
+		})
+
+		It("requires the password of the platform", func() {
+			bundle.Cluster.Platform = &models.Platform{
+				Type:    common.PlatformTypePtr(models.PlatformTypeNutanix),
+				Nutanix: &models.NutanixPlatform{Username: swag.String("admin")},
+			}
+			Expect(fields(bundle.Validate())).To(ConsistOf("cluster.platform.password"))
+
+			bundle.SetPlatformPassword("prism-secret")
+			Expect(bundle.Validate()).To(BeEmpty())
+			Expect(bundle.Cluster.Platform.Nutanix.Password.String()).To(Equal("prism-secret"))
+		})
+
+		It("reports the errors of every field", func() {
+			bundle.Version = "v0"
+			bundle.Cluster.OpenshiftVersion = nil
+			bundle.Cluster.PullSecret = nil
+			bundle.Cluster.APIVip = "not-an-ip"
+			bundle.Cluster.InstallConfigOverrides = "{"
+			bundle.Cluster.IgnitionConfigOverrides = `{"ignition":{"version":"1.0.0"}}`
+			bundle.Manifests = append(bundle.Manifests,
+				Manifest{Folder: "other", FileName: "test.yaml", Content: testManifest},
+				Manifest{Folder: "manifests", FileName: "test.txt", Content: "text"},
+				Manifest{Folder: "manifests", FileName: "test.json", Content: "{"})
+			bundle.Hosts["not-a-mac"] = Host{}
+			bundle.Hosts["52:54:00:aa:00:02"] = Host{Role: models.HostRoleBootstrap, Hostname: "-invalid-"}
+
+			Expect(fields(bundle.Validate())).To(ConsistOf(
+				"version",
+				"cluster.openshift_version",
+				"cluster.pull_secret",
+				"cluster.api_vip",
+				"cluster.install_config_overrides",
+				"cluster.ignition_config_overrides",
+				"manifests[1]",
+				"manifests[2]",
+				"manifests[3]",
+				"hosts[not-a-mac]",
+				"hosts[52:54:00:aa:00:02].role",
+				"hosts[52:54:00:aa:00:02].hostname",
+			))
+		})
+	})
+})
+
+var _ = Describe("V2ImportClusterBundle", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockInstaller *bminventory.MockInstallerInternals
+		mockManifests *manifestsapi.MockClusterManifestsInternals
+		handler       *Handler
+		bundle        string
+		ctx           = context.Background()
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockInstaller = bminventory.NewMockInstallerInternals(ctrl)
+		mockManifests = manifestsapi.NewMockClusterManifestsInternals(ctrl)
+		handler = NewHandler(nil, logrus.New(), mockInstaller, mockManifests, nil)
+
+		exported := Export(testCluster(), []Manifest{{Folder: "openshift", FileName: "test.yaml", Content: testManifest}})
+		exported.Hosts = nil
+		data, err := exported.Marshal()
+		Expect(err).ShouldNot(HaveOccurred())
+		bundle = string(data)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	importParams := func(name string) operations.V2ImportClusterBundleParams {
+		return operations.V2ImportClusterBundleParams{ImportParams: &models.ClusterBundleImportParams{
+			Bundle:     swag.String(bundle),
+			Name:       name,
+			PullSecret: swag.String(`{"auths":{}}`),
+		}}
+	}
+
+	validationError := func(reply interface{}) *models.ClusterBundleValidationError {
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2ImportClusterBundleBadRequest()))
+		return reply.(*operations.V2ImportClusterBundleBadRequest).Payload
+	}
+
+	It("registers and configures the cluster", func() {
+		clusterID := strfmt.UUID(uuid.New().String())
+		cluster := &common.Cluster{Cluster: models.Cluster{ID: &clusterID}}
+		mockInstaller.EXPECT().RegisterClusterInternal(ctx, nil, gomock.Any(), common.SkipInfraEnvCreation).Return(cluster, nil)
+		mockInstaller.EXPECT().UpdateClusterNonInteractive(ctx, gomock.Any()).Return(cluster, nil)
+		mockInstaller.EXPECT().UpdateClusterInstallConfigInternal(ctx, gomock.Any()).Return(cluster, nil)
+		mockInstaller.EXPECT().UpdateDiscoveryIgnitionInternal(ctx, gomock.Any()).Return(nil)
+		mockManifests.EXPECT().CreateClusterManifestInternal(ctx, gomock.Any()).Return(&models.Manifest{}, nil)
+		mockInstaller.EXPECT().GetClusterInternal(ctx, gomock.Any()).Return(cluster, nil)
+
+		reply := handler.V2ImportClusterBundle(ctx, importParams("imported"))
+		Expect(reply).To(BeAssignableToTypeOf(operations.NewV2ImportClusterBundleCreated()))
+	})
+
+	It("reports an invalid bundle", func() {
+		bundle = "version: v1\ncluster: ["
+		payload := validationError(handler.V2ImportClusterBundle(ctx, importParams("")))
+		Expect(payload.FieldErrors).To(HaveLen(1))
+		Expect(swag.StringValue(payload.FieldErrors[0].Field)).To(Equal("bundle"))
+	})
+
+	It("reports a registration failure as an error of the cluster", func() {
+		mockInstaller.EXPECT().RegisterClusterInternal(ctx, nil, gomock.Any(), common.SkipInfraEnvCreation).
+			Return(nil, common.NewApiError(http.StatusBadRequest, errors.New("invalid base DNS domain")))
+
+		payload := validationError(handler.V2ImportClusterBundle(ctx, importParams("")))
+		Expect(swag.StringValue(payload.FieldErrors[0].Field)).To(Equal("cluster"))
+		Expect(swag.StringValue(payload.FieldErrors[0].Message)).To(Equal("invalid base DNS domain"))
+	})
+
+	It("deregisters the cluster when the bundle fails to be applied", func() {
+		clusterID := strfmt.UUID(uuid.New().String())
+		cluster := &common.Cluster{Cluster: models.Cluster{ID: &clusterID}}
+		mockInstaller.EXPECT().RegisterClusterInternal(ctx, nil, gomock.Any(), common.SkipInfraEnvCreation).Return(cluster, nil)
+		mockInstaller.EXPECT().UpdateClusterNonInteractive(ctx, gomock.Any()).Return(cluster, nil)
+		mockInstaller.EXPECT().UpdateClusterInstallConfigInternal(ctx, gomock.Any()).Return(cluster, nil)
+		mockInstaller.EXPECT().UpdateDiscoveryIgnitionInternal(ctx, gomock.Any()).Return(nil)
+		mockManifests.EXPECT().CreateClusterManifestInternal(ctx, gomock.Any()).
+			Return(nil, common.NewApiError(http.StatusBadRequest, errors.New("invalid manifest")))
+		mockInstaller.EXPECT().DeregisterClusterInternal(ctx, gomock.Any()).Return(nil)
+
+		payload := validationError(handler.V2ImportClusterBundle(ctx, importParams("")))
+		Expect(swag.StringValue(payload.FieldErrors[0].Field)).To(Equal("manifests[0]"))
+	})
+})
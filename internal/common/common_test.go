@@ -2,6 +2,7 @@ package common
 
 import (
 	"testing"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -263,6 +264,77 @@ var _ = Describe("compare OCP 4.10 versions", func() {
 	})
 })
 
+var _ = Describe("FIPS support", func() {
+	It("supported release and CPU architecture", func() {
+		Expect(ValidateFIPSSupport("4.8.0", "x86_64")).To(Succeed())
+		Expect(ValidateFIPSSupport("4.10.0-fc.1", "")).To(Succeed())
+	})
+	It("old release", func() {
+		err := ValidateFIPSSupport("4.7.13", "x86_64")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not supported for OCP version 4.7.13"))
+	})
+	It("unsupported CPU architecture", func() {
+		err := ValidateFIPSSupport("4.10.0", "arm64")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not supported for CPU architecture arm64"))
+	})
+	It("invalid release", func() {
+		Expect(ValidateFIPSSupport("invalid", "x86_64")).ToNot(Succeed())
+	})
+})
+
+var _ = Describe("Page tokens", func() {
+	id := strfmt.UUID(uuid.New().String())
+
+	It("round trips a timestamp", func() {
+		created := time.Date(2022, 3, 1, 10, 20, 30, 123456000, time.UTC)
+		token, err := encodePageToken(SortByCreatedAt, created, id)
+		Expect(err).ToNot(HaveOccurred())
+		value, tokenID, err := decodePageToken(token, SortByCreatedAt)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(created))
+		Expect(tokenID).To(Equal(id.String()))
+	})
+
+	It("round trips a status", func() {
+		token, err := encodePageToken(SortByStatus, swag.String(models.ClusterStatusReady), id)
+		Expect(err).ToNot(HaveOccurred())
+		value, _, err := decodePageToken(token, SortByStatus)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(models.ClusterStatusReady))
+	})
+
+	It("rejects a token of another sort field", func() {
+		token, err := encodePageToken(SortByStatus, "ready", id)
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = decodePageToken(token, SortByUpdatedAt)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects garbage", func() {
+		_, _, err := decodePageToken("garbage!", SortByCreatedAt)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("trims the extra record and returns the next token", func() {
+		page := PageRequest{Limit: swag.Int64(2)}
+		count, next, err := NextPage(page, 3, func(i int, sortBy string) (interface{}, strfmt.UUID) {
+			Expect(i).To(Equal(1))
+			Expect(sortBy).To(Equal(SortByCreatedAt))
+			return time.Now(), id
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(Equal(2))
+		Expect(next).NotTo(BeEmpty())
+
+		count, next, err = NextPage(page, 2, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(Equal(2))
+		Expect(next).To(BeEmpty())
+	})
+})
+
 func createHost(hostRole models.HostRole, state string) *models.Host {
 	hostId := strfmt.UUID(uuid.New().String())
 	clusterId := strfmt.UUID(uuid.New().String())
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -29,6 +30,10 @@ type Cluster struct {
 	// The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.
 	PullSecret string `json:"pull_secret" gorm:"type:TEXT"`
 
+	// The details of the external RHCS cluster the OCS operator connects to. They hold Ceph keys, so as the pull secret
+	// they are kept out of the operator properties returned by the API.
+	OCSExternalClusterDetails string `json:"ocs_external_cluster_details" gorm:"type:TEXT"`
+
 	// The compute hash value of the http-proxy, https-proxy and no-proxy attributes, used internally to indicate
 	// if the proxy settings were changed while downloading ISO
 	ProxyHash string `json:"proxy_hash"`
@@ -72,6 +77,26 @@ type Event struct {
 	models.Event
 }
 
+// V2GetEventsParams holds the filters of an events query. At least one of the cluster, host or infra-env IDs is required.
+type V2GetEventsParams struct {
+	ClusterID  *strfmt.UUID
+	HostID     *strfmt.UUID
+	InfraEnvID *strfmt.UUID
+	Categories []string
+	Severities []string
+	Names      []string
+	Since      *strfmt.DateTime
+	Until      *strfmt.DateTime
+	// Message is matched case insensitively against any part of the event message
+	Message *string
+	// Order is either OrderAscending (the default) or OrderDescending, by event time
+	Order  *string
+	Limit  *int64
+	Offset *int64
+	// AfterEventID selects only the events stored after the event with this sequence number
+	AfterEventID *int64
+}
+
 type Host struct {
 	models.Host
 	Approved bool `json:"approved"`
@@ -116,6 +141,49 @@ type InfraEnv struct {
 	ImageTokenKey string `json:"image_token_key"`
 }
 
+type Webhook struct {
+	models.Webhook
+
+	// The key used to sign the delivered payloads. It is never returned to the user.
+	Secret string `json:"secret" gorm:"type:text"`
+
+	// JSON encoded event names and severities the subscription is filtered to
+	EventNamesFilter string `json:"event_names_filter" gorm:"type:text"`
+	SeveritiesFilter string `json:"severities_filter" gorm:"type:text"`
+}
+
+type WebhookDelivery struct {
+	models.WebhookDelivery
+
+	// The JSON document posted to the webhook URL
+	Payload string `json:"payload" gorm:"type:text"`
+
+	// The earliest time of the next delivery attempt
+	NextAttemptAt time.Time `json:"next_attempt_at" gorm:"type:timestamp with time zone;index"`
+}
+
+// WebhookDeadLetter holds a copy of a delivery which exhausted all of its attempts, so it can be inspected or replayed
+type WebhookDeadLetter struct {
+	WebhookDelivery
+}
+
+// ClusterBundleHost is the configuration of a host of an imported cluster bundle, applied once a host with the same
+// MAC address registers to the cluster
+type ClusterBundleHost struct {
+	ClusterID  strfmt.UUID `json:"cluster_id" gorm:"primaryKey"`
+	MacAddress string      `json:"mac_address" gorm:"primaryKey"`
+
+	Role                    models.HostRole `json:"role"`
+	Hostname                string          `json:"hostname"`
+	IgnitionConfigOverrides string          `json:"ignition_config_overrides" gorm:"type:text"`
+
+	// JSON encoded installer arguments
+	InstallerArgs string `json:"installer_args" gorm:"type:text"`
+
+	// The host the configuration was applied to
+	HostID *strfmt.UUID `json:"host_id"`
+}
+
 type EagerLoadingState bool
 
 const (
@@ -142,7 +210,8 @@ var ClusterSubTables = [...]string{HostsTable, MonitoredOperatorsTable, ClusterN
 
 func AutoMigrate(db *gorm.DB) error {
 	return db.AutoMigrate(&models.MonitoredOperator{}, &Host{}, &Cluster{}, &Event{}, &InfraEnv{},
-		&models.ClusterNetwork{}, &models.ServiceNetwork{}, &models.MachineNetwork{})
+		&models.ClusterNetwork{}, &models.ServiceNetwork{}, &models.MachineNetwork{},
+		&Webhook{}, &WebhookDelivery{}, &WebhookDeadLetter{}, &ClusterBundleHost{}, &models.AuditRecord{}, &models.RoleBinding{}, &models.HostInventorySnapshot{})
 }
 
 func LoadTableFromDB(db *gorm.DB, tableName string, conditions ...interface{}) *gorm.DB {
@@ -242,6 +311,18 @@ func GetClustersFromDBWhere(db *gorm.DB, eagerLoading EagerLoadingState, include
 	return clusters, nil
 }
 
+// CountClustersWhere counts the clusters that GetClustersFromDBWhere returns for the same conditions
+func CountClustersWhere(db *gorm.DB, includeDeleted DeleteRecordsState, where ...interface{}) (int64, error) {
+	var count int64
+
+	db = prepareClusterDB(db, SkipEagerLoading, includeDeleted).Model(&Cluster{})
+	if len(where) > 0 {
+		db = db.Where(where[0], where[1:]...)
+	}
+	err := db.Count(&count).Error
+	return count, err
+}
+
 func GetHostFromDB(db *gorm.DB, infraEnvId, hostId string) (*Host, error) {
 	var host Host
 
@@ -365,6 +446,34 @@ func (c *Cluster) AfterFind(db *gorm.DB) error {
 	return nil
 }
 
+func (w *Webhook) BeforeSave(db *gorm.DB) error {
+	eventNames, err := json.Marshal(w.EventNames)
+	if err != nil {
+		return err
+	}
+	severities, err := json.Marshal(w.Severities)
+	if err != nil {
+		return err
+	}
+	w.EventNamesFilter = string(eventNames)
+	w.SeveritiesFilter = string(severities)
+	return nil
+}
+
+func (w *Webhook) AfterFind(db *gorm.DB) error {
+	if w.EventNamesFilter != "" {
+		if err := json.Unmarshal([]byte(w.EventNamesFilter), &w.EventNames); err != nil {
+			return err
+		}
+	}
+	if w.SeveritiesFilter != "" {
+		if err := json.Unmarshal([]byte(w.SeveritiesFilter), &w.Severities); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func ToSqlList(strs []string) string {
 	res := strings.Join(strs, `', '`)
 	res = fmt.Sprintf("('%s')", res)
@@ -1599,6 +1599,144 @@ func (e *InstallationPreparingTimedOutEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event cluster_dry_run_installation_succeeded
+//
+type ClusterDryRunInstallationSucceededEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+}
+
+var ClusterDryRunInstallationSucceededEventName string = "cluster_dry_run_installation_succeeded"
+
+func NewClusterDryRunInstallationSucceededEvent(
+    clusterId strfmt.UUID,
+) *ClusterDryRunInstallationSucceededEvent {
+    return &ClusterDryRunInstallationSucceededEvent{
+        eventName: ClusterDryRunInstallationSucceededEventName,
+        ClusterId: clusterId,
+    }
+}
+
+func SendClusterDryRunInstallationSucceededEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,) {
+    ev := NewClusterDryRunInstallationSucceededEvent(
+        clusterId,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendClusterDryRunInstallationSucceededEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    eventTime time.Time) {
+    ev := NewClusterDryRunInstallationSucceededEvent(
+        clusterId,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ClusterDryRunInstallationSucceededEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ClusterDryRunInstallationSucceededEvent) GetSeverity() string {
+    return "info"
+}
+func (e *ClusterDryRunInstallationSucceededEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ClusterDryRunInstallationSucceededEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ClusterDryRunInstallationSucceededEvent) FormatMessage() string {
+    s := "Dry-run installation rendered the manifests and ignition files of the cluster"
+    return e.format(&s)
+}
+
+//
+// Event cluster_dry_run_installation_failed
+//
+type ClusterDryRunInstallationFailedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    Error string
+}
+
+var ClusterDryRunInstallationFailedEventName string = "cluster_dry_run_installation_failed"
+
+func NewClusterDryRunInstallationFailedEvent(
+    clusterId strfmt.UUID,
+    error string,
+) *ClusterDryRunInstallationFailedEvent {
+    return &ClusterDryRunInstallationFailedEvent{
+        eventName: ClusterDryRunInstallationFailedEventName,
+        ClusterId: clusterId,
+        Error: error,
+    }
+}
+
+func SendClusterDryRunInstallationFailedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    error string,) {
+    ev := NewClusterDryRunInstallationFailedEvent(
+        clusterId,
+        error,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendClusterDryRunInstallationFailedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    error string,
+    eventTime time.Time) {
+    ev := NewClusterDryRunInstallationFailedEvent(
+        clusterId,
+        error,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ClusterDryRunInstallationFailedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ClusterDryRunInstallationFailedEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *ClusterDryRunInstallationFailedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ClusterDryRunInstallationFailedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{error}", fmt.Sprint(e.Error),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ClusterDryRunInstallationFailedEvent) FormatMessage() string {
+    s := "Dry-run installation of the cluster failed: {error}"
+    return e.format(&s)
+}
+
 //
 // Event cluster_degraded_OLM_operators_failed
 //
@@ -2403,6 +2541,229 @@ func (e *HostRoleUpdatedEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event host_role_assignment_explained
+//
+type HostRoleAssignmentExplainedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+    SuggestedRole string
+    Strategy string
+    Reason string
+}
+
+var HostRoleAssignmentExplainedEventName string = "host_role_assignment_explained"
+
+func NewHostRoleAssignmentExplainedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    suggestedRole string,
+    strategy string,
+    reason string,
+) *HostRoleAssignmentExplainedEvent {
+    return &HostRoleAssignmentExplainedEvent{
+        eventName: HostRoleAssignmentExplainedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+        SuggestedRole: suggestedRole,
+        Strategy: strategy,
+        Reason: reason,
+    }
+}
+
+func SendHostRoleAssignmentExplainedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    suggestedRole string,
+    strategy string,
+    reason string,) {
+    ev := NewHostRoleAssignmentExplainedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        suggestedRole,
+        strategy,
+        reason,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostRoleAssignmentExplainedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    suggestedRole string,
+    strategy string,
+    reason string,
+    eventTime time.Time) {
+    ev := NewHostRoleAssignmentExplainedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        suggestedRole,
+        strategy,
+        reason,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostRoleAssignmentExplainedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostRoleAssignmentExplainedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostRoleAssignmentExplainedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostRoleAssignmentExplainedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostRoleAssignmentExplainedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostRoleAssignmentExplainedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{suggested_role}", fmt.Sprint(e.SuggestedRole),
+        "{strategy}", fmt.Sprint(e.Strategy),
+        "{reason}", fmt.Sprint(e.Reason),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostRoleAssignmentExplainedEvent) FormatMessage() string {
+    s := "Host {host_name}: the {strategy} role assignment strategy selected role {suggested_role}: {reason}"
+    return e.format(&s)
+}
+
+
+//
+// Event host_inventory_drift_detected
+//
+type HostInventoryDriftDetectedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+    Changes string
+}
+
+var HostInventoryDriftDetectedEventName string = "host_inventory_drift_detected"
+
+func NewHostInventoryDriftDetectedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    changes string,
+) *HostInventoryDriftDetectedEvent {
+    return &HostInventoryDriftDetectedEvent{
+        eventName: HostInventoryDriftDetectedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+        Changes: changes,
+    }
+}
+
+func SendHostInventoryDriftDetectedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    changes string,) {
+    ev := NewHostInventoryDriftDetectedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        changes,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostInventoryDriftDetectedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    changes string,
+    eventTime time.Time) {
+    ev := NewHostInventoryDriftDetectedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        changes,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostInventoryDriftDetectedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostInventoryDriftDetectedEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *HostInventoryDriftDetectedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostInventoryDriftDetectedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostInventoryDriftDetectedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostInventoryDriftDetectedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{changes}", fmt.Sprint(e.Changes),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostInventoryDriftDetectedEvent) FormatMessage() string {
+    s := "Host {host_name}: the critical hardware of the host changed since it was reviewed: {changes}"
+    return e.format(&s)
+}
+
 //
 // Event image_status_updated
 //
@@ -0,0 +1,27 @@
+package common
+
+import "github.com/pkg/errors"
+
+const (
+	// MinimalOpenShiftVersionForFIPS is the first release that can be installed in FIPS mode
+	MinimalOpenShiftVersionForFIPS = "4.8.0-0.0"
+	// FIPSKernelArgument enables FIPS mode on the hosts booted from the discovery image
+	FIPSKernelArgument = "fips=1"
+)
+
+// ValidateFIPSSupport returns an error when a cluster of the release and CPU architecture can't be installed in
+// FIPS mode. An empty CPU architecture stands for the default one.
+func ValidateFIPSSupport(openshiftVersion, cpuArchitecture string) error {
+	if cpuArchitecture != "" && cpuArchitecture != DefaultCPUArchitecture {
+		return errors.Errorf("FIPS mode is not supported for CPU architecture %s, it is supported for %s only",
+			cpuArchitecture, DefaultCPUArchitecture)
+	}
+	supported, err := VersionGreaterOrEqual(openshiftVersion, MinimalOpenShiftVersionForFIPS)
+	if err != nil {
+		return errors.Errorf("Failed to parse OCP version %s", openshiftVersion)
+	}
+	if !supported {
+		return errors.Errorf("FIPS mode is not supported for OCP version %s, it is supported for version 4.8 and above", openshiftVersion)
+	}
+	return nil
+}
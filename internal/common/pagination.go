@@ -0,0 +1,145 @@
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// Values accepted by the sort_by and order parameters of the paginated list APIs
+const (
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
+	SortByStatus    = "status"
+
+	OrderAscending  = "ascending"
+	OrderDescending = "descending"
+)
+
+// PageRequest holds the sorting and paging parameters of a list request
+type PageRequest struct {
+	SortBy   *string
+	Order    *string
+	Limit    *int64
+	Continue *string
+}
+
+// pageToken is the opaque continue token handed to the user, pointing at the last record of the previous page
+type pageToken struct {
+	SortBy string `json:"sort_by"`
+	Value  string `json:"value"`
+	ID     string `json:"id"`
+}
+
+func (p PageRequest) sortBy() string {
+	if p.SortBy == nil || *p.SortBy == "" {
+		return SortByCreatedAt
+	}
+	return *p.SortBy
+}
+
+func (p PageRequest) descending() bool {
+	return swag.StringValue(p.Order) == OrderDescending
+}
+
+// IsPaged returns true if the request asks for a single page rather than for all the records
+func (p PageRequest) IsPaged() bool {
+	return p.Limit != nil || swag.StringValue(p.Continue) != ""
+}
+
+// ApplyPageRequest adds the ordering, continue token and limit clauses of the page request to the query.
+// One record more than the limit is requested, so that NextPage can tell if there is another page.
+func ApplyPageRequest(db *gorm.DB, page PageRequest) (*gorm.DB, error) {
+	column := page.sortBy()
+	switch column {
+	case SortByCreatedAt, SortByUpdatedAt, SortByStatus:
+	default:
+		return nil, NewApiError(http.StatusBadRequest, errors.Errorf("unsupported sort field %s", column))
+	}
+
+	direction, operator := "ASC", ">"
+	if page.descending() {
+		direction, operator = "DESC", "<"
+	}
+
+	if token := swag.StringValue(page.Continue); token != "" {
+		value, id, err := decodePageToken(token, column)
+		if err != nil {
+			return nil, NewApiError(http.StatusBadRequest, errors.Wrapf(err, "invalid continue token"))
+		}
+		db = db.Where(fmt.Sprintf("(%s, id) %s (?, ?)", column, operator), value, id)
+	}
+
+	db = db.Order(fmt.Sprintf("%s %s, id %s", column, direction, direction))
+	if page.Limit != nil {
+		db = db.Limit(int(*page.Limit) + 1)
+	}
+	return db, nil
+}
+
+// NextPage returns the number of records belonging to the requested page out of the count fetched with
+// ApplyPageRequest, and the continue token of the following page, which is empty if this is the last one.
+// sortValue returns the value of the sort field and the ID of the record at the given index.
+func NextPage(page PageRequest, count int, sortValue func(int, string) (interface{}, strfmt.UUID)) (int, string, error) {
+	if page.Limit == nil || int64(count) <= *page.Limit {
+		return count, "", nil
+	}
+	last := int(*page.Limit)
+	value, id := sortValue(last-1, page.sortBy())
+	token, err := encodePageToken(page.sortBy(), value, id)
+	if err != nil {
+		return 0, "", err
+	}
+	return last, token, nil
+}
+
+func encodePageToken(sortBy string, value interface{}, id strfmt.UUID) (string, error) {
+	token := pageToken{SortBy: sortBy, ID: id.String()}
+	switch v := value.(type) {
+	case time.Time:
+		token.Value = v.UTC().Format(time.RFC3339Nano)
+	case *string:
+		token.Value = swag.StringValue(v)
+	case string:
+		token.Value = v
+	default:
+		return "", errors.Errorf("unsupported sort value type %T", value)
+	}
+	b, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodePageToken(encoded, sortBy string) (interface{}, string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, "", err
+	}
+	var token pageToken
+	if err = json.Unmarshal(b, &token); err != nil {
+		return nil, "", err
+	}
+	if token.SortBy != sortBy {
+		return nil, "", errors.Errorf("token was issued for sort field %s and not %s", token.SortBy, sortBy)
+	}
+	if !strfmt.IsUUID(token.ID) {
+		return nil, "", errors.Errorf("malformed id %s", token.ID)
+	}
+	if sortBy == SortByStatus {
+		return token.Value, token.ID, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, token.Value)
+	if err != nil {
+		return nil, "", err
+	}
+	return t, token.ID, nil
+}
@@ -3,6 +3,7 @@ package connectivity
 import (
 	"encoding/json"
 
+	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -28,8 +29,10 @@ func (v *validator) GetHostValidInterfaces(host *models.Host) ([]*models.Interfa
 	if err := json.Unmarshal([]byte(host.Inventory), &inventory); err != nil {
 		return nil, err
 	}
-	if len(inventory.Interfaces) == 0 {
+	// The connectivity is checked from the bonds and bridges rather than from their ports
+	interfaces := network.GetAddressableInterfaces(&inventory)
+	if len(interfaces) == 0 {
 		return nil, errors.Errorf("host %s doesn't have interfaces", host.ID)
 	}
-	return inventory.Interfaces, nil
+	return interfaces, nil
 }
@@ -48,6 +48,21 @@ var _ = Describe("get valid interfaces", func() {
 		Expect(len(interfaces)).Should(Equal(1))
 	})
 
+	It("ports of bonds are left out", func() {
+		inventory.Interfaces = []*models.Interface{
+			{Name: "bond0", Type: models.InterfaceTypeBond, IPV4Addresses: []string{"1.2.3.4/24"}},
+			{Name: "eth0", Type: models.InterfaceTypePhysical, Controller: "bond0"},
+			{Name: "eth1", Type: models.InterfaceTypePhysical, Controller: "bond0"},
+		}
+		hw, err := json.Marshal(&inventory)
+		Expect(err).NotTo(HaveOccurred())
+		host.Inventory = string(hw)
+		interfaces, err := connectivityValidator.GetHostValidInterfaces(host)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(interfaces).To(HaveLen(1))
+		Expect(interfaces[0].Name).To(Equal("bond0"))
+	})
+
 	It("invalid interfaces", func() {
 
 		host.Inventory = ""
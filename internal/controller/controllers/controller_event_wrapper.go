@@ -52,6 +52,10 @@ func (c *controllerEventsWrapper) V2GetEvents(ctx context.Context, clusterID *st
 	return c.events.V2GetEvents(ctx, clusterID, hostID, infraEnvID, categories...)
 }
 
+func (c *controllerEventsWrapper) V2GetFilteredEvents(ctx context.Context, params *common.V2GetEventsParams) ([]*common.Event, int64, error) {
+	return c.events.V2GetFilteredEvents(ctx, params)
+}
+
 func (c *controllerEventsWrapper) SendClusterEvent(ctx context.Context, event eventsapi.ClusterEvent) {
 	c.events.SendClusterEvent(ctx, event)
 
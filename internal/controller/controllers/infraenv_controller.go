@@ -61,13 +61,14 @@ type InfraEnvConfig struct {
 // InfraEnvReconciler reconciles a InfraEnv object
 type InfraEnvReconciler struct {
 	client.Client
-	APIReader        client.Reader
-	Config           InfraEnvConfig
-	Log              logrus.FieldLogger
-	Installer        bminventory.InstallerInternals
-	CRDEventsHandler CRDEventsHandler
-	ServiceBaseURL   string
-	AuthType         auth.AuthType
+	APIReader           client.Reader
+	Config              InfraEnvConfig
+	Log                 logrus.FieldLogger
+	Installer           bminventory.InstallerInternals
+	CRDEventsHandler    CRDEventsHandler
+	ServiceBaseURL      string
+	AuthType            auth.AuthType
+	ImageExpirationTime time.Duration
 }
 
 // +kubebuilder:rbac:groups=agent-install.openshift.io,resources=nmstateconfigs,verbs=get;list;watch
@@ -477,6 +478,16 @@ func (r *InfraEnvReconciler) populateEventsURL(log logrus.FieldLogger, infraEnv
 	return nil
 }
 
+func (r *InfraEnvReconciler) populateIPXEScriptURL(log logrus.FieldLogger, infraEnv *aiv1beta1.InfraEnv, internalInfraEnv *common.InfraEnv) error {
+	scriptURL, err := bminventory.InfraEnvFileURL(r.ServiceBaseURL, r.AuthType, internalInfraEnv, "ipxe-script", r.ImageExpirationTime)
+	if err != nil {
+		log.WithError(err).Error("failed to generate iPXE script URL")
+		return err
+	}
+	infraEnv.Status.IPXEScriptURL = scriptURL
+	return nil
+}
+
 func (r *InfraEnvReconciler) updateEnsureISOSuccess(
 	ctx context.Context, log logrus.FieldLogger, infraEnv *aiv1beta1.InfraEnv, internalInfraEnv *common.InfraEnv) (ctrl.Result, error) {
 	conditionsv1.SetStatusConditionNoHeartbeat(&infraEnv.Status.Conditions, conditionsv1.Condition{
@@ -499,6 +510,12 @@ func (r *InfraEnvReconciler) updateEnsureISOSuccess(
 		}
 	}
 
+	if infraEnv.Status.IPXEScriptURL == "" {
+		if r.populateIPXEScriptURL(log, infraEnv, internalInfraEnv) != nil {
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
 	if updateErr := r.Status().Update(ctx, infraEnv); updateErr != nil {
 		log.WithError(updateErr).Error("failed to update infraEnv status")
 		return ctrl.Result{Requeue: true}, nil
@@ -561,6 +578,7 @@ func (r *InfraEnvReconciler) handleEnsureISOErrors(
 		// In a case of an error, clear the download URL.
 		log.Debugf("cleanup up ISODownloadURL due to %s", errMsg)
 		infraEnv.Status.ISODownloadURL = ""
+		infraEnv.Status.IPXEScriptURL = ""
 		infraEnv.Status.CreatedTime = nil
 	}
 
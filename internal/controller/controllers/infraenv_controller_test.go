@@ -156,6 +156,10 @@ var _ = Describe("infraEnv reconcile", func() {
 		By("validate events URL")
 		Expect(infraEnvImage.Status.InfraEnvDebugInfo.EventsURL).NotTo(BeEmpty())
 		Expect(infraEnvImage.Status.InfraEnvDebugInfo.EventsURL).To(HavePrefix(eventURL))
+
+		By("validate iPXE script URL")
+		Expect(infraEnvImage.Status.IPXEScriptURL).To(Equal(
+			fmt.Sprintf("%s/api/assisted-install/v2/infra-envs/%s/downloads/files?file_name=ipxe-script", ir.ServiceBaseURL, sId)))
 	})
 
 	It("create new infraEnv full-iso image - success", func() {
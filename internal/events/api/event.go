@@ -34,6 +34,9 @@ type Sender interface {
 type Handler interface {
 	Sender
 	V2GetEvents(ctx context.Context, clusterID *strfmt.UUID, hostID *strfmt.UUID, infraEnvID *strfmt.UUID, categories ...string) ([]*common.Event, error)
+	// V2GetFilteredEvents returns the events matching the params, along with the total count of matching events,
+	// regardless of the requested limit and offset
+	V2GetFilteredEvents(ctx context.Context, params *common.V2GetEventsParams) ([]*common.Event, int64, error)
 }
 
 var DefaultEventCategories = []string{
@@ -360,6 +360,22 @@ func (mr *MockHandlerMockRecorder) V2GetEvents(ctx, clusterID, hostID, infraEnvI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetEvents", reflect.TypeOf((*MockHandler)(nil).V2GetEvents), varargs...)
 }
 
+// V2GetFilteredEvents mocks base method.
+func (m *MockHandler) V2GetFilteredEvents(ctx context.Context, params *common.V2GetEventsParams) ([]*common.Event, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetFilteredEvents", ctx, params)
+	ret0, _ := ret[0].([]*common.Event)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// V2GetFilteredEvents indicates an expected call of V2GetFilteredEvents.
+func (mr *MockHandlerMockRecorder) V2GetFilteredEvents(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetFilteredEvents", reflect.TypeOf((*MockHandler)(nil).V2GetFilteredEvents), ctx, params)
+}
+
 // MockBaseEvent is a mock of BaseEvent interface.
 type MockBaseEvent struct {
 	ctrl     *gomock.Controller
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/identity"
@@ -24,6 +25,9 @@ var DefaultEventCategories = []string{
 	models.EventCategoryUser,
 }
 
+// likeEscaper escapes the wildcards of a LIKE pattern, so user input is matched literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 type Events struct {
 	db  *gorm.DB
 	log logrus.FieldLogger
@@ -126,6 +130,10 @@ func (e *Events) v2SaveEvent(ctx context.Context, clusterID *strfmt.UUID, hostID
 		}
 	}()
 	dberr = tx.Create(&event).Error
+	if dberr == nil && category == models.EventCategoryUser {
+		// The notification is sent when the transaction commits, so stream subscribers only get stored events
+		dberr = publish(tx, eventStreamMessage(&event))
+	}
 }
 
 func (e *Events) SendClusterEvent(ctx context.Context, event eventsapi.ClusterEvent) {
@@ -176,9 +184,10 @@ func (e *Events) V2AddMetricsEvent(ctx context.Context, clusterID *strfmt.UUID,
 	e.v2SaveEvent(ctx, clusterID, hostID, infraEnvID, name, models.EventCategoryMetrics, severity, msg, eventTime, requestID, props...)
 }
 
-func (e Events) eventsQuery(ctx context.Context, events *[]*common.Event, selectedCategories []string, clusterID *strfmt.UUID, hostID *strfmt.UUID, infraEnvID *strfmt.UUID) *gorm.DB {
+func (e Events) eventsQuery(ctx context.Context, params *common.V2GetEventsParams) *gorm.DB {
 	whereCondition := make([]string, 0)
 	user := ocm.UserNameFromContext(ctx)
+	clusterID, hostID, infraEnvID := params.ClusterID, params.HostID, params.InfraEnvID
 
 	if clusterID != nil {
 		cluster, err := common.GetClusterFromDB(e.db, *clusterID, common.UseEagerLoading)
@@ -188,7 +197,7 @@ func (e Events) eventsQuery(ctx context.Context, events *[]*common.Event, select
 			}
 			return &gorm.DB{Error: err}
 		}
-		if user != "" && !identity.IsAdmin(ctx) { // A case where there is a non-admin user in context, the service should only present resources matching to it.
+		if user != "" && !identity.IsAdmin(ctx) && !identity.IsAuthorizedByRoleBinding(ctx) { // A case where there is a non-admin user in context, the service should only present resources matching to it.
 			if cluster.UserName == user {
 				whereCondition = append(whereCondition, fmt.Sprintf("cluster_id = '%s'", clusterID.String()))
 			} else {
@@ -209,7 +218,7 @@ func (e Events) eventsQuery(ctx context.Context, events *[]*common.Event, select
 			}
 			return &gorm.DB{Error: err}
 		}
-		if user != "" && !identity.IsAdmin(ctx) { // A case where there is a non-admin user in context, the service should only present resources matching to it.
+		if user != "" && !identity.IsAdmin(ctx) && !identity.IsAuthorizedByRoleBinding(ctx) { // A case where there is a non-admin user in context, the service should only present resources matching to it.
 			if host != nil && host.UserName == user {
 				whereCondition = append(whereCondition, fmt.Sprintf("host_id = '%s'", hostID.String()))
 			} else {
@@ -230,7 +239,7 @@ func (e Events) eventsQuery(ctx context.Context, events *[]*common.Event, select
 			}
 			return &gorm.DB{Error: err}
 		}
-		if user != "" && !identity.IsAdmin(ctx) { // A case where there is a non-admin user in context, the service should only present resources matching to it.
+		if user != "" && !identity.IsAdmin(ctx) && !identity.IsAuthorizedByRoleBinding(ctx) { // A case where there is a non-admin user in context, the service should only present resources matching to it.
 			if infraEnv.UserName == user {
 				whereCondition = append(whereCondition, fmt.Sprintf("infra_env_id = '%s'", infraEnvID.String()))
 			} else {
@@ -248,25 +257,85 @@ func (e Events) eventsQuery(ctx context.Context, events *[]*common.Event, select
 		e.log.Error(queryErr)
 		return &gorm.DB{Error: queryErr}
 	}
-	return e.db.Where("category IN (?)", selectedCategories).Order("event_time").Find(&events, strings.Join(whereCondition, " AND "))
-}
-
-func (e Events) V2GetEvents(ctx context.Context, clusterID *strfmt.UUID, hostID *strfmt.UUID, infraEnvID *strfmt.UUID, categories ...string) ([]*common.Event, error) {
-	var err error
-	var events []*common.Event
 
 	//initialize the selectedCategories either from the filter, if exists, or from the default values
 	selectedCategories := make([]string, 0)
-	if len(categories) > 0 {
-		selectedCategories = categories[:]
+	if len(params.Categories) > 0 {
+		selectedCategories = params.Categories[:]
 	} else {
 		selectedCategories = append(selectedCategories, DefaultEventCategories...)
 	}
 
-	err = e.eventsQuery(ctx, &events, selectedCategories, clusterID, hostID, infraEnvID).Error
+	db := e.db.Where("category IN (?)", selectedCategories).Where(strings.Join(whereCondition, " AND "))
+	if len(params.Severities) > 0 {
+		db = db.Where("severity IN (?)", params.Severities)
+	}
+	if len(params.Names) > 0 {
+		db = db.Where("name IN (?)", params.Names)
+	}
+	if params.Since != nil {
+		db = db.Where("event_time >= ?", time.Time(*params.Since))
+	}
+	if params.Until != nil {
+		db = db.Where("event_time <= ?", time.Time(*params.Until))
+	}
+	if message := swag.StringValue(params.Message); message != "" {
+		db = db.Where("message ILIKE ?", "%"+likeEscaper.Replace(message)+"%")
+	}
+	if params.AfterEventID != nil {
+		db = db.Where("id > ?", *params.AfterEventID)
+	}
+	return db
+}
+
+func (e Events) V2GetEvents(ctx context.Context, clusterID *strfmt.UUID, hostID *strfmt.UUID, infraEnvID *strfmt.UUID, categories ...string) ([]*common.Event, error) {
+	events, _, err := e.V2GetFilteredEvents(ctx, &common.V2GetEventsParams{
+		ClusterID:  clusterID,
+		HostID:     hostID,
+		InfraEnvID: infraEnvID,
+		Categories: categories,
+	})
 	return events, err
 }
 
+func (e Events) V2GetFilteredEvents(ctx context.Context, params *common.V2GetEventsParams) ([]*common.Event, int64, error) {
+	var events []*common.Event
+
+	db := e.eventsQuery(ctx, params)
+	if db.Error != nil {
+		return nil, 0, db.Error
+	}
+	// the filtered query is shared by the count and the list queries
+	db = db.Session(&gorm.Session{})
+
+	var total int64
+	paged := params.Limit != nil || params.Offset != nil
+	if paged {
+		if err := db.Model(&common.Event{}).Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
+	}
+
+	direction := "ASC"
+	if swag.StringValue(params.Order) == common.OrderDescending {
+		direction = "DESC"
+	}
+	db = db.Order(fmt.Sprintf("event_time %s", direction))
+	if params.Offset != nil {
+		db = db.Offset(int(*params.Offset))
+	}
+	if params.Limit != nil {
+		db = db.Limit(int(*params.Limit))
+	}
+	if err := db.Find(&events).Error; err != nil {
+		return nil, 0, err
+	}
+	if !paged {
+		total = int64(len(events))
+	}
+	return events, total, nil
+}
+
 func toProps(attrs ...interface{}) (result string, err error) {
 	props := make(map[string]interface{})
 	length := len(attrs)
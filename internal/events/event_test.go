@@ -205,6 +205,74 @@ var _ = Describe("Events library", func() {
 		})
 	})
 
+	Context("filtered events", func() {
+		var now time.Time
+
+		BeforeEach(func() {
+			now = time.Now()
+			theEvents.V2AddEvent(context.TODO(), &cluster1, nil, nil, eventgen.ClusterRegistrationSucceededEventName,
+				models.EventSeverityInfo, "Cluster registered", now.Add(-3*time.Hour))
+			theEvents.V2AddEvent(context.TODO(), &cluster1, nil, nil, "cluster_validation_failed",
+				models.EventSeverityWarning, "Cluster validation 'api-vip-defined' that used to succeed is now failing", now.Add(-2*time.Hour))
+			theEvents.V2AddEvent(context.TODO(), &cluster1, nil, nil, "cluster_installation_failed",
+				models.EventSeverityError, "Failed installing cluster: 100% broken", now.Add(-1*time.Hour))
+			theEvents.V2AddEvent(context.TODO(), &cluster2, nil, nil, eventgen.ClusterRegistrationSucceededEventName,
+				models.EventSeverityInfo, "Cluster registered", now)
+		})
+
+		get := func(params common.V2GetEventsParams) ([]*common.Event, int64) {
+			params.ClusterID = &cluster1
+			evs, total, err := theEvents.V2GetFilteredEvents(context.TODO(), &params)
+			Expect(err).ToNot(HaveOccurred())
+			return evs, total
+		}
+
+		It("filters by severity", func() {
+			evs, total := get(common.V2GetEventsParams{Severities: []string{models.EventSeverityWarning, models.EventSeverityError}})
+			Expect(evs).To(HaveLen(2))
+			Expect(total).To(Equal(int64(2)))
+		})
+
+		It("filters by name", func() {
+			evs, _ := get(common.V2GetEventsParams{Names: []string{eventgen.ClusterRegistrationSucceededEventName}})
+			Expect(evs).To(HaveLen(1))
+			Expect(evs[0]).Should(WithMessage(swag.String("Cluster registered")))
+		})
+
+		It("filters by time range", func() {
+			since := strfmt.DateTime(now.Add(-150 * time.Minute))
+			until := strfmt.DateTime(now.Add(-90 * time.Minute))
+			evs, _ := get(common.V2GetEventsParams{Since: &since, Until: &until})
+			Expect(evs).To(HaveLen(1))
+			Expect(evs[0]).Should(WithSeverity(swag.String(models.EventSeverityWarning)))
+		})
+
+		It("matches message text ignoring case", func() {
+			evs, _ := get(common.V2GetEventsParams{Message: swag.String("API-VIP")})
+			Expect(evs).To(HaveLen(1))
+			Expect(evs[0]).Should(WithSeverity(swag.String(models.EventSeverityWarning)))
+		})
+
+		It("matches LIKE wildcards literally", func() {
+			evs, _ := get(common.V2GetEventsParams{Message: swag.String("100%")})
+			Expect(evs).To(HaveLen(1))
+			evs, _ = get(common.V2GetEventsParams{Message: swag.String("%registered")})
+			Expect(evs).To(BeEmpty())
+		})
+
+		It("orders and pages", func() {
+			evs, total := get(common.V2GetEventsParams{
+				Order:  swag.String(common.OrderDescending),
+				Limit:  swag.Int64(2),
+				Offset: swag.Int64(1),
+			})
+			Expect(total).To(Equal(int64(3)))
+			Expect(evs).To(HaveLen(2))
+			Expect(evs[0]).Should(WithSeverity(swag.String(models.EventSeverityWarning)))
+			Expect(evs[1]).Should(WithSeverity(swag.String(models.EventSeverityInfo)))
+		})
+	})
+
 	Context("events query filtering", func() {
 
 		Context("query with no filters - expect no valid transaction error", func() {
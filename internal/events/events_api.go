@@ -2,9 +2,15 @@ package events
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"sort"
+	"time"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/models"
@@ -19,14 +25,16 @@ import (
 var _ restapi.EventsAPI = &Api{}
 
 type Api struct {
-	handler eventsapi.Handler
-	log     logrus.FieldLogger
+	handler  eventsapi.Handler
+	streamer *Streamer
+	log      logrus.FieldLogger
 }
 
-func NewApi(handler eventsapi.Handler, log logrus.FieldLogger) *Api {
+func NewApi(handler eventsapi.Handler, streamer *Streamer, log logrus.FieldLogger) *Api {
 	return &Api{
-		handler: handler,
-		log:     log,
+		handler:  handler,
+		streamer: streamer,
+		log:      log,
 	}
 }
 
@@ -37,7 +45,20 @@ func (a *Api) ListEvents(ctx context.Context, params events.ListEventsParams) mi
 func (a *Api) V2ListEvents(ctx context.Context, params events.V2ListEventsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, a.log)
 
-	evs, err := a.handler.V2GetEvents(ctx, params.ClusterID, params.HostID, params.InfraEnvID, params.Categories...)
+	evs, total, err := a.handler.V2GetFilteredEvents(ctx, &common.V2GetEventsParams{
+		ClusterID:  params.ClusterID,
+		HostID:     params.HostID,
+		InfraEnvID: params.InfraEnvID,
+		Categories: params.Categories,
+		Severities: params.Severities,
+		Names:      params.Names,
+		Since:      params.Since,
+		Until:      params.Until,
+		Message:    params.Message,
+		Order:      params.Order,
+		Limit:      params.Limit,
+		Offset:     params.Offset,
+	})
 	if err != nil {
 		if errors.Is(err, gorm.ErrInvalidTransaction) {
 			return common.NewApiError(http.StatusBadRequest, err)
@@ -60,5 +81,148 @@ func (a *Api) V2ListEvents(ctx context.Context, params events.V2ListEventsParams
 			Props:      ev.Props,
 		}
 	}
-	return events.NewV2ListEventsOK().WithPayload(ret)
+	return events.NewV2ListEventsOK().WithPayload(ret).WithXTotalCount(total)
+}
+
+func (a *Api) V2StreamEvents(ctx context.Context, params events.V2StreamEventsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+
+	// Subscribing before querying the stored events guarantees that no event falls between the two
+	filter := StreamFilter{
+		ClusterID:  params.ClusterID,
+		HostID:     params.HostID,
+		InfraEnvID: params.InfraEnvID,
+		Severities: params.Severities,
+	}
+	messages, unsubscribe := a.streamer.Subscribe(filter)
+
+	// The query also verifies that the user may access the requested resources
+	query := &common.V2GetEventsParams{
+		ClusterID:  params.ClusterID,
+		HostID:     params.HostID,
+		InfraEnvID: params.InfraEnvID,
+		Severities: params.Severities,
+	}
+	if params.LastEventID != nil {
+		query.AfterEventID = params.LastEventID
+	} else {
+		query.Order = swag.String(common.OrderDescending)
+		query.Limit = swag.Int64(1)
+	}
+	evs, _, err := a.handler.V2GetFilteredEvents(ctx, query)
+	if err != nil {
+		unsubscribe()
+		log.WithError(err).Errorf("failed to get events")
+		return jsonResponder{responder: eventsErrorResponder(err)}
+	}
+
+	responder := &eventStreamResponder{ctx: ctx, log: log, messages: messages, unsubscribe: unsubscribe}
+	if params.LastEventID != nil {
+		responder.replay = evs
+		responder.lastEventID = uint(*params.LastEventID)
+	} else if len(evs) > 0 {
+		// Only the events that follow the latest stored one are sent to a new client
+		responder.lastEventID = evs[0].ID
+	}
+	return responder
+}
+
+func eventsErrorResponder(err error) middleware.Responder {
+	if errors.Is(err, gorm.ErrInvalidTransaction) {
+		return common.NewApiError(http.StatusBadRequest, err)
+	} else if errors.Is(err, gorm.ErrRecordNotFound) {
+		return common.NewApiError(http.StatusNotFound, err)
+	}
+	return common.NewApiError(http.StatusInternalServerError, err)
+}
+
+// jsonResponder writes the error responses of the stream endpoint as JSON, since the producer negotiated for
+// the endpoint is the one of the event stream
+type jsonResponder struct {
+	responder middleware.Responder
+}
+
+func (j jsonResponder) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	rw.Header().Set("Content-Type", runtime.JSONMime)
+	j.responder.WriteResponse(rw, runtime.JSONProducer())
+}
+
+// streamHeartbeatInterval is the interval of the comments sent on an idle stream, to keep proxies from closing it
+const streamHeartbeatInterval = 15 * time.Second
+
+// eventStreamResponder writes the missed events followed by the live stream messages, until the client disconnects
+type eventStreamResponder struct {
+	ctx         context.Context
+	log         logrus.FieldLogger
+	messages    <-chan *StreamMessage
+	unsubscribe func()
+	replay      []*common.Event
+	lastEventID uint
+}
+
+func (r *eventStreamResponder) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	defer r.unsubscribe()
+
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.Header().Set("X-Accel-Buffering", "no")
+	rw.WriteHeader(http.StatusOK)
+	flush := func() {
+		if flusher, ok := rw.(http.Flusher); ok {
+			flusher.Flush()
+		}
+	}
+
+	sort.Slice(r.replay, func(i, j int) bool { return r.replay[i].ID < r.replay[j].ID })
+	for _, ev := range r.replay {
+		data, err := json.Marshal(streamEvent(ev))
+		if err != nil {
+			r.log.WithError(err).Errorf("failed to marshal event %d", ev.ID)
+			return
+		}
+		if err = r.write(rw, &StreamMessage{Type: StreamMessageEvent, EventID: ev.ID, Data: data}); err != nil {
+			return
+		}
+	}
+	flush()
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(rw, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case msg, ok := <-r.messages:
+			if !ok {
+				// The client reconnects with the id of the last event it got and catches up
+				return
+			}
+			if msg.Type == StreamMessageEvent && msg.EventID <= r.lastEventID {
+				continue
+			}
+			if err := r.write(rw, msg); err != nil {
+				return
+			}
+		}
+		flush()
+	}
+}
+
+func (r *eventStreamResponder) write(rw http.ResponseWriter, msg *StreamMessage) error {
+	var err error
+	if msg.Type == StreamMessageEvent {
+		r.lastEventID = msg.EventID
+		_, err = fmt.Fprintf(rw, "id: %d\n", msg.EventID)
+	}
+	if err == nil {
+		_, err = fmt.Fprintf(rw, "event: %s\ndata: %s\n\n", msg.Type, msg.Data)
+	}
+	if err != nil {
+		r.log.WithError(err).Debug("event stream client went away")
+	}
+	return err
 }
@@ -0,0 +1,318 @@
+package events
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jackc/pgx/v4/stdlib"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+// streamChannel is the Postgres notification channel carrying the live updates to the event streams of all the service replicas
+const streamChannel = "assisted_service_stream"
+
+// Types of the messages sent on the event stream
+const (
+	StreamMessageEvent           = "event"
+	StreamMessageClusterProgress = "cluster-progress"
+	StreamMessageHostProgress    = "host-progress"
+)
+
+const (
+	// streamSubscriberBuffer is the number of messages a subscriber may fall behind before it is dropped
+	streamSubscriberBuffer    = 256
+	streamListenRetryInterval = 5 * time.Second
+)
+
+// StreamMessage is a live update sent to the event stream subscribers. Events are published with their sequence
+// number only, because of the size limit of the notification payload, and are loaded once by every replica.
+type StreamMessage struct {
+	Type       string          `json:"type"`
+	EventID    uint            `json:"event_id,omitempty"`
+	ClusterID  *strfmt.UUID    `json:"cluster_id,omitempty"`
+	HostID     *strfmt.UUID    `json:"host_id,omitempty"`
+	InfraEnvID *strfmt.UUID    `json:"infra_env_id,omitempty"`
+	Severity   string          `json:"severity,omitempty"`
+	Data       json.RawMessage `json:"data,omitempty"`
+}
+
+// StreamFilter selects the messages of a single subscriber. At least one of the IDs is expected to be set.
+type StreamFilter struct {
+	ClusterID  *strfmt.UUID
+	HostID     *strfmt.UUID
+	InfraEnvID *strfmt.UUID
+	Severities []string
+}
+
+func (f StreamFilter) matches(msg *StreamMessage) bool {
+	if f.ClusterID != nil && (msg.ClusterID == nil || *msg.ClusterID != *f.ClusterID) {
+		return false
+	}
+	if f.HostID != nil && (msg.HostID == nil || *msg.HostID != *f.HostID) {
+		return false
+	}
+	if f.InfraEnvID != nil && (msg.InfraEnvID == nil || *msg.InfraEnvID != *f.InfraEnvID) {
+		return false
+	}
+	if msg.Type == StreamMessageEvent && len(f.Severities) > 0 && !funk.ContainsString(f.Severities, msg.Severity) {
+		return false
+	}
+	return true
+}
+
+type streamProgress struct {
+	ClusterID  *strfmt.UUID `json:"cluster_id,omitempty"`
+	HostID     *strfmt.UUID `json:"host_id,omitempty"`
+	InfraEnvID *strfmt.UUID `json:"infra_env_id,omitempty"`
+	Progress   interface{}  `json:"progress"`
+}
+
+// PublishClusterProgress sends the installation progress of the cluster to the event stream subscribers
+func PublishClusterProgress(db *gorm.DB, log logrus.FieldLogger, clusterID strfmt.UUID, progress *models.ClusterProgressInfo) {
+	data, err := json.Marshal(streamProgress{ClusterID: &clusterID, Progress: progress})
+	if err != nil {
+		log.WithError(err).Errorf("failed to marshal progress of cluster %s", clusterID)
+		return
+	}
+	msg := &StreamMessage{Type: StreamMessageClusterProgress, ClusterID: &clusterID, Data: data}
+	if err = publish(db, msg); err != nil {
+		log.WithError(err).Warnf("failed to publish progress of cluster %s", clusterID)
+	}
+}
+
+// PublishHostProgress sends the installation progress of the host to the event stream subscribers
+func PublishHostProgress(db *gorm.DB, log logrus.FieldLogger, host *models.Host) {
+	data, err := json.Marshal(streamProgress{ClusterID: host.ClusterID, HostID: host.ID, InfraEnvID: &host.InfraEnvID, Progress: host.Progress})
+	if err != nil {
+		log.WithError(err).Errorf("failed to marshal progress of host %s", host.ID)
+		return
+	}
+	msg := &StreamMessage{Type: StreamMessageHostProgress, ClusterID: host.ClusterID, HostID: host.ID, InfraEnvID: &host.InfraEnvID, Data: data}
+	if err = publish(db, msg); err != nil {
+		log.WithError(err).Warnf("failed to publish progress of host %s", host.ID)
+	}
+}
+
+func eventStreamMessage(event *common.Event) *StreamMessage {
+	return &StreamMessage{
+		Type:       StreamMessageEvent,
+		EventID:    event.ID,
+		ClusterID:  event.ClusterID,
+		HostID:     event.HostID,
+		InfraEnvID: event.InfraEnvID,
+		Severity:   *event.Severity,
+	}
+}
+
+// publish notifies all the replicas listening on the stream channel. When called within a transaction, the
+// notification is only sent once the transaction commits.
+func publish(db *gorm.DB, msg *StreamMessage) error {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return db.Exec("SELECT pg_notify(?, ?)", streamChannel, string(payload)).Error
+}
+
+// streamEvent returns the event in the format of the events API
+func streamEvent(ev *common.Event) *models.Event {
+	return &models.Event{
+		Name:       ev.Name,
+		ClusterID:  ev.ClusterID,
+		HostID:     ev.HostID,
+		InfraEnvID: ev.InfraEnvID,
+		Severity:   ev.Severity,
+		EventTime:  ev.EventTime,
+		Message:    ev.Message,
+		Props:      ev.Props,
+	}
+}
+
+type streamSubscriber struct {
+	filter StreamFilter
+	// mutex guards the channel from being closed while a message is sent to it
+	mutex  sync.Mutex
+	closed bool
+	ch     chan *StreamMessage
+}
+
+// send delivers the message without blocking, and reports false if the subscriber fell too far behind
+func (sub *streamSubscriber) send(msg *StreamMessage) bool {
+	sub.mutex.Lock()
+	defer sub.mutex.Unlock()
+	if sub.closed {
+		return true
+	}
+	select {
+	case sub.ch <- msg:
+		return true
+	default:
+		return false
+	}
+}
+
+func (sub *streamSubscriber) close() {
+	sub.mutex.Lock()
+	defer sub.mutex.Unlock()
+	if !sub.closed {
+		sub.closed = true
+		close(sub.ch)
+	}
+}
+
+// Streamer listens to the stream channel on a dedicated database connection and fans the messages out to the
+// event stream subscribers of this replica
+type Streamer struct {
+	db          *gorm.DB
+	log         logrus.FieldLogger
+	mutex       sync.Mutex
+	subscribers map[*streamSubscriber]struct{}
+	cancel      context.CancelFunc
+	done        chan struct{}
+}
+
+func NewStreamer(db *gorm.DB, log logrus.FieldLogger) *Streamer {
+	return &Streamer{
+		db:          db,
+		log:         log,
+		subscribers: make(map[*streamSubscriber]struct{}),
+	}
+}
+
+func (s *Streamer) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.done = make(chan struct{})
+	go func() {
+		defer close(s.done)
+		for {
+			err := s.listen(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			s.log.WithError(err).Warnf("lost connection to the %s channel, reconnecting", streamChannel)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(streamListenRetryInterval):
+			}
+		}
+	}()
+}
+
+func (s *Streamer) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	<-s.done
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for sub := range s.subscribers {
+		sub.close()
+		delete(s.subscribers, sub)
+	}
+}
+
+// Subscribe returns the channel of the messages matching the filter and a function that ends the subscription.
+// The channel is closed if the subscriber falls too far behind, in which case it should resubscribe and catch up
+// on the events it missed.
+func (s *Streamer) Subscribe(filter StreamFilter) (<-chan *StreamMessage, func()) {
+	sub := &streamSubscriber{filter: filter, ch: make(chan *StreamMessage, streamSubscriberBuffer)}
+	s.mutex.Lock()
+	s.subscribers[sub] = struct{}{}
+	s.mutex.Unlock()
+
+	return sub.ch, func() { s.unsubscribe(sub) }
+}
+
+func (s *Streamer) unsubscribe(sub *streamSubscriber) {
+	s.mutex.Lock()
+	delete(s.subscribers, sub)
+	s.mutex.Unlock()
+	sub.close()
+}
+
+func (s *Streamer) listen(ctx context.Context) error {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn interface{}) error {
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return errors.Errorf("unexpected database driver connection %T", driverConn)
+		}
+		pgConn := stdlibConn.Conn()
+		if _, err = pgConn.Exec(ctx, "LISTEN "+streamChannel); err != nil {
+			return err
+		}
+		for {
+			notification, err := pgConn.WaitForNotification(ctx)
+			if err != nil {
+				s.log.WithError(err).Debugf("stopped listening to the %s channel", streamChannel)
+				// The connection is discarded rather than returned to the pool while listening
+				return driver.ErrBadConn
+			}
+			s.dispatch(notification.Payload)
+		}
+	})
+}
+
+func (s *Streamer) dispatch(payload string) {
+	var msg StreamMessage
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		s.log.WithError(err).Errorf("failed to unmarshal stream message %s", payload)
+		return
+	}
+
+	// The subscribers are copied so that loading the event and delivering it don't hold back the other subscribers
+	// and the publishers
+	var matching []*streamSubscriber
+	s.mutex.Lock()
+	for sub := range s.subscribers {
+		if sub.filter.matches(&msg) {
+			matching = append(matching, sub)
+		}
+	}
+	s.mutex.Unlock()
+	if len(matching) == 0 {
+		return
+	}
+
+	if msg.Type == StreamMessageEvent && msg.Data == nil {
+		var event common.Event
+		if err := s.db.Take(&event, "id = ?", msg.EventID).Error; err != nil {
+			s.log.WithError(err).Errorf("failed to get event %d", msg.EventID)
+			return
+		}
+		data, err := json.Marshal(streamEvent(&event))
+		if err != nil {
+			s.log.WithError(err).Errorf("failed to marshal event %d", msg.EventID)
+			return
+		}
+		msg.Data = data
+	}
+
+	for _, sub := range matching {
+		if !sub.send(&msg) {
+			s.log.Warnf("dropping event stream subscriber that fell %d messages behind", streamSubscriberBuffer)
+			s.unsubscribe(sub)
+		}
+	}
+}
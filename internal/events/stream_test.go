@@ -0,0 +1,191 @@
+package events_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/events"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type sseMessage struct {
+	id    string
+	event string
+	data  string
+}
+
+func readSSEMessage(reader *bufio.Reader) sseMessage {
+	var msg sseMessage
+	for {
+		line, err := reader.ReadString('\n')
+		Expect(err).ShouldNot(HaveOccurred())
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			if msg.event != "" {
+				return msg
+			}
+		case strings.HasPrefix(line, ":"):
+		case strings.HasPrefix(line, "id: "):
+			msg.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			msg.event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			msg.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+var _ = Describe("Event stream", func() {
+	var (
+		db        *gorm.DB
+		dbName    string
+		theEvents eventsapi.Handler
+		streamer  *events.Streamer
+		api       *events.Api
+		cluster1  strfmt.UUID
+		cluster2  strfmt.UUID
+		ctx       = context.Background()
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		theEvents = events.New(db, logrus.WithField("pkg", "events"))
+		streamer = events.NewStreamer(db, logrus.WithField("pkg", "events-stream"))
+		api = events.NewApi(theEvents, streamer, logrus.WithField("pkg", "eventsApi"))
+		cluster1 = strfmt.UUID(uuid.New().String())
+		cluster2 = strfmt.UUID(uuid.New().String())
+		for _, id := range []strfmt.UUID{cluster1, cluster2} {
+			clusterID := id
+			Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ShouldNot(HaveOccurred())
+		}
+		streamer.Start()
+
+		By("waiting for the streamer to listen")
+		messages, unsubscribe := streamer.Subscribe(events.StreamFilter{ClusterID: &cluster2})
+		defer unsubscribe()
+		Eventually(func() bool {
+			events.PublishClusterProgress(db, logrus.New(), cluster2, &models.ClusterProgressInfo{})
+			select {
+			case <-messages:
+				return true
+			case <-time.After(100 * time.Millisecond):
+				return false
+			}
+		}, 10*time.Second).Should(BeTrue())
+	})
+
+	AfterEach(func() {
+		streamer.Stop()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	connect := func(params operations.V2StreamEventsParams) (*http.Response, func()) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			params.HTTPRequest = r
+			api.V2StreamEvents(r.Context(), params).WriteResponse(w, runtime.JSONProducer())
+		}))
+		resp, err := http.Get(server.URL)
+		Expect(err).ShouldNot(HaveOccurred())
+		return resp, func() {
+			resp.Body.Close()
+			server.Close()
+		}
+	}
+
+	addEvent := func(clusterID strfmt.UUID, msg string) uint {
+		theEvents.V2AddEvent(ctx, &clusterID, nil, nil, eventgen.ClusterRegistrationSucceededEventName,
+			models.EventSeverityInfo, msg, time.Now())
+		var event common.Event
+		Expect(db.Where("message = ?", msg).Take(&event).Error).ShouldNot(HaveOccurred())
+		return event.ID
+	}
+
+	It("streams the live events of the cluster", func() {
+		resp, closeStream := connect(operations.V2StreamEventsParams{ClusterID: &cluster1})
+		defer closeStream()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(resp.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+		reader := bufio.NewReader(resp.Body)
+
+		addEvent(cluster2, "other cluster")
+		id := addEvent(cluster1, "first")
+
+		msg := readSSEMessage(reader)
+		Expect(msg.event).To(Equal(events.StreamMessageEvent))
+		Expect(msg.id).To(Equal(strconv.Itoa(int(id))))
+		var event models.Event
+		Expect(json.Unmarshal([]byte(msg.data), &event)).ShouldNot(HaveOccurred())
+		Expect(swag.StringValue(event.Message)).To(Equal("first"))
+		Expect(*event.ClusterID).To(Equal(cluster1))
+	})
+
+	It("replays the events following the last event id", func() {
+		first := addEvent(cluster1, "first")
+		second := addEvent(cluster1, "second")
+
+		lastEventID := int64(first)
+		resp, closeStream := connect(operations.V2StreamEventsParams{ClusterID: &cluster1, LastEventID: &lastEventID})
+		defer closeStream()
+		reader := bufio.NewReader(resp.Body)
+
+		msg := readSSEMessage(reader)
+		Expect(msg.id).To(Equal(strconv.Itoa(int(second))))
+
+		third := addEvent(cluster1, "third")
+		msg = readSSEMessage(reader)
+		Expect(msg.id).To(Equal(strconv.Itoa(int(third))))
+	})
+
+	It("streams the cluster progress", func() {
+		resp, closeStream := connect(operations.V2StreamEventsParams{ClusterID: &cluster1})
+		defer closeStream()
+		reader := bufio.NewReader(resp.Body)
+
+		events.PublishClusterProgress(db, logrus.New(), cluster1, &models.ClusterProgressInfo{TotalPercentage: 42})
+
+		msg := readSSEMessage(reader)
+		Expect(msg.event).To(Equal(events.StreamMessageClusterProgress))
+		Expect(msg.id).To(BeEmpty())
+		Expect(msg.data).To(ContainSubstring(`"total_percentage":42`))
+	})
+
+	It("filters the events by severity", func() {
+		resp, closeStream := connect(operations.V2StreamEventsParams{ClusterID: &cluster1, Severities: []string{models.EventSeverityError}})
+		defer closeStream()
+		reader := bufio.NewReader(resp.Body)
+
+		addEvent(cluster1, "informative")
+		theEvents.V2AddEvent(ctx, &cluster1, nil, nil, eventgen.ClusterRegistrationSucceededEventName,
+			models.EventSeverityError, "failure", time.Now())
+
+		msg := readSSEMessage(reader)
+		Expect(msg.data).To(ContainSubstring("failure"))
+	})
+
+	It("fails for a missing cluster", func() {
+		missing := strfmt.UUID(uuid.New().String())
+		resp, closeStream := connect(operations.V2StreamEventsParams{ClusterID: &missing})
+		defer closeStream()
+		Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+		Expect(resp.Header.Get("Content-Type")).To(Equal(runtime.JSONMime))
+	})
+})
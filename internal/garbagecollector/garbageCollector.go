@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/audit"
 	clusterPkg "github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/infraenv"
@@ -21,6 +22,7 @@ type Config struct {
 	InfraenvDeleteInactiveAfter time.Duration `envconfig:"INFRAENV_DELETED_INACTIVE_AFTER" default:"480h"` // 20d
 	MaxGCClustersPerInterval    int           `envconfig:"MAX_GC_CLUSTERS_PER_INTERVAL" default:"100"`
 	MaxGCInfraEnvsPerInterval   int           `envconfig:"MAX_GC_INFRAENVS_PER_INTERVAL" default:"100"`
+	AuditRecordsRetention       time.Duration `envconfig:"AUDIT_RECORDS_RETENTION" default:"2160h"` // 90d
 }
 
 type GarbageCollectors interface {
@@ -37,6 +39,7 @@ func NewGarbageCollectors(
 	infraEnvApi infraenv.API,
 	objectHandler s3wrapper.API,
 	leaderElector leader.Leader,
+	auditApi audit.API,
 
 ) *garbageCollector {
 	return &garbageCollector{
@@ -48,6 +51,7 @@ func NewGarbageCollectors(
 		infraEnvApi:   infraEnvApi,
 		objectHandler: objectHandler,
 		leaderElector: leaderElector,
+		auditApi:      auditApi,
 	}
 }
 
@@ -60,6 +64,7 @@ type garbageCollector struct {
 	infraEnvApi   infraenv.API
 	objectHandler s3wrapper.API
 	leaderElector leader.Leader
+	auditApi      audit.API
 }
 
 func (g garbageCollector) DeregisterInactiveClusters() {
@@ -79,6 +84,8 @@ func (g garbageCollector) PermanentlyDeleteUnregisteredClustersAndHosts() {
 		return
 	}
 
+	g.permanentlyDeleteExpiredAuditRecords()
+
 	olderThan := strfmt.DateTime(time.Now().Add(-g.Config.DeletedUnregisteredAfter))
 	if err := g.clusterApi.PermanentClustersDeletion(context.Background(), olderThan, g.objectHandler); err != nil {
 		g.log.WithError(err).Errorf("Failed deleting de-registered clusters")
@@ -94,6 +101,16 @@ func (g garbageCollector) PermanentlyDeleteUnregisteredClustersAndHosts() {
 	}
 }
 
+func (g garbageCollector) permanentlyDeleteExpiredAuditRecords() {
+	olderThan := strfmt.DateTime(time.Now().Add(-g.Config.AuditRecordsRetention))
+	g.log.Debugf(
+		"Permanently deleting all audit records of API calls made before %s",
+		olderThan)
+	if err := g.auditApi.DeleteExpiredRecords(context.Background(), olderThan); err != nil {
+		g.log.WithError(err).Errorf("Failed deleting expired audit records")
+	}
+}
+
 func (g garbageCollector) DeleteOrphanInfraEnvs() {
 	if !g.leaderElector.IsLeader() {
 		return
@@ -0,0 +1,64 @@
+package garbagecollector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/audit"
+	"github.com/openshift/assisted-service/internal/cluster"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/pkg/leader"
+)
+
+func TestGarbageCollector(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "garbage collector tests")
+}
+
+var _ = Describe("PermanentlyDeleteUnregisteredClustersAndHosts", func() {
+	var (
+		ctrl           *gomock.Controller
+		mockClusterApi *cluster.MockAPI
+		mockHostApi    *host.MockAPI
+		mockLeader     *leader.MockLeader
+		mockAudit      *audit.MockAPI
+		gc             *garbageCollector
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockClusterApi = cluster.NewMockAPI(ctrl)
+		mockHostApi = host.NewMockAPI(ctrl)
+		mockLeader = leader.NewMockLeader(ctrl)
+		mockAudit = audit.NewMockAPI(ctrl)
+		gc = NewGarbageCollectors(Config{AuditRecordsRetention: 24 * time.Hour}, nil, common.GetTestLog(),
+			mockHostApi, mockClusterApi, nil, nil, mockLeader, mockAudit)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("deletes the audit records older than the retention period", func() {
+		mockLeader.EXPECT().IsLeader().Return(true)
+		mockAudit.EXPECT().DeleteExpiredRecords(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, olderThan strfmt.DateTime) error {
+				Expect(time.Time(olderThan)).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+				return nil
+			})
+		mockClusterApi.EXPECT().PermanentClustersDeletion(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mockHostApi.EXPECT().PermanentHostsDeletion(gomock.Any()).Return(nil)
+		gc.PermanentlyDeleteUnregisteredClustersAndHosts()
+	})
+
+	It("does not delete anything when not the leader", func() {
+		mockLeader.EXPECT().IsLeader().Return(false)
+		gc.PermanentlyDeleteUnregisteredClustersAndHosts()
+	})
+})
@@ -47,6 +47,22 @@ func LocalJWTForKey(id string, private_key_pem string, keyType LocalJWTKeyType)
 	return tokenString, nil
 }
 
+// LocalUserJWTForKey returns a token authenticating a user of a deployment with local authentication, valid for
+// the given duration
+func LocalUserJWTForKey(userName string, orgID string, privateKeyPEM string, expiration time.Duration) (string, error) {
+	priv, err := jwt.ParseECPrivateKeyFromPEM([]byte(privateKeyPEM))
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"sub":    userName,
+		"org_id": orgID,
+		"exp":    time.Now().Add(expiration).Unix(),
+	})
+	return token.SignedString(priv)
+}
+
 func SignURL(urlString string, id string, keyType LocalJWTKeyType) (string, error) {
 	tok, err := LocalJWT(id, keyType)
 	if err != nil {
@@ -96,6 +96,22 @@ var _ = Context("with an ECDSA key pair", func() {
 
 		validateToken(tokenString, publicKey, id)
 	})
+
+	It("LocalUserJWTForKey creates a valid token", func() {
+		tokenString, err := LocalUserJWTForKey("jdoe", "org1", privateKeyPEM, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		parser := &jwt.Parser{ValidMethods: []string{jwt.SigningMethodES256.Alg()}}
+		parsed, err := parser.Parse(tokenString, func(t *jwt.Token) (interface{}, error) { return publicKey, nil })
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsed.Valid).To(BeTrue())
+
+		claims, ok := parsed.Claims.(jwt.MapClaims)
+		Expect(ok).To(BeTrue())
+		Expect(claims["sub"]).To(Equal("jdoe"))
+		Expect(claims["org_id"]).To(Equal("org1"))
+		Expect(claims["exp"]).To(BeNumerically(">", time.Now().Unix()))
+	})
 })
 
 var _ = Describe("JWTForSymmetricKey", func() {
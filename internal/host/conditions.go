@@ -22,6 +22,7 @@ const (
 	StageInWrongBootStages               = conditionId("stage-in-wrong-boot-stages")
 	ClusterInError                       = conditionId("cluster-in-error")
 	SuccessfulContainerImageAvailability = conditionId("successful-container-image-availability")
+	CustomValidationsSatisfied           = conditionId("custom-validations-satisfied")
 )
 
 func (c conditionId) String() string {
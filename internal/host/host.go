@@ -16,11 +16,13 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/events"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/host/hostcommands"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/models"
@@ -118,7 +120,10 @@ type Config struct {
 	EnableAutoAssign        bool                    `envconfig:"ENABLE_AUTO_ASSIGN" default:"true"`
 	ResetTimeout            time.Duration           `envconfig:"RESET_CLUSTER_TIMEOUT" default:"3m"`
 	MonitorBatchSize        int                     `envconfig:"HOST_MONITOR_BATCH_SIZE" default:"100"`
-	DisabledHostvalidations DisabledHostValidations `envconfig:"DISABLED_HOST_VALIDATIONS" default:""` // Which host validations to disable (should not run in preprocess)
+	DisabledHostvalidations DisabledHostValidations `envconfig:"DISABLED_HOST_VALIDATIONS" default:""`      // Which host validations to disable (should not run in preprocess)
+	HostValidationRules     HostValidationRules     `envconfig:"HOST_VALIDATION_RULES" default:""`          // User defined host validations, as a JSON or YAML list of rules
+	InventoryHistoryLimit   int                     `envconfig:"HOST_INVENTORY_HISTORY_LIMIT" default:"20"` // Number of inventory snapshots kept for each host, 0 keeps all of them
+	DisabledSteps           []models.StepType       // The steps that are not sent to the agents, their validations are skipped
 }
 
 //go:generate mockgen -package=host -aux_files=github.com/openshift/assisted-service/internal/host/hostcommands=instruction_manager.go -destination=mock_host_api.go . API
@@ -134,6 +139,7 @@ type API interface {
 	SetBootstrap(ctx context.Context, h *models.Host, isbootstrap bool, db *gorm.DB) error
 	UpdateConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
 	UpdateApiVipConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
+	UpdateTangConnectivityReport(ctx context.Context, h *models.Host, tangConnectivityReport string) error
 	HostMonitoring()
 	CancelInstallation(ctx context.Context, h *models.Host, reason string, db *gorm.DB) *common.ApiErrorResponse
 	IsRequireUserActionReset(h *models.Host) bool
@@ -164,6 +170,7 @@ type API interface {
 	UpdateNTP(ctx context.Context, h *models.Host, ntpSources []*models.NtpSource, db *gorm.DB) error
 	UpdateMachineConfigPoolName(ctx context.Context, db *gorm.DB, h *models.Host, machineConfigPoolName string) error
 	UpdateIgnitionEndpointToken(ctx context.Context, db *gorm.DB, h *models.Host, token string) error
+	UpdateFailureDomain(ctx context.Context, db *gorm.DB, h *models.Host, failureDomain *models.FailureDomain) error
 	UpdateInstallationDisk(ctx context.Context, db *gorm.DB, h *models.Host, installationDiskId string) error
 	UpdateKubeKeyNS(ctx context.Context, hostID, namespace string) error
 	GetHostValidDisks(role *models.Host) ([]*models.Disk, error)
@@ -174,6 +181,9 @@ type API interface {
 	UpdateDomainNameResolution(ctx context.Context, h *models.Host, domainResolutionResponse models.DomainResolutionResponse, db *gorm.DB) error
 	BindHost(ctx context.Context, h *models.Host, clusterID strfmt.UUID, db *gorm.DB) error
 	UnbindHost(ctx context.Context, h *models.Host, db *gorm.DB) error
+	ReviewInventory(ctx context.Context, h *models.Host, db *gorm.DB) error
+	GetInventoryHistory(ctx context.Context, h *models.Host) (models.HostInventorySnapshotList, error)
+	GetInventoryDiff(ctx context.Context, h *models.Host, fromSnapshotID, toSnapshotID strfmt.UUID) (*models.HostInventoryDiff, error)
 }
 
 type Manager struct {
@@ -208,7 +218,7 @@ func NewManager(log logrus.FieldLogger, db *gorm.DB, eventsHandler eventsapi.Han
 		hwValidator:    hwValidator,
 		eventsHandler:  eventsHandler,
 		sm:             sm,
-		rp:             newRefreshPreprocessor(log, hwValidatorCfg, hwValidator, operatorsApi, config.DisabledHostvalidations, providerRegistry),
+		rp:             newRefreshPreprocessor(log, hwValidatorCfg, hwValidator, operatorsApi, config.DisabledHostvalidations, config.HostValidationRules, config.DisabledSteps, providerRegistry),
 		metricApi:      metricApi,
 		Config:         *config,
 		leaderElector:  leaderElector,
@@ -363,6 +373,7 @@ func (m *Manager) updateInventory(ctx context.Context, cluster *common.Cluster,
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
 	m.populateDisksId(inventory)
+	network.PopulateInterfacesRelations(inventory)
 	inventoryStr, err = common.MarshalInventory(inventory)
 	if err != nil {
 		return err
@@ -383,14 +394,44 @@ func (m *Manager) updateInventory(ctx context.Context, cluster *common.Cluster,
 		installationDiskID = hostutil.GetDeviceIdentifier(installationDisk)
 	}
 
-	// If there is substantial change in the inventory that might cause the state machine to move to a new status
-	// or one of the validations to change, then the updated_at field has to be modified.  Otherwise, we just
-	// perform update with touching the updated_at field
-	return db.Model(h).Updates(map[string]interface{}{
+	updates := map[string]interface{}{
 		"inventory":              inventoryStr,
 		"installation_disk_path": installationDiskPath,
 		"installation_disk_id":   installationDiskID,
-	}).Error
+	}
+
+	// The failure domain set by the user takes precedence over the one derived from the LLDP neighbors. A derived
+	// rack is cleared once the host no longer reports the neighbor it was derived from.
+	if h.FailureDomain == nil || h.FailureDomain.Source != models.FailureDomainSourceUser {
+		if rack := hostutil.GetRackFromLLDP(inventory); rack != "" {
+			updates["failure_domain_rack"] = rack
+			updates["failure_domain_source"] = models.FailureDomainSourceLldp
+		} else if h.FailureDomain != nil && h.FailureDomain.Source == models.FailureDomainSourceLldp {
+			updates["failure_domain_rack"] = ""
+			updates["failure_domain_source"] = ""
+		}
+	}
+
+	tpmEKFingerprint, err := hostutil.GetTPMEKFingerprint(inventory)
+	if err != nil {
+		log.WithError(err).Warnf("failed to get the TPM endorsement key fingerprint of host %s", h.ID)
+	}
+	updates["tpm_ek_fingerprint"] = tpmEKFingerprint
+
+	if err = m.recordInventorySnapshot(db, h, inventory, inventoryStr); err != nil {
+		log.WithError(err).Warnf("failed to record the inventory history of host %s", h.ID)
+	}
+	inventoryDrift, err := m.detectInventoryDrift(ctx, db, h, inventory)
+	if err != nil {
+		log.WithError(err).Warnf("failed to detect the inventory drift of host %s", h.ID)
+	} else {
+		updates["inventory_drift"] = inventoryDrift
+	}
+
+	// If there is substantial change in the inventory that might cause the state machine to move to a new status
+	// or one of the validations to change, then the updated_at field has to be modified.  Otherwise, we just
+	// perform update with touching the updated_at field
+	return db.Model(h).Updates(updates).Error
 }
 
 func (m *Manager) refreshRoleInternal(ctx context.Context, h *models.Host, db *gorm.DB, forceRefresh bool) error {
@@ -402,12 +443,17 @@ func (m *Manager) refreshRoleInternal(ctx context.Context, h *models.Host, db *g
 		//periodically even if the suggested role is already set
 		if h.Role == models.HostRoleAutoAssign &&
 			funk.ContainsString(hostStatusesBeforeInstallation[:], *h.Status) {
-			if suggestedRole, err = m.autoRoleSelection(ctx, h, db); err == nil {
+			var strategy, reason string
+			if suggestedRole, strategy, reason, err = m.autoRoleSelection(ctx, h, db); err == nil {
 				if h.SuggestedRole != suggestedRole {
 					if err = updateRole(m.log, h, h.Role, suggestedRole, db, string(h.Role)); err == nil {
 						h.SuggestedRole = suggestedRole
-						m.log.Infof("suggested role for host %s is %s", *h.ID, suggestedRole)
+						m.log.Infof("suggested role for host %s is %s: %s", *h.ID, suggestedRole, reason)
 						eventgen.SendHostRoleUpdatedEvent(ctx, m.eventsHandler, *h.ID, h.InfraEnvID, hostutil.GetHostnameForMsg(h), string(suggestedRole))
+						if strategy != "" {
+							eventgen.SendHostRoleAssignmentExplainedEvent(ctx, m.eventsHandler, *h.ID, h.InfraEnvID, h.ClusterID,
+								hostutil.GetHostnameForMsg(h), string(suggestedRole), strategy, reason)
+						}
 					}
 				}
 			}
@@ -544,7 +590,11 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, pro
 			"progress_progress_info":    progress.ProgressInfo,
 			"progress_stage_updated_at": strfmt.DateTime(time.Now()),
 		}
-		return m.db.Model(h).UpdateColumns(updates).Error
+		if err := m.db.Model(h).UpdateColumns(updates).Error; err != nil {
+			return err
+		}
+		m.publishInstallProgress(ctx, h)
+		return nil
 	}
 
 	validStatuses := []string{
@@ -609,9 +659,23 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, pro
 			previousProgress.CurrentStage, progress.CurrentStage, progress.ProgressInfo, extra...)
 	}
 	m.reportInstallationMetrics(ctx, h, previousProgress, progress.CurrentStage)
+	if err == nil {
+		m.publishInstallProgress(ctx, h)
+	}
 	return err
 }
 
+// publishInstallProgress sends the stored installation progress of the host to the event stream subscribers
+func (m *Manager) publishInstallProgress(ctx context.Context, h *models.Host) {
+	log := logutil.FromContext(ctx, m.log)
+	host, err := common.GetHostFromDB(m.db, h.InfraEnvID.String(), h.ID.String())
+	if err != nil {
+		log.WithError(err).Warnf("failed to get host %s to publish its progress", h.ID)
+		return
+	}
+	events.PublishHostProgress(m.db, log, &host.Host)
+}
+
 func (m *Manager) SetBootstrap(ctx context.Context, h *models.Host, isbootstrap bool, db *gorm.DB) error {
 	if h.Bootstrap != isbootstrap {
 		err := db.Model(h).Update("bootstrap", isbootstrap).Error
@@ -664,6 +728,15 @@ func (m *Manager) UpdateApiVipConnectivityReport(ctx context.Context, h *models.
 	return nil
 }
 
+func (m *Manager) UpdateTangConnectivityReport(ctx context.Context, h *models.Host, tangConnectivityReport string) error {
+	if h.TangConnectivity != tangConnectivityReport {
+		if err := m.db.Model(h).Update("tang_connectivity", tangConnectivityReport).Error; err != nil {
+			return errors.Wrapf(err, "failed to set tang_connectivity to host %s", h.ID.String())
+		}
+	}
+	return nil
+}
+
 func (m *Manager) UpdateRole(ctx context.Context, h *models.Host, role models.HostRole, db *gorm.DB) error {
 	cdb := m.db
 	if db != nil {
@@ -716,6 +789,34 @@ func (m *Manager) UpdateIgnitionEndpointToken(ctx context.Context, db *gorm.DB,
 		"trigger_monitor_timestamp":   time.Now()}).Error
 }
 
+func (m *Manager) UpdateFailureDomain(ctx context.Context, db *gorm.DB, h *models.Host, failureDomain *models.FailureDomain) error {
+	hostStatus := swag.StringValue(h.Status)
+	if !funk.ContainsString(hostStatusesBeforeInstallationOrUnbound[:], hostStatus) {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Host is in %s state, host failure domain can be set only in one of %s states",
+				hostStatus, hostStatusesBeforeInstallation[:]))
+	}
+
+	cdb := m.db
+	if db != nil {
+		cdb = db
+	}
+
+	// A failure domain set by the user overrides the one derived from the LLDP neighbors of the host, and clearing
+	// it lets the derivation take over again on the next inventory update
+	source := models.FailureDomainSourceUser
+	if failureDomain.Rack == "" && failureDomain.Chassis == "" && failureDomain.PowerDomain == "" {
+		source = ""
+	}
+
+	return cdb.Model(common.Host{Host: *h}).Updates(map[string]interface{}{
+		"failure_domain_rack":         failureDomain.Rack,
+		"failure_domain_chassis":      failureDomain.Chassis,
+		"failure_domain_power_domain": failureDomain.PowerDomain,
+		"failure_domain_source":       source,
+		"trigger_monitor_timestamp":   time.Now()}).Error
+}
+
 func (m *Manager) UpdateNTP(ctx context.Context, h *models.Host, ntpSources []*models.NtpSource, db *gorm.DB) error {
 	bytes, err := json.Marshal(ntpSources)
 	if err != nil {
@@ -1095,66 +1196,41 @@ func (m *Manager) AutoAssignRole(ctx context.Context, h *models.Host, db *gorm.D
 	return false, nil
 }
 
-func (m *Manager) autoRoleSelection(ctx context.Context, host *models.Host, db *gorm.DB) (models.HostRole, error) {
+func (m *Manager) autoRoleSelection(ctx context.Context, host *models.Host, db *gorm.DB) (models.HostRole, string, string, error) {
 	h := *host
 
-	suggestedRole, err := m.selectRole(ctx, &h, db)
-	return suggestedRole, err
+	return m.selectRole(ctx, &h, db)
 }
 
-// This function recommends a role for a given host based on these criteria:
-// 1. if there are not enough masters and the host has enough capabilities to be
-//    a master the function select it to be a master
-// 2. if there are enough masters, or it is a day2 host, or it has not enough capabilities
-//    to be a master the function select it to be a  worker
-// 3. in case of missing inventory or an internal error the function returns auto-assign
-func (m *Manager) selectRole(ctx context.Context, h *models.Host, db *gorm.DB) (models.HostRole, error) {
-	var (
-		autoSelectedRole = models.HostRoleAutoAssign
-		log              = logutil.FromContext(ctx, m.log)
-		err              error
-		vc               *validationContext
-	)
+// This function recommends a role for a given host with the role assignment strategy of its cluster:
+// 1. a day2 host is always selected to be a worker
+// 2. the first-fit strategy selects the host to be a master if there are not enough masters and the
+//    host has enough capabilities to be a master, and to be a worker otherwise
+// 3. the scoring strategy selects the host to be a master if it is among the best master candidates
+//    of the cluster, and to be a worker otherwise
+// 4. in case of missing inventory or an internal error the function returns auto-assign
+// Along with the role, the function returns the strategy and the reason of the selection
+func (m *Manager) selectRole(ctx context.Context, h *models.Host, db *gorm.DB) (models.HostRole, string, string, error) {
+	log := logutil.FromContext(ctx, m.log)
 
 	if hostutil.IsDay2Host(h) {
-		return models.HostRoleWorker, nil
+		return models.HostRoleWorker, "", "the host is added to an installed cluster", nil
 	}
 
 	if h.Inventory == "" {
-		return autoSelectedRole, errors.Errorf("host %s from cluster %s don't have hardware info",
+		return models.HostRoleAutoAssign, "", "", errors.Errorf("host %s from cluster %s don't have hardware info",
 			h.ID.String(), h.ClusterID.String())
 	}
 
-	// count already existing masters or hosts with suggested role of master
-	// since aggregated functions can not run within a FOR UPDATE transaction
-	// we are now calculating the master count with SELECT query (Bug 2012570)
-	var masters []string
-	reply := db.Model(&models.Host{}).Where("cluster_id = ? and id != ? and status != ? and (role = ? or suggested_role = ?)",
-		h.ClusterID, h.ID, models.HostStatusDisabled, models.HostRoleMaster, models.HostRoleMaster).Pluck("id", &masters)
-
-	if err = reply.Error; err != nil {
-		log.WithError(err).Errorf("failed to count masters in cluster %s", h.ClusterID.String())
-		return autoSelectedRole, err
-	}
-
-	if len(masters) < common.MinMasterHostsNeededForInstallation {
-		h.Role = models.HostRoleMaster
-		vc, err = newValidationContext(h, nil, nil, db, m.hwValidator)
-		if err != nil {
-			log.WithError(err).Errorf("failed to create new validation context for host %s", h.ID.String())
-			return autoSelectedRole, err
-		}
-		conditions, _, err := m.rp.preprocess(vc)
-		if err != nil {
-			log.WithError(err).Errorf("failed to run validations on host %s", h.ID.String())
-			return autoSelectedRole, err
-		}
-		if m.canBeMaster(conditions) {
-			return models.HostRoleMaster, nil
-		}
+	var cluster common.Cluster
+	if err := db.Select("id", "role_assignment_strategy").Take(&cluster, "id = ?", h.ClusterID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to get the role assignment strategy of cluster %s", h.ClusterID.String())
+		return models.HostRoleAutoAssign, "", "", err
 	}
 
-	return models.HostRoleWorker, nil
+	name, strategy := m.getRoleAssignmentStrategy(cluster.RoleAssignmentStrategy)
+	role, reason, err := strategy.selectRole(ctx, h, db)
+	return role, name, reason, err
 }
 
 func (m *Manager) IsValidMasterCandidate(h *models.Host, c *common.Cluster, db *gorm.DB, log logrus.FieldLogger) (bool, error) {
@@ -1267,6 +1343,8 @@ func (m *Manager) ResetHostValidation(ctx context.Context, hostID, infraEnvID st
 		return m.resetDiskSpeedValidation(host, log, db)
 	case string(models.HostValidationIDContainerImagesAvailable):
 		return m.resetContainerImagesValidation(host, db)
+	case string(models.HostValidationIDCriticalInventoryUnchanged):
+		return m.ReviewInventory(ctx, host, db)
 	default:
 		return common.NewApiError(http.StatusBadRequest, errors.Errorf("Validation \"%s\" cannot be reset or does not exist", validationID))
 	}
@@ -1280,6 +1358,11 @@ func (m Manager) PermanentHostsDeletion(olderThan strfmt.DateTime) error {
 	} else if reply.RowsAffected > 0 {
 		m.log.Debugf("Deleted %s hosts from db", reply.RowsAffected)
 	}
+	if reply := db.Where("host_id not in (?)", db.Model(&models.Host{}).Select("id")).Delete(&models.HostInventorySnapshot{}); reply.Error != nil {
+		return reply.Error
+	} else if reply.RowsAffected > 0 {
+		m.log.Debugf("Deleted %d inventory snapshots of deleted hosts from db", reply.RowsAffected)
+	}
 	return nil
 }
 
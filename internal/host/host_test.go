@@ -2,13 +2,21 @@ package host
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"net/http"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -17,6 +25,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/kelseyhightower/envconfig"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
@@ -998,6 +1007,9 @@ func alternativeInventory() models.Inventory {
 	err := json.Unmarshal([]byte(common.GenerateTestDefaultInventory()), &newInventory)
 	Expect(err).To(BeNil())
 	newInventory.Timestamp = magicTimestamp
+	for _, intf := range newInventory.Interfaces {
+		intf.Type = models.InterfaceTypePhysical
+	}
 	return newInventory
 }
 
@@ -1314,6 +1326,130 @@ var _ = Describe("UpdateInventory", func() {
 		})
 	})
 
+	Context("Failure domain derived from LLDP", func() {
+		var lldpInventory string
+
+		BeforeEach(func() {
+			host = hostutil.GenerateTestHost(hostId, infraEnvId, clusterId, models.HostStatusDiscovering)
+			inventory, err := common.UnmarshalInventory(common.GenerateTestDefaultInventory())
+			Expect(err).ShouldNot(HaveOccurred())
+			inventory.Interfaces[0].LldpNeighbor = &models.LldpNeighbor{SystemName: "tor-rack1", ChassisID: "aa:bb:cc:dd:ee:ff"}
+			lldpInventory, err = common.MarshalInventory(inventory)
+			Expect(err).ShouldNot(HaveOccurred())
+			mockValidator.EXPECT().DiskIsEligible(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return([]*models.Disk{}).AnyTimes()
+		})
+
+		It("derives the rack from the LLDP neighbor", func() {
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			Expect(hapi.UpdateInventory(ctx, &host, lldpInventory)).ToNot(HaveOccurred())
+
+			h := hostutil.GetHostFromDB(hostId, infraEnvId, db)
+			Expect(h.FailureDomain).To(Equal(&models.FailureDomain{Rack: "tor-rack1", Source: models.FailureDomainSourceLldp}))
+		})
+
+		It("clears the derived rack when the LLDP neighbor is no longer reported", func() {
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			Expect(hapi.UpdateInventory(ctx, &host, lldpInventory)).ToNot(HaveOccurred())
+			h := hostutil.GetHostFromDB(hostId, infraEnvId, db)
+			Expect(h.FailureDomain.Rack).To(Equal("tor-rack1"))
+
+			Expect(hapi.UpdateInventory(ctx, &h.Host, common.GenerateTestDefaultInventory())).ToNot(HaveOccurred())
+			h = hostutil.GetHostFromDB(hostId, infraEnvId, db)
+			Expect(h.FailureDomain == nil || *h.FailureDomain == models.FailureDomain{}).To(BeTrue())
+		})
+
+		It("keeps the failure domain set by the user", func() {
+			host.FailureDomain = &models.FailureDomain{Rack: "r1", Source: models.FailureDomainSourceUser}
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			Expect(hapi.UpdateInventory(ctx, &host, lldpInventory)).ToNot(HaveOccurred())
+
+			h := hostutil.GetHostFromDB(hostId, infraEnvId, db)
+			Expect(h.FailureDomain).To(Equal(&models.FailureDomain{Rack: "r1", Source: models.FailureDomainSourceUser}))
+		})
+	})
+
+	Context("Interfaces relations", func() {
+		BeforeEach(func() {
+			host = hostutil.GenerateTestHost(hostId, infraEnvId, clusterId, models.HostStatusDiscovering)
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			mockValidator.EXPECT().DiskIsEligible(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return([]*models.Disk{}).AnyTimes()
+		})
+
+		It("populates the bond and VLAN relations of the interfaces", func() {
+			inventory, err := common.UnmarshalInventory(common.GenerateTestDefaultInventory())
+			Expect(err).ShouldNot(HaveOccurred())
+			inventory.Interfaces = []*models.Interface{
+				{Name: "eth0", MacAddress: "52:54:00:00:00:01"},
+				{Name: "eth1", MacAddress: "52:54:00:00:00:01"},
+				{Name: "bond0", MacAddress: "52:54:00:00:00:01"},
+				{Name: "bond0.100", MacAddress: "52:54:00:00:00:01", IPV4Addresses: []string{"1.2.3.4/24"}},
+			}
+			inventoryStr, err := common.MarshalInventory(inventory)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(hapi.UpdateInventory(ctx, &host, inventoryStr)).ToNot(HaveOccurred())
+
+			h := hostutil.GetHostFromDB(hostId, infraEnvId, db)
+			inventory, err = common.UnmarshalInventory(h.Inventory)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(inventory.Interfaces[0].Controller).To(Equal("bond0"))
+			Expect(inventory.Interfaces[1].Controller).To(Equal("bond0"))
+			Expect(inventory.Interfaces[2].Type).To(Equal(models.InterfaceTypeBond))
+			Expect(inventory.Interfaces[3].Type).To(Equal(models.InterfaceTypeVlan))
+			Expect(inventory.Interfaces[3].Parent).To(Equal("bond0"))
+			Expect(inventory.Interfaces[3].VlanID).To(Equal(int64(100)))
+		})
+	})
+
+	Context("TPM endorsement key fingerprint", func() {
+		BeforeEach(func() {
+			host = hostutil.GenerateTestHost(hostId, infraEnvId, clusterId, models.HostStatusDiscovering)
+			host.TpmEkFingerprint = "stale"
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			mockValidator.EXPECT().DiskIsEligible(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return([]*models.Disk{}).AnyTimes()
+		})
+
+		updateInventoryWithTPM := func(tpm *models.Tpm) {
+			inventory, err := common.UnmarshalInventory(common.GenerateTestDefaultInventory())
+			Expect(err).ShouldNot(HaveOccurred())
+			inventory.TpmVersion = models.InventoryTpmVersionNr20
+			inventory.Tpm = tpm
+			inventoryStr, err := common.MarshalInventory(inventory)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(hapi.UpdateInventory(ctx, &host, inventoryStr)).ToNot(HaveOccurred())
+		}
+
+		It("records the fingerprint of the reported certificate", func() {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).ShouldNot(HaveOccurred())
+			template := &x509.Certificate{
+				SerialNumber: big.NewInt(1),
+				Subject:      pkix.Name{CommonName: "TPM EK"},
+				NotBefore:    time.Now(),
+				NotAfter:     time.Now().Add(time.Hour),
+			}
+			der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+			Expect(err).ShouldNot(HaveOccurred())
+			tpm := &models.Tpm{
+				Enabled:       true,
+				PcrBanks:      []string{"sha256"},
+				EkCertificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+			}
+			updateInventoryWithTPM(tpm)
+
+			fingerprint, err := hostutil.GetTPMEKFingerprint(&models.Inventory{Tpm: tpm})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(hostutil.GetHostFromDB(hostId, infraEnvId, db).TpmEkFingerprint).To(Equal(fingerprint))
+		})
+
+		It("clears the fingerprint of an invalid certificate", func() {
+			updateInventoryWithTPM(&models.Tpm{Enabled: true, PcrBanks: []string{"sha256"}, EkCertificate: "not a certificate"})
+			Expect(hostutil.GetHostFromDB(hostId, infraEnvId, db).TpmEkFingerprint).To(BeEmpty())
+		})
+	})
+
 	Context("enable host", func() {
 		var newInventoryBytes []byte
 
@@ -1422,6 +1558,147 @@ var _ = Describe("UpdateInventory", func() {
 	})
 })
 
+var _ = Describe("Inventory history", func() {
+	var (
+		ctx                           = context.Background()
+		hapi                          API
+		db                            *gorm.DB
+		ctrl                          *gomock.Controller
+		mockValidator                 *hardware.MockValidator
+		mockEvents                    *eventsapi.MockHandler
+		hostId, clusterId, infraEnvId strfmt.UUID
+		host                          models.Host
+		dbName                        string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		dummy := &leader.DummyElector{}
+		ctrl = gomock.NewController(GinkgoT())
+		mockValidator = hardware.NewMockValidator(ctrl)
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		config := *defaultConfig
+		config.InventoryHistoryLimit = 2
+		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockValidator,
+			nil, createValidatorCfg(), nil, &config, dummy, nil, nil)
+		hostId = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		infraEnvId = strfmt.UUID(uuid.New().String())
+		cluster := hostutil.GenerateTestCluster(clusterId, common.TestIPv4Networking.MachineNetworks)
+		infraEnv := hostutil.GenerateTestInfraEnv(infraEnvId)
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&infraEnv).Error).ShouldNot(HaveOccurred())
+		host = hostutil.GenerateTestHost(hostId, infraEnvId, clusterId, models.HostStatusDiscovering)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		mockValidator.EXPECT().DiskIsEligible(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return([]*models.Disk{}).AnyTimes()
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	updateInventoryWithCPUCount := func(count int64) {
+		inventory, err := common.UnmarshalInventory(common.GenerateTestDefaultInventory())
+		Expect(err).ShouldNot(HaveOccurred())
+		inventory.CPU = &models.CPU{Count: count}
+		inventoryStr, err := common.MarshalInventory(inventory)
+		Expect(err).ShouldNot(HaveOccurred())
+		host = hostutil.GetHostFromDB(hostId, infraEnvId, db).Host
+		Expect(hapi.UpdateInventory(ctx, &host, inventoryStr)).ToNot(HaveOccurred())
+	}
+
+	getHistory := func() models.HostInventorySnapshotList {
+		history, err := hapi.GetInventoryHistory(ctx, &host)
+		Expect(err).ShouldNot(HaveOccurred())
+		return history
+	}
+
+	It("records a snapshot only when the hardware changed", func() {
+		updateInventoryWithCPUCount(8)
+		updateInventoryWithCPUCount(8)
+		Expect(getHistory()).To(HaveLen(1))
+
+		updateInventoryWithCPUCount(16)
+		history := getHistory()
+		Expect(history).To(HaveLen(2))
+
+		diff, err := hapi.GetInventoryDiff(ctx, &host, *history[0].ID, *history[1].ID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(diff.Changes).To(Equal([]*models.HostInventoryChange{
+			{Attribute: swag.String("cpu.count"), From: "8", To: "16", Critical: true},
+		}))
+	})
+
+	It("keeps the history limit", func() {
+		updateInventoryWithCPUCount(8)
+		updateInventoryWithCPUCount(16)
+		updateInventoryWithCPUCount(32)
+		history := getHistory()
+		Expect(history).To(HaveLen(2))
+		inventory, err := common.UnmarshalInventory(history[0].Inventory)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(inventory.CPU.Count).To(Equal(int64(16)))
+	})
+
+	It("keeps the reviewed snapshot above the history limit", func() {
+		updateInventoryWithCPUCount(8)
+		Expect(hapi.ReviewInventory(ctx, &host, db)).To(Succeed())
+		reviewedSnapshotID := *hostutil.GetHostFromDB(hostId, infraEnvId, db).InventoryReviewedSnapshotID
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), gomock.Any()).AnyTimes()
+		updateInventoryWithCPUCount(16)
+		updateInventoryWithCPUCount(32)
+		updateInventoryWithCPUCount(64)
+		history := getHistory()
+		Expect(history).To(HaveLen(3))
+		Expect(*history[0].ID).To(Equal(reviewedSnapshotID))
+	})
+
+	It("fails to diff an unknown snapshot", func() {
+		updateInventoryWithCPUCount(8)
+		_, err := hapi.GetInventoryDiff(ctx, &host, *getHistory()[0].ID, strfmt.UUID(uuid.New().String()))
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusNotFound)))
+	})
+
+	It("detects the drift of the critical hardware after the review", func() {
+		updateInventoryWithCPUCount(8)
+		Expect(hapi.ReviewInventory(ctx, &host, db)).To(Succeed())
+
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.HostInventoryDriftDetectedEventName),
+			eventstest.WithHostIdMatcher(hostId.String()),
+			eventstest.WithInfraEnvIdMatcher(infraEnvId.String()),
+			eventstest.WithSeverityMatcher(models.EventSeverityWarning))).Times(1)
+		updateInventoryWithCPUCount(16)
+		// The same drift is reported only once
+		updateInventoryWithCPUCount(16)
+
+		var drift []*models.HostInventoryChange
+		Expect(json.Unmarshal([]byte(hostutil.GetHostFromDB(hostId, infraEnvId, db).InventoryDrift), &drift)).To(Succeed())
+		Expect(drift).To(Equal([]*models.HostInventoryChange{
+			{Attribute: swag.String("cpu.count"), From: "8", To: "16", Critical: true},
+		}))
+
+		host = hostutil.GetHostFromDB(hostId, infraEnvId, db).Host
+		Expect(hapi.ReviewInventory(ctx, &host, db)).To(Succeed())
+		Expect(hostutil.GetHostFromDB(hostId, infraEnvId, db).InventoryDrift).To(BeEmpty())
+		updateInventoryWithCPUCount(16)
+		Expect(hostutil.GetHostFromDB(hostId, infraEnvId, db).InventoryDrift).To(BeEmpty())
+	})
+
+	It("clears the drift when the hardware is restored", func() {
+		updateInventoryWithCPUCount(8)
+		Expect(hapi.ReviewInventory(ctx, &host, db)).To(Succeed())
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), gomock.Any()).Times(1)
+		updateInventoryWithCPUCount(16)
+		Expect(hostutil.GetHostFromDB(hostId, infraEnvId, db).InventoryDrift).ToNot(BeEmpty())
+		updateInventoryWithCPUCount(8)
+		Expect(hostutil.GetHostFromDB(hostId, infraEnvId, db).InventoryDrift).To(BeEmpty())
+	})
+})
+
 var _ = Describe("Update hostname", func() {
 	var (
 		ctx                           = context.Background()
@@ -2265,6 +2542,58 @@ var _ = Describe("UpdateMachineConfigPoolName", func() {
 	})
 })
 
+var _ = Describe("UpdateFailureDomain", func() {
+	var (
+		ctx                           = context.Background()
+		hapi                          API
+		db                            *gorm.DB
+		ctrl                          *gomock.Controller
+		hostId, clusterId, infraEnvId strfmt.UUID
+		dbName                        string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		dummy := &leader.DummyElector{}
+		hapi = NewManager(common.GetTestLog(), db, eventsapi.NewMockHandler(ctrl), nil, nil, createValidatorCfg(), nil, defaultConfig, dummy, nil, nil)
+		hostId = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		infraEnvId = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("sets the failure domain of a host before installation", func() {
+		host := hostutil.GenerateTestHost(hostId, infraEnvId, clusterId, models.HostStatusKnown)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+
+		Expect(hapi.UpdateFailureDomain(ctx, db, &host, &models.FailureDomain{Rack: "r1", Chassis: "c1", PowerDomain: "p1"})).To(Succeed())
+		h := hostutil.GetHostFromDB(*host.ID, host.InfraEnvID, db)
+		Expect(h.FailureDomain).To(Equal(&models.FailureDomain{Rack: "r1", Chassis: "c1", PowerDomain: "p1", Source: models.FailureDomainSourceUser}))
+
+		Expect(hapi.UpdateFailureDomain(ctx, db, &host, &models.FailureDomain{Rack: "r2"})).To(Succeed())
+		h = hostutil.GetHostFromDB(*host.ID, host.InfraEnvID, db)
+		Expect(h.FailureDomain).To(Equal(&models.FailureDomain{Rack: "r2", Source: models.FailureDomainSourceUser}))
+
+		Expect(hapi.UpdateFailureDomain(ctx, db, &host, &models.FailureDomain{})).To(Succeed())
+		h = hostutil.GetHostFromDB(*host.ID, host.InfraEnvID, db)
+		Expect(h.FailureDomain).To(Equal(&models.FailureDomain{}))
+	})
+
+	It("fails to set the failure domain of an installed host", func() {
+		host := hostutil.GenerateTestHost(hostId, infraEnvId, clusterId, models.HostStatusInstalled)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+
+		Expect(hapi.UpdateFailureDomain(ctx, db, &host, &models.FailureDomain{Rack: "r1"})).NotTo(Succeed())
+		h := hostutil.GetHostFromDB(*host.ID, host.InfraEnvID, db)
+		Expect(h.FailureDomain).To(Or(BeNil(), Equal(&models.FailureDomain{})))
+	})
+})
+
 var _ = Describe("UpdateIgnitionEndpointToken", func() {
 	var (
 		ctx                           = context.Background()
@@ -2760,6 +3089,11 @@ var _ = Describe("AutoAssignRole", func() {
 			eventstest.WithHostIdMatcher(h.ID.String()),
 			eventstest.WithInfraEnvIdMatcher(h.InfraEnvID.String()),
 		))
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.HostRoleAssignmentExplainedEventName),
+			eventstest.WithHostIdMatcher(h.ID.String()),
+			eventstest.WithInfraEnvIdMatcher(h.InfraEnvID.String()),
+		))
 	}
 
 	verifyAutoAssignRole := func(host *models.Host, success bool, isSelected bool) {
@@ -3301,6 +3635,15 @@ var _ = Describe("ResetHostValidation", func() {
 		_, exists = common.GetImageStatus(imageStatuses, "a.b.c")
 		Expect(exists).To(BeFalse())
 	})
+	It("Inventory drift", func() {
+		snapshotID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&models.HostInventorySnapshot{ID: &snapshotID, HostID: h.ID, InfraEnvID: &h.InfraEnvID, Inventory: h.Inventory}).Error).ToNot(HaveOccurred())
+		Expect(db.Model(h).Update("inventory_drift", `[{"attribute":"cpu.count","critical":true,"from":"8","to":"4"}]`).Error).ToNot(HaveOccurred())
+		Expect(m.ResetHostValidation(ctx, *h.ID, h.InfraEnvID, string(models.HostValidationIDCriticalInventoryUnchanged), nil)).ToNot(HaveOccurred())
+		newHost := hostutil.GetHostFromDB(*h.ID, h.InfraEnvID, db)
+		Expect(newHost.InventoryDrift).To(BeEmpty())
+		Expect(newHost.InventoryReviewedSnapshotID).To(Equal(&snapshotID))
+	})
 	It("Unsupported validation", func() {
 		Expect(m.ResetHostValidation(ctx, *h.ID, h.InfraEnvID, string(models.HostValidationIDIgnitionDownloadable), nil)).To(HaveOccurred())
 	})
@@ -3351,6 +3694,147 @@ var _ = Describe("Disabled Host Validation", func() {
 
 })
 
+var _ = Describe("Host validation rules", func() {
+	const hostValidationRulesEnvironmentName = "HOST_VALIDATION_RULES"
+
+	var (
+		inventory = &models.Inventory{
+			BmcAddress: "10.20.1.1",
+			Disks:      []*models.Disk{{Vendor: "ACME", Bootable: true}, {Vendor: "Other"}},
+			Interfaces: []*models.Interface{{Name: "eth0", SpeedMbps: 25000}, {Name: "eth1", SpeedMbps: 1000}},
+		}
+		host models.Host
+	)
+
+	BeforeEach(func() {
+		data, err := json.Marshal(inventory)
+		Expect(err).ToNot(HaveOccurred())
+		host = models.Host{Inventory: string(data), Role: models.HostRoleMaster}
+	})
+
+	AfterEach(func() {
+		os.Unsetenv(hostValidationRulesEnvironmentName)
+	})
+
+	decode := func(value string) HostValidationRules {
+		var rules HostValidationRules
+		Expect(rules.Decode(value)).ToNot(HaveOccurred())
+		return rules
+	}
+
+	preprocess := func(rules HostValidationRules) (map[string]bool, ValidationsStatus) {
+		c := &validationContext{host: &host, infraEnv: &common.InfraEnv{}, inventory: inventory}
+		rp := &refreshPreprocessor{log: common.GetTestLog(), validationRules: rules, disabledHostValidations: DisabledHostValidations{}}
+		conditions, results, err := rp.preprocess(c)
+		Expect(err).ToNot(HaveOccurred())
+		return conditions, results
+	}
+
+	It("are loaded from the environment", func() {
+		Expect(os.Setenv(hostValidationRulesEnvironmentName,
+			`[{"id": "bmc-network", "path": "{.bmc_address}", "operator": "in-cidr", "values": ["10.20.0.0/16"]}]`)).NotTo(HaveOccurred())
+		cfg := Config{}
+		Expect(envconfig.Process(common.EnvConfigPrefix, &cfg)).ToNot(HaveOccurred())
+		Expect(cfg.HostValidationRules).To(HaveLen(1))
+		Expect(cfg.HostValidationRules[0].ID).To(Equal("bmc-network"))
+	})
+
+	It("are empty by default", func() {
+		cfg := Config{}
+		Expect(envconfig.Process(common.EnvConfigPrefix, &cfg)).ToNot(HaveOccurred())
+		Expect(cfg.HostValidationRules).To(BeEmpty())
+	})
+
+	DescribeTable("reject invalid rules",
+		func(value string) {
+			var rules HostValidationRules
+			Expect(rules.Decode(value)).To(HaveOccurred())
+		},
+		Entry("missing id", `[{"path": "{.bmc_address}", "operator": "equals", "values": ["a"]}]`),
+		Entry("invalid path", `[{"id": "a", "path": "{.bmc_address", "operator": "equals", "values": ["a"]}]`),
+		Entry("unknown operator", `[{"id": "a", "path": "{.bmc_address}", "operator": "like", "values": ["a"]}]`),
+		Entry("invalid CIDR", `[{"id": "a", "path": "{.bmc_address}", "operator": "in-cidr", "values": ["10.20.0.0"]}]`),
+		Entry("invalid number", `[{"id": "a", "path": "{.bmc_address}", "operator": "min", "values": ["many"]}]`),
+		Entry("invalid match", `[{"id": "a", "path": "{.bmc_address}", "operator": "equals", "values": ["a"], "match": "some"}]`),
+		Entry("unknown field", `[{"id": "a", "path": "{.bmc_address}", "operator": "equals", "values": ["a"], "severity": "high"}]`),
+		Entry("duplicate id", `[{"id": "a", "path": "{.bmc_address}", "operator": "equals", "values": ["a"]},
+			{"id": "a", "path": "{.bmc_address}", "operator": "equals", "values": ["b"]}]`),
+	)
+
+	DescribeTable("evaluate the inventory",
+		func(rule string, expected ValidationStatus) {
+			conditions, results := preprocess(decode("[" + rule + "]"))
+			Expect(results[CustomValidationsCategory]).To(HaveLen(1))
+			Expect(results[CustomValidationsCategory][0].Status).To(Equal(expected))
+			Expect(conditions[CustomValidationsSatisfied.String()]).To(Equal(expected == ValidationSuccess))
+		},
+		Entry("BMC address in CIDR", `{"id": "a", "path": "{.bmc_address}", "operator": "in-cidr", "values": ["10.20.0.0/16"]}`, ValidationSuccess),
+		Entry("BMC address not in CIDR", `{"id": "a", "path": "{.bmc_address}", "operator": "in-cidr", "values": ["10.30.0.0/16"]}`, ValidationFailure),
+		Entry("bootable disk vendor", `{"id": "a", "path": "{.disks[?(@.bootable==true)].vendor}", "operator": "equals", "values": ["ACME"]}`, ValidationSuccess),
+		Entry("all disk vendors", `{"id": "a", "path": "{.disks[*].vendor}", "operator": "one-of", "values": ["ACME"]}`, ValidationFailure),
+		Entry("any NIC speed", `{"id": "a", "path": "{.interfaces[*].speed_mbps}", "operator": "min", "values": ["25000"], "match": "any"}`, ValidationSuccess),
+		Entry("all NIC speeds", `{"id": "a", "path": "{.interfaces[*].speed_mbps}", "operator": "min", "values": ["25000"]}`, ValidationFailure),
+		Entry("NIC name pattern", `{"id": "a", "path": "{.interfaces[*].name}", "operator": "matches", "values": ["^eth[0-9]$"]}`, ValidationSuccess),
+		Entry("missing value", `{"id": "a", "path": "{.system_vendor.serial_number}", "operator": "not-equals", "values": [""]}`, ValidationFailure),
+	)
+
+	It("report the failing values with the message of the rule", func() {
+		_, results := preprocess(decode(`[{"id": "nic-speed", "message": "NICs must be 25Gbps",
+			"path": "{.interfaces[*].speed_mbps}", "operator": "min", "values": ["25000"]}]`))
+		Expect(results[CustomValidationsCategory][0].ID).To(Equal(validationID("custom-nic-speed")))
+		Expect(results[CustomValidationsCategory][0].Message).To(Equal("NICs must be 25Gbps (found 1000)"))
+	})
+
+	It("are pending without an inventory", func() {
+		inventoryBackup := inventory
+		inventory = nil
+		defer func() { inventory = inventoryBackup }()
+		conditions, results := preprocess(decode(`[{"id": "a", "path": "{.bmc_address}", "operator": "equals", "values": ["a"]}]`))
+		Expect(results[CustomValidationsCategory][0].Status).To(Equal(ValidationPending))
+		Expect(conditions[CustomValidationsSatisfied.String()]).To(BeFalse())
+	})
+
+	It("are evaluated concurrently", func() {
+		rules := decode(`[{"id": "a", "path": "{range .interfaces[*]}{.speed_mbps}{end}", "operator": "min", "values": ["1000"]},
+			{"id": "b", "path": "{.disks[?(@.bootable==true)].vendor}", "operator": "equals", "values": ["ACME"]}]`)
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				c := &validationContext{host: &host, infraEnv: &common.InfraEnv{}, inventory: inventory}
+				rp := &refreshPreprocessor{log: common.GetTestLog(), validationRules: rules, disabledHostValidations: DisabledHostValidations{}}
+				for j := 0; j < 20; j++ {
+					conditions, _, err := rp.preprocess(c)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(conditions[CustomValidationsSatisfied.String()]).To(BeTrue())
+				}
+			}()
+		}
+		wg.Wait()
+	})
+
+	It("apply to the hosts of their roles only", func() {
+		conditions, results := preprocess(decode(`[{"id": "a", "path": "{.bmc_address}", "operator": "equals", "values": ["a"], "roles": ["worker"]}]`))
+		Expect(results[CustomValidationsCategory]).To(BeEmpty())
+		Expect(conditions[CustomValidationsSatisfied.String()]).To(BeTrue())
+	})
+
+	It("can be disabled", func() {
+		c := &validationContext{host: &host, infraEnv: &common.InfraEnv{}, inventory: inventory}
+		rp := &refreshPreprocessor{
+			log:                     common.GetTestLog(),
+			validationRules:         decode(`[{"id": "a", "path": "{.bmc_address}", "operator": "equals", "values": ["a"]}]`),
+			disabledHostValidations: DisabledHostValidations{"custom-a": struct{}{}},
+		}
+		conditions, results, err := rp.preprocess(c)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results[CustomValidationsCategory][0].Status).To(Equal(ValidationDisabled))
+		Expect(conditions[CustomValidationsSatisfied.String()]).To(BeTrue())
+	})
+})
+
 var _ = Describe("Get host by Kube key", func() {
 	var (
 		state            API
@@ -40,7 +40,7 @@ var _ = Describe("connectivitycheckconvertor", func() {
 
 		interfaces = []*models.Interface{
 			{
-				Name: "eth0", MacAddress: "44:85:00:80:12:a4",
+				Name: "eth0", MacAddress: "44:85:00:80:12:a4", Mtu: 9000,
 				IPV4Addresses: []string{"10.0.0.1/24", "10.0.0.2", "10.0.0.3/24"},
 				IPV6Addresses: []string{"2001:db8::4/120", "2001:db8::a"},
 			},
@@ -58,6 +58,8 @@ var _ = Describe("connectivitycheckconvertor", func() {
 		Expect(connectivityParamsHost.Nics).To(HaveLen(2))
 		Expect(connectivityParamsHost.Nics[0].IPAddresses).To(HaveLen(5))
 		Expect(connectivityParamsHost.Nics[1].IPAddresses).To(HaveLen(7))
+		Expect(connectivityParamsHost.Nics[0].Mtu).To(Equal(int64(9000)))
+		Expect(connectivityParamsHost.Nics[1].Mtu).To(BeZero())
 	})
 
 	It("convertHostsToConnectivityParamsHosts_success", func() {
@@ -0,0 +1,79 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type tangConnectivityCheckCmd struct {
+	baseCmd
+	db                         *gorm.DB
+	tangConnectivityCheckImage string
+}
+
+func NewTangConnectivityCheckCmd(log logrus.FieldLogger, db *gorm.DB, tangConnectivityCheckImage string) *tangConnectivityCheckCmd {
+	return &tangConnectivityCheckCmd{
+		baseCmd:                    baseCmd{log: log},
+		db:                         db,
+		tangConnectivityCheckImage: tangConnectivityCheckImage,
+	}
+}
+
+func (c *tangConnectivityCheckCmd) prepareParam(cluster *common.Cluster) (string, error) {
+	tangServers, err := common.UnmarshalTangServers(cluster.DiskEncryption.TangServers)
+	if err != nil {
+		c.log.WithError(err).Warnf("failed to get the tang servers of cluster %s", cluster.ID)
+		return "", err
+	}
+	request := models.TangConnectivityRequest{TangServers: make([]*models.TangConnectivityRequestServer, 0, len(tangServers))}
+	for i := range tangServers {
+		request.TangServers = append(request.TangServers, &models.TangConnectivityRequestServer{
+			URL:        swag.String(tangServers[i].Url),
+			Thumbprint: tangServers[i].Thumbprint,
+		})
+	}
+	b, err := json.Marshal(&request)
+	if err != nil {
+		c.log.WithError(err).Warn("Json marshal")
+		return "", err
+	}
+	return string(b), nil
+}
+
+// GetSteps returns the tang connectivity check step only when the disks of the cluster are encrypted using tang
+func (c *tangConnectivityCheckCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
+	var cluster common.Cluster
+	if err := c.db.First(&cluster, "id = ?", host.ClusterID).Error; err != nil {
+		c.log.WithError(err).Errorf("failed to fetch cluster %s", host.ClusterID)
+		return nil, err
+	}
+	if cluster.DiskEncryption == nil ||
+		swag.StringValue(cluster.DiskEncryption.EnableOn) == models.DiskEncryptionEnableOnNone ||
+		swag.StringValue(cluster.DiskEncryption.Mode) != models.DiskEncryptionModeTang {
+		return nil, nil
+	}
+	param, err := c.prepareParam(&cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	step := &models.Step{
+		StepType: models.StepTypeTangConnectivityCheck,
+		Command:  "podman",
+		Args: []string{
+			"run", "--privileged", "--net=host", "--rm", "--quiet",
+			"-v", "/var/log:/var/log",
+			"-v", "/run/systemd/journal/socket:/run/systemd/journal/socket",
+			c.tangConnectivityCheckImage,
+			"tang_connectivity_check",
+			param,
+		},
+	}
+	return []*models.Step{step}, nil
+}
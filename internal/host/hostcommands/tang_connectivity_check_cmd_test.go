@@ -0,0 +1,92 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("tangConnectivityCheck", func() {
+	ctx := context.Background()
+	var host models.Host
+	var db *gorm.DB
+	var tCmd *tangConnectivityCheckCmd
+	var id, clusterID, infraEnvID strfmt.UUID
+	var dbName string
+
+	const tangServers = `[{"url":"http://tang.example.com:7500","thumbprint":"PLjNyRdGw03zlRoGjQYMahSZGu9"}]`
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		tCmd = NewTangConnectivityCheckCmd(common.GetTestLog(), db, "quay.io/ocpmetal/assisted-installer-agent:latest")
+		id = strfmt.UUID(uuid.New().String())
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		host = hostutil.GenerateTestHost(id, infraEnvID, clusterID, models.HostStatusKnown)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	createCluster := func(diskEncryption *models.DiskEncryption) {
+		cluster := common.Cluster{Cluster: models.Cluster{ID: &clusterID, DiskEncryption: diskEncryption}}
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+	}
+
+	It("happy flow", func() {
+		createCluster(&models.DiskEncryption{
+			EnableOn:    swag.String(models.DiskEncryptionEnableOnAll),
+			Mode:        swag.String(models.DiskEncryptionModeTang),
+			TangServers: tangServers,
+		})
+		stepReply, stepErr := tCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(HaveLen(1))
+		Expect(stepReply[0].StepType).To(Equal(models.StepTypeTangConnectivityCheck))
+
+		var request models.TangConnectivityRequest
+		Expect(json.Unmarshal([]byte(stepReply[0].Args[len(stepReply[0].Args)-1]), &request)).To(Succeed())
+		Expect(request.TangServers).To(HaveLen(1))
+		Expect(swag.StringValue(request.TangServers[0].URL)).To(Equal("http://tang.example.com:7500"))
+		Expect(request.TangServers[0].Thumbprint).To(Equal("PLjNyRdGw03zlRoGjQYMahSZGu9"))
+	})
+
+	It("disk encryption disabled", func() {
+		createCluster(&models.DiskEncryption{EnableOn: swag.String(models.DiskEncryptionEnableOnNone)})
+		stepReply, stepErr := tCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	It("tpmv2 mode", func() {
+		createCluster(&models.DiskEncryption{
+			EnableOn: swag.String(models.DiskEncryptionEnableOnAll),
+			Mode:     swag.String(models.DiskEncryptionModeTpmv2),
+		})
+		stepReply, stepErr := tCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	It("invalid tang servers", func() {
+		createCluster(&models.DiskEncryption{
+			EnableOn:    swag.String(models.DiskEncryptionEnableOnAll),
+			Mode:        swag.String(models.DiskEncryptionModeTang),
+			TangServers: "invalid",
+		})
+		stepReply, stepErr := tCmd.GetSteps(ctx, &host)
+		Expect(stepErr).To(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+})
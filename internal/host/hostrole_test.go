@@ -2,6 +2,7 @@ package host
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/golang/mock/gomock"
@@ -84,21 +85,21 @@ var _ = Describe("Suggested-Role on Refresh", func() {
 			inventory:      hostutil.GenerateInventoryWithResourcesWithBytes(4, conversions.MibToBytes(150), conversions.MibToBytes(150), "worker"),
 			srcState:       models.HostStatusDiscovering,
 			suggested_role: models.HostRoleWorker,
-			eventTypes:     []string{eventgen.HostRoleUpdatedEventName},
+			eventTypes:     []string{eventgen.HostRoleUpdatedEventName, eventgen.HostRoleAssignmentExplainedEventName},
 		},
 		{
 			name:           "sufficient master memory --> suggested as master when masters < 3",
 			inventory:      hostutil.GenerateMasterInventory(),
 			srcState:       models.HostStatusInsufficient,
 			suggested_role: models.HostRoleMaster,
-			eventTypes:     []string{eventgen.HostRoleUpdatedEventName},
+			eventTypes:     []string{eventgen.HostRoleUpdatedEventName, eventgen.HostRoleAssignmentExplainedEventName},
 		},
 		{
 			name:           "sufficient worker memory --> suggested as worker",
 			inventory:      workerInventory(),
 			srcState:       models.HostStatusKnown,
 			suggested_role: models.HostRoleWorker,
-			eventTypes:     []string{eventgen.HostRoleUpdatedEventName},
+			eventTypes:     []string{eventgen.HostRoleUpdatedEventName, eventgen.HostRoleAssignmentExplainedEventName},
 		},
 	}
 
@@ -132,3 +133,137 @@ var _ = Describe("Suggested-Role on Refresh", func() {
 		})
 	}
 })
+
+var _ = Describe("Scoring role assignment strategy", func() {
+	var (
+		ctx             = context.Background()
+		hapi            API
+		db              *gorm.DB
+		clusterId       strfmt.UUID
+		infraEnvId      strfmt.UUID
+		mockEvents      *eventsapi.MockHandler
+		ctrl            *gomock.Controller
+		dbName          string
+		mockHwValidator *hardware.MockValidator
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		clusterId = strfmt.UUID(uuid.New().String())
+		infraEnvId = strfmt.UUID(uuid.New().String())
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		mockHwValidator = hardware.NewMockValidator(ctrl)
+		mockHwValidator.EXPECT().ListEligibleDisks(gomock.Any()).AnyTimes()
+		mockHwValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return(nil, nil).AnyTimes()
+		mockHwValidator.EXPECT().GetHostInstallationPath(gomock.Any()).Return("/dev/sda").AnyTimes()
+		mockDefaultClusterHostRequirements(mockHwValidator)
+		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{}, nil, nil)
+		pr := registry.NewMockProviderRegistry(ctrl)
+		pr.EXPECT().IsHostSupported(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+		hapi = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, createValidatorCfg(), nil, defaultConfig, nil, operatorsManager, pr)
+
+		cluster := hostutil.GenerateTestCluster(clusterId, common.TestIPv4Networking.MachineNetworks)
+		cluster.RoleAssignmentStrategy = models.ClusterRoleAssignmentStrategyScoring
+		Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	inventory := func(cpu, memoryGib, nicSpeedMbps int64) string {
+		var inv models.Inventory
+		Expect(json.Unmarshal([]byte(hostutil.GenerateInventoryWithResources(cpu, memoryGib, "host")), &inv)).To(Succeed())
+		inv.Interfaces[0].SpeedMbps = nicSpeedMbps
+		b, err := json.Marshal(&inv)
+		Expect(err).ToNot(HaveOccurred())
+		return string(b)
+	}
+
+	addHost := func(role models.HostRole, inv string, diskSpeedMs int64, failureDomain *models.FailureDomain) *models.Host {
+		h := hostutil.GenerateTestHost(strfmt.UUID(uuid.New().String()), infraEnvId, clusterId, models.HostStatusKnown)
+		h.Inventory = inv
+		h.Role = role
+		h.SuggestedRole = role
+		h.FailureDomain = failureDomain
+		h.InstallationDiskPath = "/dev/sda"
+		if diskSpeedMs > 0 {
+			disksInfo, err := common.SetDiskSpeed("/dev/sda", diskSpeedMs, 0, "")
+			Expect(err).ToNot(HaveOccurred())
+			h.DisksInfo = disksInfo
+		}
+		Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+		return &h
+	}
+
+	refreshRoles := func(hosts ...*models.Host) []models.HostRole {
+		var roles []models.HostRole
+		for _, h := range hosts {
+			Expect(hapi.RefreshRole(ctx, &hostutil.GetHostFromDB(*h.ID, infraEnvId, db).Host, db)).To(Succeed())
+		}
+		for _, h := range hosts {
+			roles = append(roles, hostutil.GetHostFromDB(*h.ID, infraEnvId, db).SuggestedRole)
+		}
+		return roles
+	}
+
+	It("selects the hosts with the best hardware", func() {
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), gomock.Any()).AnyTimes()
+		hosts := []*models.Host{
+			addHost(models.HostRoleAutoAssign, inventory(8, 32, 1000), 8, nil),
+			addHost(models.HostRoleAutoAssign, inventory(8, 32, 10000), 4, nil),
+			addHost(models.HostRoleAutoAssign, inventory(8, 32, 1000), 2, nil),
+			addHost(models.HostRoleAutoAssign, inventory(8, 32, 10000), 2, nil),
+		}
+		Expect(refreshRoles(hosts...)).To(Equal([]models.HostRole{
+			models.HostRoleWorker, models.HostRoleMaster, models.HostRoleMaster, models.HostRoleMaster}))
+	})
+
+	It("spreads the masters across failure domains", func() {
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), gomock.Any()).AnyTimes()
+		hosts := []*models.Host{
+			addHost(models.HostRoleAutoAssign, inventory(16, 64, 0), 0, &models.FailureDomain{Rack: "r1", Chassis: "c1"}),
+			addHost(models.HostRoleAutoAssign, inventory(8, 32, 0), 0, &models.FailureDomain{Rack: "r1", Chassis: "c2"}),
+			addHost(models.HostRoleAutoAssign, inventory(4, 24, 0), 0, &models.FailureDomain{Rack: "r2", Chassis: "c3"}),
+			addHost(models.HostRoleAutoAssign, inventory(4, 24, 0), 0, &models.FailureDomain{Rack: "r3", Chassis: "c4"}),
+		}
+		Expect(refreshRoles(hosts...)).To(Equal([]models.HostRole{
+			models.HostRoleMaster, models.HostRoleWorker, models.HostRoleMaster, models.HostRoleMaster}))
+	})
+
+	It("keeps the masters assigned by the user", func() {
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), gomock.Any()).AnyTimes()
+		addHost(models.HostRoleMaster, inventory(4, 24, 0), 0, &models.FailureDomain{Rack: "r1"})
+		addHost(models.HostRoleMaster, inventory(4, 24, 0), 0, &models.FailureDomain{Rack: "r2"})
+		hosts := []*models.Host{
+			addHost(models.HostRoleAutoAssign, inventory(16, 64, 0), 0, &models.FailureDomain{Rack: "r1"}),
+			addHost(models.HostRoleAutoAssign, inventory(8, 32, 0), 0, &models.FailureDomain{Rack: "r3"}),
+		}
+		Expect(refreshRoles(hosts...)).To(Equal([]models.HostRole{models.HostRoleWorker, models.HostRoleMaster}))
+	})
+
+	It("skips the hosts that do not meet the master requirements", func() {
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), gomock.Any()).AnyTimes()
+		hosts := []*models.Host{
+			addHost(models.HostRoleAutoAssign, inventory(2, 64, 0), 0, nil),
+			addHost(models.HostRoleAutoAssign, inventory(4, 24, 0), 0, nil),
+		}
+		Expect(refreshRoles(hosts...)).To(Equal([]models.HostRole{models.HostRoleWorker, models.HostRoleMaster}))
+	})
+
+	It("explains the selection", func() {
+		h := addHost(models.HostRoleAutoAssign, inventory(8, 32, 0), 0, nil)
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.HostRoleUpdatedEventName),
+			eventstest.WithHostIdMatcher(h.ID.String())))
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.HostRoleAssignmentExplainedEventName),
+			eventstest.WithHostIdMatcher(h.ID.String()),
+			eventstest.WithClusterIdMatcher(clusterId.String()),
+			eventstest.WithMessageContainsMatcher("the scoring role assignment strategy selected role master: score 2.00"),
+			eventstest.WithMessageContainsMatcher("ranked 1 of the 3 missing masters")))
+		Expect(refreshRoles(h)).To(Equal([]models.HostRole{models.HostRoleMaster}))
+	})
+})
@@ -1,10 +1,15 @@
 package hostutil
 
 import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"net/http"
 	"regexp"
+	"sort"
 
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
@@ -150,6 +155,46 @@ func GetDiskByInstallationPath(disks []*models.Disk, installationPath string) *m
 	return result.(*models.Disk)
 }
 
+// GetRackFromLLDP derives the rack of a host from the top-of-rack switch its interfaces are connected to, as
+// advertised by LLDP. The interfaces are sorted by name so that the derived rack is stable across inventory updates.
+func GetRackFromLLDP(inventory *models.Inventory) string {
+	interfaces := make([]*models.Interface, 0, len(inventory.Interfaces))
+	for _, intf := range inventory.Interfaces {
+		if intf != nil && intf.LldpNeighbor != nil {
+			interfaces = append(interfaces, intf)
+		}
+	}
+	sort.Slice(interfaces, func(i, j int) bool {
+		return interfaces[i].Name < interfaces[j].Name
+	})
+	for _, intf := range interfaces {
+		if intf.LldpNeighbor.SystemName != "" {
+			return intf.LldpNeighbor.SystemName
+		}
+		if intf.LldpNeighbor.ChassisID != "" {
+			return intf.LldpNeighbor.ChassisID
+		}
+	}
+	return ""
+}
+
+// GetTPMEKFingerprint returns the SHA-256 fingerprint of the DER encoding of the TPM endorsement key certificate
+// reported in the inventory, or an empty string when the agent did not report it
+func GetTPMEKFingerprint(inventory *models.Inventory) (string, error) {
+	if inventory.Tpm == nil || inventory.Tpm.EkCertificate == "" {
+		return "", nil
+	}
+	block, _ := pem.Decode([]byte(inventory.Tpm.EkCertificate))
+	if block == nil {
+		return "", errors.New("failed to decode the PEM encoded TPM endorsement key certificate")
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return "", errors.Wrap(err, "failed to parse the TPM endorsement key certificate")
+	}
+	fingerprint := sha256.Sum256(block.Bytes)
+	return hex.EncodeToString(fingerprint[:]), nil
+}
+
 func IgnitionFileName(host *models.Host) string {
 	return fmt.Sprintf("%s-%s.ign", common.GetEffectiveRole(host), host.ID)
 }
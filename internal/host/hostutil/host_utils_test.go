@@ -1,7 +1,17 @@
 package hostutil
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -88,6 +98,62 @@ var _ = Describe("Installation Disk selection", func() {
 	}
 })
 
+var _ = Describe("GetRackFromLLDP", func() {
+	It("is empty without LLDP neighbors", func() {
+		inventory := &models.Inventory{Interfaces: []*models.Interface{{Name: "eth0"}}}
+		Expect(GetRackFromLLDP(inventory)).To(BeEmpty())
+	})
+
+	It("uses the system name of the neighbor of the first interface", func() {
+		inventory := &models.Inventory{Interfaces: []*models.Interface{
+			{Name: "eth1", LldpNeighbor: &models.LldpNeighbor{SystemName: "tor-b", ChassisID: "aa:bb"}},
+			{Name: "eth0", LldpNeighbor: &models.LldpNeighbor{SystemName: "tor-a", ChassisID: "cc:dd"}},
+		}}
+		Expect(GetRackFromLLDP(inventory)).To(Equal("tor-a"))
+	})
+
+	It("falls back to the chassis ID of the neighbor", func() {
+		inventory := &models.Inventory{Interfaces: []*models.Interface{
+			{Name: "eth0", LldpNeighbor: &models.LldpNeighbor{ChassisID: "cc:dd"}},
+		}}
+		Expect(GetRackFromLLDP(inventory)).To(Equal("cc:dd"))
+	})
+})
+
+var _ = Describe("GetTPMEKFingerprint", func() {
+	It("is empty without TPM attestation data", func() {
+		fingerprint, err := GetTPMEKFingerprint(&models.Inventory{TpmVersion: models.InventoryTpmVersionNr20})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fingerprint).To(BeEmpty())
+	})
+
+	It("is the SHA-256 digest of the endorsement key certificate", func() {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "TPM EK"},
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(time.Hour),
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		Expect(err).ToNot(HaveOccurred())
+		inventory := &models.Inventory{Tpm: &models.Tpm{
+			EkCertificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		}}
+
+		fingerprint, err := GetTPMEKFingerprint(inventory)
+		Expect(err).ToNot(HaveOccurred())
+		digest := sha256.Sum256(der)
+		Expect(fingerprint).To(Equal(hex.EncodeToString(digest[:])))
+	})
+
+	It("fails on an invalid certificate", func() {
+		_, err := GetTPMEKFingerprint(&models.Inventory{Tpm: &models.Tpm{EkCertificate: "not a certificate"}})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
 func TestHostUtil(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "HostUtil Tests")
@@ -0,0 +1,324 @@
+package host
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type inventoryDiffer struct {
+	changes            []*models.HostInventoryChange
+	installationDiskID string
+}
+
+func (d *inventoryDiffer) compare(attribute, from, to string, critical bool) {
+	if from != to {
+		d.changes = append(d.changes, &models.HostInventoryChange{Attribute: &attribute, From: from, To: to, Critical: critical})
+	}
+}
+
+func (d *inventoryDiffer) compareDisks(from, to []*models.Disk) {
+	disksByID := func(disks []*models.Disk) map[string]*models.Disk {
+		ret := make(map[string]*models.Disk, len(disks))
+		for _, disk := range disks {
+			ret[hostutil.GetDeviceIdentifier(disk)] = disk
+		}
+		return ret
+	}
+	fromDisks, toDisks := disksByID(from), disksByID(to)
+	for id := range unionKeys(fromDisks, toDisks) {
+		attribute := fmt.Sprintf("disks[%s]", id)
+		isInstallationDisk := id == d.installationDiskID
+		fromDisk, toDisk := fromDisks[id], toDisks[id]
+		if fromDisk == nil || toDisk == nil {
+			d.compare(attribute, diskPresence(fromDisk), diskPresence(toDisk), isInstallationDisk)
+			continue
+		}
+		d.compare(attribute+".serial", fromDisk.Serial, toDisk.Serial, isInstallationDisk)
+		d.compare(attribute+".wwn", fromDisk.Wwn, toDisk.Wwn, isInstallationDisk)
+		d.compare(attribute+".model", fromDisk.Model, toDisk.Model, false)
+		d.compare(attribute+".size_bytes", fmt.Sprint(fromDisk.SizeBytes), fmt.Sprint(toDisk.SizeBytes), false)
+	}
+}
+
+func (d *inventoryDiffer) compareInterfaces(from, to []*models.Interface) {
+	interfacesByName := func(interfaces []*models.Interface) map[string]*models.Interface {
+		ret := make(map[string]*models.Interface, len(interfaces))
+		for _, intf := range interfaces {
+			ret[intf.Name] = intf
+		}
+		return ret
+	}
+	fromInterfaces, toInterfaces := interfacesByName(from), interfacesByName(to)
+	for name := range unionKeys(fromInterfaces, toInterfaces) {
+		attribute := fmt.Sprintf("interfaces[%s]", name)
+		fromInterface, toInterface := fromInterfaces[name], toInterfaces[name]
+		if fromInterface == nil || toInterface == nil {
+			d.compare(attribute, interfacePresence(fromInterface), interfacePresence(toInterface), false)
+			continue
+		}
+		d.compare(attribute+".mac_address", fromInterface.MacAddress, toInterface.MacAddress, false)
+		d.compare(attribute+".mtu", fmt.Sprint(fromInterface.Mtu), fmt.Sprint(toInterface.Mtu), false)
+	}
+	// The MAC addresses are compared as a set, so that renaming an interface is not a critical change
+	d.compare("mac_addresses", macAddressSet(from), macAddressSet(to), true)
+}
+
+func unionKeys(maps ...interface{}) map[string]struct{} {
+	ret := make(map[string]struct{})
+	for _, m := range maps {
+		switch typed := m.(type) {
+		case map[string]*models.Disk:
+			for k := range typed {
+				ret[k] = struct{}{}
+			}
+		case map[string]*models.Interface:
+			for k := range typed {
+				ret[k] = struct{}{}
+			}
+		}
+	}
+	return ret
+}
+
+func diskPresence(disk *models.Disk) string {
+	if disk == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s (serial %s)", disk.Path, disk.Serial)
+}
+
+func interfacePresence(intf *models.Interface) string {
+	if intf == nil {
+		return ""
+	}
+	return intf.MacAddress
+}
+
+func macAddressSet(interfaces []*models.Interface) string {
+	macs := make([]string, 0, len(interfaces))
+	for _, intf := range interfaces {
+		if intf.MacAddress != "" {
+			macs = append(macs, strings.ToLower(intf.MacAddress))
+		}
+	}
+	sort.Strings(macs)
+	return strings.Join(macs, ",")
+}
+
+// DiffInventories compares the hardware of two inventories of a host, leaving out the attributes that change without
+// any hardware change, such as the addresses of the interfaces or the SMART data of the disks. The changes of the serial
+// number or WWN of the installation disk, of the MAC addresses and of the CPU count are critical.
+func DiffInventories(from, to *models.Inventory, installationDiskID string) []*models.HostInventoryChange {
+	d := &inventoryDiffer{installationDiskID: installationDiskID}
+	d.compare("hostname", from.Hostname, to.Hostname, false)
+	var fromCPU, toCPU models.CPU
+	if from.CPU != nil {
+		fromCPU = *from.CPU
+	}
+	if to.CPU != nil {
+		toCPU = *to.CPU
+	}
+	d.compare("cpu.count", fmt.Sprint(fromCPU.Count), fmt.Sprint(toCPU.Count), true)
+	d.compare("cpu.model_name", fromCPU.ModelName, toCPU.ModelName, false)
+	var fromMemory, toMemory models.Memory
+	if from.Memory != nil {
+		fromMemory = *from.Memory
+	}
+	if to.Memory != nil {
+		toMemory = *to.Memory
+	}
+	d.compare("memory.physical_bytes", fmt.Sprint(fromMemory.PhysicalBytes), fmt.Sprint(toMemory.PhysicalBytes), false)
+	var fromVendor, toVendor models.SystemVendor
+	if from.SystemVendor != nil {
+		fromVendor = *from.SystemVendor
+	}
+	if to.SystemVendor != nil {
+		toVendor = *to.SystemVendor
+	}
+	d.compare("system_vendor.product_name", fromVendor.ProductName, toVendor.ProductName, false)
+	d.compare("system_vendor.serial_number", fromVendor.SerialNumber, toVendor.SerialNumber, false)
+	d.compare("tpm_version", from.TpmVersion, to.TpmVersion, false)
+	d.compareDisks(from.Disks, to.Disks)
+	d.compareInterfaces(from.Interfaces, to.Interfaces)
+	sort.Slice(d.changes, func(i, j int) bool { return *d.changes[i].Attribute < *d.changes[j].Attribute })
+	return d.changes
+}
+
+func criticalChanges(changes []*models.HostInventoryChange) []*models.HostInventoryChange {
+	var ret []*models.HostInventoryChange
+	for _, change := range changes {
+		if change.Critical {
+			ret = append(ret, change)
+		}
+	}
+	return ret
+}
+
+func formatInventoryChanges(changes []*models.HostInventoryChange) string {
+	formatted := make([]string, 0, len(changes))
+	for _, change := range changes {
+		formatted = append(formatted, fmt.Sprintf("%s changed from %q to %q", *change.Attribute, change.From, change.To))
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func getLatestInventorySnapshot(db *gorm.DB, h *models.Host) (*models.HostInventorySnapshot, error) {
+	var snapshot models.HostInventorySnapshot
+	err := db.Where("host_id = ? and infra_env_id = ?", h.ID.String(), h.InfraEnvID.String()).
+		Order("created_at desc").Take(&snapshot).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &snapshot, err
+}
+
+func getInventorySnapshot(db *gorm.DB, h *models.Host, snapshotID strfmt.UUID) (*models.HostInventorySnapshot, error) {
+	var snapshot models.HostInventorySnapshot
+	if err := db.Take(&snapshot, "id = ? and host_id = ? and infra_env_id = ?", snapshotID.String(), h.ID.String(), h.InfraEnvID.String()).Error; err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// recordInventorySnapshot adds a snapshot of the inventory of the host when its hardware differs from the last snapshot,
+// and deletes the oldest snapshots above the history limit, except for the snapshot the user reviewed
+func (m *Manager) recordInventorySnapshot(db *gorm.DB, h *models.Host, inventory *models.Inventory, inventoryStr string) error {
+	latest, err := getLatestInventorySnapshot(db, h)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the latest inventory snapshot of host %s", h.ID)
+	}
+	if latest != nil {
+		latestInventory, err := common.UnmarshalInventory(latest.Inventory)
+		if err == nil && len(DiffInventories(latestInventory, inventory, h.InstallationDiskID)) == 0 {
+			return nil
+		}
+	}
+	id := strfmt.UUID(uuid.New().String())
+	snapshot := &models.HostInventorySnapshot{
+		ID:         &id,
+		HostID:     h.ID,
+		InfraEnvID: &h.InfraEnvID,
+		Inventory:  inventoryStr,
+	}
+	if err = db.Create(snapshot).Error; err != nil {
+		return errors.Wrapf(err, "failed to record the inventory snapshot of host %s", h.ID)
+	}
+
+	if m.Config.InventoryHistoryLimit <= 0 {
+		return nil
+	}
+	var expired []*models.HostInventorySnapshot
+	query := db.Select("id").Where("host_id = ? and infra_env_id = ?", h.ID.String(), h.InfraEnvID.String())
+	if h.InventoryReviewedSnapshotID != nil {
+		query = query.Where("id <> ?", h.InventoryReviewedSnapshotID.String())
+	}
+	if err = query.Order("created_at desc").Offset(m.Config.InventoryHistoryLimit).Find(&expired).Error; err != nil {
+		return errors.Wrapf(err, "failed to find the expired inventory snapshots of host %s", h.ID)
+	}
+	if len(expired) > 0 {
+		if err = db.Delete(&expired).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete the expired inventory snapshots of host %s", h.ID)
+		}
+	}
+	return nil
+}
+
+// detectInventoryDrift compares the inventory with the one the user reviewed, and returns the critical changes to store
+// on the host, as a JSON list. An event is sent the first time a given drift is detected.
+func (m *Manager) detectInventoryDrift(ctx context.Context, db *gorm.DB, h *models.Host, inventory *models.Inventory) (string, error) {
+	if h.InventoryReviewedSnapshotID == nil {
+		return "", nil
+	}
+	reviewed, err := getInventorySnapshot(db, h, *h.InventoryReviewedSnapshotID)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get the reviewed inventory snapshot of host %s", h.ID)
+	}
+	reviewedInventory, err := common.UnmarshalInventory(reviewed.Inventory)
+	if err != nil {
+		return "", err
+	}
+	changes := criticalChanges(DiffInventories(reviewedInventory, inventory, h.InstallationDiskID))
+	if len(changes) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(changes)
+	if err != nil {
+		return "", err
+	}
+	if string(b) != h.InventoryDrift {
+		eventgen.SendHostInventoryDriftDetectedEvent(ctx, m.eventsHandler, *h.ID, h.InfraEnvID, h.ClusterID,
+			hostutil.GetHostnameForMsg(h), formatInventoryChanges(changes))
+	}
+	return string(b), nil
+}
+
+// ReviewInventory records that the user reviewed the current inventory of the host, which becomes the reference for
+// the detection of the inventory drift
+func (m *Manager) ReviewInventory(ctx context.Context, h *models.Host, db *gorm.DB) error {
+	if db == nil {
+		db = m.db
+	}
+	latest, err := getLatestInventorySnapshot(db, h)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the latest inventory snapshot of host %s", h.ID)
+	}
+	if latest == nil {
+		return nil
+	}
+	logutil.FromContext(ctx, m.log).Infof("Inventory snapshot %s of host %s was reviewed", latest.ID, h.ID)
+	if err = db.Model(h).Updates(map[string]interface{}{
+		"inventory_reviewed_snapshot_id": latest.ID,
+		"inventory_drift":                "",
+	}).Error; err != nil {
+		return errors.Wrapf(err, "failed to review the inventory of host %s", h.ID)
+	}
+	h.InventoryReviewedSnapshotID = latest.ID
+	h.InventoryDrift = ""
+	return nil
+}
+
+func (m *Manager) GetInventoryHistory(ctx context.Context, h *models.Host) (models.HostInventorySnapshotList, error) {
+	var snapshots models.HostInventorySnapshotList
+	if err := m.db.Where("host_id = ? and infra_env_id = ?", h.ID.String(), h.InfraEnvID.String()).
+		Order("created_at").Find(&snapshots).Error; err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return snapshots, nil
+}
+
+func (m *Manager) GetInventoryDiff(ctx context.Context, h *models.Host, fromSnapshotID, toSnapshotID strfmt.UUID) (*models.HostInventoryDiff, error) {
+	inventories := make([]*models.Inventory, 0, 2)
+	for _, snapshotID := range []strfmt.UUID{fromSnapshotID, toSnapshotID} {
+		snapshot, err := getInventorySnapshot(m.db, h, snapshotID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("inventory snapshot %s of host %s was not found", snapshotID, h.ID))
+			}
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		inventory, err := common.UnmarshalInventory(snapshot.Inventory)
+		if err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		inventories = append(inventories, inventory)
+	}
+	return &models.HostInventoryDiff{
+		FromSnapshotID: fromSnapshotID,
+		ToSnapshotID:   toSnapshotID,
+		Changes:        DiffInventories(inventories[0], inventories[1], h.InstallationDiskID),
+	}, nil
+}
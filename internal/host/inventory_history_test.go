@@ -0,0 +1,89 @@
+package host
+
+import (
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("DiffInventories", func() {
+	const installationDiskID = "/dev/disk/by-id/wwn-0x1"
+
+	generateInventory := func() *models.Inventory {
+		return &models.Inventory{
+			Hostname: "master-0",
+			CPU:      &models.CPU{Count: 8, ModelName: "Intel Xeon"},
+			Memory:   &models.Memory{PhysicalBytes: 16 * 1024 * 1024 * 1024},
+			Disks: []*models.Disk{
+				{ID: installationDiskID, Path: "/dev/sda", Serial: "serial-a", Wwn: "0x1", SizeBytes: 120000000000},
+				{ID: "/dev/disk/by-id/wwn-0x2", Path: "/dev/sdb", Serial: "serial-b", Wwn: "0x2", SizeBytes: 240000000000},
+			},
+			Interfaces: []*models.Interface{
+				{Name: "eth0", MacAddress: "52:54:00:00:00:01", Mtu: 1500, IPV4Addresses: []string{"192.168.126.10/24"}},
+				{Name: "eth1", MacAddress: "52:54:00:00:00:02", Mtu: 1500},
+			},
+		}
+	}
+
+	It("no changes", func() {
+		Expect(DiffInventories(generateInventory(), generateInventory(), installationDiskID)).To(BeEmpty())
+	})
+
+	It("ignores the addresses of the interfaces", func() {
+		to := generateInventory()
+		to.Interfaces[0].IPV4Addresses = []string{"192.168.126.11/24"}
+		Expect(DiffInventories(generateInventory(), to, installationDiskID)).To(BeEmpty())
+	})
+
+	It("non critical changes", func() {
+		to := generateInventory()
+		to.Memory.PhysicalBytes = 32 * 1024 * 1024 * 1024
+		to.Disks[1].SizeBytes = 480000000000
+		to.Interfaces[1].Mtu = 9000
+		Expect(DiffInventories(generateInventory(), to, installationDiskID)).To(Equal([]*models.HostInventoryChange{
+			{Attribute: swag.String("disks[/dev/disk/by-id/wwn-0x2].size_bytes"), From: "240000000000", To: "480000000000"},
+			{Attribute: swag.String("interfaces[eth1].mtu"), From: "1500", To: "9000"},
+			{Attribute: swag.String("memory.physical_bytes"), From: "17179869184", To: "34359738368"},
+		}))
+	})
+
+	It("installation disk serial and CPU count are critical", func() {
+		to := generateInventory()
+		to.CPU.Count = 4
+		to.Disks[0].Serial = "serial-c"
+		to.Disks[1].Serial = "serial-d"
+		Expect(DiffInventories(generateInventory(), to, installationDiskID)).To(Equal([]*models.HostInventoryChange{
+			{Attribute: swag.String("cpu.count"), From: "8", To: "4", Critical: true},
+			{Attribute: swag.String("disks[/dev/disk/by-id/wwn-0x1].serial"), From: "serial-a", To: "serial-c", Critical: true},
+			{Attribute: swag.String("disks[/dev/disk/by-id/wwn-0x2].serial"), From: "serial-b", To: "serial-d"},
+		}))
+	})
+
+	It("replaced installation disk", func() {
+		to := generateInventory()
+		to.Disks[0] = &models.Disk{ID: "/dev/disk/by-id/wwn-0x3", Path: "/dev/sda", Serial: "serial-c", Wwn: "0x3", SizeBytes: 120000000000}
+		Expect(DiffInventories(generateInventory(), to, installationDiskID)).To(Equal([]*models.HostInventoryChange{
+			{Attribute: swag.String("disks[/dev/disk/by-id/wwn-0x1]"), From: "/dev/sda (serial serial-a)", To: "", Critical: true},
+			{Attribute: swag.String("disks[/dev/disk/by-id/wwn-0x3]"), From: "", To: "/dev/sda (serial serial-c)"},
+		}))
+	})
+
+	It("renamed interface only changes the interfaces", func() {
+		to := generateInventory()
+		to.Interfaces[1].Name = "ens3"
+		Expect(DiffInventories(generateInventory(), to, installationDiskID)).To(Equal([]*models.HostInventoryChange{
+			{Attribute: swag.String("interfaces[ens3]"), From: "", To: "52:54:00:00:00:02"},
+			{Attribute: swag.String("interfaces[eth1]"), From: "52:54:00:00:00:02", To: ""},
+		}))
+	})
+
+	It("replaced NIC changes the MAC addresses", func() {
+		to := generateInventory()
+		to.Interfaces[1].MacAddress = "52:54:00:00:00:03"
+		Expect(DiffInventories(generateInventory(), to, installationDiskID)).To(Equal([]*models.HostInventoryChange{
+			{Attribute: swag.String("interfaces[eth1].mac_address"), From: "52:54:00:00:00:02", To: "52:54:00:00:00:03"},
+			{Attribute: swag.String("mac_addresses"), From: "52:54:00:00:00:01,52:54:00:00:00:02", To: "52:54:00:00:00:01,52:54:00:00:00:03", Critical: true},
+		}))
+	})
+})
@@ -141,6 +141,36 @@ func (mr *MockAPIMockRecorder) GetHostValidDisks(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostValidDisks", reflect.TypeOf((*MockAPI)(nil).GetHostValidDisks), arg0)
 }
 
+// GetInventoryDiff mocks base method.
+func (m *MockAPI) GetInventoryDiff(arg0 context.Context, arg1 *models.Host, arg2, arg3 strfmt.UUID) (*models.HostInventoryDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInventoryDiff", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.HostInventoryDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInventoryDiff indicates an expected call of GetInventoryDiff.
+func (mr *MockAPIMockRecorder) GetInventoryDiff(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventoryDiff", reflect.TypeOf((*MockAPI)(nil).GetInventoryDiff), arg0, arg1, arg2, arg3)
+}
+
+// GetInventoryHistory mocks base method.
+func (m *MockAPI) GetInventoryHistory(arg0 context.Context, arg1 *models.Host) (models.HostInventorySnapshotList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInventoryHistory", arg0, arg1)
+	ret0, _ := ret[0].(models.HostInventorySnapshotList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInventoryHistory indicates an expected call of GetInventoryHistory.
+func (mr *MockAPIMockRecorder) GetInventoryHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventoryHistory", reflect.TypeOf((*MockAPI)(nil).GetInventoryHistory), arg0, arg1)
+}
+
 // GetNextSteps mocks base method.
 func (m *MockAPI) GetNextSteps(arg0 context.Context, arg1 *models.Host) (models.Steps, error) {
 	m.ctrl.T.Helper()
@@ -407,6 +437,20 @@ func (mr *MockAPIMockRecorder) ResetPendingUserAction(arg0, arg1, arg2 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPendingUserAction", reflect.TypeOf((*MockAPI)(nil).ResetPendingUserAction), arg0, arg1, arg2)
 }
 
+// ReviewInventory mocks base method.
+func (m *MockAPI) ReviewInventory(arg0 context.Context, arg1 *models.Host, arg2 *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewInventory", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReviewInventory indicates an expected call of ReviewInventory.
+func (mr *MockAPIMockRecorder) ReviewInventory(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewInventory", reflect.TypeOf((*MockAPI)(nil).ReviewInventory), arg0, arg1, arg2)
+}
+
 // SetBootstrap mocks base method.
 func (m *MockAPI) SetBootstrap(arg0 context.Context, arg1 *models.Host, arg2 bool, arg3 *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -519,6 +563,20 @@ func (mr *MockAPIMockRecorder) UpdateDomainNameResolution(arg0, arg1, arg2, arg3
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomainNameResolution", reflect.TypeOf((*MockAPI)(nil).UpdateDomainNameResolution), arg0, arg1, arg2, arg3)
 }
 
+// UpdateFailureDomain mocks base method.
+func (m *MockAPI) UpdateFailureDomain(arg0 context.Context, arg1 *gorm.DB, arg2 *models.Host, arg3 *models.FailureDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFailureDomain", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFailureDomain indicates an expected call of UpdateFailureDomain.
+func (mr *MockAPIMockRecorder) UpdateFailureDomain(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFailureDomain", reflect.TypeOf((*MockAPI)(nil).UpdateFailureDomain), arg0, arg1, arg2, arg3)
+}
+
 // UpdateHostname mocks base method.
 func (m *MockAPI) UpdateHostname(arg0 context.Context, arg1 *models.Host, arg2 string, arg3 *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -672,3 +730,17 @@ func (mr *MockAPIMockRecorder) UpdateRole(arg0, arg1, arg2, arg3 interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockAPI)(nil).UpdateRole), arg0, arg1, arg2, arg3)
 }
+
+// UpdateTangConnectivityReport mocks base method.
+func (m *MockAPI) UpdateTangConnectivityReport(arg0 context.Context, arg1 *models.Host, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTangConnectivityReport", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTangConnectivityReport indicates an expected call of UpdateTangConnectivityReport.
+func (mr *MockAPIMockRecorder) UpdateTangConnectivityReport(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTangConnectivityReport", reflect.TypeOf((*MockAPI)(nil).UpdateTangConnectivityReport), arg0, arg1, arg2)
+}
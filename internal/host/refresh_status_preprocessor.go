@@ -36,16 +36,19 @@ type refreshPreprocessor struct {
 	conditions              []condition
 	operatorsApi            operators.API
 	disabledHostValidations DisabledHostValidations
+	validationRules         HostValidationRules
 }
 
 func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCfg, hwValidator hardware.Validator,
-	operatorsApi operators.API, disabledHostValidations DisabledHostValidations, providerRegistry registry.ProviderRegistry) *refreshPreprocessor {
+	operatorsApi operators.API, disabledHostValidations DisabledHostValidations, validationRules HostValidationRules,
+	disabledSteps []models.StepType, providerRegistry registry.ProviderRegistry) *refreshPreprocessor {
 	v := &validator{
-		log:              log,
-		hwValidatorCfg:   hwValidatorCfg,
-		hwValidator:      hwValidator,
-		operatorsAPI:     operatorsApi,
-		providerRegistry: providerRegistry,
+		log:                           log,
+		hwValidatorCfg:                hwValidatorCfg,
+		hwValidator:                   hwValidator,
+		operatorsAPI:                  operatorsApi,
+		providerRegistry:              providerRegistry,
+		tangConnectivityCheckDisabled: funk.Contains(disabledSteps, models.StepTypeTangConnectivityCheck),
 	}
 	return &refreshPreprocessor{
 		log:                     log,
@@ -53,6 +56,7 @@ func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.Val
 		conditions:              newConditions(v),
 		operatorsApi:            operatorsApi,
 		disabledHostValidations: disabledHostValidations,
+		validationRules:         validationRules,
 	}
 }
 
@@ -95,6 +99,32 @@ func (r *refreshPreprocessor) preprocess(c *validationContext) (map[string]bool,
 		})
 	}
 
+	conditions[CustomValidationsSatisfied.String()] = true
+	for _, rule := range r.validationRules {
+		id := rule.validationID()
+		if !rule.appliesTo(c.host) {
+			continue
+		}
+		var st ValidationStatus
+		var message string
+		if r.disabledHostValidations.IsDisabled(id) {
+			st = ValidationDisabled
+			message = validationDisabledByConfiguration
+		} else {
+			var failed []string
+			st, failed = rule.evaluate(c)
+			message = rule.format(st, failed)
+			if st != ValidationSuccess {
+				conditions[CustomValidationsSatisfied.String()] = false
+			}
+		}
+		validationsOutput[CustomValidationsCategory] = append(validationsOutput[CustomValidationsCategory], ValidationResult{
+			ID:      id,
+			Status:  st,
+			Message: message,
+		})
+	}
+
 	for _, cn := range r.conditions {
 		conditions[cn.id.String()] = cn.fn(c)
 	}
@@ -238,6 +268,11 @@ func newValidations(v *validator) []validation {
 			condition: v.hasDefaultRoute,
 			formatter: v.printDefaultRoute,
 		},
+		{
+			id:        IsMTUValid,
+			condition: v.isMTUValid,
+			formatter: v.printMTUValid,
+		},
 		{
 			id:        IsAPIDomainNameResolvedCorrectly,
 			condition: v.isAPIDomainNameResolvedCorrectly,
@@ -268,6 +303,26 @@ func newValidations(v *validator) []validation {
 			condition: v.diskEncryptionRequirementsSatisfied,
 			formatter: v.printDiskEncryptionRequirementsSatisfied,
 		},
+		{
+			id:        IsTPMValid,
+			condition: v.isTPMValid,
+			formatter: v.printTPMValid,
+		},
+		{
+			id:        IsTangConnectivityValid,
+			condition: v.isTangConnectivityValid,
+			formatter: v.printTangConnectivityValid,
+		},
+		{
+			id:        IsCriticalInventoryUnchanged,
+			condition: v.isCriticalInventoryUnchanged,
+			formatter: v.printCriticalInventoryUnchanged,
+		},
+		{
+			id:        CompatibleWithFIPS,
+			condition: v.compatibleWithFIPS,
+			formatter: v.printCompatibleWithFIPS,
+		},
 	}
 }
 
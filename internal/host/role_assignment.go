@@ -0,0 +1,321 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"gorm.io/gorm"
+)
+
+// Weights of the scoring role assignment strategy. The hardware of each host is scored relatively to the best
+// master candidate of the cluster, and a host that adds a new rack or chassis to the masters is preferred over
+// a host with a better hardware in a failure domain that already has a master.
+const (
+	roleScoreCPUWeight       float64 = 1
+	roleScoreMemoryWeight    float64 = 1
+	roleScoreDiskSpeedWeight float64 = 1
+	roleScoreNICSpeedWeight  float64 = 1
+	roleScoreRackWeight      float64 = 2
+	roleScoreChassisWeight   float64 = 1
+)
+
+// roleAssignmentStrategy suggests a role for a host whose role is auto-assign, and explains the suggestion
+type roleAssignmentStrategy interface {
+	selectRole(ctx context.Context, h *models.Host, db *gorm.DB) (models.HostRole, string, error)
+}
+
+// roleAssignmentStrategies are the strategies a cluster can choose with its role_assignment_strategy
+var roleAssignmentStrategies = map[string]func(m *Manager) roleAssignmentStrategy{
+	models.ClusterRoleAssignmentStrategyFirstFit: func(m *Manager) roleAssignmentStrategy { return &firstFitRoleAssignment{m: m} },
+	models.ClusterRoleAssignmentStrategyScoring:  func(m *Manager) roleAssignmentStrategy { return &scoringRoleAssignment{m: m} },
+}
+
+// getRoleAssignmentStrategy returns the role assignment strategy of a cluster, clusters that didn't choose any
+// keeping the first-fit strategy
+func (m *Manager) getRoleAssignmentStrategy(name string) (string, roleAssignmentStrategy) {
+	newStrategy, ok := roleAssignmentStrategies[name]
+	if !ok {
+		name = models.ClusterRoleAssignmentStrategyFirstFit
+		newStrategy = roleAssignmentStrategies[name]
+	}
+	return name, newStrategy(m)
+}
+
+func (m *Manager) meetsMasterRequirements(h *models.Host, c *common.Cluster, db *gorm.DB) (bool, error) {
+	candidate := *h
+	candidate.Role = models.HostRoleMaster
+	vc, err := newValidationContext(&candidate, c, nil, db, m.hwValidator)
+	if err != nil {
+		return false, err
+	}
+	conditions, _, err := m.rp.preprocess(vc)
+	if err != nil {
+		return false, err
+	}
+	return m.canBeMaster(conditions), nil
+}
+
+// firstFitRoleAssignment selects the hosts that meet the master requirements as masters until the cluster has
+// enough masters
+type firstFitRoleAssignment struct {
+	m *Manager
+}
+
+func (s *firstFitRoleAssignment) selectRole(ctx context.Context, h *models.Host, db *gorm.DB) (models.HostRole, string, error) {
+	log := logutil.FromContext(ctx, s.m.log)
+
+	// count already existing masters or hosts with suggested role of master
+	// since aggregated functions can not run within a FOR UPDATE transaction
+	// we are now calculating the master count with SELECT query (Bug 2012570)
+	var masters []string
+	reply := db.Model(&models.Host{}).Where("cluster_id = ? and id != ? and status != ? and (role = ? or suggested_role = ?)",
+		h.ClusterID, h.ID, models.HostStatusDisabled, models.HostRoleMaster, models.HostRoleMaster).Pluck("id", &masters)
+
+	if err := reply.Error; err != nil {
+		log.WithError(err).Errorf("failed to count masters in cluster %s", h.ClusterID.String())
+		return models.HostRoleAutoAssign, "", err
+	}
+
+	if len(masters) >= common.MinMasterHostsNeededForInstallation {
+		return models.HostRoleWorker, fmt.Sprintf("the cluster already has %d masters", len(masters)), nil
+	}
+
+	canBeMaster, err := s.m.meetsMasterRequirements(h, nil, db)
+	if err != nil {
+		log.WithError(err).Errorf("failed to run validations on host %s", h.ID.String())
+		return models.HostRoleAutoAssign, "", err
+	}
+	if !canBeMaster {
+		return models.HostRoleWorker, "the host does not meet the master requirements", nil
+	}
+	return models.HostRoleMaster, fmt.Sprintf("the cluster has %d of the %d required masters and the host meets the master requirements",
+		len(masters), common.MinMasterHostsNeededForInstallation), nil
+}
+
+// hostRoleScore is the score of a master candidate, each hardware component being relative to the best
+// candidate of the cluster
+type hostRoleScore struct {
+	host      *models.Host
+	cpu       float64
+	memory    float64
+	diskSpeed float64
+	nicSpeed  float64
+	rack      string
+	chassis   string
+}
+
+func (s *hostRoleScore) hardware() float64 {
+	return roleScoreCPUWeight*s.cpu + roleScoreMemoryWeight*s.memory + roleScoreDiskSpeedWeight*s.diskSpeed + roleScoreNICSpeedWeight*s.nicSpeed
+}
+
+// spread is the bonus of a candidate that adds a new rack or chassis to the masters
+func (s *hostRoleScore) spread(racks, chassis map[string]bool) float64 {
+	var bonus float64
+	if s.rack != "" && !racks[s.rack] {
+		bonus += roleScoreRackWeight
+	}
+	if s.chassis != "" && !chassis[s.chassis] {
+		bonus += roleScoreChassisWeight
+	}
+	return bonus
+}
+
+func (s *hostRoleScore) explain(spread float64) string {
+	return fmt.Sprintf("score %.2f (cpu %.2f, memory %.2f, disk speed %.2f, nic speed %.2f, failure domain spread %.2f)",
+		s.hardware()+spread, s.cpu, s.memory, s.diskSpeed, s.nicSpeed, spread)
+}
+
+// hostHardwareMetrics are the raw values the hosts are scored by
+type hostHardwareMetrics struct {
+	cpuCount    int64
+	memoryBytes int64
+	diskSpeedMs int64
+	nicSpeed    int64
+}
+
+func getHostHardwareMetrics(h *models.Host) (*hostHardwareMetrics, error) {
+	inventory, err := common.UnmarshalInventory(h.Inventory)
+	if err != nil {
+		return nil, err
+	}
+	metrics := &hostHardwareMetrics{}
+	if inventory.CPU != nil {
+		metrics.cpuCount = inventory.CPU.Count
+	}
+	if inventory.Memory != nil {
+		metrics.memoryBytes = inventory.Memory.UsableBytes
+	}
+	for _, nic := range inventory.Interfaces {
+		if nic.SpeedMbps > metrics.nicSpeed {
+			metrics.nicSpeed = nic.SpeedMbps
+		}
+	}
+	// The speed of the installation disk is known once the disk speed check has succeeded
+	if path := hostutil.GetHostInstallationPath(h); path != "" {
+		info, err := common.GetDiskInfo(h.DisksInfo, path)
+		if err != nil {
+			return nil, err
+		}
+		if info != nil && info.DiskSpeed != nil && info.DiskSpeed.Tested && info.DiskSpeed.ExitCode == 0 {
+			metrics.diskSpeedMs = info.DiskSpeed.SpeedMs
+		}
+	}
+	return metrics, nil
+}
+
+// relativeTo returns the ratio of value to the best value, 0 when unknown
+func relativeTo(value, best int64) float64 {
+	if value <= 0 || best <= 0 {
+		return 0
+	}
+	return float64(value) / float64(best)
+}
+
+// scoringRoleAssignment scores all the master candidates of the cluster by their CPU, memory, installation disk
+// speed and NIC speed, and selects the best ones as masters while spreading them across racks and chassis
+type scoringRoleAssignment struct {
+	m *Manager
+}
+
+func (s *scoringRoleAssignment) selectRole(ctx context.Context, h *models.Host, db *gorm.DB) (models.HostRole, string, error) {
+	log := logutil.FromContext(ctx, s.m.log)
+
+	cluster, err := common.GetClusterFromDBWithoutDisabledHosts(db, *h.ClusterID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", h.ClusterID.String())
+		return models.HostRoleAutoAssign, "", err
+	}
+
+	// The masters assigned by the user are kept, the auto-assign hosts compete for the remaining masters
+	racks, chassis := map[string]bool{}, map[string]bool{}
+	var masters int
+	candidates := []*models.Host{h}
+	for _, clusterHost := range cluster.Hosts {
+		switch {
+		case clusterHost.ID.String() == h.ID.String():
+			continue
+		case clusterHost.Role == models.HostRoleMaster:
+			masters++
+			if clusterHost.FailureDomain != nil {
+				markFailureDomain(racks, clusterHost.FailureDomain.Rack)
+				markFailureDomain(chassis, clusterHost.FailureDomain.Chassis)
+			}
+		case clusterHost.Role == models.HostRoleAutoAssign && clusterHost.Inventory != "":
+			candidates = append(candidates, clusterHost)
+		}
+	}
+
+	missing := common.MinMasterHostsNeededForInstallation - masters
+	if missing <= 0 {
+		return models.HostRoleWorker, fmt.Sprintf("the cluster already has %d masters", masters), nil
+	}
+
+	scores, err := s.score(candidates)
+	if err != nil {
+		log.WithError(err).Errorf("failed to score the hosts of cluster %s", h.ClusterID.String())
+		return models.HostRoleAutoAssign, "", err
+	}
+
+	// The masters are selected one by one, as the spread bonus of each candidate depends on the failure domains
+	// of the masters selected before it. The master requirements are checked only for the selected candidates.
+	eligible := map[string]bool{}
+	for selected := 1; selected <= missing && len(scores) > 0; {
+		best, bestScore := 0, -1.0
+		for i, score := range scores {
+			if total := score.hardware() + score.spread(racks, chassis); total > bestScore {
+				best, bestScore = i, total
+			}
+		}
+		score := scores[best]
+		scores = append(scores[:best], scores[best+1:]...)
+
+		hostID := score.host.ID.String()
+		if _, checked := eligible[hostID]; !checked {
+			canBeMaster, err := s.m.meetsMasterRequirements(score.host, cluster, db)
+			if err != nil {
+				log.WithError(err).Errorf("failed to run validations on host %s", hostID)
+				return models.HostRoleAutoAssign, "", err
+			}
+			eligible[hostID] = canBeMaster
+		}
+		if !eligible[hostID] {
+			if score.host == h {
+				return models.HostRoleWorker, "the host does not meet the master requirements", nil
+			}
+			continue
+		}
+
+		if score.host == h {
+			return models.HostRoleMaster, fmt.Sprintf("%s, ranked %d of the %d missing masters",
+				score.explain(score.spread(racks, chassis)), selected, missing), nil
+		}
+		markFailureDomain(racks, score.rack)
+		markFailureDomain(chassis, score.chassis)
+		selected++
+	}
+
+	for _, score := range scores {
+		if score.host == h {
+			return models.HostRoleWorker, fmt.Sprintf("%s, lower than the %d selected master candidates",
+				score.explain(score.spread(racks, chassis)), missing), nil
+		}
+	}
+	return models.HostRoleWorker, fmt.Sprintf("the %d missing masters were selected", missing), nil
+}
+
+func markFailureDomain(domains map[string]bool, domain string) {
+	if domain != "" {
+		domains[domain] = true
+	}
+}
+
+func (s *scoringRoleAssignment) score(hosts []*models.Host) ([]*hostRoleScore, error) {
+	metrics := make([]*hostHardwareMetrics, len(hosts))
+	var best hostHardwareMetrics
+	for i, h := range hosts {
+		hostMetrics, err := getHostHardwareMetrics(h)
+		if err != nil {
+			return nil, err
+		}
+		metrics[i] = hostMetrics
+		if hostMetrics.cpuCount > best.cpuCount {
+			best.cpuCount = hostMetrics.cpuCount
+		}
+		if hostMetrics.memoryBytes > best.memoryBytes {
+			best.memoryBytes = hostMetrics.memoryBytes
+		}
+		// A faster disk has a lower latency
+		if hostMetrics.diskSpeedMs > 0 && (best.diskSpeedMs == 0 || hostMetrics.diskSpeedMs < best.diskSpeedMs) {
+			best.diskSpeedMs = hostMetrics.diskSpeedMs
+		}
+		if hostMetrics.nicSpeed > best.nicSpeed {
+			best.nicSpeed = hostMetrics.nicSpeed
+		}
+	}
+
+	scores := make([]*hostRoleScore, len(hosts))
+	for i, h := range hosts {
+		scores[i] = &hostRoleScore{
+			host:      h,
+			cpu:       relativeTo(metrics[i].cpuCount, best.cpuCount),
+			memory:    relativeTo(metrics[i].memoryBytes, best.memoryBytes),
+			diskSpeed: relativeTo(best.diskSpeedMs, metrics[i].diskSpeedMs),
+			nicSpeed:  relativeTo(metrics[i].nicSpeed, best.nicSpeed),
+		}
+		if h.FailureDomain != nil {
+			scores[i].rack = h.FailureDomain.Rack
+			scores[i].chassis = h.FailureDomain.Chassis
+		}
+	}
+
+	// The hosts with the same score are selected in the same order whichever host is refreshed
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].host.ID.String() < scores[j].host.ID.String()
+	})
+	return scores, nil
+}
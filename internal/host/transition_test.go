@@ -2206,7 +2206,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:               {status: ValidationFailure, messagePattern: "Require at least 8.35 GiB RAM for role worker, found only 150 MiB"},
 					IsHostnameUnique:               {status: ValidationSuccess, messagePattern: "Hostname worker is unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					IsNTPSynced:                    {status: ValidationFailure, messagePattern: "Host couldn't synchronize with any NTP server"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
@@ -2237,7 +2237,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role master"},
 					HasMemoryForRole:               {status: ValidationFailure, messagePattern: "Require at least 16.15 GiB RAM for role master, found only 100 MiB"},
 					IsHostnameUnique:               {status: ValidationSuccess, messagePattern: "Hostname master is unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					IsNTPSynced:                    {status: ValidationFailure, messagePattern: "Host couldn't synchronize with any NTP server"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
@@ -2268,7 +2268,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationFailure, messagePattern: "Require at least 4 CPU cores for worker role, found only 1"},
 					HasMemoryForRole:               {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:               {status: ValidationSuccess, messagePattern: "Hostname worker is unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					IsNTPSynced:                    {status: ValidationFailure, messagePattern: "Host couldn't synchronize with any NTP server"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
@@ -2299,7 +2299,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationFailure, messagePattern: "Require at least 8 CPU cores for master role, found only 1"},
 					HasMemoryForRole:               {status: ValidationSuccess, messagePattern: "Sufficient RAM for role master"},
 					IsHostnameUnique:               {status: ValidationSuccess, messagePattern: "Hostname master is unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					IsNTPSynced:                    {status: ValidationFailure, messagePattern: "Host couldn't synchronize with any NTP server"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
@@ -2329,7 +2329,7 @@ var _ = Describe("Refresh Host", func() {
 					IsMachineCidrDefined:           {status: ValidationSuccess, messagePattern: "Machine Network CIDR is defined"},
 					HasMemoryForRole:               {status: ValidationSuccess, messagePattern: "Sufficient RAM for role master"},
 					IsHostnameUnique:               {status: ValidationSuccess, messagePattern: "Hostname master is unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					IsNTPSynced:                    {status: ValidationFailure, messagePattern: "Host couldn't synchronize with any NTP server"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
@@ -2359,7 +2359,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:               {status: ValidationFailure, messagePattern: "Require at least 9.35 GiB RAM for role worker, found only 1.00 GiB"},
 					IsHostnameUnique:               {status: ValidationSuccess, messagePattern: "Hostname worker is unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					IsNTPSynced:                    {status: ValidationFailure, messagePattern: "Host couldn't synchronize with any NTP server"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
@@ -2738,6 +2738,7 @@ var _ = Describe("Refresh Host", func() {
 
 			// Cluster fields
 			machineNetworks       []*models.MachineNetwork
+			majorityGroupNetworks []*models.MachineNetwork
 			connectivity          string
 			userManagedNetworking bool
 			isDay2                bool
@@ -3099,7 +3100,7 @@ var _ = Describe("Refresh Host", func() {
 				imageStatuses:    map[string]*models.ContainerImageAvailability{common.TestDefaultConfig.ImageName: common.TestImageStatusesFailure},
 				role:             models.HostRoleWorker,
 				statusInfoChecker: makeValueChecker(formatStatusInfoFailedValidation(statusInfoNotReadyForInstall,
-					"Host does not belong to machine network CIDRs. Verify that the host belongs to every CIDR listed under machine networks",
+					"Host does not belong to machine network CIDRs. Verify that the host belongs to a CIDR of every address family listed under machine networks",
 					"Host couldn't synchronize with any NTP server",
 					"Failed to fetch container images needed for installation from image. This may be due to a network hiccup. Retry to install again. If this problem persists, "+
 						"check your network settings to make sure you’re not blocked.")),
@@ -3113,7 +3114,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:               {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:               {status: ValidationSuccess, messagePattern: "Hostname  is unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationFailure, messagePattern: "Host does not belong to machine network CIDRs. Verify that the host belongs to every CIDR listed under machine networks"},
+					BelongsToMachineCidr:           {status: ValidationFailure, messagePattern: "Host does not belong to machine network CIDRs. Verify that the host belongs to a CIDR of every address family listed under machine networks"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					CompatibleWithClusterPlatform:  {status: ValidationSuccess, messagePattern: "Host is compatible with cluster platform baremetal"},
 					IsNTPSynced:                    {status: ValidationFailure, messagePattern: "Host couldn't synchronize with any NTP server"},
@@ -3133,7 +3134,7 @@ var _ = Describe("Refresh Host", func() {
 				imageStatuses:    map[string]*models.ContainerImageAvailability{common.TestDefaultConfig.ImageName: common.TestImageStatusesFailure},
 				role:             models.HostRoleMaster,
 				statusInfoChecker: makeValueChecker(formatStatusInfoFailedValidation(statusInfoNotReadyForInstall,
-					"Host does not belong to machine network CIDRs. Verify that the host belongs to every CIDR listed under machine networks",
+					"Host does not belong to machine network CIDRs. Verify that the host belongs to a CIDR of every address family listed under machine networks",
 					"Require at least 4 CPU cores for master role, found only 2",
 					"Require at least 16.00 GiB RAM for role master, found only 8.00 GiB",
 					"Host couldn't synchronize with any NTP server",
@@ -3149,7 +3150,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationFailure, messagePattern: "Require at least 4 CPU cores for master role, found only 2"},
 					HasMemoryForRole:               {status: ValidationFailure, messagePattern: "Require at least 16.00 GiB RAM for role master, found only 8.00 GiB"},
 					IsHostnameUnique:               {status: ValidationSuccess, messagePattern: "Hostname  is unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationFailure, messagePattern: "Host does not belong to machine network CIDRs. Verify that the host belongs to every CIDR listed under machine networks"},
+					BelongsToMachineCidr:           {status: ValidationFailure, messagePattern: "Host does not belong to machine network CIDRs. Verify that the host belongs to a CIDR of every address family listed under machine networks"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					CompatibleWithClusterPlatform:  {status: ValidationSuccess, messagePattern: "Host is compatible with cluster platform baremetal"},
 					IsNTPSynced:                    {status: ValidationFailure, messagePattern: "Host couldn't synchronize with any NTP server"},
@@ -3180,7 +3181,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role master"},
 					HasMemoryForRole:               {status: ValidationSuccess, messagePattern: "Sufficient RAM for role master"},
 					IsHostnameUnique:               {status: ValidationSuccess, messagePattern: " is unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsHostnameValid:                {status: ValidationSuccess, messagePattern: "Hostname .* is allowed"},
 					IsPlatformNetworkSettingsValid: {status: ValidationFailure, messagePattern: fmt.Sprintf("Platform %s is allowed only for Single Node OpenShift or user-managed networking", OpenStackPlatform)},
 					CompatibleWithClusterPlatform:  {status: ValidationSuccess, messagePattern: "Host is compatible with cluster platform baremetal"},
@@ -3212,7 +3213,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:   {status: ValidationFailure, messagePattern: "Require at least 4 CPU cores for master role, found only 2"},
 					HasMemoryForRole:     {status: ValidationFailure, messagePattern: "Require at least 16.00 GiB RAM for role master, found only 8.00 GiB"},
 					IsHostnameUnique:     {status: ValidationSuccess, messagePattern: "Hostname  is unique in cluster"},
-					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsNTPSynced:          {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
 					SufficientOrUnknownInstallationDiskSpeed:       {status: ValidationSuccess, messagePattern: "Speed of installation disk has not yet been measured"},
@@ -3242,7 +3243,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:   {status: ValidationFailure, messagePattern: "Require at least 4 CPU cores for master role, found only 2"},
 					HasMemoryForRole:     {status: ValidationFailure, messagePattern: "Require at least 16.00 GiB RAM for role master, found only 8.00 GiB"},
 					IsHostnameUnique:     {status: ValidationSuccess, messagePattern: "Hostname  is unique in cluster"},
-					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsNTPSynced:          {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
 					SufficientOrUnknownInstallationDiskSpeed:       {status: ValidationSuccess, messagePattern: "Speed of installation disk has not yet been measured"},
@@ -3259,7 +3260,7 @@ var _ = Describe("Refresh Host", func() {
 				imageStatuses:    map[string]*models.ContainerImageAvailability{common.TestDefaultConfig.ImageName: common.TestImageStatusesFailure},
 				role:             models.HostRoleMaster,
 				statusInfoChecker: makeValueChecker(formatStatusInfoFailedValidation(statusInfoNotReadyForInstall,
-					"Host does not belong to machine network CIDRs. Verify that the host belongs to every CIDR listed under machine networks",
+					"Host does not belong to machine network CIDRs. Verify that the host belongs to a CIDR of every address family listed under machine networks",
 					"Host couldn't synchronize with any NTP server",
 					"Failed to fetch container images needed for installation from image. This may be due to a network hiccup. Retry to install again. If this problem persists, "+
 						"check your network settings to make sure you’re not blocked.")),
@@ -3290,7 +3291,7 @@ var _ = Describe("Refresh Host", func() {
 				imageStatuses:    map[string]*models.ContainerImageAvailability{common.TestDefaultConfig.ImageName: common.TestImageStatusesFailure},
 				role:             models.HostRoleMaster,
 				statusInfoChecker: makeValueChecker(formatStatusInfoFailedValidation(statusInfoNotReadyForInstall,
-					"Host does not belong to machine network CIDRs. Verify that the host belongs to every CIDR listed under machine networks",
+					"Host does not belong to machine network CIDRs. Verify that the host belongs to a CIDR of every address family listed under machine networks",
 					"Host couldn't synchronize with any NTP server",
 					"Failed to fetch container images needed for installation from image. This may be due to a network hiccup. Retry to install again. If this problem persists, "+
 						"check your network settings to make sure you’re not blocked.")),
@@ -3333,7 +3334,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:   {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role master"},
 					HasMemoryForRole:     {status: ValidationSuccess, messagePattern: "Sufficient RAM for role master"},
 					IsHostnameUnique:     {status: ValidationSuccess, messagePattern: " is unique in cluster"},
-					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsHostnameValid:      {status: ValidationFailure, messagePattern: "Hostname localhost is forbidden"},
 					IsNTPSynced:          {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
@@ -3362,7 +3363,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:   {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role master"},
 					HasMemoryForRole:     {status: ValidationSuccess, messagePattern: "Sufficient RAM for role master"},
 					IsHostnameUnique:     {status: ValidationSuccess, messagePattern: " is unique in cluster"},
-					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsHostnameValid:      {status: ValidationSuccess, messagePattern: "Hostname .* is allowed"},
 					IsNTPSynced:          {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
@@ -3511,7 +3512,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:   {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:     {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:     {status: ValidationSuccess, messagePattern: " is unique in cluster"},
-					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsHostnameValid:      {status: ValidationSuccess, messagePattern: "Hostname .* is allowed"},
 					IsNTPSynced:          {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
@@ -3540,7 +3541,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:   {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:     {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:     {status: ValidationSuccess, messagePattern: " is unique in cluster"},
-					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsHostnameValid:      {status: ValidationSuccess, messagePattern: "Hostname .* is allowed"},
 					IsNTPSynced:          {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
@@ -3570,7 +3571,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:   {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:     {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:     {status: ValidationSuccess, messagePattern: " is unique in cluster"},
-					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsHostnameValid:      {status: ValidationSuccess, messagePattern: "Hostname .* is allowed"},
 					IsNTPSynced:          {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
@@ -3600,7 +3601,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:   {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:     {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:     {status: ValidationSuccess, messagePattern: " is unique in cluster"},
-					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsHostnameValid:      {status: ValidationSuccess, messagePattern: "Hostname .* is allowed"},
 					IsNTPSynced:          {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
@@ -3628,7 +3629,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:   {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:     {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:     {status: ValidationSuccess, messagePattern: " is unique in cluster"},
-					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsHostnameValid:      {status: ValidationSuccess, messagePattern: "Hostname .* is allowed"},
 					IsNTPSynced:          {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
@@ -3656,7 +3657,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:     {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role master"},
 					HasMemoryForRole:       {status: ValidationSuccess, messagePattern: "Sufficient RAM for role master"},
 					IsHostnameUnique:       {status: ValidationSuccess, messagePattern: " is unique in cluster"},
-					BelongsToMachineCidr:   {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:   {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsHostnameValid:        {status: ValidationSuccess, messagePattern: "Hostname .* is allowed"},
 					BelongsToMajorityGroup: {status: ValidationSuccess, messagePattern: "Host has connectivity to the majority of hosts in the cluster"},
 					IsNTPSynced:            {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
@@ -3685,7 +3686,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:     {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role master"},
 					HasMemoryForRole:       {status: ValidationSuccess, messagePattern: "Sufficient RAM for role master"},
 					IsHostnameUnique:       {status: ValidationSuccess, messagePattern: " is unique in cluster"},
-					BelongsToMachineCidr:   {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:   {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsHostnameValid:        {status: ValidationSuccess, messagePattern: "Hostname .* is allowed"},
 					BelongsToMajorityGroup: {status: ValidationPending, messagePattern: "Not enough enabled hosts in cluster to calculate connectivity groups"},
 					IsNTPSynced:            {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
@@ -3715,7 +3716,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:     {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role master"},
 					HasMemoryForRole:       {status: ValidationSuccess, messagePattern: "Sufficient RAM for role master"},
 					IsHostnameUnique:       {status: ValidationSuccess, messagePattern: " is unique in cluster"},
-					BelongsToMachineCidr:   {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:   {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsHostnameValid:        {status: ValidationSuccess, messagePattern: "Hostname .* is allowed"},
 					BelongsToMajorityGroup: {status: ValidationFailure, messagePattern: "No connectivity to the majority of hosts in the cluster"},
 					IsNTPSynced:            {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
@@ -3725,6 +3726,38 @@ var _ = Describe("Refresh Host", func() {
 				errorExpected:      false,
 				numAdditionalHosts: 2,
 			},
+			{
+				name:             "known to known + additional hosts - two IPv4 machine networks",
+				validCheckInTime: true,
+				srcState:         models.HostStatusKnown,
+				dstState:         models.HostStatusKnown,
+				machineNetworks: []*models.MachineNetwork{
+					{Cidr: common.TestIPv4Networking.MachineNetworks[0].Cidr},
+					{Cidr: "10.0.0.0/16"},
+				},
+				ntpSources:        defaultNTPSources,
+				role:              models.HostRoleMaster,
+				statusInfoChecker: makeValueChecker(statusInfoKnown),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					IsConnected:            {status: ValidationSuccess, messagePattern: "Host is connected"},
+					HasInventory:           {status: ValidationSuccess, messagePattern: "Valid inventory exists for the host"},
+					HasMinCPUCores:         {status: ValidationSuccess, messagePattern: "Sufficient CPU cores"},
+					HasMinMemory:           {status: ValidationSuccess, messagePattern: "Sufficient minimum RAM"},
+					HasMinValidDisks:       {status: ValidationSuccess, messagePattern: "Sufficient disk capacity"},
+					IsMachineCidrDefined:   {status: ValidationSuccess, messagePattern: "Machine Network CIDR is defined"},
+					HasCPUCoresForRole:     {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role master"},
+					HasMemoryForRole:       {status: ValidationSuccess, messagePattern: "Sufficient RAM for role master"},
+					IsHostnameUnique:       {status: ValidationSuccess, messagePattern: " is unique in cluster"},
+					BelongsToMachineCidr:   {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
+					IsHostnameValid:        {status: ValidationSuccess, messagePattern: "Hostname .* is allowed"},
+					BelongsToMajorityGroup: {status: ValidationSuccess, messagePattern: "Host has connectivity to the majority of hosts in the cluster"},
+					IsNTPSynced:            {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
+				}),
+				inventory:             hostutil.GenerateMasterInventory(),
+				majorityGroupNetworks: common.TestIPv4Networking.MachineNetworks,
+				errorExpected:         false,
+				numAdditionalHosts:    2,
+			},
 			{
 				name:              "known to known + additional hosts - dual-stack cluster, dual-stack hosts",
 				validCheckInTime:  true,
@@ -3744,7 +3777,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:     {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role master"},
 					HasMemoryForRole:       {status: ValidationSuccess, messagePattern: "Sufficient RAM for role master"},
 					IsHostnameUnique:       {status: ValidationSuccess, messagePattern: " is unique in cluster"},
-					BelongsToMachineCidr:   {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:   {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsHostnameValid:        {status: ValidationSuccess, messagePattern: "Hostname .* is allowed"},
 					BelongsToMajorityGroup: {status: ValidationSuccess, messagePattern: "Host has connectivity to the majority of hosts in the cluster"},
 					IsNTPSynced:            {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
@@ -3762,7 +3795,7 @@ var _ = Describe("Refresh Host", func() {
 				ntpSources:       defaultNTPSources,
 				role:             models.HostRoleMaster,
 				statusInfoChecker: makeValueChecker(formatStatusInfoFailedValidation(statusInfoNotReadyForInstall,
-					"Host does not belong to machine network CIDRs. Verify that the host belongs to every CIDR listed under machine networks")),
+					"Host does not belong to machine network CIDRs. Verify that the host belongs to a CIDR of every address family listed under machine networks")),
 				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
 					IsConnected:            {status: ValidationSuccess, messagePattern: "Host is connected"},
 					HasInventory:           {status: ValidationSuccess, messagePattern: "Valid inventory exists for the host"},
@@ -3773,7 +3806,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:     {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role master"},
 					HasMemoryForRole:       {status: ValidationSuccess, messagePattern: "Sufficient RAM for role master"},
 					IsHostnameUnique:       {status: ValidationSuccess, messagePattern: " is unique in cluster"},
-					BelongsToMachineCidr:   {status: ValidationFailure, messagePattern: "Host does not belong to machine network CIDRs. Verify that the host belongs to every CIDR listed under machine networks"},
+					BelongsToMachineCidr:   {status: ValidationFailure, messagePattern: "Host does not belong to machine network CIDRs. Verify that the host belongs to a CIDR of every address family listed under machine networks"},
 					IsHostnameValid:        {status: ValidationSuccess, messagePattern: "Hostname .* is allowed"},
 					BelongsToMajorityGroup: {status: ValidationSuccess, messagePattern: "Host has connectivity to the majority of hosts in the cluster"},
 					IsNTPSynced:            {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
@@ -3824,7 +3857,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:   {status: ValidationFailure, messagePattern: "Require at least 4 CPU cores for worker role, found only 2"},
 					HasMemoryForRole:     {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:     {status: ValidationSuccess, messagePattern: "is unique in cluster"},
-					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsNTPSynced:          {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
 				}),
@@ -3859,7 +3892,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:   {status: ValidationFailure, messagePattern: "Require at least 8 CPU cores for master role, found only 1"},
 					HasMemoryForRole:     {status: ValidationFailure, messagePattern: "Require at least 16.15 GiB RAM for role master, found only 2.00 GiB"},
 					IsHostnameUnique:     {status: ValidationSuccess, messagePattern: "is unique in cluster"},
-					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsNTPSynced:          {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
 				}),
@@ -3889,7 +3922,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:   {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role master"},
 					HasMemoryForRole:     {status: ValidationSuccess, messagePattern: "Sufficient RAM for role master"},
 					IsHostnameUnique:     {status: ValidationSuccess, messagePattern: "Hostname master-1 is unique in cluster"},
-					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsNTPSynced:          {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
 				}),
@@ -3918,7 +3951,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:   {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role master"},
 					HasMemoryForRole:     {status: ValidationSuccess, messagePattern: "Sufficient RAM for role master"},
 					IsHostnameUnique:     {status: ValidationSuccess, messagePattern: "Hostname master-1 is unique in cluster"},
-					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsNTPSynced:          {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
 				}),
@@ -3949,7 +3982,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:          {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:            {status: ValidationFailure, messagePattern: "Require at least 8.35 GiB RAM for role worker, found only 8.00 GiB"},
 					IsHostnameUnique:            {status: ValidationSuccess, messagePattern: "Hostname worker-1 is unique in cluster"},
-					BelongsToMachineCidr:        {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:        {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsNTPSynced:                 {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
 					AreOcsRequirementsSatisfied: {status: ValidationFailure, messagePattern: "OCS unsupported Host Role for Compact Mode."},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"},
@@ -4023,6 +4056,8 @@ var _ = Describe("Refresh Host", func() {
 				if t.connectivity == "" {
 					if t.userManagedNetworking {
 						cluster.ConnectivityMajorityGroups = fmt.Sprintf("{\"%s\":[\"%s\"]}", network.IPv4.String(), hostId.String())
+					} else if t.majorityGroupNetworks != nil {
+						cluster.ConnectivityMajorityGroups = generateMajorityGroup(t.majorityGroupNetworks, hostId)
 					} else {
 						cluster.ConnectivityMajorityGroups = generateMajorityGroup(t.machineNetworks, hostId)
 					}
@@ -4170,7 +4205,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:   {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:     {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:     {status: ValidationSuccess, messagePattern: " is unique in cluster"},
-					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr: {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsNTPSynced:          {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
 					SucessfullOrUnknownContainerImagesAvailability: {status: ValidationSuccess, messagePattern: "All required container images were either pulled successfully or no attempt was made to pull them"}}),
 				inventory:      hostutil.GenerateMasterInventoryWithHostname("first"),
@@ -4196,7 +4231,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:               {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:               {status: ValidationFailure, messagePattern: " is not unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					CompatibleWithClusterPlatform:  {status: ValidationSuccess, messagePattern: "Host is compatible with cluster platform baremetal"},
 					IsNTPSynced:                    {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
@@ -4225,7 +4260,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:               {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:               {status: ValidationFailure, messagePattern: " is not unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					CompatibleWithClusterPlatform:  {status: ValidationSuccess, messagePattern: "Host is compatible with cluster platform baremetal"},
 					IsNTPSynced:                    {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
@@ -4255,7 +4290,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:               {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:               {status: ValidationFailure, messagePattern: " is not unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					CompatibleWithClusterPlatform:  {status: ValidationSuccess, messagePattern: "Host is compatible with cluster platform baremetal"},
 					IsNTPSynced:                    {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
@@ -4285,7 +4320,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:               {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:               {status: ValidationFailure, messagePattern: " is not unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					CompatibleWithClusterPlatform:  {status: ValidationSuccess, messagePattern: "Host is compatible with cluster platform baremetal"},
 					IsNTPSynced:                    {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
@@ -4315,7 +4350,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:               {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:               {status: ValidationSuccess, messagePattern: " is unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					CompatibleWithClusterPlatform:  {status: ValidationSuccess, messagePattern: "Host is compatible with cluster platform baremetal"},
 					IsNTPSynced:                    {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
@@ -4345,7 +4380,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:               {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:               {status: ValidationSuccess, messagePattern: " is unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					CompatibleWithClusterPlatform:  {status: ValidationSuccess, messagePattern: "Host is compatible with cluster platform baremetal"},
 					IsNTPSynced:                    {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
@@ -4373,7 +4408,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:               {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:               {status: ValidationFailure, messagePattern: " is not unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					CompatibleWithClusterPlatform:  {status: ValidationSuccess, messagePattern: "Host is compatible with cluster platform baremetal"},
 					IsNTPSynced:                    {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
@@ -4402,7 +4437,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:               {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:               {status: ValidationFailure, messagePattern: " is not unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					CompatibleWithClusterPlatform:  {status: ValidationSuccess, messagePattern: "Host is compatible with cluster platform baremetal"},
 					IsNTPSynced:                    {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
@@ -4432,7 +4467,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:               {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:               {status: ValidationFailure, messagePattern: " is not unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					CompatibleWithClusterPlatform:  {status: ValidationSuccess, messagePattern: "Host is compatible with cluster platform baremetal"},
 					IsNTPSynced:                    {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
@@ -4462,7 +4497,7 @@ var _ = Describe("Refresh Host", func() {
 					HasCPUCoresForRole:             {status: ValidationSuccess, messagePattern: "Sufficient CPU cores for role worker"},
 					HasMemoryForRole:               {status: ValidationSuccess, messagePattern: "Sufficient RAM for role worker"},
 					IsHostnameUnique:               {status: ValidationFailure, messagePattern: " is not unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					CompatibleWithClusterPlatform:  {status: ValidationSuccess, messagePattern: "Host is compatible with cluster platform baremetal"},
 					IsNTPSynced:                    {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
@@ -4490,7 +4525,7 @@ var _ = Describe("Refresh Host", func() {
 					HasMinValidDisks:               {status: ValidationSuccess, messagePattern: "Sufficient disk capacity"},
 					IsMachineCidrDefined:           {status: ValidationSuccess, messagePattern: "Machine Network CIDR is defined"},
 					IsHostnameUnique:               {status: ValidationSuccess, messagePattern: " is unique in cluster"},
-					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to all machine network CIDRs"},
+					BelongsToMachineCidr:           {status: ValidationSuccess, messagePattern: "Host belongs to a machine network CIDR of every address family"},
 					IsPlatformNetworkSettingsValid: {status: ValidationSuccess, messagePattern: "Platform RHEL is allowed"},
 					CompatibleWithClusterPlatform:  {status: ValidationSuccess, messagePattern: "Host is compatible with cluster platform baremetal"},
 					IsNTPSynced:                    {status: ValidationSuccess, messagePattern: "Host NTP is synced"},
@@ -40,6 +40,11 @@ const (
 	CompatibleWithClusterPlatform                  = validationID(models.HostValidationIDCompatibleWithClusterPlatform)
 	IsDNSWildcardNotConfigured                     = validationID(models.HostValidationIDDNSWildcardNotConfigured)
 	DiskEncryptionRequirementsSatisfied            = validationID(models.HostValidationIDDiskEncryptionRequirementsSatisfied)
+	IsMTUValid                                     = validationID(models.HostValidationIDMtuValid)
+	IsTPMValid                                     = validationID(models.HostValidationIDTpmValid)
+	IsTangConnectivityValid                        = validationID(models.HostValidationIDTangConnectivityValid)
+	IsCriticalInventoryUnchanged                   = validationID(models.HostValidationIDCriticalInventoryUnchanged)
+	CompatibleWithFIPS                             = validationID(models.HostValidationIDCompatibleWithFips)
 )
 
 func (v validationID) category() (string, error) {
@@ -58,7 +63,9 @@ func (v validationID) category() (string, error) {
 		IsAPIInternalDomainNameResolvedCorrectly,
 		IsPlatformNetworkSettingsValid,
 		IsAppsDomainNameResolvedCorrectly,
-		IsDNSWildcardNotConfigured:
+		IsDNSWildcardNotConfigured,
+		IsMTUValid,
+		IsTangConnectivityValid:
 		return "network", nil
 	case HasInventory,
 		HasMinCPUCores,
@@ -70,7 +77,10 @@ func (v validationID) category() (string, error) {
 		IsHostnameUnique,
 		IsHostnameValid,
 		CompatibleWithClusterPlatform,
-		DiskEncryptionRequirementsSatisfied:
+		DiskEncryptionRequirementsSatisfied,
+		IsTPMValid,
+		IsCriticalInventoryUnchanged,
+		CompatibleWithFIPS:
 		return "hardware", nil
 	case AreLsoRequirementsSatisfied,
 		AreOcsRequirementsSatisfied,
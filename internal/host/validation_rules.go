@@ -0,0 +1,267 @@
+package host
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/yaml"
+)
+
+// CustomValidationsCategory is the category of the validations info the results of the validation rules are reported in
+const CustomValidationsCategory = "custom"
+
+// Operators comparing the inventory values selected by a validation rule to the values of the rule
+const (
+	RuleOperatorEquals    = "equals"
+	RuleOperatorNotEquals = "not-equals"
+	RuleOperatorOneOf     = "one-of"
+	RuleOperatorMatches   = "matches"
+	RuleOperatorInCIDR    = "in-cidr"
+	RuleOperatorMin       = "min"
+	RuleOperatorMax       = "max"
+)
+
+// Quantifiers of the inventory values selected by a validation rule that have to satisfy it
+const (
+	RuleMatchAll = "all"
+	RuleMatchAny = "any"
+)
+
+// HostValidationRule is a user defined host validation. The JSONPath expression selects values of the host inventory,
+// which are compared to the values of the rule by the operator. For example, the rule
+//
+//   {"id": "bmc-network", "path": "{.bmc_address}", "operator": "in-cidr", "values": ["10.20.0.0/16"]}
+//
+// requires the BMC address of the hosts to be in 10.20.0.0/16.
+type HostValidationRule struct {
+	// The ID of the validation, reported with the custom- prefix
+	ID string `json:"id"`
+	// The message reported when the validation fails. A message is generated from the rule when it is not set.
+	Message  string   `json:"message,omitempty"`
+	Path     string   `json:"path"`
+	Operator string   `json:"operator"`
+	Values   []string `json:"values"`
+	// Whether all (default) or any of the selected values have to satisfy the rule
+	Match string `json:"match,omitempty"`
+	// The roles of the hosts the rule applies to. The rule applies to all the hosts when not set.
+	Roles []models.HostRole `json:"roles,omitempty"`
+
+	regexp *regexp.Regexp
+	cidrs  []*net.IPNet
+	bound  float64
+}
+
+// HostValidationRules are decoded from a JSON or YAML list of rules
+type HostValidationRules []*HostValidationRule
+
+func (r *HostValidationRules) Decode(value string) error {
+	rules := HostValidationRules{}
+	if strings.TrimSpace(value) != "" {
+		if err := yaml.UnmarshalStrict([]byte(value), &rules); err != nil {
+			return errors.Wrap(err, "failed to parse host validation rules")
+		}
+	}
+	ids := make(map[string]bool)
+	for _, rule := range rules {
+		if err := rule.compile(); err != nil {
+			return errors.Wrapf(err, "invalid host validation rule %s", rule.ID)
+		}
+		if ids[rule.ID] {
+			return errors.Errorf("duplicate host validation rule %s", rule.ID)
+		}
+		ids[rule.ID] = true
+	}
+	*r = rules
+	return nil
+}
+
+func (r *HostValidationRule) compile() error {
+	if r.ID == "" {
+		return errors.New("missing id")
+	}
+	if r.Match == "" {
+		r.Match = RuleMatchAll
+	}
+	if !funk.ContainsString([]string{RuleMatchAll, RuleMatchAny}, r.Match) {
+		return errors.Errorf("unsupported match %s", r.Match)
+	}
+	if _, err := r.newParser(); err != nil {
+		return errors.Wrapf(err, "invalid path %s", r.Path)
+	}
+	if len(r.Values) == 0 {
+		return errors.New("missing values")
+	}
+
+	switch r.Operator {
+	case RuleOperatorEquals, RuleOperatorNotEquals, RuleOperatorMin, RuleOperatorMax, RuleOperatorMatches:
+		if len(r.Values) != 1 {
+			return errors.Errorf("operator %s expects a single value", r.Operator)
+		}
+	}
+	switch r.Operator {
+	case RuleOperatorEquals, RuleOperatorNotEquals, RuleOperatorOneOf:
+	case RuleOperatorMatches:
+		var err error
+		if r.regexp, err = regexp.Compile(r.Values[0]); err != nil {
+			return errors.Wrapf(err, "invalid regular expression %s", r.Values[0])
+		}
+	case RuleOperatorInCIDR:
+		for _, value := range r.Values {
+			_, cidr, err := net.ParseCIDR(value)
+			if err != nil {
+				return errors.Wrapf(err, "invalid CIDR %s", value)
+			}
+			r.cidrs = append(r.cidrs, cidr)
+		}
+	case RuleOperatorMin, RuleOperatorMax:
+		var err error
+		if r.bound, err = strconv.ParseFloat(r.Values[0], 64); err != nil {
+			return errors.Wrapf(err, "invalid number %s", r.Values[0])
+		}
+	default:
+		return errors.Errorf("unsupported operator %s", r.Operator)
+	}
+	return nil
+}
+
+// newParser parses the path of the rule. A JSONPath keeps state while it is evaluated, so the rules, which are
+// evaluated concurrently, parse a new one for every evaluation.
+func (r *HostValidationRule) newParser() (*jsonpath.JSONPath, error) {
+	parser := jsonpath.New(r.ID).AllowMissingKeys(true)
+	if err := parser.Parse(r.Path); err != nil {
+		return nil, err
+	}
+	return parser, nil
+}
+
+func (r *HostValidationRule) validationID() validationID {
+	return validationID("custom-" + r.ID)
+}
+
+func (r *HostValidationRule) appliesTo(host *models.Host) bool {
+	return len(r.Roles) == 0 || funk.Contains(r.Roles, common.GetEffectiveRole(host))
+}
+
+// evaluate returns the status of the rule for the host and the values of the inventory it failed on
+func (r *HostValidationRule) evaluate(c *validationContext) (ValidationStatus, []string) {
+	if c.inventory == nil {
+		return ValidationPending, nil
+	}
+
+	// The rules are evaluated on the JSON document of the inventory, so the paths use the same field names as the API
+	var document interface{}
+	if err := json.Unmarshal([]byte(c.host.Inventory), &document); err != nil {
+		return ValidationError, nil
+	}
+	parser, err := r.newParser()
+	if err != nil {
+		return ValidationError, nil
+	}
+	results, err := parser.FindResults(document)
+	if err != nil {
+		return ValidationError, nil
+	}
+
+	var values []string
+	for _, result := range results {
+		for _, value := range result {
+			values = append(values, ruleValueString(value))
+		}
+	}
+	if len(values) == 0 {
+		return ValidationFailure, nil
+	}
+
+	var failed []string
+	for _, value := range values {
+		if !r.satisfiedBy(value) {
+			failed = append(failed, value)
+		}
+	}
+	if (r.Match == RuleMatchAll && len(failed) == 0) || (r.Match == RuleMatchAny && len(failed) < len(values)) {
+		return ValidationSuccess, nil
+	}
+	return ValidationFailure, failed
+}
+
+func (r *HostValidationRule) satisfiedBy(value string) bool {
+	switch r.Operator {
+	case RuleOperatorEquals:
+		return value == r.Values[0]
+	case RuleOperatorNotEquals:
+		return value != r.Values[0]
+	case RuleOperatorOneOf:
+		return funk.ContainsString(r.Values, value)
+	case RuleOperatorMatches:
+		return r.regexp.MatchString(value)
+	case RuleOperatorInCIDR:
+		// Interface addresses are reported with their prefix length
+		ip := net.ParseIP(value)
+		if ip == nil {
+			if addr, _, err := net.ParseCIDR(value); err == nil {
+				ip = addr
+			}
+		}
+		for _, cidr := range r.cidrs {
+			if ip != nil && cidr.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	case RuleOperatorMin, RuleOperatorMax:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return false
+		}
+		if r.Operator == RuleOperatorMin {
+			return number >= r.bound
+		}
+		return number <= r.bound
+	}
+	return false
+}
+
+func (r *HostValidationRule) format(status ValidationStatus, failed []string) string {
+	switch status {
+	case ValidationSuccess:
+		return fmt.Sprintf("Host satisfies validation rule %s", r.ID)
+	case ValidationPending:
+		return "Missing inventory"
+	case ValidationError:
+		return fmt.Sprintf("Failed to evaluate validation rule %s", r.ID)
+	}
+	message := r.Message
+	if message == "" {
+		message = fmt.Sprintf("Host does not satisfy validation rule %s: %s %s %s", r.ID, r.Path, r.Operator, strings.Join(r.Values, ", "))
+	}
+	if len(failed) == 0 {
+		return fmt.Sprintf("%s (no value found at %s)", message, r.Path)
+	}
+	return fmt.Sprintf("%s (found %s)", message, strings.Join(failed, ", "))
+}
+
+func ruleValueString(value reflect.Value) string {
+	if value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, 64)
+	case reflect.Map, reflect.Slice:
+		data, _ := json.Marshal(value.Interface())
+		return string(data)
+	case reflect.Invalid:
+		return ""
+	}
+	return fmt.Sprint(value.Interface())
+}
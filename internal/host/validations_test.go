@@ -198,6 +198,11 @@ var _ = Describe("Validations test", func() {
 				eventstest.WithHostIdMatcher(h.ID.String()),
 				eventstest.WithInfraEnvIdMatcher(h.InfraEnvID.String()),
 			))
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.HostRoleAssignmentExplainedEventName),
+				eventstest.WithHostIdMatcher(h.ID.String()),
+				eventstest.WithInfraEnvIdMatcher(h.InfraEnvID.String()),
+			))
 			err := m.RefreshRole(ctx, &h, db)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -481,4 +486,479 @@ var _ = Describe("Validations test", func() {
 			Expect(found).To(BeFalse())
 		})
 	})
+
+	Context("TPM validation", func() {
+		getTPMValidationResult := func(validationsInfo string) (ValidationStatus, string, bool) {
+			var validationsRes ValidationsStatus
+			err := json.Unmarshal([]byte(validationsInfo), &validationsRes)
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, vl := range validationsRes {
+				for _, v := range vl {
+					if v.ID == IsTPMValid {
+						return v.Status, v.Message, true
+					}
+				}
+			}
+			return ValidationStatus(""), "", false
+		}
+
+		inventoryWithTPM := func(tpmVersion string, tpm *models.Tpm) string {
+			var inventory models.Inventory
+			Expect(json.Unmarshal([]byte(common.GenerateTestInventoryWithTpmVersion(tpmVersion)), &inventory)).To(Succeed())
+			inventory.Tpm = tpm
+			b, err := json.Marshal(&inventory)
+			Expect(err).ToNot(HaveOccurred())
+			return string(b)
+		}
+
+		createCluster := func(enableOn, mode string) {
+			c := hostutil.GenerateTestCluster(clusterID, common.TestIPv4Networking.MachineNetworks)
+			c.DiskEncryption = &models.DiskEncryption{
+				EnableOn: swag.String(enableOn),
+				Mode:     swag.String(mode),
+			}
+			Expect(db.Create(&c).Error).ToNot(HaveOccurred())
+		}
+
+		for _, test := range []struct {
+			name            string
+			tpmVersion      string
+			tpm             *models.Tpm
+			expectedStatus  ValidationStatus
+			expectedMessage string
+		}{
+			{
+				name:            "usable TPM",
+				tpmVersion:      models.InventoryTpmVersionNr20,
+				tpm:             &models.Tpm{Enabled: true, PcrBanks: []string{"sha256"}},
+				expectedStatus:  ValidationSuccess,
+				expectedMessage: "The host's TPM can be used to encrypt the installation disk",
+			},
+			{
+				name:            "agent without TPM attestation data",
+				tpmVersion:      models.InventoryTpmVersionNr20,
+				expectedStatus:  ValidationSuccess,
+				expectedMessage: "The host's TPM can be used to encrypt the installation disk",
+			},
+			{
+				name:            "TPM 1.2",
+				tpmVersion:      models.InventoryTpmVersionNr12,
+				tpm:             &models.Tpm{Enabled: true, PcrBanks: []string{"sha1"}},
+				expectedStatus:  ValidationFailure,
+				expectedMessage: "The host's TPM version is not supported, expected-version: 2.0, actual-version: 1.2",
+			},
+			{
+				name:            "disabled TPM",
+				tpmVersion:      models.InventoryTpmVersionNr20,
+				tpm:             &models.Tpm{Enabled: false},
+				expectedStatus:  ValidationFailure,
+				expectedMessage: "The host's TPM is disabled, make sure TPM is enabled in host's BIOS",
+			},
+			{
+				name:            "no active PCR bank",
+				tpmVersion:      models.InventoryTpmVersionNr20,
+				tpm:             &models.Tpm{Enabled: true},
+				expectedStatus:  ValidationFailure,
+				expectedMessage: "The host's TPM has no active PCR bank",
+			},
+		} {
+			test := test
+			It(test.name, func() {
+				createCluster(models.DiskEncryptionEnableOnMasters, models.DiskEncryptionModeTpmv2)
+
+				h := hostutil.GenerateTestHostByKind(hostID, infraEnvID, &clusterID, models.HostStatusDiscovering, models.HostKindHost, models.HostRoleMaster)
+				h.Inventory = inventoryWithTPM(test.tpmVersion, test.tpm)
+				Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+
+				mockAndRefreshStatus(&h)
+
+				status, message, found := getTPMValidationResult(hostutil.GetHostFromDB(*h.ID, h.InfraEnvID, db).ValidationsInfo)
+				Expect(found).To(BeTrue())
+				Expect(status).To(Equal(test.expectedStatus))
+				Expect(message).To(Equal(test.expectedMessage))
+			})
+		}
+
+		It("un-affected roles", func() {
+			createCluster(models.DiskEncryptionEnableOnMasters, models.DiskEncryptionModeTpmv2)
+
+			h := hostutil.GenerateTestHostByKind(hostID, infraEnvID, &clusterID, models.HostStatusDiscovering, models.HostKindHost, models.HostRoleWorker)
+			h.Inventory = inventoryWithTPM(models.InventoryTpmVersionNr12, nil)
+			Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+
+			mockAndRefreshStatus(&h)
+
+			_, _, found := getTPMValidationResult(hostutil.GetHostFromDB(*h.ID, h.InfraEnvID, db).ValidationsInfo)
+			Expect(found).To(BeFalse())
+		})
+
+		It("tang mode", func() {
+			createCluster(models.DiskEncryptionEnableOnAll, models.DiskEncryptionModeTang)
+
+			h := hostutil.GenerateTestHostByKind(hostID, infraEnvID, &clusterID, models.HostStatusDiscovering, models.HostKindHost, models.HostRoleMaster)
+			h.Inventory = inventoryWithTPM(models.InventoryTpmVersionNone, nil)
+			Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+
+			mockAndRefreshStatus(&h)
+
+			_, _, found := getTPMValidationResult(hostutil.GetHostFromDB(*h.ID, h.InfraEnvID, db).ValidationsInfo)
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("Tang connectivity validation", func() {
+		const tangServers = `[{"url":"http://tang1.example.com:7500","thumbprint":"thumbprint1"},{"url":"http://tang2.example.com:7500","thumbprint":"thumbprint2"}]`
+
+		getTangValidationResult := func(validationsInfo string) (ValidationStatus, string, bool) {
+			var validationsRes ValidationsStatus
+			err := json.Unmarshal([]byte(validationsInfo), &validationsRes)
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, vl := range validationsRes {
+				for _, v := range vl {
+					if v.ID == IsTangConnectivityValid {
+						return v.Status, v.Message, true
+					}
+				}
+			}
+			return ValidationStatus(""), "", false
+		}
+
+		tangConnectivity := func(servers ...*models.TangConnectivityResponseServer) string {
+			b, err := json.Marshal(&models.TangConnectivityResponse{TangServers: servers})
+			Expect(err).ToNot(HaveOccurred())
+			return string(b)
+		}
+
+		BeforeEach(func() {
+			c := hostutil.GenerateTestCluster(clusterID, common.TestIPv4Networking.MachineNetworks)
+			c.DiskEncryption = &models.DiskEncryption{
+				EnableOn:    swag.String(models.DiskEncryptionEnableOnMasters),
+				Mode:        swag.String(models.DiskEncryptionModeTang),
+				TangServers: tangServers,
+			}
+			Expect(db.Create(&c).Error).ToNot(HaveOccurred())
+		})
+
+		for _, test := range []struct {
+			name             string
+			tangConnectivity string
+			expectedStatus   ValidationStatus
+			expectedMessage  string
+		}{
+			{
+				name:            "not checked yet",
+				expectedStatus:  ValidationPending,
+				expectedMessage: "The Tang servers have not been checked yet",
+			},
+			{
+				name: "all servers verified",
+				tangConnectivity: tangConnectivity(
+					&models.TangConnectivityResponseServer{URL: swag.String("http://tang1.example.com:7500"), Thumbprints: []string{"other", "thumbprint1"}},
+					&models.TangConnectivityResponseServer{URL: swag.String("http://tang2.example.com:7500"), Thumbprints: []string{"thumbprint2"}},
+				),
+				expectedStatus:  ValidationSuccess,
+				expectedMessage: "The Tang servers are reachable and their thumbprints match",
+			},
+			{
+				name: "unreachable server and thumbprint mismatch",
+				tangConnectivity: tangConnectivity(
+					&models.TangConnectivityResponseServer{URL: swag.String("http://tang1.example.com:7500"), Error: "connection refused"},
+					&models.TangConnectivityResponseServer{URL: swag.String("http://tang2.example.com:7500"), Thumbprints: []string{"other"}},
+				),
+				expectedStatus: ValidationFailure,
+				expectedMessage: "Failed to verify the Tang servers from the host: http://tang1.example.com:7500 is not reachable: connection refused, " +
+					"http://tang2.example.com:7500 does not advertise a key with thumbprint thumbprint2",
+			},
+			{
+				name: "server missing from the report",
+				tangConnectivity: tangConnectivity(
+					&models.TangConnectivityResponseServer{URL: swag.String("http://tang1.example.com:7500"), Thumbprints: []string{"thumbprint1"}},
+				),
+				expectedStatus:  ValidationFailure,
+				expectedMessage: "Failed to verify the Tang servers from the host: http://tang2.example.com:7500 was not checked",
+			},
+			{
+				name:             "invalid report",
+				tangConnectivity: "invalid",
+				expectedStatus:   ValidationError,
+				expectedMessage:  "Parse error for Tang connectivity check result",
+			},
+		} {
+			test := test
+			It(test.name, func() {
+				h := hostutil.GenerateTestHostByKind(hostID, infraEnvID, &clusterID, models.HostStatusDiscovering, models.HostKindHost, models.HostRoleMaster)
+				h.Inventory = common.GenerateTestDefaultInventory()
+				h.TangConnectivity = test.tangConnectivity
+				Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+
+				mockAndRefreshStatus(&h)
+
+				status, message, found := getTangValidationResult(hostutil.GetHostFromDB(*h.ID, h.InfraEnvID, db).ValidationsInfo)
+				Expect(found).To(BeTrue())
+				Expect(status).To(Equal(test.expectedStatus))
+				Expect(message).To(Equal(test.expectedMessage))
+			})
+		}
+
+		It("un-affected roles", func() {
+			h := hostutil.GenerateTestHostByKind(hostID, infraEnvID, &clusterID, models.HostStatusDiscovering, models.HostKindHost, models.HostRoleWorker)
+			h.Inventory = common.GenerateTestDefaultInventory()
+			Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+
+			mockAndRefreshStatus(&h)
+
+			_, _, found := getTangValidationResult(hostutil.GetHostFromDB(*h.ID, h.InfraEnvID, db).ValidationsInfo)
+			Expect(found).To(BeFalse())
+		})
+
+		It("step disabled", func() {
+			config := *defaultConfig
+			config.DisabledSteps = []models.StepType{models.StepTypeTangConnectivityCheck}
+			pr := registry.NewMockProviderRegistry(ctrl)
+			pr.EXPECT().IsHostSupported(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+			m = NewManager(common.GetTestLog(), db, mockEvents, mockHwValidator, nil, createValidatorCfg(), mockMetric, &config, nil, mockOperators, pr)
+
+			h := hostutil.GenerateTestHostByKind(hostID, infraEnvID, &clusterID, models.HostStatusDiscovering, models.HostKindHost, models.HostRoleMaster)
+			h.Inventory = common.GenerateTestDefaultInventory()
+			Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+
+			mockAndRefreshStatus(&h)
+
+			_, _, found := getTangValidationResult(hostutil.GetHostFromDB(*h.ID, h.InfraEnvID, db).ValidationsInfo)
+			Expect(found).To(BeFalse())
+		})
+
+		It("step unsupported by the agent", func() {
+			h := hostutil.GenerateTestHostByKind(hostID, infraEnvID, &clusterID, models.HostStatusDiscovering, models.HostKindHost, models.HostRoleMaster)
+			h.Inventory = common.GenerateTestDefaultInventory()
+			h.TangConnectivity = TangConnectivityCheckUnsupported
+			Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+
+			mockAndRefreshStatus(&h)
+
+			_, _, found := getTangValidationResult(hostutil.GetHostFromDB(*h.ID, h.InfraEnvID, db).ValidationsInfo)
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("Critical inventory validation", func() {
+		getInventoryValidationResult := func(validationsInfo string) (ValidationStatus, string, bool) {
+			var validationsRes ValidationsStatus
+			err := json.Unmarshal([]byte(validationsInfo), &validationsRes)
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, vl := range validationsRes {
+				for _, v := range vl {
+					if v.ID == IsCriticalInventoryUnchanged {
+						return v.Status, v.Message, true
+					}
+				}
+			}
+			return ValidationStatus(""), "", false
+		}
+
+		BeforeEach(func() {
+			c := hostutil.GenerateTestCluster(clusterID, common.TestIPv4Networking.MachineNetworks)
+			Expect(db.Create(&c).Error).ToNot(HaveOccurred())
+		})
+
+		It("not reviewed", func() {
+			h := hostutil.GenerateTestHostByKind(hostID, infraEnvID, &clusterID, models.HostStatusDiscovering, models.HostKindHost, models.HostRoleWorker)
+			h.Inventory = common.GenerateTestDefaultInventory()
+			Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+
+			mockAndRefreshStatus(&h)
+
+			_, _, found := getInventoryValidationResult(hostutil.GetHostFromDB(*h.ID, h.InfraEnvID, db).ValidationsInfo)
+			Expect(found).To(BeFalse())
+		})
+
+		It("reviewed without drift", func() {
+			h := hostutil.GenerateTestHostByKind(hostID, infraEnvID, &clusterID, models.HostStatusDiscovering, models.HostKindHost, models.HostRoleWorker)
+			h.Inventory = common.GenerateTestDefaultInventory()
+			reviewedSnapshotID := strfmt.UUID(uuid.New().String())
+			h.InventoryReviewedSnapshotID = &reviewedSnapshotID
+			Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+
+			mockAndRefreshStatus(&h)
+
+			status, message, found := getInventoryValidationResult(hostutil.GetHostFromDB(*h.ID, h.InfraEnvID, db).ValidationsInfo)
+			Expect(found).To(BeTrue())
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("The critical hardware of the host did not change since it was reviewed"))
+		})
+
+		It("reviewed with drift", func() {
+			b, err := json.Marshal([]*models.HostInventoryChange{
+				{Attribute: swag.String("cpu.count"), From: "16", To: "8", Critical: true},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			h := hostutil.GenerateTestHostByKind(hostID, infraEnvID, &clusterID, models.HostStatusDiscovering, models.HostKindHost, models.HostRoleWorker)
+			h.Inventory = common.GenerateTestDefaultInventory()
+			reviewedSnapshotID := strfmt.UUID(uuid.New().String())
+			h.InventoryReviewedSnapshotID = &reviewedSnapshotID
+			h.InventoryDrift = string(b)
+			Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+
+			mockAndRefreshStatus(&h)
+
+			status, message, found := getInventoryValidationResult(hostutil.GetHostFromDB(*h.ID, h.InfraEnvID, db).ValidationsInfo)
+			Expect(found).To(BeTrue())
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal(`The critical hardware of the host changed since it was reviewed: cpu.count changed from "16" to "8". Review the host again to accept the changes`))
+		})
+	})
+
+	Context("FIPS validation", func() {
+		getFIPSValidationResult := func(validationsInfo string) (ValidationStatus, string, bool) {
+			var validationsRes ValidationsStatus
+			err := json.Unmarshal([]byte(validationsInfo), &validationsRes)
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, vl := range validationsRes {
+				for _, v := range vl {
+					if v.ID == CompatibleWithFIPS {
+						return v.Status, v.Message, true
+					}
+				}
+			}
+			return ValidationStatus(""), "", false
+		}
+
+		createCluster := func(fips bool) {
+			c := hostutil.GenerateTestCluster(clusterID, common.TestIPv4Networking.MachineNetworks)
+			c.OpenshiftVersion = "4.9.0"
+			c.Fips = swag.Bool(fips)
+			Expect(db.Create(&c).Error).ToNot(HaveOccurred())
+		}
+
+		createHost := func(cpuArchitecture string) *models.Host {
+			b, err := json.Marshal(&models.Inventory{CPU: &models.CPU{Architecture: cpuArchitecture, Count: 8}})
+			Expect(err).ToNot(HaveOccurred())
+			h := hostutil.GenerateTestHostByKind(hostID, infraEnvID, &clusterID, models.HostStatusDiscovering, models.HostKindHost, models.HostRoleWorker)
+			h.Inventory = string(b)
+			Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+			return &h
+		}
+
+		It("FIPS mode disabled", func() {
+			createCluster(false)
+			h := createHost("arm64")
+
+			mockAndRefreshStatus(h)
+
+			_, _, found := getFIPSValidationResult(hostutil.GetHostFromDB(*h.ID, h.InfraEnvID, db).ValidationsInfo)
+			Expect(found).To(BeFalse())
+		})
+
+		It("compatible host", func() {
+			createCluster(true)
+			h := createHost(common.DefaultCPUArchitecture)
+
+			mockAndRefreshStatus(h)
+
+			status, message, found := getFIPSValidationResult(hostutil.GetHostFromDB(*h.ID, h.InfraEnvID, db).ValidationsInfo)
+			Expect(found).To(BeTrue())
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("Host is compatible with FIPS mode"))
+		})
+
+		It("incompatible CPU architecture", func() {
+			createCluster(true)
+			h := createHost("arm64")
+
+			mockAndRefreshStatus(h)
+
+			status, message, found := getFIPSValidationResult(hostutil.GetHostFromDB(*h.ID, h.InfraEnvID, db).ValidationsInfo)
+			Expect(found).To(BeTrue())
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal("Host is not compatible with FIPS mode: FIPS mode is not supported for CPU architecture arm64, it is supported for x86_64 only; either disable this host or disable FIPS mode"))
+		})
+	})
+
+	Context("MTU validation", func() {
+		getMTUValidationResult := func(validationsInfo string) (ValidationStatus, string, bool) {
+			var validationsRes ValidationsStatus
+			err := json.Unmarshal([]byte(validationsInfo), &validationsRes)
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, vl := range validationsRes {
+				for _, v := range vl {
+					if v.ID == IsMTUValid {
+						return v.Status, v.Message, true
+					}
+				}
+			}
+			return ValidationStatus(""), "", false
+		}
+
+		createHostWithMTU := func(id strfmt.UUID, address string, mtu int64) *models.Host {
+			h := hostutil.GenerateTestHost(id, infraEnvID, clusterID, models.HostStatusKnown)
+			inventory, err := common.UnmarshalInventory(hostutil.GenerateMasterInventory())
+			Expect(err).ToNot(HaveOccurred())
+			inventory.Interfaces[0].IPV4Addresses = []string{address}
+			inventory.Interfaces[0].Mtu = mtu
+			h.Inventory, err = common.MarshalInventory(inventory)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(db.Create(&h).Error).ToNot(HaveOccurred())
+			return &h
+		}
+
+		refreshAndGetMTUValidation := func(h *models.Host) (ValidationStatus, string) {
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), gomock.Any()).AnyTimes()
+			mockAndRefreshStatusWithoutEvents(h)
+			status, message, ok := getMTUValidationResult(hostutil.GetHostFromDB(*h.ID, infraEnvID, db).ValidationsInfo)
+			Expect(ok).To(BeTrue())
+			return status, message
+		}
+
+		BeforeEach(func() {
+			c := hostutil.GenerateTestCluster(clusterID, common.TestIPv4Networking.MachineNetworks)
+			Expect(db.Create(&c).Error).ToNot(HaveOccurred())
+		})
+
+		It("MTU is not reported", func() {
+			h := createHostWithMTU(hostID, "1.2.3.4/24", 0)
+			createHostWithMTU(strfmt.UUID(uuid.New().String()), "1.2.3.5/24", 9000)
+			status, message := refreshAndGetMTUValidation(h)
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("The MTU of the host is consistent with the other hosts of the machine network."))
+		})
+
+		It("MTU is the one of most hosts", func() {
+			h := createHostWithMTU(hostID, "1.2.3.4/24", 9000)
+			createHostWithMTU(strfmt.UUID(uuid.New().String()), "1.2.3.5/24", 9000)
+			createHostWithMTU(strfmt.UUID(uuid.New().String()), "1.2.3.6/24", 1500)
+			status, _ := refreshAndGetMTUValidation(h)
+			Expect(status).To(Equal(ValidationSuccess))
+		})
+
+		It("MTU differs from the one of most hosts", func() {
+			h := createHostWithMTU(hostID, "1.2.3.4/24", 1500)
+			createHostWithMTU(strfmt.UUID(uuid.New().String()), "1.2.3.5/24", 9000)
+			createHostWithMTU(strfmt.UUID(uuid.New().String()), "1.2.3.6/24", 9000)
+			status, message := refreshAndGetMTUValidation(h)
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal("The MTU of the host on the machine network is 1500 while it is 9000 on most hosts."))
+		})
+
+		It("large packets did not reach the other hosts", func() {
+			h := createHostWithMTU(hostID, "1.2.3.4/24", 9000)
+			createHostWithMTU(strfmt.UUID(uuid.New().String()), "1.2.3.5/24", 9000)
+			report := models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{{
+				L3Connectivity: []*models.L3Connectivity{
+					{RemoteIPAddress: "1.2.3.5", Successful: true, LargePacketSize: 9000, LargePacketSuccessful: false},
+				},
+			}}}
+			b, err := json.Marshal(&report)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(db.Model(h).Update("connectivity", string(b)).Error).ToNot(HaveOccurred())
+			h.Connectivity = string(b)
+			status, message := refreshAndGetMTUValidation(h)
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(ContainSubstring("did not reach 1.2.3.5"))
+		})
+	})
 })
@@ -244,11 +244,12 @@ func boolValue(b bool) ValidationStatus {
 }
 
 type validator struct {
-	log              logrus.FieldLogger
-	hwValidatorCfg   *hardware.ValidatorCfg
-	hwValidator      hardware.Validator
-	operatorsAPI     operators.API
-	providerRegistry registry.ProviderRegistry
+	log                           logrus.FieldLogger
+	hwValidatorCfg                *hardware.ValidatorCfg
+	hwValidator                   hardware.Validator
+	operatorsAPI                  operators.API
+	providerRegistry              registry.ProviderRegistry
+	tangConnectivityCheckDisabled bool
 }
 
 func (v *validator) isConnected(c *validationContext) ValidationStatus {
@@ -462,6 +463,202 @@ func (v *validator) printDiskEncryptionRequirementsSatisfied(c *validationContex
 	}
 }
 
+// isTPMValid checks that the TPM of the hosts whose disks are encrypted using tpmv2 is usable: a TPM 2.0 that is
+// enabled and has at least one active PCR bank. Agents not reporting the TPM attestation data are only checked
+// against the TPM version.
+func (v *validator) isTPMValid(c *validationContext) ValidationStatus {
+	if c.infraEnv != nil || swag.StringValue(c.cluster.DiskEncryption.EnableOn) == models.DiskEncryptionEnableOnNone {
+		return ValidationSuccessSuppressOutput
+	}
+	if c.inventory == nil {
+		return ValidationPending
+	}
+
+	if hostutil.IsDay2Host(c.host) {
+		luks, err := v.getDiskEncryptionForDay2(c.host)
+		if err != nil {
+			return ValidationPending
+		}
+		if luks == nil || !swag.BoolValue(luks.Clevis.Tpm2) {
+			return ValidationSuccessSuppressOutput
+		}
+	} else {
+		role := common.GetEffectiveRole(c.host)
+		if role == models.HostRoleAutoAssign {
+			return ValidationPending
+		}
+		if !isDiskEncryptionEnabledForRole(*c.cluster.DiskEncryption, role) ||
+			swag.StringValue(c.cluster.DiskEncryption.Mode) != models.DiskEncryptionModeTpmv2 {
+			return ValidationSuccessSuppressOutput
+		}
+	}
+
+	if c.inventory.TpmVersion != models.InventoryTpmVersionNr20 {
+		return ValidationFailure
+	}
+	if c.inventory.Tpm != nil && (!c.inventory.Tpm.Enabled || len(c.inventory.Tpm.PcrBanks) == 0) {
+		return ValidationFailure
+	}
+	return ValidationSuccess
+}
+
+func (v *validator) printTPMValid(c *validationContext, status ValidationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return "The host's TPM can be used to encrypt the installation disk"
+	case ValidationFailure:
+		switch {
+		case c.inventory.TpmVersion == models.InventoryTpmVersionNone:
+			return "TPM version could not be found, make sure TPM is enabled in host's BIOS"
+		case c.inventory.TpmVersion != models.InventoryTpmVersionNr20:
+			return fmt.Sprintf("The host's TPM version is not supported, expected-version: %s, actual-version: %s",
+				models.InventoryTpmVersionNr20, c.inventory.TpmVersion)
+		case !c.inventory.Tpm.Enabled:
+			return "The host's TPM is disabled, make sure TPM is enabled in host's BIOS"
+		default:
+			return "The host's TPM has no active PCR bank"
+		}
+	case ValidationPending:
+		if c.inventory == nil {
+			return "Missing host inventory"
+		}
+		return "Missing role assignment"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+// getTangConnectivityFailures compares the advertisements fetched from the host with the tang servers of the cluster,
+// and returns the tang servers that could not be reached or whose thumbprint does not match
+// TangConnectivityCheckUnsupported is stored as the Tang connectivity report of the hosts whose agent can't run the check
+const TangConnectivityCheckUnsupported = "unsupported"
+
+func getTangConnectivityFailures(c *validationContext) ([]string, error) {
+	tangServers, err := common.UnmarshalTangServers(c.cluster.DiskEncryption.TangServers)
+	if err != nil {
+		return nil, err
+	}
+	var response models.TangConnectivityResponse
+	if err = json.Unmarshal([]byte(c.host.TangConnectivity), &response); err != nil {
+		return nil, err
+	}
+	responses := make(map[string]*models.TangConnectivityResponseServer, len(response.TangServers))
+	for _, server := range response.TangServers {
+		responses[swag.StringValue(server.URL)] = server
+	}
+	var failures []string
+	for _, tangServer := range tangServers {
+		server, ok := responses[tangServer.Url]
+		switch {
+		case !ok:
+			failures = append(failures, fmt.Sprintf("%s was not checked", tangServer.Url))
+		case server.Error != "":
+			failures = append(failures, fmt.Sprintf("%s is not reachable: %s", tangServer.Url, server.Error))
+		case !funk.ContainsString(server.Thumbprints, tangServer.Thumbprint):
+			failures = append(failures, fmt.Sprintf("%s does not advertise a key with thumbprint %s", tangServer.Url, tangServer.Thumbprint))
+		}
+	}
+	return failures, nil
+}
+
+func (v *validator) isTangConnectivityValid(c *validationContext) ValidationStatus {
+	// The Tang servers can't be checked when the step is disabled or the agent can't run it, so they don't block the installation
+	if v.tangConnectivityCheckDisabled || c.host.TangConnectivity == TangConnectivityCheckUnsupported {
+		return ValidationSuccessSuppressOutput
+	}
+	if c.infraEnv != nil || hostutil.IsDay2Host(c.host) ||
+		swag.StringValue(c.cluster.DiskEncryption.EnableOn) == models.DiskEncryptionEnableOnNone ||
+		swag.StringValue(c.cluster.DiskEncryption.Mode) != models.DiskEncryptionModeTang {
+		return ValidationSuccessSuppressOutput
+	}
+	role := common.GetEffectiveRole(c.host)
+	if role == models.HostRoleAutoAssign {
+		return ValidationPending
+	}
+	if !isDiskEncryptionEnabledForRole(*c.cluster.DiskEncryption, role) {
+		return ValidationSuccessSuppressOutput
+	}
+	if c.host.TangConnectivity == "" {
+		return ValidationPending
+	}
+	failures, err := getTangConnectivityFailures(c)
+	if err != nil {
+		return ValidationError
+	}
+	return boolValue(len(failures) == 0)
+}
+
+func (v *validator) printTangConnectivityValid(c *validationContext, status ValidationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return "The Tang servers are reachable and their thumbprints match"
+	case ValidationFailure:
+		failures, _ := getTangConnectivityFailures(c)
+		return fmt.Sprintf("Failed to verify the Tang servers from the host: %s", strings.Join(failures, ", "))
+	case ValidationPending:
+		if c.host.TangConnectivity == "" {
+			return "The Tang servers have not been checked yet"
+		}
+		return "Missing role assignment"
+	case ValidationError:
+		return "Parse error for Tang connectivity check result"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+// isCriticalInventoryUnchanged fails when a critical attribute of the hardware of the host changed since the user
+// reviewed the host. The drift is computed on every inventory update, and cleared when the host is reviewed again.
+func (v *validator) isCriticalInventoryUnchanged(c *validationContext) ValidationStatus {
+	if c.infraEnv != nil || c.host.InventoryReviewedSnapshotID == nil {
+		return ValidationSuccessSuppressOutput
+	}
+	if c.host.InventoryDrift == "" {
+		return ValidationSuccess
+	}
+	return ValidationFailure
+}
+
+func (v *validator) printCriticalInventoryUnchanged(c *validationContext, status ValidationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return "The critical hardware of the host did not change since it was reviewed"
+	case ValidationFailure:
+		var changes []*models.HostInventoryChange
+		if err := json.Unmarshal([]byte(c.host.InventoryDrift), &changes); err != nil {
+			return "The critical hardware of the host changed since it was reviewed"
+		}
+		return fmt.Sprintf("The critical hardware of the host changed since it was reviewed: %s. Review the host again to accept the changes",
+			formatInventoryChanges(changes))
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+func (v *validator) compatibleWithFIPS(c *validationContext) ValidationStatus {
+	if c.infraEnv != nil || !swag.BoolValue(c.cluster.Fips) {
+		return ValidationSuccessSuppressOutput
+	}
+	if c.inventory == nil || c.inventory.CPU == nil {
+		return ValidationPending
+	}
+	return boolValue(common.ValidateFIPSSupport(c.cluster.OpenshiftVersion, c.inventory.CPU.Architecture) == nil)
+}
+
+func (v *validator) printCompatibleWithFIPS(c *validationContext, status ValidationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return "Host is compatible with FIPS mode"
+	case ValidationFailure:
+		return fmt.Sprintf("Host is not compatible with FIPS mode: %s; either disable this host or disable FIPS mode",
+			common.ValidateFIPSSupport(c.cluster.OpenshiftVersion, c.inventory.CPU.Architecture).Error())
+	case ValidationPending:
+		return "Missing inventory"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
 func (v *validator) printHasMinMemory(c *validationContext, status ValidationStatus) string {
 	switch status {
 	case ValidationSuccess:
@@ -622,9 +819,9 @@ func (v *validator) printBelongsToMachineCidr(c *validationContext, status Valid
 		if swag.StringValue(c.cluster.Kind) == models.ClusterKindAddHostsCluster {
 			return "No machine network CIDR validation needed: Day2 cluster"
 		}
-		return "Host belongs to all machine network CIDRs"
+		return "Host belongs to a machine network CIDR of every address family"
 	case ValidationFailure:
-		return "Host does not belong to machine network CIDRs. Verify that the host belongs to every CIDR listed under machine networks"
+		return "Host does not belong to machine network CIDRs. Verify that the host belongs to a CIDR of every address family listed under machine networks"
 	case ValidationPending:
 		return "Missing inventory or machine network CIDR"
 	default:
@@ -743,14 +940,21 @@ func (v *validator) belongsToL2MajorityGroup(c *validationContext, majorityGroup
 		return ValidationPending
 	}
 
-	// TODO(mko) This rule should be revised as soon as OCP supports multiple machineNetwork
-	//           entries using the same IP stack.
-	ret := true
+	// The host has to belong to the majority group of at least one machine network of every
+	// address family used by the cluster.
+	var hasIPv4, hasIPv6, inIPv4, inIPv6 bool
 	for _, machineNet := range c.cluster.MachineNetworks {
-		ret = ret && funk.Contains(majorityGroups[string(machineNet.Cidr)], *c.host.ID)
+		belongs := funk.Contains(majorityGroups[string(machineNet.Cidr)], *c.host.ID)
+		if network.IsIPV4CIDR(string(machineNet.Cidr)) {
+			hasIPv4 = true
+			inIPv4 = inIPv4 || belongs
+		} else {
+			hasIPv6 = true
+			inIPv6 = inIPv6 || belongs
+		}
 	}
 
-	return boolValue(ret)
+	return boolValue((!hasIPv4 || inIPv4) && (!hasIPv6 || inIPv6))
 }
 
 func (v *validator) belongsToL3MajorityGroup(c *validationContext, majorityGroups map[string][]strfmt.UUID) ValidationStatus {
@@ -1172,6 +1376,67 @@ func (v *validator) printDefaultRoute(c *validationContext, status ValidationSta
 	}
 }
 
+// validateMTU checks that the MTU of the host on the machine networks is the one of most hosts, and that the other
+// hosts were reached with packets of that size that must not be fragmented
+func (v *validator) validateMTU(c *validationContext) (ValidationStatus, string) {
+	if c.infraEnv != nil {
+		return ValidationSuccessSuppressOutput, ""
+	}
+	if hostutil.IsDay2Host(c.host) || (swag.BoolValue(c.cluster.UserManagedNetworking) && !common.IsSingleNodeCluster(c.cluster)) {
+		return ValidationSuccess, ""
+	}
+	if c.inventory == nil || !network.IsMachineCidrAvailable(c.cluster) {
+		return ValidationPending, ""
+	}
+
+	hostMTU, err := network.GetHostMachineNetworkMTU(c.cluster, c.host)
+	if err != nil {
+		v.log.WithError(err).Errorf("failed to get the MTU of host %s", c.host.ID)
+		return ValidationError, "Parse error while attempting to process the MTU of the hosts"
+	}
+	mtus, err := network.GetMachineNetworkMTUs(c.cluster)
+	if err != nil {
+		v.log.WithError(err).Errorf("failed to get the MTU of the hosts of cluster %s", c.cluster.ID)
+		return ValidationError, "Parse error while attempting to process the MTU of the hosts"
+	}
+	if majorityMTU := network.GetMajorityMTU(mtus); hostMTU != 0 && majorityMTU != 0 && hostMTU != majorityMTU {
+		return ValidationFailure, fmt.Sprintf("The MTU of the host on the machine network is %d while it is %d on most hosts.", hostMTU, majorityMTU)
+	}
+
+	if c.host.Connectivity == "" {
+		return ValidationSuccess, ""
+	}
+	report, err := hostutil.UnmarshalConnectivityReport(c.host.Connectivity)
+	if err != nil {
+		v.log.WithError(err).Errorf("Unable to unmarshall host connectivity for %s", c.host.ID)
+		return ValidationError, "Parse error while attempting to process the connectivity report"
+	}
+	if failed := network.GetFailedLargePacketAddresses(c.cluster, report); len(failed) > 0 {
+		return ValidationFailure, fmt.Sprintf("Packets of the size of the MTU that must not be fragmented did not reach %s, "+
+			"verify the MTU of the switches on the path.", strings.Join(failed, ", "))
+	}
+	return ValidationSuccess, ""
+}
+
+func (v *validator) isMTUValid(c *validationContext) ValidationStatus {
+	status, _ := v.validateMTU(c)
+	return status
+}
+
+func (v *validator) printMTUValid(c *validationContext, status ValidationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return "The MTU of the host is consistent with the other hosts of the machine network."
+	case ValidationFailure, ValidationError:
+		_, message := v.validateMTU(c)
+		return message
+	case ValidationPending:
+		return "Missing inventory or machine network CIDR"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
 func shouldValidateDnsResolution(c *validationContext) bool {
 	// Skip DNS resolution checks in IPI network mode
 	if !swag.BoolValue(c.cluster.UserManagedNetworking) {
@@ -14,8 +14,14 @@ func IsAdmin(ctx context.Context) bool {
 	return funk.Contains(allowedRoles, authPayload.Role)
 }
 
+// IsAuthorizedByRoleBinding returns true if the role bindings of the user granted access to every resource of the
+// request, in which case the resources aren't filtered by their owner
+func IsAuthorizedByRoleBinding(ctx context.Context) bool {
+	return ocm.PayloadFromContext(ctx).AuthorizedByRoleBinding
+}
+
 func AddUserFilter(ctx context.Context, query string) string {
-	if !IsAdmin(ctx) {
+	if !IsAdmin(ctx) && !IsAuthorizedByRoleBinding(ctx) {
 		if query != "" {
 			query += " and "
 		}
@@ -74,5 +74,15 @@ var _ = Describe("Identity", func() {
 
 			Expect(query).Should(Equal("id = ? and user_name = 'test_user'"))
 		})
+		It("user authorized by role bindings - non-empty query", func() {
+			payload := &ocm.AuthPayload{}
+			payload.Username = "test_user"
+			payload.Role = ocm.UserRole
+			payload.AuthorizedByRoleBinding = true
+			ctx = context.WithValue(ctx, restapi.AuthKey, payload)
+			query := AddUserFilter(ctx, "id = ?")
+
+			Expect(query).Should(Equal("id = ?"))
+		})
 	})
 })
@@ -6,9 +6,7 @@ import (
 	"os"
 	"path/filepath"
 
-	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
-	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/sirupsen/logrus"
@@ -39,14 +37,7 @@ func (g *dummyGenerator) Generate(_ context.Context, installConfig []byte, platf
 		return err
 	}
 
-	toUpload := fileNames[:]
-	for _, host := range g.cluster.Hosts {
-		if swag.StringValue(host.Status) != models.HostStatusDisabled {
-			toUpload = append(toUpload, hostutil.IgnitionFileName(host))
-		}
-	}
-
-	for _, fileName := range toUpload {
+	for _, fileName := range generatedFileNames(g.cluster) {
 		f, err := os.Create(filepath.Join(g.workDir, fileName))
 		if err != nil {
 			return err
@@ -76,6 +67,11 @@ func (g *dummyGenerator) UpdateEtcHosts(serviceIPs string) error {
 	return nil
 }
 
+// InstallerOutput returns nothing, as the dummy generator does not run openshift-install
+func (g *dummyGenerator) InstallerOutput() string {
+	return ""
+}
+
 const kubeconfig string = `
 clusters:
 - cluster:
@@ -382,6 +382,8 @@ type Generator interface {
 	Generate(ctx context.Context, installConfig []byte, platformType models.PlatformType) error
 	UploadToS3(ctx context.Context) error
 	UpdateEtcHosts(string) error
+	// InstallerOutput returns the output of the openshift-install commands run by Generate
+	InstallerOutput() string
 }
 
 // IgnitionBuilder defines the ignition formatting methods for the various images
@@ -405,6 +407,7 @@ type installerGenerator struct {
 	operatorsApi             operators.API
 	installInvoker           string
 	providerRegistry         registry.ProviderRegistry
+	installerOutput          bytes.Buffer
 }
 
 // IgnitionConfig contains the attributes required to build the discovery ignition file
@@ -460,6 +463,11 @@ func (g *installerGenerator) UploadToS3(ctx context.Context) error {
 	return uploadToS3(ctx, g.workDir, g.cluster, g.s3Client, g.log)
 }
 
+// InstallerOutput returns the combined output of the openshift-install commands run so far
+func (g *installerGenerator) InstallerOutput() string {
+	return g.installerOutput.String()
+}
+
 // Generate generates ignition files and applies modifications.
 func (g *installerGenerator) Generate(ctx context.Context, installConfig []byte, platformType models.PlatformType) error {
 	log := logutil.FromContext(ctx, g.log)
@@ -1060,14 +1068,7 @@ func sortHosts(hosts []*models.Host) ([]*models.Host, []*models.Host) {
 
 // UploadToS3 uploads the generated files to S3
 func uploadToS3(ctx context.Context, workDir string, cluster *common.Cluster, s3Client s3wrapper.API, log logrus.FieldLogger) error {
-	toUpload := fileNames[:]
-	for _, host := range cluster.Hosts {
-		if swag.StringValue(host.Status) != models.HostStatusDisabled {
-			toUpload = append(toUpload, hostutil.IgnitionFileName(host))
-		}
-	}
-
-	for _, fileName := range toUpload {
+	for _, fileName := range generatedFileNames(cluster) {
 		fullPath := filepath.Join(workDir, fileName)
 		key := filepath.Join(cluster.ID.String(), fileName)
 		err := s3Client.UploadFile(ctx, fullPath, key)
@@ -1085,6 +1086,29 @@ func uploadToS3(ctx context.Context, workDir string, cluster *common.Cluster, s3
 	return nil
 }
 
+// generatedFileNames returns the names of the files generated for the cluster, including the ignition of each host
+func generatedFileNames(cluster *common.Cluster) []string {
+	names := fileNames[:]
+	for _, host := range cluster.Hosts {
+		if swag.StringValue(host.Status) != models.HostStatusDisabled {
+			names = append(names, hostutil.IgnitionFileName(host))
+		}
+	}
+	return names
+}
+
+// DryRunFileNames returns the names of the generated files that are kept by a dry-run installation of the cluster. The
+// credentials are left out, as they are generated again when the cluster is installed.
+func DryRunFileNames(cluster *common.Cluster) []string {
+	var names []string
+	for _, name := range generatedFileNames(cluster) {
+		if name != "kubeconfig-noingress" && name != "kubeadmin-password" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 func ParseToLatest(content []byte) (*config_latest_types.Config, error) {
 	config, _, err := config_latest.Parse(content)
 	if err != nil {
@@ -1329,6 +1353,7 @@ func (g *installerGenerator) runCreateCommand(ctx context.Context, installerPath
 	cmd.Stderr = &out
 	cmd.Env = envVars
 	err := cmd.Run()
+	fmt.Fprintf(&g.installerOutput, "openshift-install create %s\n%s", command, out.String())
 	if err != nil {
 		log.WithError(err).
 			Errorf("error running openshift-install create %s, stdout: %s", command, out.String())
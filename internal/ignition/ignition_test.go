@@ -778,6 +778,20 @@ var _ = Describe("Generator UploadToS3", func() {
 	})
 })
 
+var _ = Describe("DryRunFileNames", func() {
+	It("leaves out the credentials and the disabled hosts", func() {
+		hostID1 := strfmt.UUID(uuid.New().String())
+		hostID2 := strfmt.UUID(uuid.New().String())
+		cluster.Hosts = []*models.Host{
+			{ID: &hostID1, Status: swag.String(models.HostStatusKnown), Role: models.HostRoleMaster},
+			{ID: &hostID2, Status: swag.String(models.HostStatusDisabled), Role: models.HostRoleWorker},
+		}
+
+		Expect(DryRunFileNames(cluster)).To(ConsistOf("bootstrap.ign", masterIgn, "metadata.json", workerIgn,
+			"install-config.yaml", hostutil.IgnitionFileName(cluster.Hosts[0])))
+	})
+})
+
 var _ = Describe("downloadManifest", func() {
 	var (
 		ctrl         *gomock.Controller
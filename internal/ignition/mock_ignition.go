@@ -51,6 +51,20 @@ func (mr *MockGeneratorMockRecorder) Generate(ctx, installConfig, platformType i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockGenerator)(nil).Generate), ctx, installConfig, platformType)
 }
 
+// InstallerOutput mocks base method.
+func (m *MockGenerator) InstallerOutput() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallerOutput")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// InstallerOutput indicates an expected call of InstallerOutput.
+func (mr *MockGeneratorMockRecorder) InstallerOutput() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallerOutput", reflect.TypeOf((*MockGenerator)(nil).InstallerOutput))
+}
+
 // UpdateEtcHosts mocks base method.
 func (m *MockGenerator) UpdateEtcHosts(arg0 string) error {
 	m.ctrl.T.Helper()
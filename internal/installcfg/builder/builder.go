@@ -22,6 +22,7 @@ import (
 type InstallConfigBuilder interface {
 	GetInstallConfig(cluster *common.Cluster, addRhCa bool, ca string) ([]byte, error)
 	ValidateInstallConfigPatch(cluster *common.Cluster, patch string) error
+	ValidateInstallConfigOverrides(cluster *common.Cluster, overrides string) (*models.InstallConfigOverridesValidation, error)
 }
 
 type installConfigBuilder struct {
@@ -88,9 +89,11 @@ func (i *installConfigBuilder) getBasicInstallConfig(cluster *common.Cluster) (*
 			Name: cluster.Name,
 		},
 		Compute: []struct {
-			Hyperthreading string `yaml:"hyperthreading,omitempty"`
-			Name           string `yaml:"name"`
-			Replicas       int    `yaml:"replicas"`
+			Architecture   string                 `yaml:"architecture,omitempty"`
+			Hyperthreading string                 `yaml:"hyperthreading,omitempty"`
+			Name           string                 `yaml:"name"`
+			Platform       map[string]interface{} `yaml:"platform,omitempty"`
+			Replicas       int                    `yaml:"replicas"`
 		}{
 			{
 				Hyperthreading: i.getHypethreadingConfiguration(cluster, "worker"),
@@ -99,9 +102,11 @@ func (i *installConfigBuilder) getBasicInstallConfig(cluster *common.Cluster) (*
 			},
 		},
 		ControlPlane: struct {
-			Hyperthreading string `yaml:"hyperthreading,omitempty"`
-			Name           string `yaml:"name"`
-			Replicas       int    `yaml:"replicas"`
+			Architecture   string                 `yaml:"architecture,omitempty"`
+			Hyperthreading string                 `yaml:"hyperthreading,omitempty"`
+			Name           string                 `yaml:"name"`
+			Platform       map[string]interface{} `yaml:"platform,omitempty"`
+			Replicas       int                    `yaml:"replicas"`
 		}{
 			Hyperthreading: i.getHypethreadingConfiguration(cluster, "master"),
 			Name:           string(models.HostRoleMaster),
@@ -109,6 +114,7 @@ func (i *installConfigBuilder) getBasicInstallConfig(cluster *common.Cluster) (*
 		},
 		PullSecret: cluster.PullSecret,
 		SSHKey:     cluster.SSHPublicKey,
+		FIPS:       swag.BoolValue(cluster.Fips),
 	}
 
 	cfg.Networking.NetworkType = networkType
@@ -3,6 +3,7 @@ package builder
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 	"testing"
 
@@ -206,6 +207,156 @@ var _ = Describe("installcfg", func() {
 		Expect(result.Networking.NetworkType).To(Equal(models.ClusterNetworkTypeOpenShiftSDN))
 	})
 
+	It("emits all the machine networks", func() {
+		var result installcfg.InstallerConfigBaremetal
+		cluster.MachineNetworks = []*models.MachineNetwork{{Cidr: "1.2.3.0/24"}, {Cidr: "1.2.4.0/24"}, {Cidr: "1001:db8::/120"}}
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(2)
+		providerRegistry.EXPECT().AddPlatformToInstallConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		data, err := installConfig.GetInstallConfig(&cluster, false, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		err = yaml.Unmarshal(data, &result)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Networking.MachineNetwork).To(Equal([]installcfg.MachineNetwork{
+			{Cidr: "1.2.3.0/24"}, {Cidr: "1.2.4.0/24"}, {Cidr: "1001:db8::/120"},
+		}))
+	})
+
+	It("sets FIPS mode from the cluster", func() {
+		var result installcfg.InstallerConfigBaremetal
+		cluster.InstallConfigOverrides = ""
+		cluster.Fips = swag.Bool(true)
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(2)
+		providerRegistry.EXPECT().AddPlatformToInstallConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		data, err := installConfig.GetInstallConfig(&cluster, false, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		err = yaml.Unmarshal(data, &result)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.FIPS).Should(BeTrue())
+	})
+
+	Context("validate install config overrides", func() {
+		mockInstallConfigGeneration := func() {
+			mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(2)
+			providerRegistry.EXPECT().AddPlatformToInstallConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		}
+
+		It("returns a diff against the generated install config", func() {
+			mockInstallConfigGeneration()
+			result, err := installConfig.ValidateInstallConfigOverrides(&cluster, `{"controlPlane": {"hyperthreading": "Enabled"}}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Errors).To(BeEmpty())
+			Expect(result.Diff).To(HavePrefix("--- generated\n+++ overridden\n"))
+			Expect(result.Diff).To(ContainSubstring("-  hyperthreading: Disabled\n+  hyperthreading: Enabled\n"))
+			// the stored overrides are not part of the generated side of the diff
+			Expect(result.Diff).NotTo(ContainSubstring("fips"))
+		})
+
+		It("returns an empty diff without overrides", func() {
+			mockInstallConfigGeneration()
+			result, err := installConfig.ValidateInstallConfigOverrides(&cluster, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.Diff).To(BeEmpty())
+		})
+
+		It("reports unknown fields with their path", func() {
+			result, err := installConfig.ValidateInstallConfigOverrides(&cluster, `{"foo": 1, "networking": {"clusterNetwork": [{"cidr": "10.128.0.0/14", "prefix": 23}]}}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Diff).To(BeEmpty())
+			Expect(result.Errors).To(HaveLen(2))
+			Expect(*result.Errors[0].Field).To(Equal("foo"))
+			Expect(*result.Errors[1].Field).To(Equal("networking.clusterNetwork.0.prefix"))
+		})
+
+		It("reports values of the wrong type", func() {
+			result, err := installConfig.ValidateInstallConfigOverrides(&cluster, `{"compute": [{"replicas": "three"}]}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Errors).To(HaveLen(1))
+			Expect(*result.Errors[0].Field).To(Equal("compute.0.replicas"))
+		})
+
+		It("rejects fields set by the assisted installer", func() {
+			result, err := installConfig.ValidateInstallConfigOverrides(&cluster, `{"sshKey": "ssh-rsa AAAA", "pullSecret": "{}", "platform": {"none": {}}, "fips": true}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Errors).To(HaveLen(3))
+			Expect(*result.Errors[0].Field).To(Equal("platform"))
+			Expect(*result.Errors[1].Field).To(Equal("pullSecret"))
+			Expect(*result.Errors[2].Field).To(Equal("sshKey"))
+		})
+
+		It("validates against the schema of the cluster version", func() {
+			overrides := `{"bootstrapInPlace": {"installationDisk": "/dev/sda"}}`
+			cluster.OpenshiftVersion = "4.7.13"
+			result, err := installConfig.ValidateInstallConfigOverrides(&cluster, overrides)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.SchemaVersion).To(Equal("4.7"))
+			Expect(*result.Errors[0].Field).To(Equal("bootstrapInPlace"))
+
+			mockInstallConfigGeneration()
+			cluster.OpenshiftVersion = "4.8.0-fc.1"
+			result, err = installConfig.ValidateInstallConfigOverrides(&cluster, overrides)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.SchemaVersion).To(Equal("4.8"))
+		})
+
+		It("accepts the commonly used overrides", func() {
+			overrides := `{
+				"publish": "Internal",
+				"credentialsMode": "Manual",
+				"networking": {"networkType": "OVNKubernetes"},
+				"compute": [{"name": "worker", "architecture": "amd64", "hyperthreading": "Enabled", "platform": {"baremetal": {}}, "replicas": 2}],
+				"controlPlane": {"name": "master", "architecture": "amd64", "platform": {"baremetal": {}}, "replicas": 3},
+				"proxy": {"httpProxy": "http://proxy.example.com:3128", "noProxy": ".example.com"},
+				"imageContentSources": [{"source": "quay.io/openshift-release-dev/ocp-release", "mirrors": ["mirror.example.com/ocp-release"]}]
+			}`
+			for _, version := range []string{"4.8.0", "4.10.3"} {
+				mockInstallConfigGeneration()
+				cluster.OpenshiftVersion = version
+				result, err := installConfig.ValidateInstallConfigOverrides(&cluster, overrides)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Errors).To(BeEmpty())
+				Expect(result.Valid).To(BeTrue())
+				Expect(result.SchemaVersion).To(Equal("4.8"))
+				Expect(result.Diff).To(ContainSubstring("+publish: Internal\n"))
+				Expect(result.Diff).To(ContainSubstring("+credentialsMode: Manual\n"))
+				Expect(result.Diff).To(ContainSubstring("+  architecture: amd64\n"))
+			}
+		})
+
+		It("rejects the credentials mode before OpenShift 4.7", func() {
+			cluster.OpenshiftVersion = "4.6.16"
+			result, err := installConfig.ValidateInstallConfigOverrides(&cluster, `{"publish": "External", "credentialsMode": "Mint"}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.SchemaVersion).To(Equal("4.6"))
+			Expect(result.Errors).To(HaveLen(1))
+			Expect(*result.Errors[0].Field).To(Equal("credentialsMode"))
+		})
+
+		It("reports an invalid trust bundle", func() {
+			mockInstallConfigGeneration()
+			result, err := installConfig.ValidateInstallConfigOverrides(&cluster, `{"additionalTrustBundle": "not a certificate"}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.Errors).To(HaveLen(1))
+			Expect(result.Diff).To(BeEmpty())
+		})
+
+		It("fails on overrides that are not a JSON object", func() {
+			for _, overrides := range []string{`{"fips":`, `["fips"]`} {
+				_, err := installConfig.ValidateInstallConfigOverrides(&cluster, overrides)
+				Expect(err).Should(HaveOccurred())
+				Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+			}
+		})
+	})
+
 	It("doesn't fail with empty overrides", func() {
 		var result installcfg.InstallerConfigBaremetal
 		cluster.InstallConfigOverrides = ""
@@ -9,6 +9,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	common "github.com/openshift/assisted-service/internal/common"
+	models "github.com/openshift/assisted-service/models"
 )
 
 // MockInstallConfigBuilder is a mock of InstallConfigBuilder interface.
@@ -49,6 +50,21 @@ func (mr *MockInstallConfigBuilderMockRecorder) GetInstallConfig(cluster, addRhC
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstallConfig", reflect.TypeOf((*MockInstallConfigBuilder)(nil).GetInstallConfig), cluster, addRhCa, ca)
 }
 
+// ValidateInstallConfigOverrides mocks base method.
+func (m *MockInstallConfigBuilder) ValidateInstallConfigOverrides(cluster *common.Cluster, overrides string) (*models.InstallConfigOverridesValidation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateInstallConfigOverrides", cluster, overrides)
+	ret0, _ := ret[0].(*models.InstallConfigOverridesValidation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateInstallConfigOverrides indicates an expected call of ValidateInstallConfigOverrides.
+func (mr *MockInstallConfigBuilderMockRecorder) ValidateInstallConfigOverrides(cluster, overrides interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateInstallConfigOverrides", reflect.TypeOf((*MockInstallConfigBuilder)(nil).ValidateInstallConfigOverrides), cluster, overrides)
+}
+
 // ValidateInstallConfigPatch mocks base method.
 func (m *MockInstallConfigBuilder) ValidateInstallConfigPatch(cluster *common.Cluster, patch string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,149 @@
+package builder
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v2"
+)
+
+//go:embed schemas/*.json
+var installConfigSchemas embed.FS
+
+// installConfigSchemaVersions lists the OpenShift versions that have their own install-config schema, newest first.
+// A cluster is validated against the newest schema that is not newer than its OpenShift version. The schemas only
+// describe the fields that the generated install-config supports, so the newer versions use the 4.8 schema until a
+// field they introduce is supported.
+var installConfigSchemaVersions = []string{"4.8", "4.7", "4.6"}
+
+// forbiddenInstallConfigOverrides are install-config fields that are generated from the cluster and may not be overridden
+var forbiddenInstallConfigOverrides = []string{"platform", "pullSecret", "sshKey"}
+
+const rootField = "(root)"
+
+func getInstallConfigSchemaVersion(openshiftVersion string) string {
+	for _, schemaVersion := range installConfigSchemaVersions {
+		if ok, err := common.VersionGreaterOrEqual(openshiftVersion, schemaVersion+".0-0.0"); err == nil && ok {
+			return schemaVersion
+		}
+	}
+	return installConfigSchemaVersions[len(installConfigSchemaVersions)-1]
+}
+
+func overrideFieldPath(field, property string) string {
+	if field == "" || field == rootField {
+		return property
+	}
+	return field + "." + property
+}
+
+func validateOverridesSchema(schemaVersion, overrides string) ([]*models.InstallConfigOverrideError, error) {
+	schema, err := installConfigSchemas.ReadFile(fmt.Sprintf("schemas/install-config-%s.json", schemaVersion))
+	if err != nil {
+		return nil, err
+	}
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(schema), gojsonschema.NewStringLoader(overrides))
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*models.InstallConfigOverrideError, 0)
+	for _, resultErr := range result.Errors() {
+		field := resultErr.Field()
+		message := resultErr.Description()
+		if resultErr.Type() == "additional_property_not_allowed" {
+			property, _ := resultErr.Details()["property"].(string)
+			field = overrideFieldPath(field, property)
+			message = fmt.Sprintf("Unknown field for OpenShift %s install-config", schemaVersion)
+		}
+		ret = append(ret, &models.InstallConfigOverrideError{Field: &field, Message: &message})
+	}
+	return ret, nil
+}
+
+func (i *installConfigBuilder) getOverridesDiff(cluster *common.Cluster, overrides string) (string, error) {
+	generatedCluster := *cluster
+	generatedCluster.InstallConfigOverrides = ""
+	cfg, err := i.getInstallConfig(&generatedCluster, false, "")
+	if err != nil {
+		return "", err
+	}
+	generated, err := yaml.Marshal(*cfg)
+	if err != nil {
+		return "", err
+	}
+
+	if err = i.applyConfigOverrides(overrides, cfg); err != nil {
+		return "", err
+	}
+	if err = cfg.Validate(); err != nil {
+		return "", err
+	}
+	overridden, err := yaml.Marshal(*cfg)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(generated)),
+		B:        difflib.SplitLines(string(overridden)),
+		FromFile: "generated",
+		ToFile:   "overridden",
+		Context:  3,
+	})
+}
+
+func (i *installConfigBuilder) ValidateInstallConfigOverrides(cluster *common.Cluster, overrides string) (*models.InstallConfigOverridesValidation, error) {
+	if strings.TrimSpace(overrides) == "" {
+		overrides = "{}"
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(overrides), &fields); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "install config overrides must be a JSON object"))
+	}
+
+	schemaVersion := getInstallConfigSchemaVersion(cluster.OpenshiftVersion)
+	validationErrors, err := validateOverridesSchema(schemaVersion, overrides)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	for _, field := range forbiddenInstallConfigOverrides {
+		if _, ok := fields[field]; ok {
+			name := field
+			message := "The field is set by the assisted installer and cannot be overridden"
+			validationErrors = append(validationErrors, &models.InstallConfigOverrideError{Field: &name, Message: &message})
+		}
+	}
+
+	ret := &models.InstallConfigOverridesValidation{
+		SchemaVersion: schemaVersion,
+		Errors:        validationErrors,
+	}
+	if len(validationErrors) > 0 {
+		sort.SliceStable(ret.Errors, func(a, b int) bool {
+			return *ret.Errors[a].Field < *ret.Errors[b].Field
+		})
+		return ret, nil
+	}
+
+	ret.Diff, err = i.getOverridesDiff(cluster, overrides)
+	if err != nil {
+		message := err.Error()
+		field := rootField
+		ret.Errors = append(ret.Errors, &models.InstallConfigOverrideError{Field: &field, Message: &message})
+		return ret, nil
+	}
+	ret.Valid = true
+	return ret, nil
+}
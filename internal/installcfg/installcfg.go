@@ -13,6 +13,7 @@ type Platform struct {
 	None      *PlatformNone                   `yaml:"none,omitempty"`
 	Ovirt     *OvirtInstallConfigPlatform     `yaml:"ovirt,omitempty"`
 	Vsphere   *VsphereInstallConfigPlatform   `yaml:"vsphere"`
+	Nutanix   *NutanixInstallConfigPlatform   `yaml:"nutanix,omitempty"`
 }
 
 type Host struct {
@@ -54,6 +55,32 @@ type OvirtInstallConfigPlatform struct {
 	VnicProfileID   strfmt.UUID `yaml:"vnicProfileID"`
 }
 
+// NutanixInstallConfigPlatform represents the required parameters
+// within the `install-config.yaml` for the Nutanix platform.
+type NutanixInstallConfigPlatform struct {
+	APIVIP        string                `yaml:"apiVIP"`
+	IngressVIP    string                `yaml:"ingressVIP"`
+	PrismCentral  NutanixPrismCentral   `yaml:"prismCentral"`
+	PrismElements []NutanixPrismElement `yaml:"prismElements"`
+	SubnetUUIDs   []strfmt.UUID         `yaml:"subnetUUIDs"`
+}
+
+type NutanixPrismCentral struct {
+	Endpoint NutanixPrismEndpoint `yaml:"endpoint"`
+	Username string               `yaml:"username"`
+	Password strfmt.Password      `yaml:"password"`
+}
+
+type NutanixPrismElement struct {
+	UUID     strfmt.UUID          `yaml:"uuid"`
+	Endpoint NutanixPrismEndpoint `yaml:"endpoint"`
+}
+
+type NutanixPrismEndpoint struct {
+	Address string `yaml:"address"`
+	Port    int64  `yaml:"port"`
+}
+
 type PlatformNone struct {
 }
 
@@ -95,14 +122,18 @@ type InstallerConfigBaremetal struct {
 		Name string `yaml:"name"`
 	} `yaml:"metadata"`
 	Compute []struct {
-		Hyperthreading string `yaml:"hyperthreading,omitempty"`
-		Name           string `yaml:"name"`
-		Replicas       int    `yaml:"replicas"`
+		Architecture   string                 `yaml:"architecture,omitempty"`
+		Hyperthreading string                 `yaml:"hyperthreading,omitempty"`
+		Name           string                 `yaml:"name"`
+		Platform       map[string]interface{} `yaml:"platform,omitempty"`
+		Replicas       int                    `yaml:"replicas"`
 	} `yaml:"compute"`
 	ControlPlane struct {
-		Hyperthreading string `yaml:"hyperthreading,omitempty"`
-		Name           string `yaml:"name"`
-		Replicas       int    `yaml:"replicas"`
+		Architecture   string                 `yaml:"architecture,omitempty"`
+		Hyperthreading string                 `yaml:"hyperthreading,omitempty"`
+		Name           string                 `yaml:"name"`
+		Platform       map[string]interface{} `yaml:"platform,omitempty"`
+		Replicas       int                    `yaml:"replicas"`
 	} `yaml:"controlPlane"`
 	Platform              Platform             `yaml:"platform"`
 	BootstrapInPlace      BootstrapInPlace     `yaml:"bootstrapInPlace,omitempty"`
@@ -111,6 +142,8 @@ type InstallerConfigBaremetal struct {
 	SSHKey                string               `yaml:"sshKey"`
 	AdditionalTrustBundle string               `yaml:"additionalTrustBundle,omitempty"`
 	ImageContentSources   []ImageContentSource `yaml:"imageContentSources,omitempty"`
+	Publish               string               `yaml:"publish,omitempty"`
+	CredentialsMode       string               `yaml:"credentialsMode,omitempty"`
 }
 
 func (c *InstallerConfigBaremetal) Validate() error {
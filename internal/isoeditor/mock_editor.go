@@ -35,18 +35,18 @@ func (m *MockEditor) EXPECT() *MockEditorMockRecorder {
 }
 
 // CreateClusterMinimalISO mocks base method.
-func (m *MockEditor) CreateClusterMinimalISO(arg0 string, arg1 []staticnetworkconfig.StaticNetworkConfigData, arg2 *ClusterProxyInfo) (string, error) {
+func (m *MockEditor) CreateClusterMinimalISO(arg0 string, arg1 []staticnetworkconfig.StaticNetworkConfigData, arg2 *ClusterProxyInfo, arg3 []string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateClusterMinimalISO", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "CreateClusterMinimalISO", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateClusterMinimalISO indicates an expected call of CreateClusterMinimalISO.
-func (mr *MockEditorMockRecorder) CreateClusterMinimalISO(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockEditorMockRecorder) CreateClusterMinimalISO(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterMinimalISO", reflect.TypeOf((*MockEditor)(nil).CreateClusterMinimalISO), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterMinimalISO", reflect.TypeOf((*MockEditor)(nil).CreateClusterMinimalISO), arg0, arg1, arg2, arg3)
 }
 
 // CreateMinimalISOTemplate mocks base method.
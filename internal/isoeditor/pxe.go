@@ -0,0 +1,81 @@
+package isoeditor
+
+import (
+	"bytes"
+	"io"
+	"os"
+
+	"github.com/openshift/assisted-service/internal/isoutil"
+	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
+	"github.com/pkg/errors"
+)
+
+// Paths of the network boot artifacts in the RHCOS live ISO
+const (
+	PXEKernelPath = "/images/pxeboot/vmlinuz"
+	PXEInitrdPath = "/images/pxeboot/initrd.img"
+	PXERootfsPath = "/images/pxeboot/rootfs.img"
+)
+
+// initrdAlignment is the alignment of the archives appended to an initrd
+const initrdAlignment = 4
+
+type isoFileReader struct {
+	*io.SectionReader
+	io.Closer
+}
+
+// PXEArtifact returns a reader of the file at filePath in the ISO and its size in bytes.
+// The reader must be closed by the caller.
+func PXEArtifact(isoPath, filePath string) (io.ReadCloser, int64, error) {
+	offset, err := isoutil.GetFileLocation(filePath, isoPath)
+	if err != nil {
+		return nil, 0, err
+	}
+	size, err := isoutil.GetFileSize(filePath, isoPath)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	iso, err := os.Open(isoPath)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "Failed to open ISO %s", isoPath)
+	}
+	return &isoFileReader{SectionReader: io.NewSectionReader(iso, int64(offset), int64(size)), Closer: iso}, int64(size), nil
+}
+
+// PXEInitrd returns a reader of the initrd of the ISO with the ignition and the custom ramdisk archives
+// appended, the same way they are embedded in the cluster minimal ISO, and its size in bytes.
+// The reader must be closed by the caller.
+func PXEInitrd(isoPath, ignition string, netFiles []staticnetworkconfig.StaticNetworkConfigData, clusterProxyInfo *ClusterProxyInfo) (io.ReadCloser, int64, error) {
+	ignitionArchive, err := IgnitionImageArchive(ignition)
+	if err != nil {
+		return nil, 0, err
+	}
+	ramdiskArchive, err := RamdiskImageArchive(netFiles, clusterProxyInfo)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	initrd, size, err := PXEArtifact(isoPath, PXEInitrdPath)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	readers := []io.Reader{initrd}
+	for _, archive := range [][]byte{ignitionArchive, ramdiskArchive} {
+		if len(archive) == 0 {
+			continue
+		}
+		padding := make([]byte, (initrdAlignment-size%initrdAlignment)%initrdAlignment)
+		readers = append(readers, bytes.NewReader(padding), bytes.NewReader(archive))
+		size += int64(len(padding) + len(archive))
+	}
+
+	return &initrdReader{Reader: io.MultiReader(readers...), Closer: initrd}, size, nil
+}
+
+type initrdReader struct {
+	io.Reader
+	io.Closer
+}
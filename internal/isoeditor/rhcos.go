@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"text/template"
 
 	"github.com/cavaliercoder/go-cpio"
@@ -29,15 +30,18 @@ Environment=HTTPS_PROXY={{.HTTPS_PROXY}}
 Environment=NO_PROXY={{.NO_PROXY}}`
 
 const (
-	RamDiskPaddingLength  = uint64(1024 * 1024) // 1MB
-	IgnitionPaddingLength = uint64(256 * 1024)  // 256KB
-	ignitionImagePath     = "/images/ignition.img"
-	ramDiskImagePath      = "/images/assisted_installer_custom.img"
-	ignitionHeaderKey     = "coreiso+"
-	ramdiskHeaderKey      = "ramdisk+"
-	isoSystemAreaSize     = 32768
-	ignitionHeaderSize    = 24
-	headerLength          = int64(32768) // first 32KB in ISO
+	RamDiskPaddingLength         = uint64(1024 * 1024) // 1MB
+	IgnitionPaddingLength        = uint64(256 * 1024)  // 256KB
+	kernelArgumentsPaddingLength = 256
+	ignitionImagePath            = "/images/ignition.img"
+	ramDiskImagePath             = "/images/assisted_installer_custom.img"
+	grubConfigPath               = "/EFI/redhat/grub.cfg"
+	isolinuxConfigPath           = "/isolinux/isolinux.cfg"
+	ignitionHeaderKey            = "coreiso+"
+	ramdiskHeaderKey             = "ramdisk+"
+	isoSystemAreaSize            = 32768
+	ignitionHeaderSize           = 24
+	headerLength                 = int64(32768) // first 32KB in ISO
 )
 
 type ClusterProxyInfo struct {
@@ -59,7 +63,7 @@ type OffsetInfo struct {
 //go:generate mockgen -package=isoeditor -destination=mock_editor.go -self_package=github.com/openshift/assisted-service/internal/isoeditor . Editor
 type Editor interface {
 	CreateMinimalISOTemplate(rootFSURL string) (string, error)
-	CreateClusterMinimalISO(ignition string, netFiles []staticnetworkconfig.StaticNetworkConfigData, clusterProxyInfo *ClusterProxyInfo) (string, error)
+	CreateClusterMinimalISO(ignition string, netFiles []staticnetworkconfig.StaticNetworkConfigData, clusterProxyInfo *ClusterProxyInfo, kernelArguments []string) (string, error)
 }
 
 type rhcosEditor struct {
@@ -104,7 +108,7 @@ func (e *rhcosEditor) CreateMinimalISOTemplate(rootFSURL string) (string, error)
 	return isoPath, nil
 }
 
-func (e *rhcosEditor) CreateClusterMinimalISO(ignition string, netFiles []staticnetworkconfig.StaticNetworkConfigData, clusterProxyInfo *ClusterProxyInfo) (string, error) {
+func (e *rhcosEditor) CreateClusterMinimalISO(ignition string, netFiles []staticnetworkconfig.StaticNetworkConfigData, clusterProxyInfo *ClusterProxyInfo, kernelArguments []string) (string, error) {
 	clusterISOPath, err := tempFileName(e.workDir)
 	if err != nil {
 		return "", err
@@ -129,6 +133,12 @@ func (e *rhcosEditor) CreateClusterMinimalISO(ignition string, netFiles []static
 		}
 	}
 
+	if len(kernelArguments) > 0 {
+		if err := addKernelArguments(clusterISOPath, kernelArguments); err != nil {
+			return "", errors.Wrap(err, "failed to add kernel arguments")
+		}
+	}
+
 	if err := e.isoHandler.CleanWorkDir(); err != nil {
 		e.log.WithError(err).Warnf("Failed to clean isoHandler work dir")
 	}
@@ -349,6 +359,51 @@ func (e *rhcosEditor) fixTemplateConfigs(rootFSURL string) error {
 		return err
 	}
 
+	// Add a commented out placeholder after the kernel parameters, replaced by the kernel arguments of the cluster
+	placeholder := strings.Repeat("#", kernelArgumentsPaddingLength)
+	if err := editFile(e.isoHandler.ExtractedPath("EFI/redhat/grub.cfg"), `(?m)^(\s+linux .+)$`, "${1}\n"+placeholder); err != nil {
+		return err
+	}
+	if err := editFile(e.isoHandler.ExtractedPath("isolinux/isolinux.cfg"), `(?m)^(\s+append .+)$`, "${1}\n"+placeholder); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// addKernelArguments appends the kernel arguments to the kernel parameters of the boot configurations of the ISO.
+// The arguments overwrite the beginning of the placeholder that follows the kernel parameters, so the size and the
+// location of the configuration files are unchanged.
+func addKernelArguments(clusterISOPath string, kernelArguments []string) error {
+	arguments := " " + strings.Join(kernelArguments, " ")
+	if len(arguments) > kernelArgumentsPaddingLength {
+		return errors.Errorf("Kernel arguments are too long to be embedded (%d > %d)", len(arguments), kernelArgumentsPaddingLength)
+	}
+	placeholder := []byte("\n" + strings.Repeat("#", kernelArgumentsPaddingLength))
+	area := []byte(arguments + "\n" + strings.Repeat("#", kernelArgumentsPaddingLength-len(arguments)))
+
+	for _, configPath := range []string{grubConfigPath, isolinuxConfigPath} {
+		offset, err := isoutil.GetFileLocation(configPath, clusterISOPath)
+		if err != nil {
+			return err
+		}
+		size, err := isoutil.GetFileSize(configPath, clusterISOPath)
+		if err != nil {
+			return err
+		}
+		content, err := readAt(clusterISOPath, int64(offset), int64(size))
+		if err != nil {
+			return errors.Wrapf(err, "Failed to read %s", configPath)
+		}
+		index := bytes.Index(content, placeholder)
+		if index < 0 {
+			return errors.Errorf("Failed to find the kernel arguments placeholder in %s", configPath)
+		}
+		if err = writeAt(area, int64(offset)+int64(index), clusterISOPath); err != nil {
+			return errors.Wrapf(err, "Failed to write the kernel arguments to %s", configPath)
+		}
+	}
+
 	return nil
 }
 
@@ -382,6 +437,21 @@ func tempFileName(baseDir string) (string, error) {
 	return path, nil
 }
 
+func readAt(isoPath string, offset, length int64) ([]byte, error) {
+	iso, err := os.Open(isoPath)
+	if err != nil {
+		return nil, err
+	}
+	defer iso.Close()
+
+	b := make([]byte, length)
+	if _, err = iso.ReadAt(b, offset); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
 func writeAt(b []byte, offset int64, clusterISOPath string) error {
 	iso, err := os.OpenFile(clusterISOPath, os.O_WRONLY, 0o664)
 	if err != nil {
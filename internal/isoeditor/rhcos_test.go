@@ -96,7 +96,7 @@ var _ = Context("with test files", func() {
 		It("cluster ISO created successfully", func() {
 			editor := editorForFile(isoFile, workDir, mockStaticNetworkConfig)
 			proxyInfo := &ClusterProxyInfo{}
-			file, err := editor.CreateClusterMinimalISO("ignition", nil, proxyInfo)
+			file, err := editor.CreateClusterMinimalISO("ignition", nil, proxyInfo, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			_, err = os.Stat(workDir)
@@ -106,6 +106,48 @@ var _ = Context("with test files", func() {
 		})
 	})
 
+	Describe("PXEArtifact", func() {
+		It("reads the file from the ISO", func() {
+			reader, size, err := PXEArtifact(isoFile, PXERootfsPath)
+			Expect(err).ToNot(HaveOccurred())
+			defer reader.Close()
+			content, err := ioutil.ReadAll(reader)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(Equal("this is rootfs"))
+			Expect(size).To(Equal(int64(len(content))))
+		})
+		It("missing file", func() {
+			_, _, err := PXEArtifact(isoFile, "/images/pxeboot/missing.img")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("PXEInitrd", func() {
+		It("appends the ignition archive to the initrd", func() {
+			reader, size, err := PXEInitrd(isoFile, "ignition", nil, &ClusterProxyInfo{})
+			Expect(err).ToNot(HaveOccurred())
+			defer reader.Close()
+			content, err := ioutil.ReadAll(reader)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(size).To(Equal(int64(len(content))))
+
+			By("checking the archive is aligned after the initrd")
+			Expect(string(content[:14])).To(Equal("this is initrd"))
+			Expect(content[14:16]).To(Equal([]byte{0, 0}))
+
+			By("checking the ignition is present in the archive")
+			gzipReader, err := gzip.NewReader(bytes.NewReader(content[16:]))
+			Expect(err).ToNot(HaveOccurred())
+			r := cpio.NewReader(gzipReader)
+			hdr, err := r.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hdr.Name).To(Equal("config.ign"))
+			ignitionBytes, err := ioutil.ReadAll(r)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(ignitionBytes)).To(Equal("ignition"))
+		})
+	})
+
 	Describe("fixTemplateConfigs", func() {
 		It("alters the kernel parameters correctly", func() {
 			editor := editorForFile(isoFile, workDir, mockStaticNetworkConfig)
@@ -128,6 +170,49 @@ var _ = Context("with test files", func() {
 			newLine = "  append initrd=/images/pxeboot/initrd.img,/images/ignition.img,%s random.trust_cpu=on rd.luks.options=discard ignition.firstboot ignition.platform.id=metal coreos.live.rootfs_url=%s"
 			isolinuxCfg := fmt.Sprintf(newLine, ramDiskImagePath, testRootFSURL)
 			validateFileContainsLine(isoHandler.ExtractedPath("isolinux/isolinux.cfg"), isolinuxCfg)
+
+			placeholder := strings.Repeat("#", kernelArgumentsPaddingLength)
+			validateFileContainsLine(isoHandler.ExtractedPath("EFI/redhat/grub.cfg"), placeholder)
+			validateFileContainsLine(isoHandler.ExtractedPath("isolinux/isolinux.cfg"), placeholder)
+		})
+	})
+
+	Describe("addKernelArguments", func() {
+		var templatePath string
+
+		BeforeEach(func() {
+			var err error
+			editor := editorForFile(isoFile, workDir, mockStaticNetworkConfig)
+			templatePath, err = editor.CreateMinimalISOTemplate(testRootFSURL)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.Remove(templatePath)
+		})
+
+		It("appends the kernel arguments to the kernel parameters", func() {
+			grubSize, err := isoutil.GetFileSize(grubConfigPath, templatePath)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(addKernelArguments(templatePath, []string{"fips=1", "foo=bar"})).To(Succeed())
+
+			grubCfg := readISOFile(templatePath, grubConfigPath)
+			Expect(grubCfg).To(ContainSubstring(fmt.Sprintf("'coreos.live.rootfs_url=%s' fips=1 foo=bar\n#", testRootFSURL)))
+			Expect(uint64(len(grubCfg))).To(Equal(grubSize))
+			isolinuxCfg := readISOFile(templatePath, isolinuxConfigPath)
+			Expect(isolinuxCfg).To(ContainSubstring(fmt.Sprintf("coreos.live.rootfs_url=%s fips=1 foo=bar\n#", testRootFSURL)))
+		})
+
+		It("kernel arguments are longer than the placeholder", func() {
+			err := addKernelArguments(templatePath, []string{strings.Repeat("a", kernelArgumentsPaddingLength)})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("ISO without placeholder", func() {
+			err := addKernelArguments(isoFile, []string{"fips=1"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Failed to find the kernel arguments placeholder"))
 		})
 	})
 
@@ -370,6 +455,10 @@ label linux
 	Expect(err).ToNot(HaveOccurred())
 	err = ioutil.WriteFile(filepath.Join(filesDir, "files/images/pxeboot/rootfs.img"), []byte("this is rootfs"), 0600)
 	Expect(err).ToNot(HaveOccurred())
+	err = ioutil.WriteFile(filepath.Join(filesDir, "files/images/pxeboot/vmlinuz"), []byte("this is kernel"), 0600)
+	Expect(err).ToNot(HaveOccurred())
+	err = ioutil.WriteFile(filepath.Join(filesDir, "files/images/pxeboot/initrd.img"), []byte("this is initrd"), 0600)
+	Expect(err).ToNot(HaveOccurred())
 	err = os.MkdirAll(filepath.Join(filesDir, "files/EFI/redhat"), 0755)
 	Expect(err).ToNot(HaveOccurred())
 	err = ioutil.WriteFile(filepath.Join(filesDir, "files/EFI/redhat/grub.cfg"), []byte(grubConfig), 0600)
@@ -389,6 +478,16 @@ label linux
 	return filesDir, isoDir, isoFile
 }
 
+func readISOFile(isoPath, filePath string) string {
+	offset, err := isoutil.GetFileLocation(filePath, isoPath)
+	Expect(err).ToNot(HaveOccurred())
+	size, err := isoutil.GetFileSize(filePath, isoPath)
+	Expect(err).ToNot(HaveOccurred())
+	content, err := readAt(isoPath, int64(offset), int64(size))
+	Expect(err).ToNot(HaveOccurred())
+	return string(content)
+}
+
 func validateFileContainsLine(filename string, content string) {
 	fileContent, err := ioutil.ReadFile(filename)
 	Expect(err).NotTo(HaveOccurred())
@@ -1,14 +1,18 @@
 package network
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"sort"
+	"strconv"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/golang-collections/go-datastructures/bitarray"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 	"github.com/thoas/go-funk"
@@ -364,7 +368,7 @@ func newL3QueryFactory(hosts []*models.Host, family AddressFamily) (hostQueryFac
 		if err != nil {
 			return nil, err
 		}
-		for _, intf := range inventory.Interfaces {
+		for _, intf := range GetAddressableInterfaces(inventory) {
 			var array []string
 			switch family {
 			case IPv4:
@@ -474,3 +478,114 @@ func CreateL3MajorityGroup(hosts []*models.Host, family AddressFamily) ([]strfmt
 	}
 	return calculateMajoryGroup(hosts, factory)
 }
+
+func addressFamilyOf(address string) string {
+	ip := net.ParseIP(address)
+	switch {
+	case ip == nil:
+		return ""
+	case ip.To4() != nil:
+		return models.ConnectivityMatrixEntryAddressFamilyIPV4
+	default:
+		return models.ConnectivityMatrixEntryAddressFamilyIPV6
+	}
+}
+
+/*
+ * Create the connectivity matrix of the hosts.  Unlike the majority groups that only tell which hosts have full mesh
+ * connectivity, the matrix keeps the result of every L2 and L3 check from a host to an address of another host, with
+ * its outgoing interface, address family, latency and packet loss, so that partial connectivity can be debugged.
+ */
+func CreateConnectivityMatrix(hosts []*models.Host) (models.ConnectivityMatrix, error) {
+	hostNames := make(map[strfmt.UUID]string)
+	for _, h := range hosts {
+		hostNames[*h.ID] = hostutil.GetHostnameForMsg(h)
+	}
+
+	matrix := make(models.ConnectivityMatrix, 0)
+	for _, h := range hosts {
+		if h.Connectivity == "" {
+			continue
+		}
+		var report models.ConnectivityReport
+		if err := json.Unmarshal([]byte(h.Connectivity), &report); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal the connectivity report of host %s", h.ID)
+		}
+		for _, rh := range report.RemoteHosts {
+			targetName, ok := hostNames[rh.HostID]
+			if !ok {
+				continue
+			}
+			newEntry := func(layer, outgoingNic, remoteIPAddress string, successful bool) *models.ConnectivityMatrixEntry {
+				return &models.ConnectivityMatrixEntry{
+					SourceHostID:    *h.ID,
+					SourceHostName:  hostNames[*h.ID],
+					TargetHostID:    rh.HostID,
+					TargetHostName:  targetName,
+					Layer:           layer,
+					AddressFamily:   addressFamilyOf(remoteIPAddress),
+					OutgoingNic:     outgoingNic,
+					RemoteIPAddress: remoteIPAddress,
+					Successful:      successful,
+				}
+			}
+			for _, l2 := range rh.L2Connectivity {
+				entry := newEntry(models.ConnectivityMatrixEntryLayerL2, l2.OutgoingNic, l2.RemoteIPAddress, l2.Successful)
+				entry.RemoteMac = l2.RemoteMac
+				matrix = append(matrix, entry)
+			}
+			for _, l3 := range rh.L3Connectivity {
+				entry := newEntry(models.ConnectivityMatrixEntryLayerL3, l3.OutgoingNic, l3.RemoteIPAddress, l3.Successful)
+				entry.AverageRTTMs = l3.AverageRTTMs
+				entry.PacketLossPercentage = l3.PacketLossPercentage
+				matrix = append(matrix, entry)
+			}
+		}
+	}
+
+	sort.SliceStable(matrix, func(i, j int) bool {
+		a, b := matrix[i], matrix[j]
+		for _, pair := range [][2]string{
+			{a.SourceHostName, b.SourceHostName},
+			{a.TargetHostName, b.TargetHostName},
+			{a.Layer, b.Layer},
+			{a.AddressFamily, b.AddressFamily},
+			{a.OutgoingNic, b.OutgoingNic},
+		} {
+			if pair[0] != pair[1] {
+				return pair[0] < pair[1]
+			}
+		}
+		return false
+	})
+	return matrix, nil
+}
+
+var connectivityMatrixCSVHeader = []string{"source_host_id", "source_host_name", "target_host_id", "target_host_name", "layer",
+	"address_family", "outgoing_nic", "remote_ip_address", "remote_mac", "successful", "average_rtt_ms", "packet_loss_percentage"}
+
+/*
+ * Write the connectivity matrix as CSV, one row per check, so that it can be loaded in a spreadsheet or plotted as a
+ * heatmap.  The latency and packet loss are left empty for the L2 checks.
+ */
+func WriteConnectivityMatrixCSV(w io.Writer, matrix models.ConnectivityMatrix) error {
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(connectivityMatrixCSVHeader); err != nil {
+		return err
+	}
+	for _, entry := range matrix {
+		rtt, loss := "", ""
+		if entry.Layer == models.ConnectivityMatrixEntryLayerL3 {
+			rtt = strconv.FormatFloat(entry.AverageRTTMs, 'f', -1, 64)
+			loss = strconv.FormatFloat(entry.PacketLossPercentage, 'f', -1, 64)
+		}
+		record := []string{entry.SourceHostID.String(), entry.SourceHostName, entry.TargetHostID.String(), entry.TargetHostName,
+			entry.Layer, entry.AddressFamily, entry.OutgoingNic, entry.RemoteIPAddress, entry.RemoteMac,
+			strconv.FormatBool(entry.Successful), rtt, loss}
+		if err := csvWriter.Write(record); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
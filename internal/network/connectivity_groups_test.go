@@ -824,3 +824,83 @@ func GenerateL3ConnectivityGroupTests(ipV4 bool, net1CIDR, net2CIDR string) {
 		})
 	})
 }
+
+var _ = Describe("Connectivity matrix", func() {
+	var (
+		nodes []*node
+		hosts []*models.Host
+	)
+
+	BeforeEach(func() {
+		nodes = generateIPv4Nodes(4, "1.2.3.0/24", "5.6.7.0/24")
+		nodes[0].addressNet2 = "fe80::1"
+		// The last node is not a host of the cluster
+		hosts = []*models.Host{
+			{
+				ID:                nodes[0].id,
+				RequestedHostname: "host-b",
+				Connectivity: createConnectivityReport(
+					&models.ConnectivityRemoteHost{
+						HostID: *nodes[1].id,
+						L2Connectivity: []*models.L2Connectivity{
+							{OutgoingNic: "eth0", RemoteIPAddress: nodes[1].addressNet1, RemoteMac: "aa:bb:cc:dd:ee:ff", Successful: true},
+						},
+						L3Connectivity: []*models.L3Connectivity{
+							{OutgoingNic: "eth0", RemoteIPAddress: nodes[1].addressNet1, Successful: true, AverageRTTMs: 0.5, PacketLossPercentage: 10},
+						},
+					},
+					createL3Remote(nodes[3], l3LinkNet1)),
+			},
+			{
+				ID:                nodes[1].id,
+				RequestedHostname: "host-a",
+				Connectivity: createConnectivityReport(
+					createL3Remote(nodes[0], l3LinkNet2)),
+			},
+			{
+				ID:                nodes[2].id,
+				RequestedHostname: "host-c",
+			},
+		}
+	})
+
+	It("lists the checks between the hosts", func() {
+		matrix, err := CreateConnectivityMatrix(hosts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(matrix).To(HaveLen(3))
+
+		Expect(*matrix[0]).To(Equal(models.ConnectivityMatrixEntry{
+			SourceHostID: *nodes[1].id, SourceHostName: "host-a", TargetHostID: *nodes[0].id, TargetHostName: "host-b",
+			Layer: models.ConnectivityMatrixEntryLayerL3, AddressFamily: models.ConnectivityMatrixEntryAddressFamilyIPV6,
+			RemoteIPAddress: "fe80::1", Successful: true,
+		}))
+		Expect(*matrix[1]).To(Equal(models.ConnectivityMatrixEntry{
+			SourceHostID: *nodes[0].id, SourceHostName: "host-b", TargetHostID: *nodes[1].id, TargetHostName: "host-a",
+			Layer: models.ConnectivityMatrixEntryLayerL2, AddressFamily: models.ConnectivityMatrixEntryAddressFamilyIPV4,
+			OutgoingNic: "eth0", RemoteIPAddress: nodes[1].addressNet1, RemoteMac: "aa:bb:cc:dd:ee:ff", Successful: true,
+		}))
+		Expect(matrix[2].Layer).To(Equal(models.ConnectivityMatrixEntryLayerL3))
+		Expect(matrix[2].AverageRTTMs).To(Equal(0.5))
+		Expect(matrix[2].PacketLossPercentage).To(Equal(float64(10)))
+	})
+
+	It("fails on a corrupted connectivity report", func() {
+		hosts[2].Connectivity = "not json"
+		_, err := CreateConnectivityMatrix(hosts)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("writes the matrix as CSV", func() {
+		matrix, err := CreateConnectivityMatrix(hosts)
+		Expect(err).ToNot(HaveOccurred())
+
+		var buf strings.Builder
+		Expect(WriteConnectivityMatrixCSV(&buf, matrix)).To(Succeed())
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		Expect(lines).To(HaveLen(4))
+		Expect(lines[0]).To(Equal("source_host_id,source_host_name,target_host_id,target_host_name,layer,address_family," +
+			"outgoing_nic,remote_ip_address,remote_mac,successful,average_rtt_ms,packet_loss_percentage"))
+		Expect(lines[2]).To(HaveSuffix(",l2,ipv4,eth0," + nodes[1].addressNet1 + ",aa:bb:cc:dd:ee:ff,true,,"))
+		Expect(lines[3]).To(HaveSuffix(",l3,ipv4,eth0," + nodes[1].addressNet1 + ",,true,0.5,10"))
+	})
+})
@@ -7,24 +7,26 @@ import (
 )
 
 // Verify if the constrains for dual-stack machine networks are met:
-//   * there are exactly two machine networks
+//   * there are at least two machine networks
 //   * the first one is IPv4 subnet
-//   * the second one is IPv6 subnet
+//   * at least one of them is IPv6 subnet
+// Several machine networks of the same family are allowed for hosts in routed L3 subnets.
 func VerifyMachineNetworksDualStack(networks []*models.MachineNetwork, isDualStack bool) error {
 	if !isDualStack {
 		return nil
 	}
-	if len(networks) != 2 {
-		return errors.Errorf("Expected 2 machine networks, found %d", len(networks))
+	if len(networks) < 2 {
+		return errors.Errorf("Expected at least 2 machine networks, found %d", len(networks))
 	}
 	if !IsIPV4CIDR(string(networks[0].Cidr)) {
 		return errors.Errorf("First machine network has to be IPv4 subnet")
 	}
-	if !IsIPv6CIDR(string(networks[1].Cidr)) {
-		return errors.Errorf("Second machine network has to be IPv6 subnet")
+	for _, network := range networks[1:] {
+		if IsIPv6CIDR(string(network.Cidr)) {
+			return nil
+		}
 	}
-
-	return nil
+	return errors.Errorf("At least one machine network has to be IPv6 subnet")
 }
 
 func VerifyServiceNetworksDualStack(networks []*models.ServiceNetwork, isDualStack bool) error {
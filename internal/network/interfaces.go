@@ -0,0 +1,77 @@
+package network
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+var vlanInterfaceNameRegex = regexp.MustCompile(`^(.+)\.(\d{1,4})$`)
+
+func getInterfaceTypeFromName(name string) string {
+	switch {
+	case vlanInterfaceNameRegex.MatchString(name):
+		return models.InterfaceTypeVlan
+	case strings.HasPrefix(name, "bond"):
+		return models.InterfaceTypeBond
+	case strings.HasPrefix(name, "br"):
+		return models.InterfaceTypeBridge
+	default:
+		return models.InterfaceTypePhysical
+	}
+}
+
+func hasAddresses(intf *models.Interface) bool {
+	return len(intf.IPV4Addresses) > 0 || len(intf.IPV6Addresses) > 0
+}
+
+// PopulateInterfacesRelations completes the type of the interfaces of the inventory and the relations between them when
+// the agent did not report them. VLAN interfaces are recognized by their <parent>.<vlan id> name, and the ports of a bond
+// or a bridge by sharing its MAC address.
+func PopulateInterfacesRelations(inventory *models.Inventory) {
+	controllersByMac := make(map[string]*models.Interface)
+	for _, intf := range inventory.Interfaces {
+		if intf.Type == "" {
+			intf.Type = getInterfaceTypeFromName(intf.Name)
+		}
+		if intf.Type == models.InterfaceTypeVlan && intf.Parent == "" {
+			if match := vlanInterfaceNameRegex.FindStringSubmatch(intf.Name); match != nil {
+				intf.Parent = match[1]
+				intf.VlanID, _ = strconv.ParseInt(match[2], 10, 64)
+			}
+		}
+		if (intf.Type == models.InterfaceTypeBond || intf.Type == models.InterfaceTypeBridge) && intf.MacAddress != "" {
+			if _, ok := controllersByMac[intf.MacAddress]; !ok {
+				controllersByMac[intf.MacAddress] = intf
+			}
+		}
+	}
+	for _, intf := range inventory.Interfaces {
+		if intf.Controller != "" || intf.Type != models.InterfaceTypePhysical || hasAddresses(intf) {
+			continue
+		}
+		if controller, ok := controllersByMac[intf.MacAddress]; ok {
+			intf.Controller = controller.Name
+		}
+	}
+}
+
+// IsInterfacePort tests if the interface is a port of a bond or a bridge, whose addresses are the ones of the bond or
+// the bridge
+func IsInterfacePort(intf *models.Interface) bool {
+	return intf.Controller != ""
+}
+
+// GetAddressableInterfaces returns the interfaces of the inventory that can hold the addresses of the host, leaving out
+// the ports of bonds and bridges
+func GetAddressableInterfaces(inventory *models.Inventory) []*models.Interface {
+	ret := make([]*models.Interface, 0, len(inventory.Interfaces))
+	for _, intf := range inventory.Interfaces {
+		if !IsInterfacePort(intf) {
+			ret = append(ret, intf)
+		}
+	}
+	return ret
+}
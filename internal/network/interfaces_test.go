@@ -0,0 +1,52 @@
+package network
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("PopulateInterfacesRelations", func() {
+	It("recognizes bonds and their ports", func() {
+		inventory := &models.Inventory{Interfaces: []*models.Interface{
+			{Name: "eth0", MacAddress: "52:54:00:00:00:01"},
+			{Name: "eth1", MacAddress: "52:54:00:00:00:01"},
+			{Name: "eth2", MacAddress: "52:54:00:00:00:02", IPV4Addresses: []string{"5.6.7.8/24"}},
+			{Name: "bond0", MacAddress: "52:54:00:00:00:01", IPV4Addresses: []string{"1.2.3.4/24"}},
+		}}
+		PopulateInterfacesRelations(inventory)
+		Expect(inventory.Interfaces[0].Type).To(Equal(models.InterfaceTypePhysical))
+		Expect(inventory.Interfaces[0].Controller).To(Equal("bond0"))
+		Expect(inventory.Interfaces[1].Controller).To(Equal("bond0"))
+		Expect(inventory.Interfaces[2].Controller).To(BeEmpty())
+		Expect(inventory.Interfaces[3].Type).To(Equal(models.InterfaceTypeBond))
+		Expect(GetAddressableInterfaces(inventory)).To(Equal([]*models.Interface{inventory.Interfaces[2], inventory.Interfaces[3]}))
+	})
+
+	It("recognizes VLAN interfaces on bridges", func() {
+		inventory := &models.Inventory{Interfaces: []*models.Interface{
+			{Name: "ens3", MacAddress: "52:54:00:00:00:01"},
+			{Name: "br-ex", MacAddress: "52:54:00:00:00:01"},
+			{Name: "br-ex.100", MacAddress: "52:54:00:00:00:01", IPV4Addresses: []string{"1.2.3.4/24"}},
+		}}
+		PopulateInterfacesRelations(inventory)
+		Expect(inventory.Interfaces[0].Controller).To(Equal("br-ex"))
+		Expect(inventory.Interfaces[1].Type).To(Equal(models.InterfaceTypeBridge))
+		Expect(inventory.Interfaces[2].Type).To(Equal(models.InterfaceTypeVlan))
+		Expect(inventory.Interfaces[2].Parent).To(Equal("br-ex"))
+		Expect(inventory.Interfaces[2].VlanID).To(Equal(int64(100)))
+		Expect(inventory.Interfaces[2].Controller).To(BeEmpty())
+	})
+
+	It("keeps the relations reported by the agent", func() {
+		inventory := &models.Inventory{Interfaces: []*models.Interface{
+			{Name: "uplink", Type: models.InterfaceTypeBond, MacAddress: "52:54:00:00:00:01"},
+			{Name: "eno1", Type: models.InterfaceTypePhysical, MacAddress: "52:54:00:00:00:03", Controller: "uplink"},
+			{Name: "storage", Type: models.InterfaceTypeVlan, Parent: "uplink", VlanID: 20},
+		}}
+		PopulateInterfacesRelations(inventory)
+		Expect(inventory.Interfaces[1].Controller).To(Equal("uplink"))
+		Expect(inventory.Interfaces[2].Parent).To(Equal("uplink"))
+		Expect(inventory.Interfaces[2].VlanID).To(Equal(int64(20)))
+	})
+})
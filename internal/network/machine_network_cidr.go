@@ -8,6 +8,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -55,7 +56,7 @@ func CalculateMachineNetworkCIDR(apiVip string, ingressVip string, hosts []*mode
 		if err != nil {
 			continue
 		}
-		for _, intf := range inventory.Interfaces {
+		for _, intf := range GetAddressableInterfaces(&inventory) {
 			var ipnet *net.IPNet
 			if isIPv4 {
 				ipnet = getVIPInterfaceNetwork(parsedVipAddr, intf.IPV4Addresses)
@@ -85,15 +86,69 @@ func ipInCidr(ipStr, cidrStr string) bool {
 	return ipnet.Contains(ip)
 }
 
-func VerifyVip(hosts []*models.Host, machineNetworkCidr string, vip string, vipName string, mustExist bool, log logrus.FieldLogger) error {
+// GetMachineNetworkForIP returns the CIDR of the first machine network containing the given IP, or an empty string
+func GetMachineNetworkForIP(machineNetworks []*models.MachineNetwork, ip string) string {
+	for _, machineNetwork := range machineNetworks {
+		if ipInCidr(ip, string(machineNetwork.Cidr)) {
+			return string(machineNetwork.Cidr)
+		}
+	}
+	return ""
+}
+
+func countMachineNetworksOfFamily(machineNetworks []*models.MachineNetwork, isIPv4 bool) int {
+	count := 0
+	for _, machineNetwork := range machineNetworks {
+		if IsIPV4CIDR(string(machineNetwork.Cidr)) == isIPv4 {
+			count++
+		}
+	}
+	return count
+}
+
+// getMastersOutsideNetwork returns the hostnames of the masters that have an inventory and no address in the given network
+func getMastersOutsideNetwork(hosts []*models.Host, machineNetworkCidr string, log logrus.FieldLogger) []string {
+	_, machineIpnet, err := net.ParseCIDR(machineNetworkCidr)
+	if err != nil {
+		return nil
+	}
+	ret := make([]string, 0)
+	for _, h := range hosts {
+		if swag.StringValue(h.Status) == models.HostStatusDisabled || h.Inventory == "" ||
+			common.GetEffectiveRole(h) != models.HostRoleMaster {
+			continue
+		}
+		if !belongsToNetwork(log, h, machineIpnet) {
+			ret = append(ret, hostutil.GetHostnameForMsg(h))
+		}
+	}
+	return ret
+}
+
+// VerifyVip checks that the VIP belongs to one of the machine networks and that it is not in use. When there are
+// several machine networks of the VIP's address family, the network of the VIP also has to be shared by all the masters.
+func VerifyVip(hosts []*models.Host, machineNetworks []*models.MachineNetwork, vip string, vipName string, mustExist bool, log logrus.FieldLogger) error {
 	if !mustExist && vip == "" {
 		return nil
 	}
-	if machineNetworkCidr == "" {
+	if !common.IsSliceNonEmpty(machineNetworks) {
 		return errors.Errorf("%s <%s> cannot be set if Machine Network CIDR is empty", vipName, vip)
 	}
-	if !ipInCidr(vip, machineNetworkCidr) {
-		return errors.Errorf("%s <%s> does not belong to machine-network-cidr <%s>", vipName, vip, machineNetworkCidr)
+	machineNetworkCidr := GetMachineNetworkForIP(machineNetworks, vip)
+	if machineNetworkCidr == "" {
+		cidrs := make([]string, 0, len(machineNetworks))
+		for _, machineNetwork := range machineNetworks {
+			if IsIPV4CIDR(string(machineNetwork.Cidr)) == IsIPv4Addr(vip) {
+				cidrs = append(cidrs, string(machineNetwork.Cidr))
+			}
+		}
+		return errors.Errorf("%s <%s> does not belong to machine-network-cidr <%s>", vipName, vip, strings.Join(cidrs, ", "))
+	}
+	if countMachineNetworksOfFamily(machineNetworks, IsIPV4CIDR(machineNetworkCidr)) > 1 {
+		if masters := getMastersOutsideNetwork(hosts, machineNetworkCidr, log); len(masters) > 0 {
+			return errors.Errorf("%s <%s> belongs to machine-network-cidr <%s> which is not shared by all the masters, missing: %s",
+				vipName, vip, machineNetworkCidr, strings.Join(masters, ", "))
+		}
 	}
 	if !IpInFreeList(hosts, vip, machineNetworkCidr, log) {
 		return errors.Errorf("%s <%s> is already in use in cidr %s", vipName, vip, machineNetworkCidr)
@@ -108,10 +163,10 @@ func VerifyDifferentVipAddresses(apiVip string, ingressVip string) error {
 	return nil
 }
 
-func VerifyVips(hosts []*models.Host, machineNetworkCidr string, apiVip string, ingressVip string, mustExist bool, log logrus.FieldLogger) error {
-	err := VerifyVip(hosts, machineNetworkCidr, apiVip, "api-vip", mustExist, log)
+func VerifyVips(hosts []*models.Host, machineNetworks []*models.MachineNetwork, apiVip string, ingressVip string, mustExist bool, log logrus.FieldLogger) error {
+	err := VerifyVip(hosts, machineNetworks, apiVip, "api-vip", mustExist, log)
 	if err == nil {
-		err = VerifyVip(hosts, machineNetworkCidr, ingressVip, "ingress-vip", mustExist, log)
+		err = VerifyVip(hosts, machineNetworks, ingressVip, "ingress-vip", mustExist, log)
 	}
 	if err == nil {
 		err = VerifyDifferentVipAddresses(apiVip, ingressVip)
@@ -151,7 +206,7 @@ func getMachineCIDRObj(host *models.Host, machineNetworkCidr string, obj string)
 	if err != nil {
 		return "", err
 	}
-	for _, intf := range inventory.Interfaces {
+	for _, intf := range GetAddressableInterfaces(&inventory) {
 		found, addr := findMatchingIP(ipNet, intf, isIPv4)
 		if found {
 			switch obj {
@@ -203,7 +258,7 @@ func belongsToNetwork(log logrus.FieldLogger, h *models.Host, machineIpnet *net.
 		return false
 	}
 	isIPv4 := IsIPV4CIDR(machineIpnet.String())
-	for _, intf := range inventory.Interfaces {
+	for _, intf := range GetAddressableInterfaces(&inventory) {
 		if found, _ := findMatchingIP(machineIpnet, intf, isIPv4); found {
 			return true
 		}
@@ -319,7 +374,7 @@ func GetClusterNetworks(hosts []*models.Host, log logrus.FieldLogger) []string {
 				log.WithError(err).Warnf("Unmarshal inventory %s", h.Inventory)
 				continue
 			}
-			for _, inf := range inventory.Interfaces {
+			for _, inf := range GetAddressableInterfaces(&inventory) {
 
 				for _, ipv4 := range inf.IPV4Addresses {
 					_, cidr, err := net.ParseCIDR(ipv4)
@@ -349,24 +404,29 @@ func GetClusterNetworks(hosts []*models.Host, log logrus.FieldLogger) []string {
 }
 
 func IsHostInPrimaryMachineNetCidr(log logrus.FieldLogger, cluster *common.Cluster, host *models.Host) bool {
-	// The host should belong to all the networks specified as Machine Networks.
-
-	// TODO(mko) This rule should be revised as soon as OCP supports multiple machineNetwork
-	//           entries using the same IP stack.
+	// Hosts may sit in different routed subnets, so the host has to belong to at least one of the
+	// machine networks of every address family used by the cluster.
 
 	if !IsMachineCidrAvailable(cluster) {
 		return false
 	}
 
-	ret := true
+	var hasIPv4, hasIPv6, inIPv4, inIPv6 bool
 	for _, machineNet := range cluster.MachineNetworks {
 		_, machineIpnet, err := net.ParseCIDR(string(machineNet.Cidr))
 		if err != nil {
 			return false
 		}
-		ret = ret && belongsToNetwork(log, host, machineIpnet)
+		belongs := belongsToNetwork(log, host, machineIpnet)
+		if IsIPV4CIDR(string(machineNet.Cidr)) {
+			hasIPv4 = true
+			inIPv4 = inIPv4 || belongs
+		} else {
+			hasIPv6 = true
+			inIPv6 = inIPv6 || belongs
+		}
 	}
-	return ret
+	return (!hasIPv4 || inIPv4) && (!hasIPv6 || inIPv6)
 }
 
 type IPSet map[strfmt.IPv4]struct{}
@@ -155,6 +155,18 @@ var _ = Describe("inventory", func() {
 			}))
 
 		})
+		It("Ports of bonds are left out", func() {
+			port := createInterface("1.2.4.80/23")
+			port.Controller = "bond0"
+			cluster := createCluster("1.2.5.6", "1.2.4.0/23",
+				createInventory(port, createInterface("3.3.3.3/16")),
+				createInventory(createInterface("1.2.4.79/23")))
+			hosts, err := GetPrimaryMachineCIDRHosts(logrus.New(), cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(hosts).To(Equal([]*models.Host{
+				cluster.Hosts[1],
+			}))
+		})
 	})
 	Context("VerifyVips", func() {
 		var (
@@ -174,9 +186,9 @@ var _ = Describe("inventory", func() {
 				},
 			}
 			cluster.IngressVip = cluster.APIVip
-			err := VerifyVips(cluster.Hosts, primaryMachineCidr, cluster.APIVip, cluster.IngressVip, false, log)
+			err := VerifyVips(cluster.Hosts, cluster.MachineNetworks, cluster.APIVip, cluster.IngressVip, false, log)
 			Expect(err).To(HaveOccurred())
-			err = VerifyVips(cluster.Hosts, primaryMachineCidr, cluster.APIVip, cluster.IngressVip, true, log)
+			err = VerifyVips(cluster.Hosts, cluster.MachineNetworks, cluster.APIVip, cluster.IngressVip, true, log)
 			Expect(err).To(HaveOccurred())
 		})
 		It("Different vips", func() {
@@ -188,9 +200,9 @@ var _ = Describe("inventory", func() {
 					FreeAddresses: "[{\"network\":\"1.2.4.0/23\",\"free_addresses\":[\"1.2.5.6\",\"1.2.5.8\"]}]",
 				},
 			}
-			err := VerifyVips(cluster.Hosts, primaryMachineCidr, cluster.APIVip, cluster.IngressVip, false, log)
+			err := VerifyVips(cluster.Hosts, cluster.MachineNetworks, cluster.APIVip, cluster.IngressVip, false, log)
 			Expect(err).ToNot(HaveOccurred())
-			err = VerifyVips(cluster.Hosts, primaryMachineCidr, cluster.APIVip, cluster.IngressVip, true, log)
+			err = VerifyVips(cluster.Hosts, cluster.MachineNetworks, cluster.APIVip, cluster.IngressVip, true, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 		It("Not free", func() {
@@ -202,9 +214,9 @@ var _ = Describe("inventory", func() {
 					FreeAddresses: "[{\"network\":\"1.2.4.0/23\",\"free_addresses\":[\"1.2.5.9\"]}]",
 				},
 			}
-			err := VerifyVips(cluster.Hosts, primaryMachineCidr, cluster.APIVip, cluster.IngressVip, false, log)
+			err := VerifyVips(cluster.Hosts, cluster.MachineNetworks, cluster.APIVip, cluster.IngressVip, false, log)
 			Expect(err).To(HaveOccurred())
-			err = VerifyVips(cluster.Hosts, primaryMachineCidr, cluster.APIVip, cluster.IngressVip, true, log)
+			err = VerifyVips(cluster.Hosts, cluster.MachineNetworks, cluster.APIVip, cluster.IngressVip, true, log)
 			Expect(err).To(HaveOccurred())
 		})
 		It("Disabled", func() {
@@ -217,9 +229,9 @@ var _ = Describe("inventory", func() {
 					Status:        swag.String(models.HostStatusDisabled),
 				},
 			}
-			err := VerifyVips(cluster.Hosts, primaryMachineCidr, cluster.APIVip, cluster.IngressVip, false, log)
+			err := VerifyVips(cluster.Hosts, cluster.MachineNetworks, cluster.APIVip, cluster.IngressVip, false, log)
 			Expect(err).ToNot(HaveOccurred())
-			err = VerifyVips(cluster.Hosts, primaryMachineCidr, cluster.APIVip, cluster.IngressVip, true, log)
+			err = VerifyVips(cluster.Hosts, cluster.MachineNetworks, cluster.APIVip, cluster.IngressVip, true, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 		It("Empty", func() {
@@ -231,9 +243,9 @@ var _ = Describe("inventory", func() {
 					FreeAddresses: "",
 				},
 			}
-			err := VerifyVips(cluster.Hosts, primaryMachineCidr, cluster.APIVip, cluster.IngressVip, false, log)
+			err := VerifyVips(cluster.Hosts, cluster.MachineNetworks, cluster.APIVip, cluster.IngressVip, false, log)
 			Expect(err).ToNot(HaveOccurred())
-			err = VerifyVips(cluster.Hosts, primaryMachineCidr, cluster.APIVip, cluster.IngressVip, true, log)
+			err = VerifyVips(cluster.Hosts, cluster.MachineNetworks, cluster.APIVip, cluster.IngressVip, true, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 		It("Free", func() {
@@ -245,13 +257,88 @@ var _ = Describe("inventory", func() {
 					FreeAddresses: "[{\"network\":\"1.2.4.0/23\",\"free_addresses\":[\"1.2.5.6\",\"1.2.5.8\",\"1.2.5.9\"]}]",
 				},
 			}
-			err := VerifyVips(cluster.Hosts, primaryMachineCidr, cluster.APIVip, cluster.IngressVip, false, log)
+			err := VerifyVips(cluster.Hosts, cluster.MachineNetworks, cluster.APIVip, cluster.IngressVip, false, log)
 			Expect(err).ToNot(HaveOccurred())
-			err = VerifyVips(cluster.Hosts, primaryMachineCidr, cluster.APIVip, cluster.IngressVip, true, log)
+			err = VerifyVips(cluster.Hosts, cluster.MachineNetworks, cluster.APIVip, cluster.IngressVip, true, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
 	})
 
+	Context("VerifyVips with multiple machine networks", func() {
+		var (
+			log     logrus.FieldLogger
+			cluster *common.Cluster
+		)
+
+		BeforeEach(func() {
+			log = logrus.New()
+			cluster = createCluster("1.2.5.6", "",
+				createInventory(createInterface("1.2.5.7/23")),
+				createInventory(createInterface("1.2.5.8/23")),
+				createInventory(createInterface("10.0.0.7/24")))
+			cluster.IngressVip = "1.2.5.9"
+			cluster.MachineNetworks = []*models.MachineNetwork{{Cidr: "10.0.0.0/24"}, {Cidr: "1.2.4.0/23"}}
+			cluster.Hosts[0].Role = models.HostRoleMaster
+			cluster.Hosts[1].Role = models.HostRoleMaster
+			cluster.Hosts[2].Role = models.HostRoleWorker
+			cluster.Hosts[2].RequestedHostname = "worker-0"
+		})
+
+		It("accepts VIPs in a network shared by all the masters", func() {
+			Expect(VerifyVips(cluster.Hosts, cluster.MachineNetworks, cluster.APIVip, cluster.IngressVip, true, log)).ToNot(HaveOccurred())
+		})
+
+		It("rejects VIPs outside of all the machine networks", func() {
+			err := VerifyVips(cluster.Hosts, cluster.MachineNetworks, "192.168.1.1", cluster.IngressVip, true, log)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("does not belong to machine-network-cidr <10.0.0.0/24, 1.2.4.0/23>"))
+		})
+
+		It("rejects VIPs in a network that is not shared by all the masters", func() {
+			cluster.Hosts[2].Role = models.HostRoleMaster
+			err := VerifyVips(cluster.Hosts, cluster.MachineNetworks, cluster.APIVip, cluster.IngressVip, true, log)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("not shared by all the masters, missing: worker-0"))
+		})
+
+		It("ignores disabled masters", func() {
+			cluster.Hosts[2].Role = models.HostRoleMaster
+			cluster.Hosts[2].Status = swag.String(models.HostStatusDisabled)
+			Expect(VerifyVips(cluster.Hosts, cluster.MachineNetworks, cluster.APIVip, cluster.IngressVip, true, log)).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("IsHostInPrimaryMachineNetCidr", func() {
+		var log logrus.FieldLogger
+
+		BeforeEach(func() {
+			log = logrus.New()
+		})
+
+		It("accepts a host in one of several machine networks of the same family", func() {
+			cluster := createCluster("", "",
+				createInventory(createInterface("10.0.1.7/24")))
+			cluster.MachineNetworks = []*models.MachineNetwork{{Cidr: "10.0.0.0/24"}, {Cidr: "10.0.1.0/24"}}
+			Expect(IsHostInPrimaryMachineNetCidr(log, cluster, cluster.Hosts[0])).To(BeTrue())
+		})
+
+		It("rejects a host outside of all the machine networks", func() {
+			cluster := createCluster("", "",
+				createInventory(createInterface("10.0.2.7/24")))
+			cluster.MachineNetworks = []*models.MachineNetwork{{Cidr: "10.0.0.0/24"}, {Cidr: "10.0.1.0/24"}}
+			Expect(IsHostInPrimaryMachineNetCidr(log, cluster, cluster.Hosts[0])).To(BeFalse())
+		})
+
+		It("requires a machine network of every address family", func() {
+			cluster := createCluster("", "",
+				createInventory(addIPv6Addresses(createInterface("10.0.1.7/24"), "2001:db8::a1/120")),
+				createInventory(createInterface("10.0.0.7/24")))
+			cluster.MachineNetworks = []*models.MachineNetwork{{Cidr: "10.0.0.0/24"}, {Cidr: "10.0.1.0/24"}, {Cidr: "2001:db8::/120"}}
+			Expect(IsHostInPrimaryMachineNetCidr(log, cluster, cluster.Hosts[0])).To(BeTrue())
+			Expect(IsHostInPrimaryMachineNetCidr(log, cluster, cluster.Hosts[1])).To(BeFalse())
+		})
+	})
+
 	Context("GetClusterNetworks", func() {
 
 		var log logrus.FieldLogger
@@ -6,11 +6,15 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
+	"strings"
 	"text/template"
 
 	"github.com/go-openapi/swag"
 	"github.com/kelseyhightower/envconfig"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
 	"github.com/openshift/assisted-service/models"
 	operations "github.com/openshift/assisted-service/restapi/operations/manifests"
@@ -27,6 +31,7 @@ type ManifestsGeneratorAPI interface {
 	AddTelemeterManifest(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error
 	AddSchedulableMastersManifest(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error
 	AddDiskEncryptionManifest(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error
+	AddNodeTopologyLabelsManifest(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error
 	IsSNODNSMasqEnabled() bool
 }
 
@@ -356,6 +361,167 @@ func (m *ManifestsGenerator) AddDiskEncryptionManifest(ctx context.Context, log
 	return nil
 }
 
+const (
+	topologyZoneLabel              = "topology.kubernetes.io/zone"
+	failureDomainLabelPrefix       = "failure-domain.assisted-install.openshift.io/"
+	maxLabelValueLength            = 63
+	nodeTopologyLabelsScriptPath   = "/usr/local/bin/node-topology-labels.sh"
+	nodeTopologyLabelsManifestName = "50-%ss-node-topology-labels"
+)
+
+// The script runs on every node of a role and labels the node with the failure domains of its host, using the
+// credentials of the kubelet that are allowed to label its own node
+const nodeTopologyLabelsScript = `#!/bin/bash
+set -euo pipefail
+
+case "$(hostname)" in
+{{- range .HOSTS }}
+  {{ .Hostname }}) LABELS="{{ .Labels }}" ;;
+{{- end }}
+  *) exit 0 ;;
+esac
+
+until oc --kubeconfig /var/lib/kubelet/kubeconfig label node "$(hostname)" --overwrite ${LABELS}; do
+  sleep 10
+done
+`
+
+const nodeTopologyLabelsManifest = `
+apiVersion: machineconfiguration.openshift.io/v1
+kind: MachineConfig
+metadata:
+  labels:
+    machineconfiguration.openshift.io/role: {{.ROLE}}
+  name: {{.NAME}}
+spec:
+  config:
+    ignition:
+      config: {}
+      security:
+        tls: {}
+      timeouts: {}
+      version: 2.2.0
+    networkd: {}
+    passwd: {}
+    storage:
+      files:
+        - contents:
+            source: data:text/plain;charset=utf-8;base64,{{.SCRIPT_CONTENT}}
+            verification: {}
+          filesystem: root
+          mode: 365
+          path: {{.SCRIPT_PATH}}
+    systemd:
+      units:
+        - name: node-topology-labels.service
+          enabled: true
+          contents: |
+            [Unit]
+            Description=Label the node with the failure domains of its host
+            Wants=kubelet.service
+            After=kubelet.service
+
+            [Service]
+            Type=oneshot
+            RemainAfterExit=yes
+            ExecStart={{.SCRIPT_PATH}}
+
+            [Install]
+            WantedBy=multi-user.target
+`
+
+var invalidLabelValueChars = regexp.MustCompile("[^A-Za-z0-9._-]+")
+
+// toLabelValue turns a failure domain, for example the MAC address of the chassis of an LLDP neighbor, into a
+// valid label value
+func toLabelValue(value string) string {
+	value = invalidLabelValueChars.ReplaceAllString(value, "-")
+	if len(value) > maxLabelValueLength {
+		value = value[:maxLabelValueLength]
+	}
+	return strings.Trim(value, "-_.")
+}
+
+type nodeTopologyLabels struct {
+	Hostname string
+	Labels   string
+}
+
+func getNodeTopologyLabels(c *common.Cluster, role models.HostRole) ([]nodeTopologyLabels, error) {
+	nodes := make([]nodeTopologyLabels, 0)
+	for _, h := range c.Hosts {
+		if swag.StringValue(h.Status) == models.HostStatusDisabled || common.GetEffectiveRole(h) != role || h.FailureDomain == nil {
+			continue
+		}
+
+		labels := make([]string, 0)
+		addLabel := func(key, value string) {
+			if value = toLabelValue(value); value != "" {
+				labels = append(labels, fmt.Sprintf("%s=%s", key, value))
+			}
+		}
+		addLabel(topologyZoneLabel, h.FailureDomain.Rack)
+		addLabel(failureDomainLabelPrefix+"rack", h.FailureDomain.Rack)
+		addLabel(failureDomainLabelPrefix+"chassis", h.FailureDomain.Chassis)
+		addLabel(failureDomainLabelPrefix+"power-domain", h.FailureDomain.PowerDomain)
+		if len(labels) == 0 {
+			continue
+		}
+
+		hostname, err := hostutil.GetCurrentHostName(h)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the hostname of host %s", h.ID)
+		}
+		nodes = append(nodes, nodeTopologyLabels{Hostname: hostname, Labels: strings.Join(labels, " ")})
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Hostname < nodes[j].Hostname
+	})
+	return nodes, nil
+}
+
+func createNodeTopologyLabelsManifestContent(c *common.Cluster, role models.HostRole, log logrus.FieldLogger) ([]byte, error) {
+	nodes, err := getNodeTopologyLabels(c, role)
+	if err != nil || len(nodes) == 0 {
+		return nil, err
+	}
+
+	script, err := fillTemplate(map[string]interface{}{"HOSTS": nodes}, nodeTopologyLabelsScript, log)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifestParams = map[string]interface{}{
+		"NAME":           fmt.Sprintf(nodeTopologyLabelsManifestName, role),
+		"ROLE":           string(role),
+		"SCRIPT_CONTENT": base64.StdEncoding.EncodeToString(script),
+		"SCRIPT_PATH":    nodeTopologyLabelsScriptPath,
+	}
+	return fillTemplate(manifestParams, nodeTopologyLabelsManifest, log)
+}
+
+// AddNodeTopologyLabelsManifest propagates the failure domains of the hosts, either set by the user or derived from
+// their LLDP neighbors, as labels of their nodes
+func (m *ManifestsGenerator) AddNodeTopologyLabelsManifest(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error {
+	for _, role := range []models.HostRole{models.HostRoleMaster, models.HostRoleWorker} {
+		content, err := createNodeTopologyLabelsManifestContent(c, role, log)
+		if err != nil {
+			return errors.Wrapf(err, "Failed to create node topology labels manifest content for role %s cluster id %s", role, *c.ID)
+		}
+		if content == nil {
+			continue
+		}
+
+		log.Infof("Creating manifest to label the %s nodes with their failure domains", role)
+		filename := fmt.Sprintf(nodeTopologyLabelsManifestName+".yaml", role)
+		if err := m.createManifests(ctx, c, filename, content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ManifestsGenerator) createManifests(ctx context.Context, cluster *common.Cluster, filename string, content []byte) error {
 	// all relevant logs of creating manifest will be inside CreateClusterManifest
 	_, err := m.manifestsApi.CreateClusterManifestInternal(ctx, operations.CreateClusterManifestParams{
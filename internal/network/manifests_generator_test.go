@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -609,3 +610,110 @@ var _ = Describe("disk encryption manifest", func() {
 		})
 	}
 })
+
+var _ = Describe("node topology labels manifest", func() {
+
+	var (
+		ctx                   = context.Background()
+		log                   *logrus.Logger
+		ctrl                  *gomock.Controller
+		mockManifestsApi      *manifestsapi.MockManifestsAPI
+		manifestsGeneratorApi ManifestsGeneratorAPI
+		clusterId             strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		log = logrus.New()
+		ctrl = gomock.NewController(GinkgoT())
+		mockManifestsApi = manifestsapi.NewMockManifestsAPI(ctrl)
+		manifestsGeneratorApi = NewManifestsGenerator(mockManifestsApi, Config{})
+		clusterId = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	createHost := func(hostname string, role models.HostRole, failureDomain *models.FailureDomain) *models.Host {
+		hostID := strfmt.UUID(uuid.New().String())
+		return &models.Host{
+			ID:                &hostID,
+			ClusterID:         &clusterId,
+			InfraEnvID:        clusterId,
+			Status:            swag.String(models.HostStatusKnown),
+			Role:              role,
+			RequestedHostname: hostname,
+			FailureDomain:     failureDomain,
+		}
+	}
+
+	getScript := func(content []byte) string {
+		prefix := "source: data:text/plain;charset=utf-8;base64,"
+		start := strings.Index(string(content), prefix) + len(prefix)
+		end := start + strings.Index(string(content[start:]), "\n")
+		script, err := base64.StdEncoding.DecodeString(string(content[start:end]))
+		Expect(err).ShouldNot(HaveOccurred())
+		return string(script)
+	}
+
+	It("labels the nodes with the failure domains of their hosts", func() {
+		c := &common.Cluster{Cluster: models.Cluster{ID: &clusterId, Hosts: []*models.Host{
+			createHost("master-0", models.HostRoleMaster, &models.FailureDomain{Rack: "rack1", PowerDomain: "pdu-a"}),
+			createHost("master-1", models.HostRoleMaster, &models.FailureDomain{Rack: "aa:bb:cc:dd:ee:ff", Chassis: "c1"}),
+			createHost("master-2", models.HostRoleMaster, nil),
+			createHost("worker-0", models.HostRoleWorker, &models.FailureDomain{Rack: "rack2"}),
+		}}}
+
+		content, err := createNodeTopologyLabelsManifestContent(c, models.HostRoleMaster, log)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring("name: 50-masters-node-topology-labels"))
+		script := getScript(content)
+		Expect(script).To(ContainSubstring(`master-0) LABELS="topology.kubernetes.io/zone=rack1 ` +
+			`failure-domain.assisted-install.openshift.io/rack=rack1 failure-domain.assisted-install.openshift.io/power-domain=pdu-a"`))
+		Expect(script).To(ContainSubstring(`master-1) LABELS="topology.kubernetes.io/zone=aa-bb-cc-dd-ee-ff`))
+		Expect(script).To(ContainSubstring("failure-domain.assisted-install.openshift.io/chassis=c1"))
+		Expect(script).ToNot(ContainSubstring("master-2"))
+		Expect(script).ToNot(ContainSubstring("worker-0"))
+	})
+
+	for _, t := range []struct {
+		name           string
+		hosts          []*models.Host
+		numOfManifests int
+	}{
+		{
+			name:           "no failure domains",
+			hosts:          []*models.Host{createHost("master-0", models.HostRoleMaster, &models.FailureDomain{})},
+			numOfManifests: 0,
+		},
+		{
+			name:           "masters only",
+			hosts:          []*models.Host{createHost("master-0", models.HostRoleMaster, &models.FailureDomain{Rack: "rack1"})},
+			numOfManifests: 1,
+		},
+		{
+			name: "masters and workers",
+			hosts: []*models.Host{
+				createHost("master-0", models.HostRoleMaster, &models.FailureDomain{Rack: "rack1"}),
+				createHost("worker-0", models.HostRoleWorker, &models.FailureDomain{PowerDomain: "pdu-a"}),
+			},
+			numOfManifests: 2,
+		},
+	} {
+		t := t
+
+		It(t.name, func() {
+			c := &common.Cluster{Cluster: models.Cluster{ID: &clusterId, Hosts: t.hosts}}
+			mockManifestsApi.EXPECT().CreateClusterManifestInternal(ctx, gomock.Any()).Times(t.numOfManifests)
+			Expect(manifestsGeneratorApi.AddNodeTopologyLabelsManifest(ctx, log, c)).To(Succeed())
+		})
+	}
+
+	It("CreateClusterManifest failure", func() {
+		c := &common.Cluster{Cluster: models.Cluster{ID: &clusterId, Hosts: []*models.Host{
+			createHost("master-0", models.HostRoleMaster, &models.FailureDomain{Rack: "rack1"}),
+		}}}
+		mockManifestsApi.EXPECT().CreateClusterManifestInternal(ctx, gomock.Any()).Return(nil, errors.Errorf("failed to upload to s3")).Times(1)
+		Expect(manifestsGeneratorApi.AddNodeTopologyLabelsManifest(ctx, log, c)).ToNot(Succeed())
+	})
+})
@@ -78,6 +78,20 @@ func (mr *MockManifestsGeneratorAPIMockRecorder) AddDnsmasqForSingleNode(ctx, lo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDnsmasqForSingleNode", reflect.TypeOf((*MockManifestsGeneratorAPI)(nil).AddDnsmasqForSingleNode), ctx, log, c)
 }
 
+// AddNodeTopologyLabelsManifest mocks base method.
+func (m *MockManifestsGeneratorAPI) AddNodeTopologyLabelsManifest(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNodeTopologyLabelsManifest", ctx, log, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddNodeTopologyLabelsManifest indicates an expected call of AddNodeTopologyLabelsManifest.
+func (mr *MockManifestsGeneratorAPIMockRecorder) AddNodeTopologyLabelsManifest(ctx, log, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNodeTopologyLabelsManifest", reflect.TypeOf((*MockManifestsGeneratorAPI)(nil).AddNodeTopologyLabelsManifest), ctx, log, c)
+}
+
 // AddSchedulableMastersManifest mocks base method.
 func (m *MockManifestsGeneratorAPI) AddSchedulableMastersManifest(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error {
 	m.ctrl.T.Helper()
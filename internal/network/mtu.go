@@ -0,0 +1,108 @@
+package network
+
+import (
+	"net"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+func parseMachineNetworks(cluster *common.Cluster) []*net.IPNet {
+	ipNets := make([]*net.IPNet, 0)
+	for _, machineNetwork := range cluster.MachineNetworks {
+		if _, ipNet, err := net.ParseCIDR(string(machineNetwork.Cidr)); err == nil {
+			ipNets = append(ipNets, ipNet)
+		}
+	}
+	return ipNets
+}
+
+// GetHostMachineNetworkMTU returns the lowest MTU of the interfaces of the host on the machine networks of the
+// cluster, or 0 if it is unknown
+func GetHostMachineNetworkMTU(cluster *common.Cluster, host *models.Host) (int64, error) {
+	if host.Inventory == "" {
+		return 0, nil
+	}
+	inventory, err := common.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		return 0, err
+	}
+	var mtu int64
+	for _, ipNet := range parseMachineNetworks(cluster) {
+		isIPv4 := IsIPV4CIDR(ipNet.String())
+		for _, intf := range GetAddressableInterfaces(inventory) {
+			if found, _ := findMatchingIP(ipNet, intf, isIPv4); found && intf.Mtu > 0 && (mtu == 0 || intf.Mtu < mtu) {
+				mtu = intf.Mtu
+			}
+		}
+	}
+	return mtu, nil
+}
+
+// GetMachineNetworkMTUs returns the MTU of the enabled hosts of the cluster on the machine networks, by host ID.
+// The hosts whose MTU is unknown are left out.
+func GetMachineNetworkMTUs(cluster *common.Cluster) (map[strfmt.UUID]int64, error) {
+	mtus := make(map[strfmt.UUID]int64)
+	for _, h := range cluster.Hosts {
+		if swag.StringValue(h.Status) == models.HostStatusDisabled {
+			continue
+		}
+		mtu, err := GetHostMachineNetworkMTU(cluster, h)
+		if err != nil {
+			return nil, err
+		}
+		if mtu > 0 {
+			mtus[*h.ID] = mtu
+		}
+	}
+	return mtus, nil
+}
+
+// GetMajorityMTU returns the MTU shared by most of the hosts, the lowest one in case of a tie, or 0 if there are no
+// hosts
+func GetMajorityMTU(mtus map[strfmt.UUID]int64) int64 {
+	counts := make(map[int64]int)
+	for _, mtu := range mtus {
+		counts[mtu]++
+	}
+	values := make([]int64, 0, len(counts))
+	for mtu := range counts {
+		values = append(values, mtu)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if counts[values[i]] != counts[values[j]] {
+			return counts[values[i]] > counts[values[j]]
+		}
+		return values[i] < values[j]
+	})
+	if len(values) == 0 {
+		return 0
+	}
+	return values[0]
+}
+
+// GetFailedLargePacketAddresses returns the addresses on the machine networks that the host could not reach with
+// packets that must not be fragmented, as reported by its connectivity check
+func GetFailedLargePacketAddresses(cluster *common.Cluster, report *models.ConnectivityReport) []string {
+	ipNets := parseMachineNetworks(cluster)
+	failed := make([]string, 0)
+	for _, rh := range report.RemoteHosts {
+		for _, l3 := range rh.L3Connectivity {
+			if l3.LargePacketSize == 0 || l3.LargePacketSuccessful {
+				continue
+			}
+			ip := net.ParseIP(l3.RemoteIPAddress)
+			for _, ipNet := range ipNets {
+				if ip != nil && ipNet.Contains(ip) {
+					failed = append(failed, l3.RemoteIPAddress)
+					break
+				}
+			}
+		}
+	}
+	sort.Strings(failed)
+	return failed
+}
@@ -0,0 +1,65 @@
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("MTU", func() {
+	createHost := func(status string, interfaces ...*models.Interface) *models.Host {
+		id := strfmt.UUID(uuid.New().String())
+		inventory, err := json.Marshal(&models.Inventory{Interfaces: interfaces})
+		Expect(err).ToNot(HaveOccurred())
+		return &models.Host{ID: &id, Status: swag.String(status), Inventory: string(inventory)}
+	}
+
+	createCluster := func(hosts ...*models.Host) *common.Cluster {
+		return &common.Cluster{Cluster: models.Cluster{
+			MachineNetworks: []*models.MachineNetwork{{Cidr: "1.2.3.0/24"}},
+			Hosts:           hosts,
+		}}
+	}
+
+	It("gets the MTU of the hosts on the machine network", func() {
+		jumbo := createHost(models.HostStatusKnown,
+			&models.Interface{Name: "eth0", IPV4Addresses: []string{"1.2.3.4/24"}, Mtu: 9000},
+			&models.Interface{Name: "eth1", IPV4Addresses: []string{"5.6.7.8/24"}, Mtu: 1500})
+		standard := createHost(models.HostStatusKnown, &models.Interface{Name: "eth0", IPV4Addresses: []string{"1.2.3.5/24"}, Mtu: 1500})
+		unknown := createHost(models.HostStatusKnown, &models.Interface{Name: "eth0", IPV4Addresses: []string{"1.2.3.6/24"}})
+		disabled := createHost(models.HostStatusDisabled, &models.Interface{Name: "eth0", IPV4Addresses: []string{"1.2.3.7/24"}, Mtu: 1400})
+		cluster := createCluster(jumbo, standard, unknown, disabled)
+
+		mtu, err := GetHostMachineNetworkMTU(cluster, jumbo)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mtu).To(Equal(int64(9000)))
+
+		mtus, err := GetMachineNetworkMTUs(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mtus).To(Equal(map[strfmt.UUID]int64{*jumbo.ID: 9000, *standard.ID: 1500}))
+	})
+
+	It("gets the MTU of most hosts", func() {
+		Expect(GetMajorityMTU(map[strfmt.UUID]int64{})).To(BeZero())
+		Expect(GetMajorityMTU(map[strfmt.UUID]int64{"a": 9000, "b": 1500})).To(Equal(int64(1500)))
+		Expect(GetMajorityMTU(map[strfmt.UUID]int64{"a": 9000, "b": 1500, "c": 9000})).To(Equal(int64(9000)))
+	})
+
+	It("gets the addresses of the machine network that large packets did not reach", func() {
+		report := &models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{{
+			L3Connectivity: []*models.L3Connectivity{
+				{RemoteIPAddress: "1.2.3.5", Successful: true, LargePacketSize: 9000, LargePacketSuccessful: false},
+				{RemoteIPAddress: "1.2.3.6", Successful: true, LargePacketSize: 9000, LargePacketSuccessful: true},
+				{RemoteIPAddress: "1.2.3.7", Successful: true},
+				{RemoteIPAddress: "5.6.7.8", Successful: true, LargePacketSize: 9000, LargePacketSuccessful: false},
+			},
+		}}}
+		Expect(GetFailedLargePacketAddresses(createCluster(), report)).To(Equal([]string{"1.2.3.5"}))
+	})
+})
@@ -56,7 +56,7 @@ func (o *operator) GetName() string {
 }
 
 // GetDependencies provides a list of dependencies of the Operator
-func (o *operator) GetDependencies() []string {
+func (o *operator) GetDependencies(*common.Cluster) []string {
 	return []string{lso.Operator.Name}
 }
 
@@ -212,7 +212,7 @@ func (o *operator) GetPreflightRequirements(_ context.Context, cluster *common.C
 	}
 	requirements := models.OperatorHardwareRequirements{
 		OperatorName: o.GetName(),
-		Dependencies: o.GetDependencies(),
+		Dependencies: o.GetDependencies(cluster),
 		Requirements: &models.HostTypeHardwareRequirementsWrapper{
 			Master: &models.HostTypeHardwareRequirements{
 				Qualitative: qualitativeRequirements,
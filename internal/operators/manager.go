@@ -309,6 +309,8 @@ func (mgr *Manager) ResolveDependencies(operators []*models.MonitoredOperator) (
 }
 
 func (mgr *Manager) getDependencies(operators []*models.MonitoredOperator) map[string]bool {
+	// The dependencies of an operator may depend on its properties, so they are resolved for a cluster with the operators
+	cluster := &common.Cluster{Cluster: models.Cluster{MonitoredOperators: operators}}
 	fifo := list.New()
 	visited := make(map[string]bool)
 	for _, op := range operators {
@@ -317,14 +319,14 @@ func (mgr *Manager) getDependencies(operators []*models.MonitoredOperator) map[s
 		}
 
 		visited[op.Name] = true
-		for _, dep := range mgr.olmOperators[op.Name].GetDependencies() {
+		for _, dep := range mgr.olmOperators[op.Name].GetDependencies(cluster) {
 			fifo.PushBack(dep)
 		}
 	}
 	for fifo.Len() > 0 {
 		first := fifo.Front()
 		op := first.Value.(string)
-		for _, dep := range mgr.olmOperators[op].GetDependencies() {
+		for _, dep := range mgr.olmOperators[op].GetDependencies(cluster) {
 			if !visited[dep] {
 				fifo.PushBack(dep)
 			}
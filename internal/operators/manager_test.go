@@ -243,6 +243,18 @@ var _ = Describe("Operators manager", func() {
 				[]*models.MonitoredOperator{&cnv.Operator, &ocs.Operator, &lso.Operator},
 			),
 		)
+
+		It("should not add LSO for OCS in external mode", func() {
+			externalOCS := ocs.Operator
+			externalOCS.Properties = `{"deployment_profile": "external"}`
+			resolvedDependencies, err := manager.ResolveDependencies([]*models.MonitoredOperator{&externalOCS})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolvedDependencies).To(Equal([]*models.MonitoredOperator{&externalOCS}))
+
+			resolvedDependencies, err = manager.ResolveDependencies([]*models.MonitoredOperator{&externalOCS, &cnv.Operator})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolvedDependencies).To(ConsistOf(&externalOCS, &cnv.Operator, &lso.Operator))
+		})
 	})
 
 	Context("Supported Operators", func() {
@@ -256,7 +268,9 @@ var _ = Describe("Operators manager", func() {
 			properties, err := manager.GetOperatorProperties("ocs")
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(properties).To(BeEquivalentTo(models.OperatorProperties{}))
+			Expect(properties).To(HaveLen(2))
+			Expect(properties[0].Name).To(Equal("deployment_profile"))
+			Expect(properties[1].Name).To(Equal("external_cluster_details"))
 		})
 	})
 
@@ -7,4 +7,18 @@ const (
 	HddDrive     string            = "HDD"
 	compactMode  ocsDeploymentMode = "Compact"
 	standardMode ocsDeploymentMode = "Standard"
+	externalMode ocsDeploymentMode = "External"
+)
+
+// Deployment profiles that can be selected through the operator properties
+const (
+	autoProfile             string = "auto"
+	internalStandardProfile string = "internal-standard"
+	internalCompactProfile  string = "internal-compact"
+	externalProfile         string = "external"
+)
+
+const (
+	deploymentProfileProperty      string = "deployment_profile"
+	externalClusterDetailsProperty string = "external_cluster_details"
 )
@@ -2,6 +2,7 @@ package ocs
 
 import (
 	"bytes"
+	"encoding/base64"
 	"text/template"
 )
 
@@ -60,6 +61,53 @@ func Manifests(ocsConfig *Config) (map[string][]byte, []byte, error) {
 	return openshiftManifests, ocsSC, nil
 }
 
+// ExternalManifests generates the manifests of OCS in external mode, which consumes the RHCS cluster described by
+// the details exported from it instead of deploying Ceph on the hosts
+func ExternalManifests(externalClusterDetails string) (map[string][]byte, []byte, error) {
+	openshiftManifests := make(map[string][]byte)
+
+	secret, err := ocsExternalClusterDetailsSecret(externalClusterDetails)
+	if err != nil {
+		return nil, nil, err
+	}
+	openshiftManifests["50_openshift-ocs_ns.yaml"] = []byte(ocsNamespace)
+	ocsSubscription, err := ocsSubscription()
+	if err != nil {
+		return map[string][]byte{}, []byte{}, err
+	}
+	openshiftManifests["50_openshift-ocs_subscription.yaml"] = []byte(ocsSubscription)
+	openshiftManifests["50_openshift-ocs_operator_group.yaml"] = []byte(ocsOperatorGroup)
+	openshiftManifests["50_openshift-ocs_external_cluster_details.yaml"] = []byte(secret)
+	return openshiftManifests, []byte(ocsExternalSC), nil
+}
+
+func ocsExternalClusterDetailsSecret(externalClusterDetails string) (string, error) {
+	data := map[string]string{
+		"OPERATOR_NAMESPACE":       Operator.Namespace,
+		"EXTERNAL_CLUSTER_DETAILS": base64.StdEncoding.EncodeToString([]byte(externalClusterDetails)),
+	}
+
+	const ocsExternalClusterDetailsSecret = `apiVersion: v1
+kind: Secret
+metadata:
+  name: rook-ceph-external-cluster-details
+  namespace: "{{.OPERATOR_NAMESPACE}}"
+type: Opaque
+data:
+  external_cluster_details: {{.EXTERNAL_CLUSTER_DETAILS}}`
+
+	tmpl, err := template.New("ocsExternalClusterDetailsSecret").Parse(ocsExternalClusterDetailsSecret)
+	if err != nil {
+		return "", err
+	}
+	buf := &bytes.Buffer{}
+	err = tmpl.Execute(buf, data)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 func ocsSubscription() (string, error) {
 	data := map[string]string{
 		"OPERATOR_NAMESPACE":         Operator.Namespace,
@@ -107,6 +155,16 @@ spec:
   targetNamespaces:
   - openshift-storage`
 
+const ocsExternalSC = `apiVersion: ocs.openshift.io/v1
+kind: StorageCluster
+metadata:
+  name: ocs-external-storagecluster
+  namespace: openshift-storage
+spec:
+  externalStorage:
+    enable: true
+  labelSelector: {}`
+
 const ocsMinDeploySC = `apiVersion: ocs.openshift.io/v1
 kind: StorageCluster
 metadata:
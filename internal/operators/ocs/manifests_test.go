@@ -50,6 +50,41 @@ var _ = Describe("OCS manifest generation", func() {
 			_, err = yaml.YAMLToJSON(manifest)
 			Expect(err).ShouldNot(HaveOccurred())
 		})
+		It("Check YAMLs of OCS in External Mode", func() {
+			externalCluster := common.Cluster{Cluster: models.Cluster{
+				OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+				MonitoredOperators: []*models.MonitoredOperator{{
+					Name:       Operator.Name,
+					Properties: `{"deployment_profile": "external"}`,
+				}},
+			},
+				OCSExternalClusterDetails: `[{"name": "rook-ceph-mon"}]`,
+			}
+			openshiftManifests, manifest, err := operator.GenerateManifests(&externalCluster)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(openshiftManifests).To(HaveLen(4))
+			Expect(openshiftManifests["50_openshift-ocs_ns.yaml"]).NotTo(HaveLen(0))
+			Expect(openshiftManifests["50_openshift-ocs_subscription.yaml"]).NotTo(HaveLen(0))
+			Expect(openshiftManifests["50_openshift-ocs_operator_group.yaml"]).NotTo(HaveLen(0))
 
+			for _, manifest := range openshiftManifests {
+				_, err = yaml.YAMLToJSON(manifest)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			var secret struct {
+				Metadata struct {
+					Name string `json:"name"`
+				} `json:"metadata"`
+				Data map[string][]byte `json:"data"`
+			}
+			Expect(yaml.Unmarshal(openshiftManifests["50_openshift-ocs_external_cluster_details.yaml"], &secret)).To(Succeed())
+			Expect(secret.Metadata.Name).To(Equal("rook-ceph-external-cluster-details"))
+			Expect(string(secret.Data["external_cluster_details"])).To(Equal(`[{"name": "rook-ceph-mon"}]`))
+
+			Expect(string(manifest)).To(ContainSubstring("ocs-external-storagecluster"))
+			_, err = yaml.YAMLToJSON(manifest)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
 	})
 })
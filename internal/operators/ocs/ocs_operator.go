@@ -53,8 +53,12 @@ func (o *operator) GetName() string {
 	return Operator.Name
 }
 
-// GetDependencies provides a list of dependencies of the Operator
-func (o *operator) GetDependencies() []string {
+// GetDependencies provides a list of dependencies of the Operator. In external mode OCS doesn't use the local disks,
+// so it doesn't depend on LSO.
+func (o *operator) GetDependencies(cluster *common.Cluster) []string {
+	if properties, err := getOCSProperties(cluster); err == nil && properties.DeploymentProfile == externalProfile {
+		return []string{}
+	}
 	return []string{lso.Operator.Name}
 }
 
@@ -70,14 +74,21 @@ func (o *operator) GetHostValidationID() string {
 
 // ValidateCluster verifies whether this operator is valid for given cluster
 func (o *operator) ValidateCluster(_ context.Context, cluster *common.Cluster) (api.ValidationResult, error) {
-	status, message := o.validateRequirements(&cluster.Cluster)
+	status, message := o.validateRequirements(cluster)
 
 	return api.ValidationResult{Status: status, ValidationId: o.GetClusterValidationID(), Reasons: []string{message}}, nil
 }
 
 // ValidateHost verifies whether this operator is valid for given host
 func (o *operator) ValidateHost(_ context.Context, cluster *common.Cluster, host *models.Host) (api.ValidationResult, error) {
-	numOfHosts := len(cluster.Hosts)
+	properties, err := getOCSProperties(cluster)
+	if err != nil {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{err.Error()}}, nil
+	}
+	// In external mode OCS connects to an existing RHCS cluster and doesn't use the disks of the hosts
+	if properties.DeploymentProfile == externalProfile {
+		return api.ValidationResult{Status: api.Success, ValidationId: o.GetHostValidationID(), Reasons: []string{}}, nil
+	}
 	if host.Inventory == "" {
 		return api.ValidationResult{Status: api.Pending, ValidationId: o.GetHostValidationID(), Reasons: []string{"Missing Inventory in the host."}}, nil
 	}
@@ -94,7 +105,7 @@ func (o *operator) ValidateHost(_ context.Context, cluster *common.Cluster, host
 	}
 
 	// compact mode
-	if numOfHosts <= 3 {
+	if isCompactMode(&cluster.Cluster, properties) {
 		if host.Role == models.HostRoleMaster || host.Role == models.HostRoleAutoAssign {
 			if diskCount == 0 {
 				return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{"Insufficient disks, OCS requires at least one non-bootable disk on each host in compact mode."}}, nil
@@ -115,13 +126,45 @@ func (o *operator) ValidateHost(_ context.Context, cluster *common.Cluster, host
 
 // GenerateManifests generates manifests for the operator
 func (o *operator) GenerateManifests(cluster *common.Cluster) (map[string][]byte, []byte, error) {
-	o.log.Info("No. of OCS eligible disks are ", o.config.OCSDisksAvailable)
-	return Manifests(o.config)
+	properties, err := getOCSProperties(cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config := *o.config
+	switch properties.DeploymentProfile {
+	case externalProfile:
+		o.log.Info("Deploying OCS in external mode")
+		return ExternalManifests(properties.ExternalClusterDetails)
+	case internalCompactProfile:
+		config.OCSDeploymentType = compactMode
+	case internalStandardProfile:
+		config.OCSDeploymentType = standardMode
+	}
+	o.log.Info("No. of OCS eligible disks are ", config.OCSDisksAvailable)
+	return Manifests(&config)
 }
 
-// GetProperties provides description of operator properties: none required
+// GetProperties provides description of operator properties: the deployment profile and the external cluster details
 func (o *operator) GetProperties() models.OperatorProperties {
-	return models.OperatorProperties{}
+	return models.OperatorProperties{
+		{
+			Name:         deploymentProfileProperty,
+			DataType:     models.OperatorPropertyDataTypeString,
+			Mandatory:    false,
+			Options:      []string{autoProfile, internalStandardProfile, internalCompactProfile, externalProfile},
+			DefaultValue: autoProfile,
+			Description: "OCS deployment profile: auto selects an internal profile from the number of hosts, internal-standard runs Ceph " +
+				"on at least 3 workers, internal-compact runs Ceph on the 3 masters and external connects to an existing RHCS cluster",
+		},
+		{
+			Name:      externalClusterDetailsProperty,
+			DataType:  models.OperatorPropertyDataTypeString,
+			Mandatory: false,
+			Description: "JSON output of ceph-external-cluster-details-exporter.py run on the RHCS cluster, " +
+				"required by the external deployment profile",
+		},
+	}
 }
 
 // GetMonitoredOperator returns MonitoredOperator corresponding to the OCS Operator
@@ -131,7 +174,13 @@ func (o *operator) GetMonitoredOperator() *models.MonitoredOperator {
 
 // GetHostRequirements provides operator's requirements towards the host
 func (o *operator) GetHostRequirements(_ context.Context, cluster *common.Cluster, host *models.Host) (*models.ClusterHostRequirementsDetails, error) {
-	numOfHosts := len(cluster.Hosts)
+	properties, err := getOCSProperties(cluster)
+	if err != nil {
+		return nil, err
+	}
+	if properties.DeploymentProfile == externalProfile {
+		return &models.ClusterHostRequirementsDetails{CPUCores: 0, RAMMib: 0}, nil
+	}
 
 	var diskCount int64 = 0
 	if host.Inventory != "" {
@@ -146,7 +195,7 @@ func (o *operator) GetHostRequirements(_ context.Context, cluster *common.Cluste
 	}
 
 	role := common.GetEffectiveRole(host)
-	if numOfHosts <= 3 { // Compact Mode
+	if isCompactMode(&cluster.Cluster, properties) { // Compact Mode
 		var reqDisks int64 = 1
 		if diskCount > 0 {
 			reqDisks = diskCount
@@ -185,10 +234,10 @@ func (o *operator) GetHostRequirements(_ context.Context, cluster *common.Cluste
 }
 
 // GetPreflightRequirements returns operator hardware requirements that can be determined with cluster data only
-func (o *operator) GetPreflightRequirements(context.Context, *common.Cluster) (*models.OperatorHardwareRequirements, error) {
+func (o *operator) GetPreflightRequirements(_ context.Context, cluster *common.Cluster) (*models.OperatorHardwareRequirements, error) {
 	return &models.OperatorHardwareRequirements{
 		OperatorName: o.GetName(),
-		Dependencies: o.GetDependencies(),
+		Dependencies: o.GetDependencies(cluster),
 		Requirements: &models.HostTypeHardwareRequirementsWrapper{
 			Master: &models.HostTypeHardwareRequirements{
 				Quantitative: &models.ClusterHostRequirementsDetails{
@@ -340,4 +340,137 @@ var _ = Describe("Ocs Operator", func() {
 		)
 	})
 
+	Context("Deployment profiles", func() {
+		const externalClusterDetails = `[{"name": "rook-ceph-mon-endpoints", "kind": "ConfigMap", "data": {"data": "a=10.0.0.1:6789", "maxMonId": "0"}},
+			{"name": "rook-ceph-mon", "kind": "Secret", "data": {"admin-secret": "admin-secret", "fsid": "fsid", "mon-secret": "mon-secret"}}]`
+
+		clusterWithProfile := func(properties string, hosts ...*models.Host) *common.Cluster {
+			return &common.Cluster{Cluster: models.Cluster{
+				Hosts:              hosts,
+				MonitoredOperators: []*models.MonitoredOperator{{Name: Operator.Name, Properties: properties}},
+			}}
+		}
+		withDetails := func(cluster *common.Cluster, details string) *common.Cluster {
+			cluster.OCSExternalClusterDetails = details
+			return cluster
+		}
+
+		table.DescribeTable("cluster validation when ", func(cluster *common.Cluster, expectedStatus api.ValidationStatus, expectedReason string) {
+			res, err := operator.ValidateCluster(ctx, cluster)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.Status).Should(Equal(expectedStatus))
+			Expect(res.Reasons).Should(Equal([]string{expectedReason}))
+		},
+			table.Entry("the profile is not supported",
+				clusterWithProfile(`{"deployment_profile": "internal-tiny"}`, masterWithThreeDisk, masterWithThreeDisk, masterWithThreeDisk),
+				api.Failure, "Unsupported OCS deployment profile internal-tiny",
+			),
+			table.Entry("the properties are not valid JSON",
+				clusterWithProfile(`{"deployment_profile": `, masterWithThreeDisk, masterWithThreeDisk, masterWithThreeDisk),
+				api.Failure, "Failed to parse the OCS operator properties: unexpected end of JSON input",
+			),
+			table.Entry("RHCS cluster details are set with an internal profile",
+				withDetails(clusterWithProfile(`{"deployment_profile": "internal-compact"}`, masterWithThreeDisk, masterWithThreeDisk, masterWithThreeDisk), "[]"),
+				api.Failure, "The RHCS cluster details can be set only with the external OCS deployment profile",
+			),
+			table.Entry("internal-compact is selected with more than 3 hosts",
+				clusterWithProfile(`{"deployment_profile": "internal-compact"}`, masterWithThreeDisk, masterWithThreeDisk, masterWithThreeDisk, workerWithTwoDisk),
+				api.Failure, "The internal-compact OCS deployment profile requires a cluster with exactly 3 masters.",
+			),
+			table.Entry("internal-standard is selected with 3 hosts",
+				clusterWithProfile(`{"deployment_profile": "internal-standard"}`, masterWithThreeDisk, masterWithThreeDisk, masterWithThreeDisk),
+				api.Failure, "The internal-standard OCS deployment profile requires a cluster with a minimum of 3 workers.",
+			),
+			table.Entry("external is selected without RHCS cluster details",
+				clusterWithProfile(`{"deployment_profile": "external"}`),
+				api.Failure, "The external OCS deployment profile requires the RHCS cluster details exported by ceph-external-cluster-details-exporter.py.",
+			),
+			table.Entry("external is selected with RHCS cluster details that are not a list",
+				withDetails(clusterWithProfile(`{"deployment_profile": "external"}`), "{}"),
+				api.Failure, "The RHCS cluster details are not a valid JSON list of resources: json: cannot unmarshal object into Go value of type []ocs.externalClusterResource",
+			),
+			table.Entry("external is selected with RHCS cluster details missing the monitors secret",
+				withDetails(clusterWithProfile(`{"deployment_profile": "external"}`), `[{"name": "rook-ceph-mon-endpoints", "kind": "ConfigMap"}]`),
+				api.Failure, "The RHCS cluster details are missing the rook-ceph-mon resource.",
+			),
+			table.Entry("external is selected with valid RHCS cluster details",
+				withDetails(clusterWithProfile(`{"deployment_profile": "external"}`), externalClusterDetails),
+				api.Success, "OCS Requirements for External Mode are satisfied.",
+			),
+		)
+
+		It("external profile doesn't validate or require the disks of the hosts", func() {
+			cluster := withDetails(clusterWithProfile(`{"deployment_profile": "external"}`, masterWithNoDisk, masterWithNoDisk, masterWithNoDisk),
+				externalClusterDetails)
+			res, err := operator.ValidateHost(ctx, cluster, masterWithNoDisk)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res).Should(Equal(api.ValidationResult{Status: api.Success, ValidationId: operator.GetHostValidationID(), Reasons: []string{}}))
+
+			requirements, err := operator.GetHostRequirements(ctx, cluster, masterWithNoDisk)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(requirements).Should(Equal(&models.ClusterHostRequirementsDetails{CPUCores: 0, RAMMib: 0}))
+		})
+
+		It("external profile doesn't depend on LSO", func() {
+			Expect(operator.GetDependencies(clusterWithProfile(`{"deployment_profile": "external"}`))).To(BeEmpty())
+			Expect(operator.GetDependencies(clusterWithProfile(`{"deployment_profile": "internal-standard"}`))).To(Equal([]string{"lso"}))
+			Expect(operator.GetDependencies(clusterWithProfile(""))).To(Equal([]string{"lso"}))
+		})
+
+		It("internal-compact profile validates the masters in compact mode", func() {
+			cluster := clusterWithProfile(`{"deployment_profile": "internal-compact"}`, masterWithThreeDisk, masterWithNoDisk, masterWithOneDisk)
+			res, err := operator.ValidateHost(ctx, cluster, masterWithNoDisk)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.Reasons).Should(Equal([]string{"Insufficient disks, OCS requires at least one non-bootable disk on each host in compact mode."}))
+		})
+
+		It("internal-standard profile doesn't require resources from the masters", func() {
+			cluster := clusterWithProfile(`{"deployment_profile": "internal-standard"}`, masterWithThreeDisk, masterWithNoDisk, masterWithOneDisk)
+			requirements, err := operator.GetHostRequirements(ctx, cluster, masterWithThreeDisk)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(requirements).Should(Equal(&models.ClusterHostRequirementsDetails{CPUCores: 0, RAMMib: 0}))
+		})
+
+		It("invalid properties fail the host requirements", func() {
+			_, err := operator.GetHostRequirements(ctx, clusterWithProfile(`{"deployment_profile": "internal-tiny"}`), masterWithThreeDisk)
+			Expect(err).Should(HaveOccurred())
+		})
+
+		table.DescribeTable("extracting the RHCS cluster details from the properties when ", func(properties, currentDetails, expectedProperties, expectedDetails string) {
+			remaining, details, err := ExtractExternalClusterDetails(properties, currentDetails)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(remaining).To(MatchJSON(expectedProperties))
+			Expect(details).To(Equal(expectedDetails))
+		},
+			table.Entry("the details are set", `{"deployment_profile": "external", "external_cluster_details": "[{}]"}`, "[]",
+				`{"deployment_profile": "external"}`, "[{}]"),
+			table.Entry("the external profile is kept without the details", `{"deployment_profile": "external"}`, "[]",
+				`{"deployment_profile": "external"}`, "[]"),
+			table.Entry("an internal profile is selected", `{"deployment_profile": "internal-standard"}`, "[]",
+				`{"deployment_profile": "internal-standard"}`, ""),
+		)
+
+		It("clears the RHCS cluster details without properties", func() {
+			remaining, details, err := ExtractExternalClusterDetails("", "[]")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(remaining).To(BeEmpty())
+			Expect(details).To(BeEmpty())
+		})
+
+		It("rejects properties that are not valid when extracting the RHCS cluster details", func() {
+			_, _, err := ExtractExternalClusterDetails(`{"deployment_profile": `, "")
+			Expect(err).Should(HaveOccurred())
+			_, _, err = ExtractExternalClusterDetails(`{"deployment_profile": "external", "external_cluster_details": []}`, "")
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("exposes the deployment profile and the RHCS cluster details as properties", func() {
+			properties := operator.GetProperties()
+			Expect(properties).To(HaveLen(2))
+			Expect(properties[0].Name).To(Equal("deployment_profile"))
+			Expect(properties[0].DefaultValue).To(Equal("auto"))
+			Expect(properties[0].Options).To(ConsistOf("auto", "internal-standard", "internal-compact", "external"))
+			Expect(properties[1].Name).To(Equal("external_cluster_details"))
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package ocs
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -25,28 +26,157 @@ type Config struct {
 	OCSDeploymentType               ocsDeploymentMode `envconfig:"OCS_DEPLOYMENT_TYPE" default:"None"`
 }
 
+// ocsProperties are the operator properties set by the user when enabling OCS. The external cluster details are set
+// with them, but are stored in the cluster as they hold Ceph keys.
+type ocsProperties struct {
+	DeploymentProfile      string `json:"deployment_profile,omitempty"`
+	ExternalClusterDetails string `json:"-"`
+}
+
+// externalClusterResource is an entry of the JSON exported from the RHCS cluster by ceph-external-cluster-details-exporter.py
+type externalClusterResource struct {
+	Name string                 `json:"name"`
+	Kind string                 `json:"kind"`
+	Data map[string]interface{} `json:"data"`
+}
+
+// resources of the exported RHCS cluster details that rook needs to connect to the external cluster
+var requiredExternalClusterResources = []string{"rook-ceph-mon-endpoints", "rook-ceph-mon"}
+
 type ocsClusterResourcesInfo struct {
 	numberOfDisks    int64 //number of Valid disks in the cluster
 	hostsWithDisks   int64 //number of hosts with Valid disk in the cluster
 	missingInventory bool  //checks for the missing inventory
 }
 
-func (o *operator) validateRequirements(cluster *models.Cluster) (api.ValidationStatus, string) {
+// getOCSProperties returns the OCS operator properties of the cluster, defaulting to the auto profile
+func getOCSProperties(cluster *common.Cluster) (*ocsProperties, error) {
+	properties := &ocsProperties{DeploymentProfile: autoProfile, ExternalClusterDetails: cluster.OCSExternalClusterDetails}
+	for _, operator := range cluster.MonitoredOperators {
+		if operator.Name != Operator.Name || operator.Properties == "" {
+			continue
+		}
+		if err := json.Unmarshal([]byte(operator.Properties), properties); err != nil {
+			return nil, fmt.Errorf("Failed to parse the OCS operator properties: %s", err.Error())
+		}
+	}
+
+	switch properties.DeploymentProfile {
+	case "":
+		properties.DeploymentProfile = autoProfile
+	case autoProfile, internalStandardProfile, internalCompactProfile, externalProfile:
+	default:
+		return nil, fmt.Errorf("Unsupported OCS deployment profile %s", properties.DeploymentProfile)
+	}
+	if properties.DeploymentProfile != externalProfile && properties.ExternalClusterDetails != "" {
+		return nil, fmt.Errorf("The RHCS cluster details can be set only with the %s OCS deployment profile", externalProfile)
+	}
+	return properties, nil
+}
+
+// ExtractExternalClusterDetails removes the RHCS cluster details from the OCS operator properties set by the user, as
+// they are stored in the cluster. It returns the remaining properties and the details of the cluster: the ones set, the
+// current ones when the external profile is kept without them, or none for the other profiles.
+func ExtractExternalClusterDetails(properties string, currentDetails string) (string, string, error) {
+	if properties == "" {
+		return "", "", nil
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(properties), &values); err != nil {
+		return "", "", fmt.Errorf("Failed to parse the OCS operator properties: %s", err.Error())
+	}
+	details, ok := values[externalClusterDetailsProperty]
+	if !ok {
+		if values[deploymentProfileProperty] != externalProfile {
+			currentDetails = ""
+		}
+		return properties, currentDetails, nil
+	}
+	detailsString, ok := details.(string)
+	if !ok {
+		return "", "", fmt.Errorf("The %s OCS operator property must be a string", externalClusterDetailsProperty)
+	}
+	delete(values, externalClusterDetailsProperty)
+	remaining, err := json.Marshal(values)
+	if err != nil {
+		return "", "", err
+	}
+	return string(remaining), detailsString, nil
+}
+
+// isCompactMode tells whether OCS runs on the masters, either because it was selected or because the cluster has only 3 hosts
+func isCompactMode(cluster *models.Cluster, properties *ocsProperties) bool {
+	switch properties.DeploymentProfile {
+	case internalCompactProfile:
+		return true
+	case internalStandardProfile:
+		return false
+	}
+	return len(cluster.Hosts) <= 3
+}
+
+func validateExternalClusterDetails(details string) error {
+	if details == "" {
+		return errors.New("The external OCS deployment profile requires the RHCS cluster details exported by ceph-external-cluster-details-exporter.py.")
+	}
+	var resources []externalClusterResource
+	if err := json.Unmarshal([]byte(details), &resources); err != nil {
+		return fmt.Errorf("The RHCS cluster details are not a valid JSON list of resources: %s", err.Error())
+	}
+	names := make(map[string]bool)
+	for i, resource := range resources {
+		if resource.Name == "" || resource.Kind == "" {
+			return fmt.Errorf("Resource %d of the RHCS cluster details is missing its name or kind.", i)
+		}
+		names[resource.Name] = true
+	}
+	for _, name := range requiredExternalClusterResources {
+		if !names[name] {
+			return fmt.Errorf("The RHCS cluster details are missing the %s resource.", name)
+		}
+	}
+	return nil
+}
+
+func (o *operator) validateRequirements(cluster *common.Cluster) (api.ValidationStatus, string) {
 	var status string
 	hosts := cluster.Hosts
 	numAvailableHosts := int64(len(hosts))
 
-	if numAvailableHosts < o.config.OCSNumMinimumHosts {
-		status = "A minimum of 3 hosts is required to deploy OCS."
-		return api.Failure, status
+	properties, err := getOCSProperties(cluster)
+	if err != nil {
+		return api.Failure, err.Error()
 	}
-	if numAvailableHosts > o.config.OCSNumMinimumHosts && numAvailableHosts < o.config.OCSMinimumHostsStandardMode {
-		status = "A cluster with only 3 masters or with a minimum of 3 workers is required."
-		return api.Failure, status
+
+	switch properties.DeploymentProfile {
+	case externalProfile:
+		if err = validateExternalClusterDetails(properties.ExternalClusterDetails); err != nil {
+			return api.Failure, err.Error()
+		}
+		return api.Success, "OCS Requirements for External Mode are satisfied."
+	case internalCompactProfile:
+		if numAvailableHosts != o.config.OCSNumMinimumHosts {
+			status = "The internal-compact OCS deployment profile requires a cluster with exactly 3 masters."
+			return api.Failure, status
+		}
+	case internalStandardProfile:
+		if numAvailableHosts < o.config.OCSMinimumHostsStandardMode {
+			status = "The internal-standard OCS deployment profile requires a cluster with a minimum of 3 workers."
+			return api.Failure, status
+		}
+	default:
+		if numAvailableHosts < o.config.OCSNumMinimumHosts {
+			status = "A minimum of 3 hosts is required to deploy OCS."
+			return api.Failure, status
+		}
+		if numAvailableHosts > o.config.OCSNumMinimumHosts && numAvailableHosts < o.config.OCSMinimumHostsStandardMode {
+			status = "A cluster with only 3 masters or with a minimum of 3 workers is required."
+			return api.Failure, status
+		}
 	}
 
 	ocsClusterResources := &ocsClusterResourcesInfo{}
-	status, err := o.computeResourcesAllNodes(cluster, ocsClusterResources)
+	status, err = o.computeResourcesAllNodes(&cluster.Cluster, ocsClusterResources)
 	if err != nil {
 		if ocsClusterResources.missingInventory {
 			return api.Pending, status
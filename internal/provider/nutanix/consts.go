@@ -0,0 +1,17 @@
+package nutanix
+
+const (
+	DbFieldPrismCentralAddress = "platform_nutanix_prism_central_address"
+	DbFieldPrismCentralPort    = "platform_nutanix_prism_central_port"
+	DbFieldUsername            = "platform_nutanix_username"
+	/* #nosec */
+	DbFieldPassword            = "platform_nutanix_password"
+	DbFieldPrismElementAddress = "platform_nutanix_prism_element_address"
+	DbFieldPrismElementPort    = "platform_nutanix_prism_element_port"
+	DbFieldPrismElementUUID    = "platform_nutanix_prism_element_uuid"
+	DbFieldSubnetUUID          = "platform_nutanix_subnet_uuid"
+
+	NutanixManufacturer string = "Nutanix"
+
+	defaultPrismPort int64 = 9440
+)
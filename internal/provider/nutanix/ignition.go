@@ -0,0 +1,12 @@
+package nutanix
+
+import "github.com/openshift/assisted-service/internal/common"
+
+// PreCreateManifestsHook does nothing, the installer creates the Prism Central credentials secret from the install config
+func (p nutanixProvider) PreCreateManifestsHook(cluster *common.Cluster, envVars *[]string, workDir string) error {
+	return nil
+}
+
+func (p nutanixProvider) PostCreateManifestsHook(cluster *common.Cluster, envVars *[]string, workDir string) error {
+	return nil
+}
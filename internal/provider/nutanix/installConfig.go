@@ -0,0 +1,67 @@
+package nutanix
+
+import (
+	"errors"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/models"
+)
+
+func portValue(port *int64) int64 {
+	if port == nil {
+		return defaultPrismPort
+	}
+	return *port
+}
+
+func setPlatformValues(platform *installcfg.NutanixInstallConfigPlatform, clusterPlatform *models.NutanixPlatform) {
+	platform.PrismCentral = installcfg.NutanixPrismCentral{
+		Endpoint: installcfg.NutanixPrismEndpoint{
+			Address: swag.StringValue(clusterPlatform.PrismCentralAddress),
+			Port:    portValue(clusterPlatform.PrismCentralPort),
+		},
+		Username: swag.StringValue(clusterPlatform.Username),
+	}
+	if clusterPlatform.Password != nil {
+		platform.PrismCentral.Password = *clusterPlatform.Password
+	}
+	prismElement := installcfg.NutanixPrismElement{
+		Endpoint: installcfg.NutanixPrismEndpoint{
+			Address: swag.StringValue(clusterPlatform.PrismElementAddress),
+			Port:    portValue(clusterPlatform.PrismElementPort),
+		},
+	}
+	if clusterPlatform.PrismElementUUID != nil {
+		prismElement.UUID = *clusterPlatform.PrismElementUUID
+	}
+	platform.PrismElements = []installcfg.NutanixPrismElement{prismElement}
+	if clusterPlatform.SubnetUUID != nil {
+		platform.SubnetUUIDs = []strfmt.UUID{*clusterPlatform.SubnetUUID}
+	}
+}
+
+func (p nutanixProvider) AddPlatformToInstallConfig(cfg *installcfg.InstallerConfigBaremetal, cluster *common.Cluster) error {
+	if cluster.Platform.Nutanix == nil {
+		return errors.New("invalid cluster parameters, Nutanix platform parameters must be provided")
+	}
+	if len(cluster.APIVip) == 0 {
+		return errors.New("invalid cluster parameters, APIVip must be provided")
+	}
+	if len(cluster.IngressVip) == 0 {
+		return errors.New("invalid cluster parameters, IngressVip must be provided")
+	}
+	nutanixPlatform := &installcfg.NutanixInstallConfigPlatform{
+		APIVIP:     cluster.APIVip,
+		IngressVIP: cluster.IngressVip,
+	}
+	setPlatformValues(nutanixPlatform, cluster.Platform.Nutanix)
+	cfg.Platform = installcfg.Platform{
+		Nutanix: nutanixPlatform,
+	}
+	// The workers are discovered hosts, the machine API must not create additional VMs
+	cfg.Compute[0].Replicas = 0
+	return nil
+}
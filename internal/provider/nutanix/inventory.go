@@ -0,0 +1,68 @@
+package nutanix
+
+import (
+	"errors"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/internal/usage"
+	"github.com/openshift/assisted-service/models"
+)
+
+func (p *nutanixProvider) CleanPlatformValuesFromDBUpdates(updates map[string]interface{}) error {
+	updates[provider.DbFieldPlatformType] = models.PlatformTypeBaremetal
+	updates[DbFieldPrismCentralAddress] = nil
+	updates[DbFieldPrismCentralPort] = nil
+	updates[DbFieldUsername] = nil
+	updates[DbFieldPassword] = nil
+	updates[DbFieldPrismElementAddress] = nil
+	updates[DbFieldPrismElementPort] = nil
+	updates[DbFieldPrismElementUUID] = nil
+	updates[DbFieldSubnetUUID] = nil
+	return nil
+}
+
+// ValidatePlatformParams checks that the Prism Central address and credentials are set, as the installation can't
+// proceed without them
+func ValidatePlatformParams(platformParams *models.Platform) error {
+	if platformParams == nil || platformParams.Nutanix == nil {
+		return errors.New("the Nutanix platform parameters must be provided")
+	}
+	if swag.StringValue(platformParams.Nutanix.PrismCentralAddress) == "" {
+		return errors.New("the Nutanix Prism Central address must be provided")
+	}
+	if swag.StringValue(platformParams.Nutanix.Username) == "" || platformParams.Nutanix.Password == nil || *platformParams.Nutanix.Password == "" {
+		return errors.New("the Nutanix Prism Central username and password must be provided")
+	}
+	return nil
+}
+
+func (p *nutanixProvider) SetPlatformValuesInDBUpdates(
+	platformParams *models.Platform, updates map[string]interface{}) error {
+	if err := ValidatePlatformParams(platformParams); err != nil {
+		return err
+	}
+	updates[DbFieldPrismCentralAddress] = platformParams.Nutanix.PrismCentralAddress
+	updates[DbFieldPrismCentralPort] = platformParams.Nutanix.PrismCentralPort
+	updates[DbFieldUsername] = platformParams.Nutanix.Username
+	updates[DbFieldPassword] = platformParams.Nutanix.Password
+	updates[DbFieldPrismElementAddress] = platformParams.Nutanix.PrismElementAddress
+	updates[DbFieldPrismElementPort] = platformParams.Nutanix.PrismElementPort
+	updates[DbFieldPrismElementUUID] = platformParams.Nutanix.PrismElementUUID
+	updates[DbFieldSubnetUUID] = platformParams.Nutanix.SubnetUUID
+	return nil
+}
+
+func (p *nutanixProvider) SetPlatformUsages(
+	platformParams *models.Platform,
+	usages map[string]models.Usage,
+	usageApi usage.API) error {
+	withCredentials := platformParams.Nutanix != nil &&
+		platformParams.Nutanix.Password != nil &&
+		platformParams.Nutanix.Username != nil
+	props := &map[string]interface{}{
+		"platform_type":    p.Name(),
+		"with_credentials": withCredentials}
+	usageApi.Add(usages, usage.PlatformSelectionUsage, props)
+	return nil
+}
@@ -12,6 +12,8 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/internal/provider/nutanix"
 	"github.com/openshift/assisted-service/internal/provider/ovirt"
 	"github.com/openshift/assisted-service/internal/provider/vsphere"
 	"github.com/openshift/assisted-service/internal/usage"
@@ -67,6 +69,13 @@ RxNEp7yHoXcwn+fXna+t5JWh1gxUZty3
 -----END CERTIFICATE-----
 `
 
+const nutanixPrismCentralAddress = "prism-central.example.com"
+const nutanixUsername = "admin"
+const nutanixPassword = "nutanix/4u"
+const nutanixPrismElementAddress = "prism-element.example.com"
+const nutanixPrismElementUUID = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+const nutanixSubnetUUID = "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+
 var _ = Describe("Test GetSupportedProvidersByHosts", func() {
 	bmInventory := getBaremetalInventoryStr("hostname0", "bootMode", true, false)
 	vsphereInventory := getVsphereInventoryStr("hostname0", "bootMode", true, false)
@@ -181,6 +190,33 @@ var _ = Describe("Test GetSupportedProvidersByHosts", func() {
 		Expect(len(platforms)).Should(Equal(1))
 		Expect(platforms[0]).Should(Equal(models.PlatformTypeBaremetal))
 	})
+	It("5 nutanix hosts - 3 masters, 2 workers", func() {
+		nutanixInventory := getNutanixInventoryStr("hostname0", "bootMode", true, false)
+		hosts := make([]*models.Host, 0)
+		hosts = append(hosts, createHost(true, models.HostStatusKnown, nutanixInventory))
+		hosts = append(hosts, createHost(true, models.HostStatusKnown, nutanixInventory))
+		hosts = append(hosts, createHost(true, models.HostStatusKnown, nutanixInventory))
+		hosts = append(hosts, createHost(false, models.HostStatusKnown, nutanixInventory))
+		hosts = append(hosts, createHost(false, models.HostStatusKnown, nutanixInventory))
+		platforms, err := providerRegistry.GetSupportedProvidersByHosts(hosts)
+		Expect(err).To(BeNil())
+		Expect(len(platforms)).Should(Equal(2))
+		supportedPlatforms := []models.PlatformType{models.PlatformTypeBaremetal, models.PlatformTypeNutanix}
+		Expect(platforms).Should(ContainElements(supportedPlatforms))
+	})
+	It("3 nutanix masters 2 generic workers", func() {
+		nutanixInventory := getNutanixInventoryStr("hostname0", "bootMode", true, false)
+		hosts := make([]*models.Host, 0)
+		hosts = append(hosts, createHost(true, models.HostStatusKnown, nutanixInventory))
+		hosts = append(hosts, createHost(true, models.HostStatusKnown, nutanixInventory))
+		hosts = append(hosts, createHost(true, models.HostStatusKnown, nutanixInventory))
+		hosts = append(hosts, createHost(false, models.HostStatusKnown, bmInventory))
+		hosts = append(hosts, createHost(false, models.HostStatusKnown, bmInventory))
+		platforms, err := providerRegistry.GetSupportedProvidersByHosts(hosts)
+		Expect(err).To(BeNil())
+		Expect(len(platforms)).Should(Equal(1))
+		Expect(platforms[0]).Should(Equal(models.PlatformTypeBaremetal))
+	})
 	It("host with an invalid inventory", func() {
 		hosts := make([]*models.Host, 0)
 		hosts = append(hosts, createHost(true, models.HostStatusKnown, invalidInventory))
@@ -321,6 +357,45 @@ var _ = Describe("Test AddPlatformToInstallConfig", func() {
 			Expect(cfg.Platform.Ovirt).To(BeNil())
 		})
 	})
+	Context("nutanix", func() {
+		It("with cluster params", func() {
+			cfg := getInstallerConfigBaremetal()
+			hosts := make([]*models.Host, 0)
+			hosts = append(hosts, createHost(true, models.HostStatusKnown, getNutanixInventoryStr("hostname0", "bootMode", true, false)))
+			hosts = append(hosts, createHost(true, models.HostStatusKnown, getNutanixInventoryStr("hostname1", "bootMode", true, false)))
+			hosts = append(hosts, createHost(true, models.HostStatusKnown, getNutanixInventoryStr("hostname2", "bootMode", true, false)))
+			cluster := createClusterFromHosts(hosts)
+			cluster.Platform = createNutanixPlatformParams()
+			err := providerRegistry.AddPlatformToInstallConfig(models.PlatformTypeNutanix, &cfg, &cluster)
+			Expect(err).To(BeNil())
+			Expect(cfg.Platform.Nutanix).To(Equal(&installcfg.NutanixInstallConfigPlatform{
+				APIVIP:     cluster.Cluster.APIVip,
+				IngressVIP: cluster.Cluster.IngressVip,
+				PrismCentral: installcfg.NutanixPrismCentral{
+					Endpoint: installcfg.NutanixPrismEndpoint{Address: nutanixPrismCentralAddress, Port: 9440},
+					Username: nutanixUsername,
+					Password: nutanixPassword,
+				},
+				PrismElements: []installcfg.NutanixPrismElement{{
+					UUID:     nutanixPrismElementUUID,
+					Endpoint: installcfg.NutanixPrismEndpoint{Address: nutanixPrismElementAddress, Port: 9440},
+				}},
+				SubnetUUIDs: []strfmt.UUID{nutanixSubnetUUID},
+			}))
+			Expect(cfg.Compute[0].Replicas).To(Equal(0))
+		})
+		It("without cluster params", func() {
+			cfg := getInstallerConfigBaremetal()
+			hosts := make([]*models.Host, 0)
+			hosts = append(hosts, createHost(true, models.HostStatusKnown, getNutanixInventoryStr("hostname0", "bootMode", true, false)))
+			cluster := createClusterFromHosts(hosts)
+			cluster.Platform = createNutanixPlatformParams()
+			cluster.Platform.Nutanix = nil
+			err := providerRegistry.AddPlatformToInstallConfig(models.PlatformTypeNutanix, &cfg, &cluster)
+			Expect(err).ToNot(BeNil())
+			Expect(cfg.Platform.Nutanix).To(BeNil())
+		})
+	})
 })
 
 var _ = Describe("Test SetPlatformValuesInDBUpdates", func() {
@@ -357,6 +432,43 @@ var _ = Describe("Test SetPlatformValuesInDBUpdates", func() {
 			Expect(updates[ovirt.DbFieldUsername]).To(BeNil())
 		})
 	})
+	Context("nutanix", func() {
+		It("set from empty updates", func() {
+			platformParams := createNutanixPlatformParams()
+			updates := make(map[string]interface{})
+			err := providerRegistry.SetPlatformValuesInDBUpdates(models.PlatformTypeNutanix, platformParams, updates)
+			Expect(err).To(BeNil())
+			Expect(updates[provider.DbFieldPlatformType]).To(Equal(platformParams.Type))
+			Expect(updates[nutanix.DbFieldUsername]).To(Equal(platformParams.Nutanix.Username))
+			Expect(updates[nutanix.DbFieldPassword]).To(Equal(platformParams.Nutanix.Password))
+			Expect(updates[nutanix.DbFieldPrismCentralAddress]).To(Equal(platformParams.Nutanix.PrismCentralAddress))
+			Expect(updates[nutanix.DbFieldSubnetUUID]).To(Equal(platformParams.Nutanix.SubnetUUID))
+		})
+		It("switch from nutanix to ovirt", func() {
+			updates := make(map[string]interface{})
+			err := providerRegistry.SetPlatformValuesInDBUpdates(models.PlatformTypeNutanix, createNutanixPlatformParams(), updates)
+			Expect(err).To(BeNil())
+			err = providerRegistry.SetPlatformValuesInDBUpdates(models.PlatformTypeOvirt, createOvirtPlatformParams(), updates)
+			Expect(err).To(BeNil())
+			Expect(updates[nutanix.DbFieldUsername]).To(BeNil())
+			Expect(updates[nutanix.DbFieldPassword]).To(BeNil())
+			Expect(updates[ovirt.DbFieldUsername]).ToNot(BeNil())
+		})
+		It("reject missing credentials", func() {
+			platformParams := createNutanixPlatformParams()
+			platformParams.Nutanix.Password = nil
+			updates := make(map[string]interface{})
+			err := providerRegistry.SetPlatformValuesInDBUpdates(models.PlatformTypeNutanix, platformParams, updates)
+			Expect(err).To(HaveOccurred())
+			Expect(updates[nutanix.DbFieldUsername]).To(BeNil())
+		})
+		It("reject missing platform parameters", func() {
+			platformParams := createNutanixPlatformParams()
+			platformParams.Nutanix = nil
+			err := providerRegistry.SetPlatformValuesInDBUpdates(models.PlatformTypeNutanix, platformParams, make(map[string]interface{}))
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
 
 var _ = Describe("Test SetPlatformUsages", func() {
@@ -398,6 +510,16 @@ var _ = Describe("Test SetPlatformUsages", func() {
 			Expect(err).To(BeNil())
 		})
 	})
+	Context("nutanix", func() {
+		It("success", func() {
+			usageApi.EXPECT().Add(gomock.Any(), usage.PlatformSelectionUsage, &map[string]interface{}{
+				"platform_type":    models.PlatformTypeNutanix,
+				"with_credentials": true}).Times(1)
+			platformParams := createNutanixPlatformParams()
+			err := providerRegistry.SetPlatformUsages(models.PlatformTypeNutanix, platformParams, nil, usageApi)
+			Expect(err).To(BeNil())
+		})
+	})
 })
 
 func createHost(isMaster bool, state string, inventory string) *models.Host {
@@ -465,6 +587,18 @@ func getOvirtInventoryStr(hostname, bootMode string, ipv4, ipv6 bool) string {
 	return string(ret)
 }
 
+func getNutanixInventoryStr(hostname, bootMode string, ipv4, ipv6 bool) string {
+	inventory := getInventory(hostname, bootMode, ipv4, ipv6)
+	inventory.SystemVendor = &models.SystemVendor{
+		Manufacturer: "Nutanix",
+		ProductName:  "AHV",
+		SerialNumber: "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+		Virtual:      true,
+	}
+	ret, _ := json.Marshal(&inventory)
+	return string(ret)
+}
+
 func getBaremetalInventoryStr(hostname, bootMode string, ipv4, ipv6 bool) string {
 	inventory := getInventory(hostname, bootMode, ipv4, ipv6)
 	inventory.SystemVendor = &models.SystemVendor{
@@ -506,6 +640,24 @@ func createOvirtPlatformParams() *models.Platform {
 	}
 }
 
+func createNutanixPlatformParams() *models.Platform {
+	password := strfmt.Password(nutanixPassword)
+	prismElementUUID := strfmt.UUID(nutanixPrismElementUUID)
+	subnetUUID := strfmt.UUID(nutanixSubnetUUID)
+
+	return &models.Platform{
+		Type: common.PlatformTypePtr(models.PlatformTypeNutanix),
+		Nutanix: &models.NutanixPlatform{
+			PrismCentralAddress: swag.String(nutanixPrismCentralAddress),
+			Username:            swag.String(nutanixUsername),
+			Password:            &password,
+			PrismElementAddress: swag.String(nutanixPrismElementAddress),
+			PrismElementUUID:    &prismElementUUID,
+			SubnetUUID:          &subnetUUID,
+		},
+	}
+}
+
 func createClusterFromHosts(hosts []*models.Host) common.Cluster {
 	return common.Cluster{
 		Cluster: models.Cluster{
@@ -536,17 +688,21 @@ func getInstallerConfigBaremetal() installcfg.InstallerConfigBaremetal {
 			Name string `yaml:"name"`
 		}{Name: "dummy"},
 		Compute: []struct {
-			Hyperthreading string `yaml:"hyperthreading,omitempty"`
-			Name           string `yaml:"name"`
-			Replicas       int    `yaml:"replicas"`
+			Architecture   string                 `yaml:"architecture,omitempty"`
+			Hyperthreading string                 `yaml:"hyperthreading,omitempty"`
+			Name           string                 `yaml:"name"`
+			Platform       map[string]interface{} `yaml:"platform,omitempty"`
+			Replicas       int                    `yaml:"replicas"`
 		}{{
 			Name:     "worker-test",
 			Replicas: 2,
 		}},
 		ControlPlane: struct {
-			Hyperthreading string `yaml:"hyperthreading,omitempty"`
-			Name           string `yaml:"name"`
-			Replicas       int    `yaml:"replicas"`
+			Architecture   string                 `yaml:"architecture,omitempty"`
+			Hyperthreading string                 `yaml:"hyperthreading,omitempty"`
+			Name           string                 `yaml:"name"`
+			Platform       map[string]interface{} `yaml:"platform,omitempty"`
+			Replicas       int                    `yaml:"replicas"`
 		}{
 			Name:     "master-test",
 			Replicas: 3,
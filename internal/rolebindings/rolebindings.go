@@ -0,0 +1,106 @@
+package rolebindings
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/role_bindings"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// Manager keeps the roles bound to the users of a deployment with local role-based access control
+type Manager struct {
+	db  *gorm.DB
+	log logrus.FieldLogger
+}
+
+var _ restapi.RoleBindingsAPI = &Manager{}
+
+func NewManager(db *gorm.DB, log logrus.FieldLogger) *Manager {
+	return &Manager{
+		db:  db,
+		log: log,
+	}
+}
+
+func (m *Manager) V2ListRoleBindings(ctx context.Context, params operations.V2ListRoleBindingsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+
+	db := m.db
+	if params.UserName != nil {
+		db = db.Where("user_name = ?", *params.UserName)
+	}
+	if params.ClusterID != nil {
+		db = db.Where("cluster_id = ?", params.ClusterID.String())
+	}
+	if params.OrgID != nil {
+		db = db.Where("org_id = ?", *params.OrgID)
+	}
+	bindings := models.RoleBindingList{}
+	if err := db.Order("created_at").Find(&bindings).Error; err != nil {
+		log.WithError(err).Error("failed to list role bindings")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return operations.NewV2ListRoleBindingsOK().WithPayload(bindings)
+}
+
+func (m *Manager) V2CreateRoleBinding(ctx context.Context, params operations.V2CreateRoleBindingParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+	createParams := params.NewRoleBindingParams
+
+	id := strfmt.UUID(uuid.New().String())
+	binding := &models.RoleBinding{
+		ID:        &id,
+		UserName:  createParams.UserName,
+		Role:      createParams.Role,
+		ClusterID: createParams.ClusterID,
+		OrgID:     createParams.OrgID,
+		CreatedAt: time.Now(),
+	}
+	if err := auth.ValidateRoleBinding(binding); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if binding.ClusterID != nil {
+		if _, err := common.GetClusterFromDB(m.db, *binding.ClusterID, common.SkipEagerLoading); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return common.NewApiError(http.StatusBadRequest, errors.Errorf("cluster %s not found", binding.ClusterID))
+			}
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
+	if err := m.db.Create(binding).Error; err != nil {
+		log.WithError(err).Errorf("failed to bind role %s to user %s", *binding.Role, *binding.UserName)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	log.Infof("Bound role %s to user %s", *binding.Role, *binding.UserName)
+	return operations.NewV2CreateRoleBindingCreated().WithPayload(binding)
+}
+
+func (m *Manager) V2DeleteRoleBinding(ctx context.Context, params operations.V2DeleteRoleBindingParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+
+	result := m.db.Delete(&models.RoleBinding{}, "id = ?", params.RoleBindingID.String())
+	if result.Error != nil {
+		log.WithError(result.Error).Errorf("failed to delete role binding %s", params.RoleBindingID)
+		return common.NewApiError(http.StatusInternalServerError, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return common.NewApiError(http.StatusNotFound, errors.Errorf("role binding %s not found", params.RoleBindingID))
+	}
+
+	log.Infof("Deleted role binding %s", params.RoleBindingID)
+	return operations.NewV2DeleteRoleBindingNoContent()
+}
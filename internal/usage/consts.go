@@ -35,4 +35,6 @@ const (
 	ClusterManagedNetworkWithVMs string = "Cluster managed networking with VMs"
 	// ARM64 Architecture usage
 	CPUArchitectureARM64 string = "arm64 architecture"
+	// usage of FIPS mode
+	FIPSUsage string = "FIPS"
 )
@@ -0,0 +1,96 @@
+package webhooks
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/requestid"
+)
+
+// eventsWrapper passes the user events through to the inner events handler and queues them to be delivered to the
+// subscribed webhooks in the background. Metrics events are never delivered.
+type eventsWrapper struct {
+	events  eventsapi.Handler
+	manager *Manager
+}
+
+var _ eventsapi.Handler = &eventsWrapper{}
+
+func NewEventsWrapper(events eventsapi.Handler, manager *Manager) eventsapi.Handler {
+	return &eventsWrapper{events: events, manager: manager}
+}
+
+func (w *eventsWrapper) AddEvent(ctx context.Context, clusterID strfmt.UUID, hostID *strfmt.UUID, severity string, msg string, eventTime time.Time, props ...interface{}) {
+	w.events.AddEvent(ctx, clusterID, hostID, severity, msg, eventTime, props...)
+}
+
+func (w *eventsWrapper) V2AddEvent(ctx context.Context, clusterID *strfmt.UUID, hostID *strfmt.UUID, infraEnvID *strfmt.UUID, name string, severity string, msg string, eventTime time.Time, props ...interface{}) {
+	w.events.V2AddEvent(ctx, clusterID, hostID, infraEnvID, name, severity, msg, eventTime, props...)
+	w.notify(ctx, clusterID, hostID, infraEnvID, name, severity, msg, eventTime)
+}
+
+func (w *eventsWrapper) AddMetricsEvent(ctx context.Context, clusterID strfmt.UUID, hostID *strfmt.UUID, severity string, msg string, eventTime time.Time, props ...interface{}) {
+	w.events.AddMetricsEvent(ctx, clusterID, hostID, severity, msg, eventTime, props...)
+}
+
+func (w *eventsWrapper) V2AddMetricsEvent(ctx context.Context, clusterID *strfmt.UUID, hostID *strfmt.UUID, infraEnvID *strfmt.UUID, name string, severity string, msg string, eventTime time.Time, props ...interface{}) {
+	w.events.V2AddMetricsEvent(ctx, clusterID, hostID, infraEnvID, name, severity, msg, eventTime, props...)
+}
+
+func (w *eventsWrapper) SendClusterEvent(ctx context.Context, event eventsapi.ClusterEvent) {
+	w.SendClusterEventAtTime(ctx, event, time.Now())
+}
+
+func (w *eventsWrapper) SendClusterEventAtTime(ctx context.Context, event eventsapi.ClusterEvent, eventTime time.Time) {
+	w.events.SendClusterEventAtTime(ctx, event, eventTime)
+	clusterID := event.GetClusterId()
+	w.notify(ctx, &clusterID, nil, nil, event.GetName(), event.GetSeverity(), event.FormatMessage(), eventTime)
+}
+
+func (w *eventsWrapper) SendHostEvent(ctx context.Context, event eventsapi.HostEvent) {
+	w.SendHostEventAtTime(ctx, event, time.Now())
+}
+
+func (w *eventsWrapper) SendHostEventAtTime(ctx context.Context, event eventsapi.HostEvent, eventTime time.Time) {
+	w.events.SendHostEventAtTime(ctx, event, eventTime)
+	hostID := event.GetHostId()
+	infraEnvID := event.GetInfraEnvId()
+	w.notify(ctx, event.GetClusterId(), &hostID, &infraEnvID, event.GetName(), event.GetSeverity(), event.FormatMessage(), eventTime)
+}
+
+func (w *eventsWrapper) SendInfraEnvEvent(ctx context.Context, event eventsapi.InfraEnvEvent) {
+	w.SendInfraEnvEventAtTime(ctx, event, time.Now())
+}
+
+func (w *eventsWrapper) SendInfraEnvEventAtTime(ctx context.Context, event eventsapi.InfraEnvEvent, eventTime time.Time) {
+	w.events.SendInfraEnvEventAtTime(ctx, event, eventTime)
+	infraEnvID := event.GetInfraEnvId()
+	w.notify(ctx, event.GetClusterId(), nil, &infraEnvID, event.GetName(), event.GetSeverity(), event.FormatMessage(), eventTime)
+}
+
+func (w *eventsWrapper) V2GetEvents(ctx context.Context, clusterID *strfmt.UUID, hostID *strfmt.UUID, infraEnvID *strfmt.UUID, categories ...string) ([]*common.Event, error) {
+	return w.events.V2GetEvents(ctx, clusterID, hostID, infraEnvID, categories...)
+}
+
+func (w *eventsWrapper) V2GetFilteredEvents(ctx context.Context, params *common.V2GetEventsParams) ([]*common.Event, int64, error) {
+	return w.events.V2GetFilteredEvents(ctx, params)
+}
+
+func (w *eventsWrapper) notify(ctx context.Context, clusterID *strfmt.UUID, hostID *strfmt.UUID, infraEnvID *strfmt.UUID, name string, severity string, msg string, eventTime time.Time) {
+	t := strfmt.DateTime(eventTime)
+	w.manager.Enqueue(&models.Event{
+		ClusterID:  clusterID,
+		HostID:     hostID,
+		InfraEnvID: infraEnvID,
+		Name:       name,
+		Severity:   &severity,
+		Message:    &msg,
+		Category:   models.EventCategoryUser,
+		EventTime:  &t,
+		RequestID:  strfmt.UUID(requestid.FromContext(ctx)),
+	})
+}
@@ -0,0 +1,382 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// SignatureHeader holds the hex encoded HMAC-SHA256 of the request body, keyed with the webhook secret
+	SignatureHeader = "X-Assisted-Signature"
+	// EventHeader holds the name of the delivered event
+	EventHeader = "X-Assisted-Event"
+	// DeliveryHeader holds the ID of the delivery, which is the same across the retries of a single event
+	DeliveryHeader = "X-Assisted-Delivery"
+
+	signaturePrefix = "sha256="
+	// maxResponseBody is the number of bytes of a failed response body that are kept in the delivery record
+	maxResponseBody = 1024
+)
+
+type Config struct {
+	MaxAttempts      int64         `envconfig:"WEBHOOK_MAX_ATTEMPTS" default:"5"`
+	RetryBackoff     time.Duration `envconfig:"WEBHOOK_RETRY_BACKOFF" default:"10s"`
+	MaxRetryBackoff  time.Duration `envconfig:"WEBHOOK_MAX_RETRY_BACKOFF" default:"10m"`
+	RequestTimeout   time.Duration `envconfig:"WEBHOOK_REQUEST_TIMEOUT" default:"10s"`
+	DeliveryInterval time.Duration `envconfig:"WEBHOOK_DELIVERY_INTERVAL" default:"5s"`
+	DeliveryBatch    int           `envconfig:"WEBHOOK_DELIVERY_BATCH" default:"50"`
+	// AllowedNetworks are the CIDRs of private, loopback or link-local networks that webhooks may be delivered to anyway
+	AllowedNetworks []string `envconfig:"WEBHOOK_ALLOWED_NETWORKS" default:""`
+	// NotifyQueueSize is the number of events waiting to be matched against the webhooks before new events are dropped
+	NotifyQueueSize int `envconfig:"WEBHOOK_NOTIFY_QUEUE_SIZE" default:"1000"`
+}
+
+// Manager keeps the webhook subscriptions, queues a delivery for every event matching a subscription
+// and posts the queued deliveries to the subscribed URLs
+type Manager struct {
+	db              *gorm.DB
+	log             logrus.FieldLogger
+	cfg             Config
+	client          *http.Client
+	allowedNetworks []*net.IPNet
+	events          chan *models.Event
+	done            chan struct{}
+}
+
+func NewManager(db *gorm.DB, log logrus.FieldLogger, cfg Config) *Manager {
+	m := &Manager{
+		db:     db,
+		log:    log,
+		cfg:    cfg,
+		events: make(chan *models.Event, cfg.NotifyQueueSize),
+		done:   make(chan struct{}),
+	}
+	for _, cidr := range cfg.AllowedNetworks {
+		_, network, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			log.WithError(err).Errorf("ignoring invalid webhook allowed network %s", cidr)
+			continue
+		}
+		m.allowedNetworks = append(m.allowedNetworks, network)
+	}
+	// The destination is checked once the host name is resolved, so a webhook can't reach a blocked address
+	// through DNS changes or redirects
+	dialer := &net.Dialer{
+		Timeout: cfg.RequestTimeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			return m.checkDestination(address)
+		},
+	}
+	m.client = &http.Client{
+		Timeout:   cfg.RequestTimeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext},
+	}
+	return m
+}
+
+// Start matches the queued events against the webhooks in the background
+func (m *Manager) Start() {
+	go func() {
+		defer close(m.done)
+		for event := range m.events {
+			m.Notify(context.Background(), event)
+		}
+	}()
+}
+
+// Stop matches the events that are already queued and stops the background matching
+func (m *Manager) Stop() {
+	close(m.events)
+	<-m.done
+}
+
+// Enqueue queues the event to be matched against the webhooks in the background, so the event writers are not
+// delayed by the webhook queries. The event is dropped when the queue is full.
+func (m *Manager) Enqueue(event *models.Event) {
+	select {
+	case m.events <- event:
+	default:
+		m.log.Warnf("webhook notification queue is full, dropping event %s", event.Name)
+	}
+}
+
+// Notify queues a delivery of the event to every webhook that is subscribed to it. Webhooks are
+// subscribed to the events of the clusters and infra-envs owned by the user that registered them.
+func (m *Manager) Notify(ctx context.Context, event *models.Event) {
+	owner, err := m.eventOwner(event)
+	if err != nil {
+		m.log.WithError(err).Warnf("failed to find the owner of event %s", event.Name)
+		return
+	}
+
+	var webhooks []*common.Webhook
+	if err = m.db.Where("user_name = ?", owner).Find(&webhooks).Error; err != nil {
+		m.log.WithError(err).Errorf("failed to get the webhooks of user %s", owner)
+		return
+	}
+
+	var payload []byte
+	for _, webhook := range webhooks {
+		if !matches(webhook, event) {
+			continue
+		}
+		if payload == nil {
+			if payload, err = json.Marshal(event); err != nil {
+				m.log.WithError(err).Errorf("failed to marshal event %s", event.Name)
+				return
+			}
+		}
+		id := strfmt.UUID(uuid.New().String())
+		delivery := common.WebhookDelivery{
+			WebhookDelivery: models.WebhookDelivery{
+				ID:        &id,
+				WebhookID: webhook.ID,
+				EventName: event.Name,
+				Status:    swag.String(models.WebhookDeliveryStatusPending),
+			},
+			Payload:       string(payload),
+			NextAttemptAt: time.Now(),
+		}
+		if err = m.db.Create(&delivery).Error; err != nil {
+			m.log.WithError(err).Errorf("failed to queue event %s for webhook %s", event.Name, webhook.ID)
+		}
+	}
+}
+
+// eventOwner returns the name of the user that owns the cluster or the infra-env the event belongs to
+func (m *Manager) eventOwner(event *models.Event) (string, error) {
+	if event.ClusterID != nil {
+		var cluster common.Cluster
+		if err := m.db.Select("user_name").Take(&cluster, "id = ?", event.ClusterID.String()).Error; err != nil {
+			return "", err
+		}
+		return cluster.UserName, nil
+	}
+	if event.InfraEnvID != nil {
+		var infraEnv common.InfraEnv
+		if err := m.db.Select("user_name").Take(&infraEnv, "id = ?", event.InfraEnvID.String()).Error; err != nil {
+			return "", err
+		}
+		return infraEnv.UserName, nil
+	}
+	return "", errors.New("event is not related to a cluster or an infra-env")
+}
+
+func matches(webhook *common.Webhook, event *models.Event) bool {
+	if len(webhook.EventNames) > 0 && !funk.ContainsString(webhook.EventNames, event.Name) {
+		return false
+	}
+	if len(webhook.Severities) > 0 && !funk.ContainsString(webhook.Severities, swag.StringValue(event.Severity)) {
+		return false
+	}
+	return true
+}
+
+// DeliveryTask posts the pending deliveries that are due. The deliveries are leased with row locks, so
+// several service replicas can run the task concurrently without posting the same delivery twice.
+func (m *Manager) DeliveryTask() {
+	deliveries, err := m.claimDeliveries()
+	if err != nil {
+		m.log.WithError(err).Error("failed to claim pending webhook deliveries")
+		return
+	}
+	for _, delivery := range deliveries {
+		m.deliver(delivery)
+	}
+}
+
+func (m *Manager) claimDeliveries() ([]*common.WebhookDelivery, error) {
+	var deliveries []*common.WebhookDelivery
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", models.WebhookDeliveryStatusPending, time.Now()).
+			Order("next_attempt_at").Limit(m.cfg.DeliveryBatch).Find(&deliveries).Error
+		if err != nil || len(deliveries) == 0 {
+			return err
+		}
+		ids := make([]string, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			ids = append(ids, delivery.ID.String())
+		}
+		// Push the next attempt past the request timeout, so the deliveries are not picked up again while they are posted
+		lease := time.Now().Add(2 * m.cfg.RequestTimeout)
+		return tx.Model(&common.WebhookDelivery{}).Where("id IN ?", ids).Update("next_attempt_at", lease).Error
+	})
+	return deliveries, err
+}
+
+func (m *Manager) deliver(delivery *common.WebhookDelivery) {
+	log := m.log.WithFields(logrus.Fields{"webhook_id": delivery.WebhookID, "delivery_id": delivery.ID})
+
+	var webhook common.Webhook
+	if err := m.db.Take(&webhook, "id = ?", delivery.WebhookID.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// The webhook was deregistered while the delivery was in flight
+			log.Infof("dropping delivery of event %s to a deregistered webhook", delivery.EventName)
+			m.db.Delete(&common.WebhookDelivery{}, "id = ?", delivery.ID.String())
+			return
+		}
+		log.WithError(err).Error("failed to get webhook")
+		return
+	}
+
+	delivery.Attempts++
+	code, err := m.post(&webhook, delivery)
+	delivery.LastResponseCode = int64(code)
+	if err == nil {
+		delivery.Status = swag.String(models.WebhookDeliveryStatusDelivered)
+		delivery.DeliveredAt = strfmt.DateTime(time.Now())
+		delivery.LastError = ""
+		m.save(log, delivery)
+		return
+	}
+
+	log.WithError(err).Warnf("attempt %d to deliver event %s failed", delivery.Attempts, delivery.EventName)
+	delivery.LastError = err.Error()
+	if delivery.Attempts < m.cfg.MaxAttempts {
+		delivery.NextAttemptAt = time.Now().Add(m.backoff(delivery.Attempts))
+		m.save(log, delivery)
+		return
+	}
+
+	delivery.Status = swag.String(models.WebhookDeliveryStatusFailed)
+	err = m.db.Transaction(func(tx *gorm.DB) error {
+		if err = tx.Save(delivery).Error; err != nil {
+			return err
+		}
+		return tx.Create(&common.WebhookDeadLetter{WebhookDelivery: *delivery}).Error
+	})
+	if err != nil {
+		log.WithError(err).Error("failed to move delivery to the dead letter table")
+		return
+	}
+	log.Errorf("giving up on delivering event %s after %d attempts", delivery.EventName, delivery.Attempts)
+}
+
+func (m *Manager) save(log logrus.FieldLogger, delivery *common.WebhookDelivery) {
+	if err := m.db.Save(delivery).Error; err != nil {
+		log.WithError(err).Error("failed to update delivery")
+	}
+}
+
+// post sends the delivery payload to the webhook and returns the HTTP status code of the response,
+// or 0 if no response was received
+func (m *Manager) post(webhook *common.Webhook, delivery *common.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequest(http.MethodPost, swag.StringValue(webhook.URL), bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.EventName)
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, body))
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+		return resp.StatusCode, errors.Errorf("webhook responded with %d: %s", resp.StatusCode, string(respBody))
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	return resp.StatusCode, nil
+}
+
+// backoff returns the delay before the next attempt, doubling with every failed attempt up to the configured maximum
+func (m *Manager) backoff(attempts int64) time.Duration {
+	delay := m.cfg.RetryBackoff
+	for i := int64(1); i < attempts && delay < m.cfg.MaxRetryBackoff; i++ {
+		delay *= 2
+	}
+	if delay > m.cfg.MaxRetryBackoff {
+		delay = m.cfg.MaxRetryBackoff
+	}
+	return delay
+}
+
+// blockedNetworks are the private, loopback and link-local networks webhooks are not delivered to, unless they are allowed
+var blockedNetworks = func() []*net.IPNet {
+	cidrs := []string{
+		"0.0.0.0/8",
+		"10.0.0.0/8",
+		"100.64.0.0/10",
+		"127.0.0.0/8",
+		"169.254.0.0/16",
+		"172.16.0.0/12",
+		"192.168.0.0/16",
+		"::/128",
+		"::1/128",
+		"fc00::/7",
+		"fe80::/10",
+	}
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, _ := net.ParseCIDR(cidr)
+		networks = append(networks, network)
+	}
+	return networks
+}()
+
+func containsIP(networks []*net.IPNet, ip net.IP) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkIP fails if webhooks may not be delivered to the address
+func (m *Manager) checkIP(ip net.IP) error {
+	if containsIP(blockedNetworks, ip) && !containsIP(m.allowedNetworks, ip) {
+		return errors.Errorf("webhook destination %s is a private, loopback or link-local address", ip)
+	}
+	return nil
+}
+
+// checkDestination fails if webhooks may not be delivered to the resolved host:port address
+func (m *Manager) checkDestination(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return errors.Errorf("webhook destination %s is not an IP address", host)
+	}
+	return m.checkIP(ip)
+}
+
+// Sign returns the value of the signature header of a request with the given body
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return fmt.Sprintf("%s%s", signaturePrefix, hex.EncodeToString(mac.Sum(nil)))
+}
@@ -0,0 +1,159 @@
+package webhooks
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/identity"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/webhooks"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+var _ restapi.WebhooksAPI = &Manager{}
+
+func (m *Manager) V2RegisterWebhook(ctx context.Context, params operations.V2RegisterWebhookParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+	createParams := params.NewWebhookParams
+
+	if err := m.validateURL(ctx, swag.StringValue(createParams.URL)); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	id := strfmt.UUID(uuid.New().String())
+	webhook := common.Webhook{
+		Webhook: models.Webhook{
+			ID:         &id,
+			URL:        createParams.URL,
+			EventNames: createParams.EventNames,
+			Severities: createParams.Severities,
+			UserName:   ocm.UserNameFromContext(ctx),
+			OrgID:      ocm.OrgIDFromContext(ctx),
+		},
+		Secret: swag.StringValue(createParams.Secret),
+	}
+	if err := m.db.Create(&webhook).Error; err != nil {
+		log.WithError(err).Errorf("failed to register webhook for %s", swag.StringValue(createParams.URL))
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	log.Infof("Registered webhook %s for %s", id, swag.StringValue(createParams.URL))
+	return operations.NewV2RegisterWebhookCreated().WithPayload(&webhook.Webhook)
+}
+
+func (m *Manager) V2ListWebhooks(ctx context.Context, params operations.V2ListWebhooksParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+
+	var webhooks []*common.Webhook
+	if err := m.db.Where(identity.AddUserFilter(ctx, "")).Order("created_at").Find(&webhooks).Error; err != nil {
+		log.WithError(err).Error("failed to list webhooks")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	payload := make(models.WebhookList, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		payload = append(payload, &webhook.Webhook)
+	}
+	return operations.NewV2ListWebhooksOK().WithPayload(payload)
+}
+
+func (m *Manager) V2DeregisterWebhook(ctx context.Context, params operations.V2DeregisterWebhookParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+
+	if _, err := m.getWebhook(ctx, params.WebhookID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&common.WebhookDelivery{}, "webhook_id = ?", params.WebhookID.String()).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&common.Webhook{}, "id = ?", params.WebhookID.String()).Error
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to deregister webhook %s", params.WebhookID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	log.Infof("Deregistered webhook %s", params.WebhookID)
+	return operations.NewV2DeregisterWebhookNoContent()
+}
+
+func (m *Manager) V2ListWebhookDeliveries(ctx context.Context, params operations.V2ListWebhookDeliveriesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, m.log)
+
+	if _, err := m.getWebhook(ctx, params.WebhookID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+
+	db := m.db.Where("webhook_id = ?", params.WebhookID.String())
+	if params.Status != nil {
+		db = db.Where("status = ?", *params.Status)
+	}
+	var deliveries []*common.WebhookDelivery
+	if err := db.Order("created_at DESC").Find(&deliveries).Error; err != nil {
+		log.WithError(err).Errorf("failed to list deliveries of webhook %s", params.WebhookID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	payload := make(models.WebhookDeliveryList, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		payload = append(payload, &delivery.WebhookDelivery)
+	}
+	return operations.NewV2ListWebhookDeliveriesOK().WithPayload(payload)
+}
+
+// getWebhook returns the webhook if it exists and is owned by the user in the context
+func (m *Manager) getWebhook(ctx context.Context, webhookID strfmt.UUID) (*common.Webhook, error) {
+	var webhook common.Webhook
+	err := m.db.Where(identity.AddUserFilter(ctx, "id = ?"), webhookID.String()).Take(&webhook).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("webhook %s not found", webhookID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return &webhook, nil
+}
+
+// validateURL rejects webhook URLs whose host is, or resolves to, a blocked address. A host name that can't be resolved
+// yet is accepted, as the address is checked again on every delivery.
+func (m *Manager) validateURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrapf(err, "invalid webhook URL %s", rawURL)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.Errorf("webhook URL %s must use the http or https scheme", rawURL)
+	}
+	host := u.Hostname()
+	if host == "" {
+		return errors.Errorf("webhook URL %s has no host", rawURL)
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return m.checkIP(ip)
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		m.log.WithError(err).Warnf("failed to resolve webhook host %s", host)
+		return nil
+	}
+	for _, addr := range addrs {
+		if err = m.checkIP(addr.IP); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,373 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	operations "github.com/openshift/assisted-service/restapi/operations/webhooks"
+	"gorm.io/gorm"
+)
+
+func TestWebhooks(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "webhooks tests")
+}
+
+const testSecret = "0123456789abcdef"
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// testReceiver is a webhook endpoint answering with the configured status code and recording the requests it got
+type testReceiver struct {
+	sync.Mutex
+	server   *httptest.Server
+	status   int
+	requests []receivedRequest
+}
+
+func newTestReceiver(status int) *testReceiver {
+	r := &testReceiver{status: status}
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		r.Lock()
+		defer r.Unlock()
+		r.requests = append(r.requests, receivedRequest{header: req.Header, body: body})
+		w.WriteHeader(r.status)
+	}))
+	return r
+}
+
+func (r *testReceiver) received() []receivedRequest {
+	r.Lock()
+	defer r.Unlock()
+	return r.requests
+}
+
+func userContext(userName string) context.Context {
+	payload := &ocm.AuthPayload{Username: userName, Role: ocm.UserRole}
+	return context.WithValue(context.Background(), restapi.AuthKey, payload)
+}
+
+var _ = Describe("Webhooks", func() {
+	var (
+		db        *gorm.DB
+		dbName    string
+		manager   *Manager
+		clusterID strfmt.UUID
+		ctx       = userContext("alice")
+		cfg       = Config{
+			MaxAttempts:     3,
+			RetryBackoff:    0,
+			MaxRetryBackoff: time.Minute,
+			RequestTimeout:  5 * time.Second,
+			DeliveryBatch:   10,
+			AllowedNetworks: []string{"127.0.0.0/8"},
+			NotifyQueueSize: 10,
+		}
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		manager = NewManager(db, common.GetTestLog(), cfg)
+		clusterID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, UserName: "alice"}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	register := func(ctx context.Context, url string, eventNames, severities []string) *models.Webhook {
+		reply := manager.V2RegisterWebhook(ctx, operations.V2RegisterWebhookParams{
+			NewWebhookParams: &models.WebhookCreateParams{
+				URL:        swag.String(url),
+				Secret:     swag.String(testSecret),
+				EventNames: eventNames,
+				Severities: severities,
+			},
+		})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewV2RegisterWebhookCreated()))
+		return reply.(*operations.V2RegisterWebhookCreated).Payload
+	}
+
+	listDeliveries := func(webhookID strfmt.UUID) models.WebhookDeliveryList {
+		reply := manager.V2ListWebhookDeliveries(ctx, operations.V2ListWebhookDeliveriesParams{WebhookID: webhookID})
+		Expect(reply).Should(BeAssignableToTypeOf(operations.NewV2ListWebhookDeliveriesOK()))
+		return reply.(*operations.V2ListWebhookDeliveriesOK).Payload
+	}
+
+	clusterEvent := func(name, severity string) *models.Event {
+		return &models.Event{
+			ClusterID: &clusterID,
+			Name:      name,
+			Severity:  swag.String(severity),
+			Message:   swag.String("message"),
+			Category:  models.EventCategoryUser,
+		}
+	}
+
+	Context("API", func() {
+		It("rejects a non http URL", func() {
+			reply := manager.V2RegisterWebhook(ctx, operations.V2RegisterWebhookParams{
+				NewWebhookParams: &models.WebhookCreateParams{
+					URL:    swag.String("ftp://example.com/hook"),
+					Secret: swag.String(testSecret),
+				},
+			})
+			verifyApiError(reply, http.StatusBadRequest)
+		})
+
+		It("rejects private, loopback and link-local destinations", func() {
+			manager = NewManager(db, common.GetTestLog(), Config{})
+			for _, url := range []string{
+				"http://127.0.0.1:8080/hook",
+				"http://localhost/hook",
+				"http://169.254.169.254/latest/meta-data",
+				"https://10.1.2.3/hook",
+				"https://192.168.1.1/hook",
+				"http://[::1]/hook",
+				"http://[fe80::1]/hook",
+			} {
+				reply := manager.V2RegisterWebhook(ctx, operations.V2RegisterWebhookParams{
+					NewWebhookParams: &models.WebhookCreateParams{
+						URL:    swag.String(url),
+						Secret: swag.String(testSecret),
+					},
+				})
+				verifyApiError(reply, http.StatusBadRequest)
+			}
+		})
+
+		It("accepts a destination in an allowed network", func() {
+			manager = NewManager(db, common.GetTestLog(), Config{AllowedNetworks: []string{"10.0.0.0/8"}})
+			register(ctx, "https://10.1.2.3/hook", nil, nil)
+		})
+
+		It("lists only the webhooks of the user", func() {
+			register(ctx, "http://example.com/alice", nil, nil)
+			register(userContext("bob"), "http://example.com/bob", nil, nil)
+
+			reply := manager.V2ListWebhooks(ctx, operations.V2ListWebhooksParams{})
+			Expect(reply).Should(BeAssignableToTypeOf(operations.NewV2ListWebhooksOK()))
+			webhooks := reply.(*operations.V2ListWebhooksOK).Payload
+			Expect(webhooks).Should(HaveLen(1))
+			Expect(swag.StringValue(webhooks[0].URL)).Should(Equal("http://example.com/alice"))
+			Expect(webhooks[0].UserName).Should(Equal("alice"))
+		})
+
+		It("keeps the filters", func() {
+			webhook := register(ctx, "http://example.com/hook", []string{"cluster_status_updated"}, []string{models.EventSeverityError})
+
+			stored, err := manager.getWebhook(ctx, *webhook.ID)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stored.EventNames).Should(Equal([]string{"cluster_status_updated"}))
+			Expect(stored.Severities).Should(Equal([]string{models.EventSeverityError}))
+			Expect(stored.Secret).Should(Equal(testSecret))
+		})
+
+		It("does not deregister the webhook of another user", func() {
+			webhook := register(userContext("bob"), "http://example.com/bob", nil, nil)
+
+			reply := manager.V2DeregisterWebhook(ctx, operations.V2DeregisterWebhookParams{WebhookID: *webhook.ID})
+			verifyApiError(reply, http.StatusNotFound)
+		})
+
+		It("deregisters a webhook along with its deliveries", func() {
+			webhook := register(ctx, "http://example.com/hook", nil, nil)
+			manager.Notify(ctx, clusterEvent("cluster_status_updated", models.EventSeverityInfo))
+
+			reply := manager.V2DeregisterWebhook(ctx, operations.V2DeregisterWebhookParams{WebhookID: *webhook.ID})
+			Expect(reply).Should(BeAssignableToTypeOf(operations.NewV2DeregisterWebhookNoContent()))
+
+			var count int64
+			Expect(db.Model(&common.WebhookDelivery{}).Count(&count).Error).ShouldNot(HaveOccurred())
+			Expect(count).Should(BeZero())
+			reply = manager.V2ListWebhookDeliveries(ctx, operations.V2ListWebhookDeliveriesParams{WebhookID: *webhook.ID})
+			verifyApiError(reply, http.StatusNotFound)
+		})
+	})
+
+	Context("Delivery", func() {
+		var receiver *testReceiver
+
+		AfterEach(func() {
+			receiver.server.Close()
+		})
+
+		It("posts a signed event", func() {
+			receiver = newTestReceiver(http.StatusOK)
+			webhook := register(ctx, receiver.server.URL, nil, nil)
+
+			manager.Notify(ctx, clusterEvent("cluster_status_updated", models.EventSeverityInfo))
+			deliveries := listDeliveries(*webhook.ID)
+			Expect(deliveries).Should(HaveLen(1))
+			Expect(swag.StringValue(deliveries[0].Status)).Should(Equal(models.WebhookDeliveryStatusPending))
+
+			manager.DeliveryTask()
+
+			requests := receiver.received()
+			Expect(requests).Should(HaveLen(1))
+			Expect(requests[0].header.Get(SignatureHeader)).Should(Equal(Sign(testSecret, requests[0].body)))
+			Expect(requests[0].header.Get(EventHeader)).Should(Equal("cluster_status_updated"))
+			Expect(requests[0].header.Get(DeliveryHeader)).Should(Equal(deliveries[0].ID.String()))
+			var event models.Event
+			Expect(json.Unmarshal(requests[0].body, &event)).ShouldNot(HaveOccurred())
+			Expect(*event.ClusterID).Should(Equal(clusterID))
+
+			deliveries = listDeliveries(*webhook.ID)
+			Expect(swag.StringValue(deliveries[0].Status)).Should(Equal(models.WebhookDeliveryStatusDelivered))
+			Expect(deliveries[0].Attempts).Should(Equal(int64(1)))
+			Expect(deliveries[0].LastResponseCode).Should(Equal(int64(http.StatusOK)))
+
+			By("not posting a delivered event again")
+			manager.DeliveryTask()
+			Expect(receiver.received()).Should(HaveLen(1))
+		})
+
+		It("applies the subscription filters", func() {
+			receiver = newTestReceiver(http.StatusOK)
+			webhook := register(ctx, receiver.server.URL, []string{"cluster_status_updated"}, []string{models.EventSeverityError})
+
+			manager.Notify(ctx, clusterEvent("cluster_status_updated", models.EventSeverityInfo))
+			manager.Notify(ctx, clusterEvent("cluster_validation_failed", models.EventSeverityError))
+			manager.Notify(ctx, clusterEvent("cluster_status_updated", models.EventSeverityError))
+
+			deliveries := listDeliveries(*webhook.ID)
+			Expect(deliveries).Should(HaveLen(1))
+			Expect(deliveries[0].EventName).Should(Equal("cluster_status_updated"))
+		})
+
+		It("does not deliver the events of other users", func() {
+			receiver = newTestReceiver(http.StatusOK)
+			webhook := register(userContext("bob"), receiver.server.URL, nil, nil)
+
+			manager.Notify(ctx, clusterEvent("cluster_status_updated", models.EventSeverityInfo))
+			manager.DeliveryTask()
+
+			Expect(receiver.received()).Should(BeEmpty())
+			var count int64
+			Expect(db.Model(&common.WebhookDelivery{}).Where("webhook_id = ?", webhook.ID.String()).Count(&count).Error).ShouldNot(HaveOccurred())
+			Expect(count).Should(BeZero())
+		})
+
+		It("retries and moves the delivery to the dead letter table", func() {
+			receiver = newTestReceiver(http.StatusServiceUnavailable)
+			webhook := register(ctx, receiver.server.URL, nil, nil)
+			manager.Notify(ctx, clusterEvent("cluster_status_updated", models.EventSeverityInfo))
+
+			for i := int64(1); i <= cfg.MaxAttempts; i++ {
+				manager.DeliveryTask()
+				deliveries := listDeliveries(*webhook.ID)
+				Expect(deliveries[0].Attempts).Should(Equal(i))
+				Expect(deliveries[0].LastResponseCode).Should(Equal(int64(http.StatusServiceUnavailable)))
+			}
+			Expect(receiver.received()).Should(HaveLen(int(cfg.MaxAttempts)))
+
+			deliveries := listDeliveries(*webhook.ID)
+			Expect(swag.StringValue(deliveries[0].Status)).Should(Equal(models.WebhookDeliveryStatusFailed))
+			var deadLetters []*common.WebhookDeadLetter
+			Expect(db.Find(&deadLetters).Error).ShouldNot(HaveOccurred())
+			Expect(deadLetters).Should(HaveLen(1))
+			Expect(*deadLetters[0].ID).Should(Equal(*deliveries[0].ID))
+
+			By("giving up on the failed delivery")
+			manager.DeliveryTask()
+			Expect(receiver.received()).Should(HaveLen(int(cfg.MaxAttempts)))
+		})
+
+		It("does not post to a blocked address", func() {
+			receiver = newTestReceiver(http.StatusOK)
+			webhook := register(ctx, receiver.server.URL, nil, nil)
+			manager.Notify(ctx, clusterEvent("cluster_status_updated", models.EventSeverityInfo))
+
+			By("delivering with a manager that doesn't allow loopback destinations")
+			manager = NewManager(db, common.GetTestLog(), Config{MaxAttempts: 3, RequestTimeout: 5 * time.Second, DeliveryBatch: 10})
+			manager.DeliveryTask()
+
+			Expect(receiver.received()).Should(BeEmpty())
+			deliveries := listDeliveries(*webhook.ID)
+			Expect(swag.StringValue(deliveries[0].Status)).Should(Equal(models.WebhookDeliveryStatusPending))
+			Expect(deliveries[0].LastError).Should(ContainSubstring("private, loopback or link-local address"))
+		})
+
+		It("waits for the backoff before retrying", func() {
+			receiver = newTestReceiver(http.StatusInternalServerError)
+			manager = NewManager(db, common.GetTestLog(), Config{
+				MaxAttempts:     3,
+				RetryBackoff:    time.Hour,
+				MaxRetryBackoff: time.Hour,
+				RequestTimeout:  5 * time.Second,
+				DeliveryBatch:   10,
+				AllowedNetworks: []string{"127.0.0.0/8"},
+			})
+			register(ctx, receiver.server.URL, nil, nil)
+			manager.Notify(ctx, clusterEvent("cluster_status_updated", models.EventSeverityInfo))
+
+			manager.DeliveryTask()
+			manager.DeliveryTask()
+			Expect(receiver.received()).Should(HaveLen(1))
+		})
+	})
+
+	Context("Events wrapper", func() {
+		It("passes the event through and queues its delivery", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			defer ctrl.Finish()
+			mockEvents := eventsapi.NewMockHandler(ctrl)
+			webhook := register(ctx, "http://example.com/hook", nil, nil)
+			event := eventgen.NewClusterStatusUpdatedEvent(clusterID, models.ClusterStatusReady, "ready")
+
+			mockEvents.EXPECT().SendClusterEventAtTime(ctx, event, gomock.Any()).Times(1)
+			NewEventsWrapper(mockEvents, manager).SendClusterEvent(ctx, event)
+			Expect(listDeliveries(*webhook.ID)).Should(BeEmpty())
+
+			By("matching the queued event in the background")
+			manager.Start()
+			manager.Stop()
+			deliveries := listDeliveries(*webhook.ID)
+			Expect(deliveries).Should(HaveLen(1))
+			Expect(deliveries[0].EventName).Should(Equal(eventgen.ClusterStatusUpdatedEventName))
+		})
+	})
+})
+
+var _ = Describe("backoff", func() {
+	It("doubles up to the maximum", func() {
+		manager := NewManager(nil, common.GetTestLog(), Config{RetryBackoff: 10 * time.Second, MaxRetryBackoff: time.Minute})
+		Expect(manager.backoff(1)).Should(Equal(10 * time.Second))
+		Expect(manager.backoff(2)).Should(Equal(20 * time.Second))
+		Expect(manager.backoff(3)).Should(Equal(40 * time.Second))
+		Expect(manager.backoff(4)).Should(Equal(time.Minute))
+		Expect(manager.backoff(10)).Should(Equal(time.Minute))
+	})
+})
+
+func verifyApiError(responder middleware.Responder, expectedHttpStatus int32) {
+	ExpectWithOffset(1, responder).To(BeAssignableToTypeOf(common.NewApiError(expectedHttpStatus, nil)))
+	concreteError := responder.(*common.ApiErrorResponse)
+	ExpectWithOffset(1, concreteError.StatusCode()).To(Equal(expectedHttpStatus))
+}
@@ -36,6 +36,20 @@ func (m *MockInstallerAPI) EXPECT() *MockInstallerAPIMockRecorder {
 	return m.recorder
 }
 
+// ApplyStaticNetworkConfigTemplate mocks base method.
+func (m *MockInstallerAPI) ApplyStaticNetworkConfigTemplate(arg0 context.Context, arg1 installer.ApplyStaticNetworkConfigTemplateParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyStaticNetworkConfigTemplate", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// ApplyStaticNetworkConfigTemplate indicates an expected call of ApplyStaticNetworkConfigTemplate.
+func (mr *MockInstallerAPIMockRecorder) ApplyStaticNetworkConfigTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyStaticNetworkConfigTemplate", reflect.TypeOf((*MockInstallerAPI)(nil).ApplyStaticNetworkConfigTemplate), arg0, arg1)
+}
+
 // BindHost mocks base method.
 func (m *MockInstallerAPI) BindHost(arg0 context.Context, arg1 installer.BindHostParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -890,6 +904,20 @@ func (mr *MockInstallerAPIMockRecorder) V2DeregisterHost(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DeregisterHost", reflect.TypeOf((*MockInstallerAPI)(nil).V2DeregisterHost), arg0, arg1)
 }
 
+// V2DownloadClusterConnectivityMatrix mocks base method.
+func (m *MockInstallerAPI) V2DownloadClusterConnectivityMatrix(arg0 context.Context, arg1 installer.V2DownloadClusterConnectivityMatrixParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2DownloadClusterConnectivityMatrix", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2DownloadClusterConnectivityMatrix indicates an expected call of V2DownloadClusterConnectivityMatrix.
+func (mr *MockInstallerAPIMockRecorder) V2DownloadClusterConnectivityMatrix(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DownloadClusterConnectivityMatrix", reflect.TypeOf((*MockInstallerAPI)(nil).V2DownloadClusterConnectivityMatrix), arg0, arg1)
+}
+
 // V2DownloadClusterCredentials mocks base method.
 func (m *MockInstallerAPI) V2DownloadClusterCredentials(arg0 context.Context, arg1 installer.V2DownloadClusterCredentialsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -904,6 +932,20 @@ func (mr *MockInstallerAPIMockRecorder) V2DownloadClusterCredentials(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DownloadClusterCredentials", reflect.TypeOf((*MockInstallerAPI)(nil).V2DownloadClusterCredentials), arg0, arg1)
 }
 
+// V2DownloadClusterDryRun mocks base method.
+func (m *MockInstallerAPI) V2DownloadClusterDryRun(arg0 context.Context, arg1 installer.V2DownloadClusterDryRunParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2DownloadClusterDryRun", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2DownloadClusterDryRun indicates an expected call of V2DownloadClusterDryRun.
+func (mr *MockInstallerAPIMockRecorder) V2DownloadClusterDryRun(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DownloadClusterDryRun", reflect.TypeOf((*MockInstallerAPI)(nil).V2DownloadClusterDryRun), arg0, arg1)
+}
+
 // V2DownloadClusterFiles mocks base method.
 func (m *MockInstallerAPI) V2DownloadClusterFiles(arg0 context.Context, arg1 installer.V2DownloadClusterFilesParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -960,6 +1002,20 @@ func (mr *MockInstallerAPIMockRecorder) V2DownloadInfraEnvFiles(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DownloadInfraEnvFiles", reflect.TypeOf((*MockInstallerAPI)(nil).V2DownloadInfraEnvFiles), arg0, arg1)
 }
 
+// V2DryRunInstallCluster mocks base method.
+func (m *MockInstallerAPI) V2DryRunInstallCluster(arg0 context.Context, arg1 installer.V2DryRunInstallClusterParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2DryRunInstallCluster", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2DryRunInstallCluster indicates an expected call of V2DryRunInstallCluster.
+func (mr *MockInstallerAPIMockRecorder) V2DryRunInstallCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DryRunInstallCluster", reflect.TypeOf((*MockInstallerAPI)(nil).V2DryRunInstallCluster), arg0, arg1)
+}
+
 // V2GetCluster mocks base method.
 func (m *MockInstallerAPI) V2GetCluster(arg0 context.Context, arg1 installer.V2GetClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -974,6 +1030,20 @@ func (mr *MockInstallerAPIMockRecorder) V2GetCluster(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetCluster", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetCluster), arg0, arg1)
 }
 
+// V2GetClusterConnectivityMatrix mocks base method.
+func (m *MockInstallerAPI) V2GetClusterConnectivityMatrix(arg0 context.Context, arg1 installer.V2GetClusterConnectivityMatrixParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetClusterConnectivityMatrix", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetClusterConnectivityMatrix indicates an expected call of V2GetClusterConnectivityMatrix.
+func (mr *MockInstallerAPIMockRecorder) V2GetClusterConnectivityMatrix(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterConnectivityMatrix", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterConnectivityMatrix), arg0, arg1)
+}
+
 // V2GetClusterDefaultConfig mocks base method.
 func (m *MockInstallerAPI) V2GetClusterDefaultConfig(arg0 context.Context, arg1 installer.V2GetClusterDefaultConfigParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -1044,6 +1114,20 @@ func (mr *MockInstallerAPIMockRecorder) V2GetHostIgnition(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetHostIgnition", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetHostIgnition), arg0, arg1)
 }
 
+// V2GetHostInventoryDiff mocks base method.
+func (m *MockInstallerAPI) V2GetHostInventoryDiff(arg0 context.Context, arg1 installer.V2GetHostInventoryDiffParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetHostInventoryDiff", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetHostInventoryDiff indicates an expected call of V2GetHostInventoryDiff.
+func (mr *MockInstallerAPIMockRecorder) V2GetHostInventoryDiff(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetHostInventoryDiff", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetHostInventoryDiff), arg0, arg1)
+}
+
 // V2GetNextSteps mocks base method.
 func (m *MockInstallerAPI) V2GetNextSteps(arg0 context.Context, arg1 installer.V2GetNextStepsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -1170,6 +1254,20 @@ func (mr *MockInstallerAPIMockRecorder) V2ListFeatureSupportLevels(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListFeatureSupportLevels", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListFeatureSupportLevels), arg0, arg1)
 }
 
+// V2ListHostInventoryHistory mocks base method.
+func (m *MockInstallerAPI) V2ListHostInventoryHistory(arg0 context.Context, arg1 installer.V2ListHostInventoryHistoryParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListHostInventoryHistory", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListHostInventoryHistory indicates an expected call of V2ListHostInventoryHistory.
+func (mr *MockInstallerAPIMockRecorder) V2ListHostInventoryHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListHostInventoryHistory", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListHostInventoryHistory), arg0, arg1)
+}
+
 // V2ListHosts mocks base method.
 func (m *MockInstallerAPI) V2ListHosts(arg0 context.Context, arg1 installer.V2ListHostsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -1407,3 +1505,17 @@ func (mr *MockInstallerAPIMockRecorder) V2UploadLogs(arg0, arg1 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2UploadLogs", reflect.TypeOf((*MockInstallerAPI)(nil).V2UploadLogs), arg0, arg1)
 }
+
+// V2ValidateClusterInstallConfig mocks base method.
+func (m *MockInstallerAPI) V2ValidateClusterInstallConfig(arg0 context.Context, arg1 installer.V2ValidateClusterInstallConfigParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ValidateClusterInstallConfig", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ValidateClusterInstallConfig indicates an expected call of V2ValidateClusterInstallConfig.
+func (mr *MockInstallerAPIMockRecorder) V2ValidateClusterInstallConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ValidateClusterInstallConfig", reflect.TypeOf((*MockInstallerAPI)(nil).V2ValidateClusterInstallConfig), arg0, arg1)
+}
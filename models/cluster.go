@@ -85,6 +85,9 @@ type Cluster struct {
 	// JSON-formatted string containing the usage information by feature name
 	FeatureUsage string `json:"feature_usage,omitempty" gorm:"type:text"`
 
+	// Install the cluster in FIPS mode. Requires a release and a CPU architecture that support FIPS.
+	Fips *bool `json:"fips,omitempty"`
+
 	// Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster
 	// over multiple master nodes whereas 'None' installs a full cluster over one node.
 	//
@@ -202,6 +205,10 @@ type Cluster struct {
 	// hosts associated to this cluster that are in 'known' state.
 	ReadyHostCount int64 `json:"ready_host_count,omitempty" gorm:"-"`
 
+	// Strategy used to assign roles to the hosts whose role is auto-assign. The first-fit strategy selects the first hosts that meet the control plane requirements, the scoring strategy selects the best hosts according to their hardware and failure domains.
+	// Enum: [first-fit scoring]
+	RoleAssignmentStrategy string `json:"role_assignment_strategy,omitempty"`
+
 	// Schedule workloads on masters
 	SchedulableMasters *bool `json:"schedulable_masters,omitempty"`
 
@@ -368,6 +375,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRoleAssignmentStrategy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateServiceNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -986,6 +997,48 @@ func (m *Cluster) validateProgress(formats strfmt.Registry) error {
 	return nil
 }
 
+var clusterTypeRoleAssignmentStrategyPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["first-fit","scoring"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterTypeRoleAssignmentStrategyPropEnum = append(clusterTypeRoleAssignmentStrategyPropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterRoleAssignmentStrategyFirstFit captures enum value "first-fit"
+	ClusterRoleAssignmentStrategyFirstFit string = "first-fit"
+
+	// ClusterRoleAssignmentStrategyScoring captures enum value "scoring"
+	ClusterRoleAssignmentStrategyScoring string = "scoring"
+)
+
+// prop value enum
+func (m *Cluster) validateRoleAssignmentStrategyEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterTypeRoleAssignmentStrategyPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Cluster) validateRoleAssignmentStrategy(formats strfmt.Registry) error {
+	if swag.IsZero(m.RoleAssignmentStrategy) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateRoleAssignmentStrategyEnum("role_assignment_strategy", "body", m.RoleAssignmentStrategy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateServiceNetworkCidr(formats strfmt.Registry) error {
 	if swag.IsZero(m.ServiceNetworkCidr) { // not required
 		return nil
@@ -45,6 +45,9 @@ type ClusterCreateParams struct {
 	// Installation disks encryption mode and host roles to be applied.
 	DiskEncryption *DiskEncryption `json:"disk_encryption,omitempty" gorm:"embedded;embeddedPrefix:disk_encryption_"`
 
+	// Install the cluster in FIPS mode. Requires a release and a CPU architecture that support FIPS.
+	Fips *bool `json:"fips,omitempty"`
+
 	// Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster
 	// over multiple master nodes whereas 'None' installs a full cluster over one node.
 	//
@@ -105,6 +108,10 @@ type ClusterCreateParams struct {
 	// Required: true
 	PullSecret *string `json:"pull_secret"`
 
+	// Strategy used to assign roles to the hosts whose role is auto-assign. The first-fit strategy selects the first hosts that meet the control plane requirements, the scoring strategy selects the best hosts according to their hardware and failure domains.
+	// Enum: [first-fit scoring]
+	RoleAssignmentStrategy *string `json:"role_assignment_strategy,omitempty"`
+
 	// Schedule workloads on masters
 	SchedulableMasters *bool `json:"schedulable_masters,omitempty"`
 
@@ -189,6 +196,10 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRoleAssignmentStrategy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateServiceNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -545,6 +556,48 @@ func (m *ClusterCreateParams) validatePullSecret(formats strfmt.Registry) error
 	return nil
 }
 
+var clusterCreateParamsTypeRoleAssignmentStrategyPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["first-fit","scoring"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterCreateParamsTypeRoleAssignmentStrategyPropEnum = append(clusterCreateParamsTypeRoleAssignmentStrategyPropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterCreateParamsRoleAssignmentStrategyFirstFit captures enum value "first-fit"
+	ClusterCreateParamsRoleAssignmentStrategyFirstFit string = "first-fit"
+
+	// ClusterCreateParamsRoleAssignmentStrategyScoring captures enum value "scoring"
+	ClusterCreateParamsRoleAssignmentStrategyScoring string = "scoring"
+)
+
+// prop value enum
+func (m *ClusterCreateParams) validateRoleAssignmentStrategyEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterCreateParamsTypeRoleAssignmentStrategyPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterCreateParams) validateRoleAssignmentStrategy(formats strfmt.Registry) error {
+	if swag.IsZero(m.RoleAssignmentStrategy) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateRoleAssignmentStrategyEnum("role_assignment_strategy", "body", *m.RoleAssignmentStrategy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) validateServiceNetworkCidr(formats strfmt.Registry) error {
 	if swag.IsZero(m.ServiceNetworkCidr) { // not required
 		return nil
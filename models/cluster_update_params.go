@@ -52,6 +52,9 @@ type ClusterUpdateParams struct {
 	// disks selected config
 	DisksSelectedConfig []*ClusterUpdateParamsDisksSelectedConfigItems0 `json:"disks_selected_config"`
 
+	// Install the cluster in FIPS mode. Requires a release and a CPU architecture that support FIPS.
+	Fips *bool `json:"fips,omitempty"`
+
 	// The desired machine config pool for hosts associated with the cluster.
 	HostsMachineConfigPoolNames []*ClusterUpdateParamsHostsMachineConfigPoolNamesItems0 `json:"hosts_machine_config_pool_names"`
 
@@ -110,6 +113,10 @@ type ClusterUpdateParams struct {
 	// The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.
 	PullSecret *string `json:"pull_secret,omitempty"`
 
+	// Strategy used to assign roles to the hosts whose role is auto-assign. The first-fit strategy selects the first hosts that meet the control plane requirements, the scoring strategy selects the best hosts according to their hardware and failure domains.
+	// Enum: [first-fit scoring]
+	RoleAssignmentStrategy *string `json:"role_assignment_strategy,omitempty"`
+
 	// Schedule workloads on masters
 	SchedulableMasters *bool `json:"schedulable_masters,omitempty"`
 
@@ -206,6 +213,10 @@ func (m *ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRoleAssignmentStrategy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateServiceNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -629,6 +640,48 @@ func (m *ClusterUpdateParams) validatePlatform(formats strfmt.Registry) error {
 	return nil
 }
 
+var clusterUpdateParamsTypeRoleAssignmentStrategyPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["first-fit","scoring"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterUpdateParamsTypeRoleAssignmentStrategyPropEnum = append(clusterUpdateParamsTypeRoleAssignmentStrategyPropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterUpdateParamsRoleAssignmentStrategyFirstFit captures enum value "first-fit"
+	ClusterUpdateParamsRoleAssignmentStrategyFirstFit string = "first-fit"
+
+	// ClusterUpdateParamsRoleAssignmentStrategyScoring captures enum value "scoring"
+	ClusterUpdateParamsRoleAssignmentStrategyScoring string = "scoring"
+)
+
+// prop value enum
+func (m *ClusterUpdateParams) validateRoleAssignmentStrategyEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterUpdateParamsTypeRoleAssignmentStrategyPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterUpdateParams) validateRoleAssignmentStrategy(formats strfmt.Registry) error {
+	if swag.IsZero(m.RoleAssignmentStrategy) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateRoleAssignmentStrategyEnum("role_assignment_strategy", "body", *m.RoleAssignmentStrategy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ClusterUpdateParams) validateServiceNetworkCidr(formats strfmt.Registry) error {
 	if swag.IsZero(m.ServiceNetworkCidr) { // not required
 		return nil
@@ -82,6 +82,12 @@ const (
 
 	// ClusterValidationIDNetworkTypeValid captures enum value "network-type-valid"
 	ClusterValidationIDNetworkTypeValid ClusterValidationID = "network-type-valid"
+
+	// ClusterValidationIDMastersSpreadAcrossFailureDomains captures enum value "masters-spread-across-failure-domains"
+	ClusterValidationIDMastersSpreadAcrossFailureDomains ClusterValidationID = "masters-spread-across-failure-domains"
+
+	// ClusterValidationIDMtuConsistent captures enum value "mtu-consistent"
+	ClusterValidationIDMtuConsistent ClusterValidationID = "mtu-consistent"
 )
 
 // for schema
@@ -89,7 +95,7 @@ var clusterValidationIdEnum []interface{}
 
 func init() {
 	var res []ClusterValidationID
-	if err := json.Unmarshal([]byte(`["machine-cidr-defined","cluster-cidr-defined","service-cidr-defined","no-cidrs-overlapping","network-prefix-valid","machine-cidr-equals-to-calculated-cidr","api-vip-defined","api-vip-valid","ingress-vip-defined","ingress-vip-valid","all-hosts-are-ready-to-install","sufficient-masters-count","dns-domain-defined","pull-secret-set","ntp-server-configured","lso-requirements-satisfied","ocs-requirements-satisfied","cnv-requirements-satisfied","network-type-valid"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["machine-cidr-defined","cluster-cidr-defined","service-cidr-defined","no-cidrs-overlapping","network-prefix-valid","machine-cidr-equals-to-calculated-cidr","api-vip-defined","api-vip-valid","ingress-vip-defined","ingress-vip-valid","all-hosts-are-ready-to-install","sufficient-masters-count","dns-domain-defined","pull-secret-set","ntp-server-configured","lso-requirements-satisfied","ocs-requirements-satisfied","cnv-requirements-satisfied","network-type-valid","masters-spread-across-failure-domains","mtu-consistent"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
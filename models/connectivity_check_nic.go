@@ -23,6 +23,11 @@ type ConnectivityCheckNic struct {
 	// mac
 	Mac string `json:"mac,omitempty"`
 
+	// The MTU of the interface. The addresses of the interface are probed with packets of this size that must not
+	// be fragmented, so that path MTU problems are detected.
+	//
+	Mtu int64 `json:"mtu,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 }
@@ -31,7 +31,7 @@ type Event struct {
 	// event time
 	// Required: true
 	// Format: date-time
-	EventTime *strfmt.DateTime `json:"event_time" gorm:"type:timestamp with time zone"`
+	EventTime *strfmt.DateTime `json:"event_time" gorm:"type:timestamp with time zone;index"`
 
 	// Unique identifier of the host this event relates to.
 	// Format: uuid
@@ -46,7 +46,7 @@ type Event struct {
 	Message *string `json:"message" gorm:"type:varchar(4096)"`
 
 	// Event Name.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name,omitempty" gorm:"index"`
 
 	// Additional properties for the event in JSON format.
 	Props string `json:"props,omitempty" gorm:"type:text"`
@@ -58,7 +58,7 @@ type Event struct {
 	// severity
 	// Required: true
 	// Enum: [info warning error critical]
-	Severity *string `json:"severity"`
+	Severity *string `json:"severity" gorm:"index"`
 }
 
 // Validate validates this event
@@ -56,6 +56,9 @@ type Host struct {
 	// The domain name resolution result.
 	DomainNameResolutions string `json:"domain_name_resolutions,omitempty" gorm:"type:text"`
 
+	// The failure domain of the host, used to spread the control plane nodes.
+	FailureDomain *FailureDomain `json:"failure_domain,omitempty" gorm:"embedded;embeddedPrefix:failure_domain_"`
+
 	// free addresses
 	FreeAddresses string `json:"free_addresses,omitempty" gorm:"type:text"`
 
@@ -98,6 +101,13 @@ type Host struct {
 	// inventory
 	Inventory string `json:"inventory,omitempty" gorm:"type:text"`
 
+	// JSON-formatted list of the critical changes of the inventory of the host since the user last reviewed the host.
+	InventoryDrift string `json:"inventory_drift,omitempty" gorm:"type:text"`
+
+	// The snapshot of the inventory of the host at the time the user last reviewed the host.
+	// Format: uuid
+	InventoryReviewedSnapshotID *strfmt.UUID `json:"inventory_reviewed_snapshot_id,omitempty"`
+
 	// Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or
 	// 'AddToExistingClusterHost' for host being added to existing OCP cluster, or
 	//
@@ -158,6 +168,12 @@ type Host struct {
 	// suggested role
 	SuggestedRole HostRole `json:"suggested_role,omitempty"`
 
+	// The result of fetching the advertisements of the Tang servers of the cluster from the host.
+	TangConnectivity string `json:"tang_connectivity,omitempty" gorm:"type:text"`
+
+	// SHA-256 fingerprint of the endorsement key certificate of the host's TPM, recorded for attestation.
+	TpmEkFingerprint string `json:"tpm_ek_fingerprint,omitempty"`
+
 	// updated at
 	// Format: date-time
 	UpdatedAt timeext.Time `json:"updated_at,omitempty" gorm:"type:timestamp with time zone"`
@@ -185,6 +201,10 @@ func (m *Host) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateFailureDomain(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHref(formats); err != nil {
 		res = append(res, err)
 	}
@@ -197,6 +217,10 @@ func (m *Host) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateInventoryReviewedSnapshotID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateKind(formats); err != nil {
 		res = append(res, err)
 	}
@@ -295,6 +319,25 @@ func (m *Host) validateCreatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Host) validateFailureDomain(formats strfmt.Registry) error {
+	if swag.IsZero(m.FailureDomain) { // not required
+		return nil
+	}
+
+	if m.FailureDomain != nil {
+		if err := m.FailureDomain.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("failure_domain")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("failure_domain")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Host) validateHref(formats strfmt.Registry) error {
 
 	if err := validate.Required("href", "body", m.Href); err != nil {
@@ -329,6 +372,18 @@ func (m *Host) validateInfraEnvID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Host) validateInventoryReviewedSnapshotID(formats strfmt.Registry) error {
+	if swag.IsZero(m.InventoryReviewedSnapshotID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("inventory_reviewed_snapshot_id", "body", "uuid", m.InventoryReviewedSnapshotID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var hostTypeKindPropEnum []interface{}
 
 func init() {
@@ -663,6 +718,10 @@ func (m *Host) validateUpdatedAt(formats strfmt.Registry) error {
 func (m *Host) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateFailureDomain(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateLogsInfo(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -689,6 +748,22 @@ func (m *Host) ContextValidate(ctx context.Context, formats strfmt.Registry) err
 	return nil
 }
 
+func (m *Host) contextValidateFailureDomain(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.FailureDomain != nil {
+		if err := m.FailureDomain.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("failure_domain")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("failure_domain")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Host) contextValidateLogsInfo(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.LogsInfo.ContextValidate(ctx, formats); err != nil {
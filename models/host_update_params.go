@@ -24,6 +24,9 @@ type HostUpdateParams struct {
 	// disks selected config
 	DisksSelectedConfig []*DiskConfigParams `json:"disks_selected_config"`
 
+	// The failure domain of the host, used to spread the control plane nodes.
+	FailureDomain *FailureDomain `json:"failure_domain,omitempty" gorm:"embedded;embeddedPrefix:failure_domain_"`
+
 	// host name
 	HostName *string `json:"host_name,omitempty"`
 
@@ -46,6 +49,10 @@ func (m *HostUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateFailureDomain(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHostRole(formats); err != nil {
 		res = append(res, err)
 	}
@@ -82,6 +89,25 @@ func (m *HostUpdateParams) validateDisksSelectedConfig(formats strfmt.Registry)
 	return nil
 }
 
+func (m *HostUpdateParams) validateFailureDomain(formats strfmt.Registry) error {
+	if swag.IsZero(m.FailureDomain) { // not required
+		return nil
+	}
+
+	if m.FailureDomain != nil {
+		if err := m.FailureDomain.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("failure_domain")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("failure_domain")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var hostUpdateParamsTypeHostRolePropEnum []interface{}
 
 func init() {
@@ -135,6 +161,10 @@ func (m *HostUpdateParams) ContextValidate(ctx context.Context, formats strfmt.R
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateFailureDomain(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -161,6 +191,22 @@ func (m *HostUpdateParams) contextValidateDisksSelectedConfig(ctx context.Contex
 	return nil
 }
 
+func (m *HostUpdateParams) contextValidateFailureDomain(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.FailureDomain != nil {
+		if err := m.FailureDomain.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("failure_domain")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("failure_domain")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *HostUpdateParams) MarshalBinary() ([]byte, error) {
 	if m == nil {
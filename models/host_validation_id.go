@@ -112,6 +112,21 @@ const (
 
 	// HostValidationIDDiskEncryptionRequirementsSatisfied captures enum value "disk-encryption-requirements-satisfied"
 	HostValidationIDDiskEncryptionRequirementsSatisfied HostValidationID = "disk-encryption-requirements-satisfied"
+
+	// HostValidationIDMtuValid captures enum value "mtu-valid"
+	HostValidationIDMtuValid HostValidationID = "mtu-valid"
+
+	// HostValidationIDTpmValid captures enum value "tpm-valid"
+	HostValidationIDTpmValid HostValidationID = "tpm-valid"
+
+	// HostValidationIDTangConnectivityValid captures enum value "tang-connectivity-valid"
+	HostValidationIDTangConnectivityValid HostValidationID = "tang-connectivity-valid"
+
+	// HostValidationIDCriticalInventoryUnchanged captures enum value "critical-inventory-unchanged"
+	HostValidationIDCriticalInventoryUnchanged HostValidationID = "critical-inventory-unchanged"
+
+	// HostValidationIDCompatibleWithFips captures enum value "compatible-with-fips"
+	HostValidationIDCompatibleWithFips HostValidationID = "compatible-with-fips"
 )
 
 // for schema
@@ -119,7 +134,7 @@ var hostValidationIdEnum []interface{}
 
 func init() {
 	var res []HostValidationID
-	if err := json.Unmarshal([]byte(`["connected","has-inventory","has-min-cpu-cores","has-min-valid-disks","has-min-memory","machine-cidr-defined","has-cpu-cores-for-role","has-memory-for-role","hostname-unique","hostname-valid","belongs-to-machine-cidr","ignition-downloadable","belongs-to-majority-group","valid-platform-network-settings","ntp-synced","container-images-available","lso-requirements-satisfied","ocs-requirements-satisfied","sufficient-installation-disk-speed","cnv-requirements-satisfied","sufficient-network-latency-requirement-for-role","sufficient-packet-loss-requirement-for-role","has-default-route","api-domain-name-resolved-correctly","api-int-domain-name-resolved-correctly","apps-domain-name-resolved-correctly","compatible-with-cluster-platform","dns-wildcard-not-configured","disk-encryption-requirements-satisfied"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["connected","has-inventory","has-min-cpu-cores","has-min-valid-disks","has-min-memory","machine-cidr-defined","has-cpu-cores-for-role","has-memory-for-role","hostname-unique","hostname-valid","belongs-to-machine-cidr","ignition-downloadable","belongs-to-majority-group","valid-platform-network-settings","ntp-synced","container-images-available","lso-requirements-satisfied","ocs-requirements-satisfied","sufficient-installation-disk-speed","cnv-requirements-satisfied","sufficient-network-latency-requirement-for-role","sufficient-packet-loss-requirement-for-role","has-default-route","api-domain-name-resolved-correctly","api-int-domain-name-resolved-correctly","apps-domain-name-resolved-correctly","compatible-with-cluster-platform","dns-wildcard-not-configured","disk-encryption-requirements-satisfied","mtu-valid","tpm-valid","tang-connectivity-valid","critical-inventory-unchanged","compatible-with-fips"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
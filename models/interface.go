@@ -7,9 +7,12 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // Interface interface
@@ -23,6 +26,9 @@ type Interface struct {
 	// client id
 	ClientID string `json:"client_id,omitempty"`
 
+	// The name of the bond or bridge that the interface is a port of.
+	Controller string `json:"controller,omitempty"`
+
 	// flags
 	Flags []string `json:"flags"`
 
@@ -35,6 +41,9 @@ type Interface struct {
 	// ipv6 addresses
 	IPV6Addresses []string `json:"ipv6_addresses"`
 
+	// lldp neighbor
+	LldpNeighbor *LldpNeighbor `json:"lldp_neighbor,omitempty"`
+
 	// mac address
 	MacAddress string `json:"mac_address,omitempty"`
 
@@ -44,23 +53,138 @@ type Interface struct {
 	// name
 	Name string `json:"name,omitempty"`
 
+	// The name of the interface that a VLAN interface is defined on.
+	Parent string `json:"parent,omitempty"`
+
 	// product
 	Product string `json:"product,omitempty"`
 
 	// speed mbps
 	SpeedMbps int64 `json:"speed_mbps,omitempty"`
 
+	// The kind of the interface.
+	// Enum: [physical bond vlan bridge]
+	Type string `json:"type,omitempty"`
+
 	// vendor
 	Vendor string `json:"vendor,omitempty"`
+
+	// The VLAN ID of a VLAN interface.
+	VlanID int64 `json:"vlan_id,omitempty"`
 }
 
 // Validate validates this interface
 func (m *Interface) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLldpNeighbor(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Interface) validateLldpNeighbor(formats strfmt.Registry) error {
+	if swag.IsZero(m.LldpNeighbor) { // not required
+		return nil
+	}
+
+	if m.LldpNeighbor != nil {
+		if err := m.LldpNeighbor.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("lldp_neighbor")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("lldp_neighbor")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
-// ContextValidate validates this interface based on context it is used
+var interfaceTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["physical","bond","vlan","bridge"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		interfaceTypeTypePropEnum = append(interfaceTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// InterfaceTypePhysical captures enum value "physical"
+	InterfaceTypePhysical string = "physical"
+
+	// InterfaceTypeBond captures enum value "bond"
+	InterfaceTypeBond string = "bond"
+
+	// InterfaceTypeVlan captures enum value "vlan"
+	InterfaceTypeVlan string = "vlan"
+
+	// InterfaceTypeBridge captures enum value "bridge"
+	InterfaceTypeBridge string = "bridge"
+)
+
+// prop value enum
+func (m *Interface) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, interfaceTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Interface) validateType(formats strfmt.Registry) error {
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this interface based on the context it is used
 func (m *Interface) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateLldpNeighbor(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Interface) contextValidateLldpNeighbor(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.LldpNeighbor != nil {
+		if err := m.LldpNeighbor.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("lldp_neighbor")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("lldp_neighbor")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
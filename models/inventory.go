@@ -57,6 +57,9 @@ type Inventory struct {
 	// timestamp
 	Timestamp int64 `json:"timestamp,omitempty"`
 
+	// tpm
+	Tpm *Tpm `json:"tpm,omitempty"`
+
 	// tpm version
 	// Enum: [none 1.2 2.0]
 	TpmVersion string `json:"tpm_version,omitempty"`
@@ -98,6 +101,10 @@ func (m *Inventory) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTpm(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTpmVersion(formats); err != nil {
 		res = append(res, err)
 	}
@@ -288,6 +295,25 @@ func (m *Inventory) validateSystemVendor(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Inventory) validateTpm(formats strfmt.Registry) error {
+	if swag.IsZero(m.Tpm) { // not required
+		return nil
+	}
+
+	if m.Tpm != nil {
+		if err := m.Tpm.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tpm")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("tpm")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var inventoryTypeTpmVersionPropEnum []interface{}
 
 func init() {
@@ -369,6 +395,10 @@ func (m *Inventory) ContextValidate(ctx context.Context, formats strfmt.Registry
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateTpm(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -519,6 +549,22 @@ func (m *Inventory) contextValidateSystemVendor(ctx context.Context, formats str
 	return nil
 }
 
+func (m *Inventory) contextValidateTpm(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Tpm != nil {
+		if err := m.Tpm.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tpm")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("tpm")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Inventory) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -20,6 +20,12 @@ type L3Connectivity struct {
 	// Average round trip time in milliseconds.
 	AverageRTTMs float64 `json:"average_rtt_ms,omitempty"`
 
+	// Size in bytes of the packets sent with the don't-fragment bit set to probe the path MTU, 0 if it was not probed.
+	LargePacketSize int64 `json:"large_packet_size,omitempty"`
+
+	// Whether the packets sent with the don't-fragment bit set reached the remote address.
+	LargePacketSuccessful bool `json:"large_packet_successful,omitempty"`
+
 	// outgoing nic
 	OutgoingNic string `json:"outgoing_nic,omitempty"`
 
@@ -19,6 +19,9 @@ import (
 // swagger:model platform
 type Platform struct {
 
+	// nutanix
+	Nutanix *NutanixPlatform `json:"nutanix,omitempty" gorm:"embedded;embeddedPrefix:nutanix_"`
+
 	// ovirt
 	Ovirt *OvirtPlatform `json:"ovirt,omitempty" gorm:"embedded;embeddedPrefix:ovirt_"`
 
@@ -31,6 +34,10 @@ type Platform struct {
 func (m *Platform) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateNutanix(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateOvirt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -45,6 +52,25 @@ func (m *Platform) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Platform) validateNutanix(formats strfmt.Registry) error {
+	if swag.IsZero(m.Nutanix) { // not required
+		return nil
+	}
+
+	if m.Nutanix != nil {
+		if err := m.Nutanix.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("nutanix")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("nutanix")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Platform) validateOvirt(formats strfmt.Registry) error {
 	if swag.IsZero(m.Ovirt) { // not required
 		return nil
@@ -92,6 +118,10 @@ func (m *Platform) validateType(formats strfmt.Registry) error {
 func (m *Platform) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateNutanix(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateOvirt(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -106,6 +136,22 @@ func (m *Platform) ContextValidate(ctx context.Context, formats strfmt.Registry)
 	return nil
 }
 
+func (m *Platform) contextValidateNutanix(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Nutanix != nil {
+		if err := m.Nutanix.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("nutanix")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("nutanix")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Platform) contextValidateOvirt(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Ovirt != nil {
@@ -35,6 +35,9 @@ const (
 	// PlatformTypeOvirt captures enum value "ovirt"
 	PlatformTypeOvirt PlatformType = "ovirt"
 
+	// PlatformTypeNutanix captures enum value "nutanix"
+	PlatformTypeNutanix PlatformType = "nutanix"
+
 	// PlatformTypeNone captures enum value "none"
 	PlatformTypeNone PlatformType = "none"
 )
@@ -44,7 +47,7 @@ var platformTypeEnum []interface{}
 
 func init() {
 	var res []PlatformType
-	if err := json.Unmarshal([]byte(`["baremetal","vsphere","ovirt","none"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["baremetal","vsphere","ovirt","nutanix","none"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
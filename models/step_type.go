@@ -61,6 +61,9 @@ const (
 
 	// StepTypeDomainResolution captures enum value "domain-resolution"
 	StepTypeDomainResolution StepType = "domain-resolution"
+
+	// StepTypeTangConnectivityCheck captures enum value "tang-connectivity-check"
+	StepTypeTangConnectivityCheck StepType = "tang-connectivity-check"
 )
 
 // for schema
@@ -68,7 +71,7 @@ var stepTypeEnum []interface{}
 
 func init() {
 	var res []StepType
-	if err := json.Unmarshal([]byte(`["connectivity-check","execute","inventory","install","free-network-addresses","reset-installation","dhcp-lease-allocate","api-vip-connectivity-check","ntp-synchronizer","installation-disk-speed-check","container-image-availability","domain-resolution"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["connectivity-check","execute","inventory","install","free-network-addresses","reset-installation","dhcp-lease-allocate","api-vip-connectivity-check","ntp-synchronizer","installation-disk-speed-check","container-image-availability","domain-resolution","tang-connectivity-check"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
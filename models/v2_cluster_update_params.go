@@ -49,6 +49,9 @@ type V2ClusterUpdateParams struct {
 	// Installation disks encryption mode and host roles to be applied.
 	DiskEncryption *DiskEncryption `json:"disk_encryption,omitempty" gorm:"embedded;embeddedPrefix:disk_encryption_"`
 
+	// Install the cluster in FIPS mode. Requires a release and a CPU architecture that support FIPS.
+	Fips *bool `json:"fips,omitempty"`
+
 	// A proxy URL to use for creating HTTP connections outside the cluster.
 	// http://\<username\>:\<pswd\>@\<ip\>:\<port\>
 	//
@@ -98,6 +101,10 @@ type V2ClusterUpdateParams struct {
 	// The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.
 	PullSecret *string `json:"pull_secret,omitempty"`
 
+	// Strategy used to assign roles to the hosts whose role is auto-assign. The first-fit strategy selects the first hosts that meet the control plane requirements, the scoring strategy selects the best hosts according to their hardware and failure domains.
+	// Enum: [first-fit scoring]
+	RoleAssignmentStrategy *string `json:"role_assignment_strategy,omitempty"`
+
 	// Schedule workloads on masters
 	SchedulableMasters *bool `json:"schedulable_masters,omitempty"`
 
@@ -178,6 +185,10 @@ func (m *V2ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRoleAssignmentStrategy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateServiceNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -497,6 +508,48 @@ func (m *V2ClusterUpdateParams) validatePlatform(formats strfmt.Registry) error
 	return nil
 }
 
+var v2ClusterUpdateParamsTypeRoleAssignmentStrategyPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["first-fit","scoring"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		v2ClusterUpdateParamsTypeRoleAssignmentStrategyPropEnum = append(v2ClusterUpdateParamsTypeRoleAssignmentStrategyPropEnum, v)
+	}
+}
+
+const (
+
+	// V2ClusterUpdateParamsRoleAssignmentStrategyFirstFit captures enum value "first-fit"
+	V2ClusterUpdateParamsRoleAssignmentStrategyFirstFit string = "first-fit"
+
+	// V2ClusterUpdateParamsRoleAssignmentStrategyScoring captures enum value "scoring"
+	V2ClusterUpdateParamsRoleAssignmentStrategyScoring string = "scoring"
+)
+
+// prop value enum
+func (m *V2ClusterUpdateParams) validateRoleAssignmentStrategyEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, v2ClusterUpdateParamsTypeRoleAssignmentStrategyPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *V2ClusterUpdateParams) validateRoleAssignmentStrategy(formats strfmt.Registry) error {
+	if swag.IsZero(m.RoleAssignmentStrategy) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateRoleAssignmentStrategyEnum("role_assignment_strategy", "body", *m.RoleAssignmentStrategy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) validateServiceNetworkCidr(formats strfmt.Registry) error {
 	if swag.IsZero(m.ServiceNetworkCidr) { // not required
 		return nil
@@ -0,0 +1,231 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/openshift/assisted-service/pkg/secretdump"
+)
+
+const (
+	// maxAuditedBody is the number of bytes of a request or response body that are inspected by the audit middleware
+	maxAuditedBody = 64 * 1024
+	// agentAuthScheme is the security definition of the API used by the agents
+	agentAuthScheme = "agentAuth"
+)
+
+// AuditRecorder stores the audit records of the mutating API calls
+type AuditRecorder interface {
+	Record(ctx context.Context, record *models.AuditRecord)
+}
+
+type auditCtxKey struct{}
+
+// auditState carries the user of the request from the authorizer back to the audit middleware, since the
+// user is only set on the context of the request handed to the authorizer and the operation handler
+type auditState struct {
+	payload *ocm.AuthPayload
+}
+
+// WithAuditMiddleware returns an inner middleware which records every mutating API call, along with the identity
+// of the user that made it, the resources it targeted, its redacted request body and its result.
+// The operations that are only available to agents are not audited unless includeAgentOperations is set.
+func WithAuditMiddleware(recorder AuditRecorder, authType auth.AuthType, includeAgentOperations bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := middleware.MatchedRouteFrom(r)
+			if route == nil || !isMutatingMethod(r.Method) || (!includeAgentOperations && isAgentOperation(route)) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			createdAt := time.Now()
+			changes := auditedRequestBody(r)
+			state := &auditState{}
+			r = r.WithContext(context.WithValue(r.Context(), auditCtxKey{}, state))
+			recorded := &auditResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+			next.ServeHTTP(recorded, r)
+
+			id := strfmt.UUID(uuid.New().String())
+			record := &models.AuditRecord{
+				ID:          &id,
+				CreatedAt:   createdAt,
+				RequestID:   strfmt.UUID(requestid.FromContext(r.Context())),
+				AuthType:    string(authType),
+				OperationID: swag.String(route.Operation.ID),
+				Method:      swag.String(r.Method),
+				Path:        swag.String(r.URL.Path),
+				Changes:     changes,
+				StatusCode:  swag.Int64(int64(recorded.statusCode)),
+			}
+			// The user is unknown when the call failed to authenticate
+			if state.payload != nil {
+				record.UserName = state.payload.Username
+				record.OrgID = state.payload.Organization
+			}
+			record.ClusterID = routeParamUUID(route, "cluster_id")
+			record.HostID = routeParamUUID(route, "host_id")
+			record.InfraEnvID = routeParamUUID(route, "infra_env_id")
+			if recorded.statusCode < http.StatusBadRequest {
+				record.Result = swag.String(models.AuditRecordResultSucceeded)
+				if recorded.statusCode == http.StatusCreated {
+					setCreatedResource(record, recorded.body.Bytes())
+				}
+			} else {
+				record.Result = swag.String(models.AuditRecordResultFailed)
+				record.Error = responseError(recorded.body.Bytes())
+			}
+			recorder.Record(r.Context(), record)
+		})
+	}
+}
+
+// WithAuditAuthorizer wraps the authorizer of the API so that the user of the request is handed to the
+// audit middleware
+func WithAuditAuthorizer(authorizer func(*http.Request) error) func(*http.Request) error {
+	return func(r *http.Request) error {
+		if state, ok := r.Context().Value(auditCtxKey{}).(*auditState); ok {
+			state.payload = ocm.PayloadFromContext(r.Context())
+		}
+		return authorizer(r)
+	}
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isAgentOperation returns true if the operation can only be called with the agent authentication
+func isAgentOperation(route *middleware.MatchedRoute) bool {
+	if route.Operation == nil || len(route.Operation.Security) == 0 {
+		return false
+	}
+	for _, requirement := range route.Operation.Security {
+		for scheme := range requirement {
+			if scheme != agentAuthScheme {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// auditedRequestBody returns the request body with its secrets redacted, and restores it for the handler
+func auditedRequestBody(r *http.Request) string {
+	if r.Body == nil || r.Body == http.NoBody {
+		return ""
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(r.Body, maxAuditedBody+1))
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(data), r.Body), r.Body}
+	if err != nil || len(data) == 0 {
+		return ""
+	}
+	if len(data) <= maxAuditedBody && strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		if redacted, err := secretdump.DumpSecretJSON(data); err == nil {
+			return redacted
+		}
+	}
+	// The content of bodies that can't be redacted is not kept
+	if len(data) > maxAuditedBody {
+		return fmt.Sprintf("<more than %d bytes of %s>", maxAuditedBody, r.Header.Get("Content-Type"))
+	}
+	return fmt.Sprintf("<%d bytes of %s>", len(data), r.Header.Get("Content-Type"))
+}
+
+func routeParamUUID(route *middleware.MatchedRoute, name string) *strfmt.UUID {
+	value := route.Params.Get(name)
+	if value == "" {
+		return nil
+	}
+	id := strfmt.UUID(value)
+	return &id
+}
+
+// setCreatedResource sets the target of a call that registered a cluster, a host or an infra-env
+func setCreatedResource(record *models.AuditRecord, body []byte) {
+	var created struct {
+		ID   *strfmt.UUID `json:"id"`
+		Kind string       `json:"kind"`
+	}
+	if err := json.Unmarshal(body, &created); err != nil || created.ID == nil {
+		return
+	}
+	switch {
+	case strings.HasSuffix(created.Kind, "Cluster"):
+		record.ClusterID = created.ID
+	case strings.HasSuffix(created.Kind, "Host"):
+		record.HostID = created.ID
+	case created.Kind == "InfraEnv":
+		record.InfraEnvID = created.ID
+	}
+}
+
+// responseError returns the reason of an error response, as set by both the error and the infra_error definitions
+func responseError(body []byte) string {
+	var apiError struct {
+		Reason  string `json:"reason"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &apiError); err != nil {
+		return ""
+	}
+	if apiError.Reason != "" {
+		return apiError.Reason
+	}
+	return apiError.Message
+}
+
+// auditResponseWriter keeps the status code and the beginning of the body of the response
+type auditResponseWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (w *auditResponseWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.statusCode = statusCode
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *auditResponseWriter) Write(data []byte) (int, error) {
+	w.wroteHeader = true
+	if remaining := maxAuditedBody - w.body.Len(); remaining > 0 {
+		if len(data) < remaining {
+			remaining = len(data)
+		}
+		w.body.Write(data[:remaining])
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *auditResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
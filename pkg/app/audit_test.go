@@ -0,0 +1,184 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/mocks"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/sirupsen/logrus"
+)
+
+type fakeAuditRecorder struct {
+	records []*models.AuditRecord
+}
+
+func (f *fakeAuditRecorder) Record(ctx context.Context, record *models.AuditRecord) {
+	f.records = append(f.records, record)
+}
+
+var _ = Describe("WithAuditMiddleware", func() {
+	const (
+		clusterID = "a1b2c3d4-0000-0000-0000-000000000001"
+		userToken = "user-token"
+	)
+
+	var (
+		ctrl          *gomock.Controller
+		mockInstaller *mocks.MockInstallerAPI
+		recorder      *fakeAuditRecorder
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockInstaller = mocks.NewMockInstallerAPI(ctrl)
+		recorder = &fakeAuditRecorder{}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newHandler := func(includeAgentOperations bool) http.Handler {
+		payload := &ocm.AuthPayload{Username: "jdoe", Organization: "org1", Role: ocm.UserRole}
+		h, err := restapi.Handler(restapi.Config{
+			AuthUserAuth: func(token string) (interface{}, error) {
+				if token != userToken {
+					return nil, common.NewInfraError(http.StatusUnauthorized, errors.New("invalid token"))
+				}
+				return payload, nil
+			},
+			AuthAgentAuth: func(token string) (interface{}, error) {
+				return payload, nil
+			},
+			Authorizer:      WithAuditAuthorizer(func(*http.Request) error { return nil }),
+			InstallerAPI:    mockInstaller,
+			Logger:          logrus.Printf,
+			InnerMiddleware: WithAuditMiddleware(recorder, auth.TypeRHSSO, includeAgentOperations),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return h
+	}
+
+	serve := func(h http.Handler, method, path, body, header, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/api/assisted-install"+path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		req.Header.Set(header, token)
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, req)
+		return rr
+	}
+
+	It("records the user, the target and the redacted changes of an update", func() {
+		mockInstaller.EXPECT().V2UpdateCluster(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, params installer.V2UpdateClusterParams) middleware.Responder {
+				Expect(swag.StringValue(params.ClusterUpdateParams.PullSecret)).To(Equal("my-secret"))
+				return installer.NewV2UpdateClusterCreated().WithPayload(&models.Cluster{})
+			})
+
+		rr := serve(newHandler(false), http.MethodPatch, "/v2/clusters/"+clusterID,
+			`{"name":"new-name","pull_secret":"my-secret"}`, "Authorization", userToken)
+		Expect(rr.Code).To(Equal(http.StatusCreated))
+
+		Expect(recorder.records).To(HaveLen(1))
+		record := recorder.records[0]
+		Expect(record.ID).NotTo(BeNil())
+		Expect(record.CreatedAt.IsZero()).To(BeFalse())
+		Expect(record.UserName).To(Equal("jdoe"))
+		Expect(record.OrgID).To(Equal("org1"))
+		Expect(record.AuthType).To(Equal(string(auth.TypeRHSSO)))
+		Expect(swag.StringValue(record.OperationID)).To(Equal("V2UpdateCluster"))
+		Expect(swag.StringValue(record.Method)).To(Equal(http.MethodPatch))
+		Expect(swag.StringValue(record.Path)).To(Equal("/api/assisted-install/v2/clusters/" + clusterID))
+		Expect(record.ClusterID.String()).To(Equal(clusterID))
+		Expect(record.HostID).To(BeNil())
+		Expect(record.InfraEnvID).To(BeNil())
+		Expect(record.Changes).To(MatchJSON(`{"name":"new-name","pull_secret":"<SECRET>"}`))
+		Expect(swag.Int64Value(record.StatusCode)).To(Equal(int64(http.StatusCreated)))
+		Expect(swag.StringValue(record.Result)).To(Equal(models.AuditRecordResultSucceeded))
+		Expect(record.Error).To(BeEmpty())
+	})
+
+	It("records the resource created by a registration", func() {
+		id := strfmt.UUID(clusterID)
+		mockInstaller.EXPECT().V2RegisterCluster(gomock.Any(), gomock.Any()).Return(
+			installer.NewV2RegisterClusterCreated().WithPayload(&models.Cluster{ID: &id, Kind: swag.String(models.ClusterKindCluster)}))
+
+		rr := serve(newHandler(false), http.MethodPost, "/v2/clusters",
+			`{"name":"test","openshift_version":"4.9","pull_secret":"my-secret"}`, "Authorization", userToken)
+		Expect(rr.Code).To(Equal(http.StatusCreated))
+
+		Expect(recorder.records).To(HaveLen(1))
+		Expect(swag.StringValue(recorder.records[0].OperationID)).To(Equal("v2RegisterCluster"))
+		Expect(recorder.records[0].ClusterID.String()).To(Equal(clusterID))
+	})
+
+	It("records the reason of a failed call", func() {
+		mockInstaller.EXPECT().V2UpdateCluster(gomock.Any(), gomock.Any()).Return(
+			common.NewApiError(http.StatusBadRequest, errors.New("invalid cluster name")))
+
+		rr := serve(newHandler(false), http.MethodPatch, "/v2/clusters/"+clusterID, `{"name":"-"}`, "Authorization", userToken)
+		Expect(rr.Code).To(Equal(http.StatusBadRequest))
+
+		Expect(recorder.records).To(HaveLen(1))
+		Expect(swag.Int64Value(recorder.records[0].StatusCode)).To(Equal(int64(http.StatusBadRequest)))
+		Expect(swag.StringValue(recorder.records[0].Result)).To(Equal(models.AuditRecordResultFailed))
+		Expect(recorder.records[0].Error).To(Equal("invalid cluster name"))
+	})
+
+	It("records calls that failed to authenticate without a user", func() {
+		rr := serve(newHandler(false), http.MethodPatch, "/v2/clusters/"+clusterID, `{"name":"new-name"}`, "Authorization", "bad-token")
+		Expect(rr.Code).To(Equal(http.StatusUnauthorized))
+
+		Expect(recorder.records).To(HaveLen(1))
+		Expect(recorder.records[0].UserName).To(BeEmpty())
+		Expect(swag.StringValue(recorder.records[0].Result)).To(Equal(models.AuditRecordResultFailed))
+	})
+
+	It("does not record calls that don't change anything", func() {
+		mockInstaller.EXPECT().V2ListClusters(gomock.Any(), gomock.Any()).Return(
+			installer.NewV2ListClustersOK().WithPayload(models.ClusterList{}))
+
+		rr := serve(newHandler(false), http.MethodGet, "/v2/clusters", "", "Authorization", userToken)
+		Expect(rr.Code).To(Equal(http.StatusOK))
+		Expect(recorder.records).To(BeEmpty())
+	})
+
+	Context("agent operations", func() {
+		const path = "/v2/infra-envs/" + clusterID + "/hosts"
+
+		BeforeEach(func() {
+			mockInstaller.EXPECT().V2RegisterHost(gomock.Any(), gomock.Any()).Return(
+				installer.NewV2RegisterHostCreated().WithPayload(&models.HostRegistrationResponse{}))
+		})
+
+		It("are not recorded by default", func() {
+			rr := serve(newHandler(false), http.MethodPost, path, `{"host_id":"`+clusterID+`"}`, "X-Secret-Key", "agent-token")
+			Expect(rr.Code).To(Equal(http.StatusCreated))
+			Expect(recorder.records).To(BeEmpty())
+		})
+
+		It("are recorded when included", func() {
+			rr := serve(newHandler(true), http.MethodPost, path, `{"host_id":"`+clusterID+`"}`, "X-Secret-Key", "agent-token")
+			Expect(rr.Code).To(Equal(http.StatusCreated))
+			Expect(recorder.records).To(HaveLen(1))
+			Expect(recorder.records[0].InfraEnvID.String()).To(Equal(clusterID))
+		})
+	})
+})
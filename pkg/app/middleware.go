@@ -46,6 +46,17 @@ func WithHealthMiddleware(next http.Handler, threads []*thread.Thread, logger lo
 	})
 }
 
+// WithImageTokenQueryMiddleware returns middleware which passes the image token of a signed URL in the Image-Token
+// header, as the clients downloading the network boot artifacts of an infra-env can't set headers
+func WithImageTokenQueryMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token := r.URL.Query().Get("image_token"); token != "" && r.Header.Get("Image-Token") == "" {
+			r.Header.Set("Image-Token", token)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 func SetupCORSMiddleware(handler http.Handler, domains []string) http.Handler {
 	corsHandler := cors.New(cors.Options{
 		Debug: false,
@@ -111,3 +111,36 @@ var _ = Describe("DisableV1Middleware", func() {
 		expectStatusCode("/api/assisted-install/v1/whatever", http.StatusNotFound)
 	})
 })
+
+var _ = Describe("WithImageTokenQueryMiddleware", func() {
+	var (
+		imageToken string
+		h          http.Handler
+	)
+
+	BeforeEach(func() {
+		imageToken = ""
+		h = WithImageTokenQueryMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			imageToken = r.Header.Get("Image-Token")
+		}))
+	})
+
+	It("passes the image token of the URL in the header", func() {
+		req := httptest.NewRequest(http.MethodGet, "/api/assisted-install/v2/infra-envs/id/downloads/files?file_name=initrd.img&image_token=token", nil)
+		h.ServeHTTP(httptest.NewRecorder(), req)
+		Expect(imageToken).To(Equal("token"))
+	})
+
+	It("keeps the image token of the header", func() {
+		req := httptest.NewRequest(http.MethodGet, "/api/assisted-install/v2/infra-envs/id/downloads/files?file_name=initrd.img&image_token=token", nil)
+		req.Header.Set("Image-Token", "header-token")
+		h.ServeHTTP(httptest.NewRecorder(), req)
+		Expect(imageToken).To(Equal("header-token"))
+	})
+
+	It("does not set the header without an image token", func() {
+		req := httptest.NewRequest(http.MethodGet, "/api/assisted-install/v2/infra-envs/id/downloads/files?file_name=initrd.img", nil)
+		h.ServeHTTP(httptest.NewRecorder(), req)
+		Expect(imageToken).To(BeEmpty())
+	})
+})
@@ -188,6 +188,22 @@ func (f fakeInventory) V2InstallCluster(ctx context.Context, params installer.V2
 	return installer.NewV2InstallClusterAccepted()
 }
 
+func (f fakeInventory) V2DryRunInstallCluster(ctx context.Context, params installer.V2DryRunInstallClusterParams) middleware.Responder {
+	return installer.NewV2DryRunInstallClusterOK()
+}
+
+func (f fakeInventory) V2GetClusterConnectivityMatrix(ctx context.Context, params installer.V2GetClusterConnectivityMatrixParams) middleware.Responder {
+	return installer.NewV2GetClusterConnectivityMatrixOK()
+}
+
+func (f fakeInventory) V2DownloadClusterConnectivityMatrix(ctx context.Context, params installer.V2DownloadClusterConnectivityMatrixParams) middleware.Responder {
+	return installer.NewV2DownloadClusterConnectivityMatrixOK()
+}
+
+func (f fakeInventory) V2DownloadClusterDryRun(ctx context.Context, params installer.V2DownloadClusterDryRunParams) middleware.Responder {
+	return installer.NewV2DownloadClusterDryRunOK()
+}
+
 func (f fakeInventory) InstallHosts(ctx context.Context, params installer.InstallHostsParams) middleware.Responder {
 	return installer.NewInstallHostsAccepted()
 }
@@ -264,6 +280,10 @@ func (f fakeInventory) UpdateClusterInstallConfig(ctx context.Context, params in
 	return installer.NewUpdateClusterInstallConfigCreated()
 }
 
+func (f fakeInventory) V2ValidateClusterInstallConfig(ctx context.Context, params installer.V2ValidateClusterInstallConfigParams) middleware.Responder {
+	return installer.NewV2ValidateClusterInstallConfigOK()
+}
+
 func (f fakeInventory) V2UpdateClusterInstallConfig(ctx context.Context, params installer.V2UpdateClusterInstallConfigParams) middleware.Responder {
 	return installer.NewV2UpdateClusterInstallConfigCreated()
 }
@@ -420,6 +440,14 @@ func (f fakeInventory) V2GetHost(ctx context.Context, params installer.V2GetHost
 	return installer.NewV2GetHostNotImplemented()
 }
 
+func (f fakeInventory) V2ListHostInventoryHistory(ctx context.Context, params installer.V2ListHostInventoryHistoryParams) middleware.Responder {
+	return installer.NewV2ListHostInventoryHistoryOK()
+}
+
+func (f fakeInventory) V2GetHostInventoryDiff(ctx context.Context, params installer.V2GetHostInventoryDiffParams) middleware.Responder {
+	return installer.NewV2GetHostInventoryDiffOK()
+}
+
 func (f fakeInventory) V2GetNextSteps(ctx context.Context, params installer.V2GetNextStepsParams) middleware.Responder {
 	return installer.NewV2GetNextStepsOK()
 }
@@ -492,6 +520,10 @@ func (b fakeInventory) RegenerateInfraEnvSigningKey(ctx context.Context, params
 	return installer.NewRegenerateInfraEnvSigningKeyNoContent()
 }
 
+func (b fakeInventory) ApplyStaticNetworkConfigTemplate(ctx context.Context, params installer.ApplyStaticNetworkConfigTemplateParams) middleware.Responder {
+	return installer.NewApplyStaticNetworkConfigTemplateCreated()
+}
+
 func (f fakeInventory) GetInfraEnvDownloadURL(ctx context.Context, params installer.GetInfraEnvDownloadURLParams) middleware.Responder {
 	return installer.NewGetInfraEnvDownloadURLOK()
 }
@@ -510,6 +542,10 @@ func (f fakeEventsAPI) V2ListEvents(ctx context.Context, params eventsapi.V2List
 	return eventsapi.NewListEventsOK()
 }
 
+func (f fakeEventsAPI) V2StreamEvents(ctx context.Context, params eventsapi.V2StreamEventsParams) middleware.Responder {
+	return eventsapi.NewV2StreamEventsOK()
+}
+
 type fakeVersionsAPI struct{}
 
 func (f fakeVersionsAPI) ListComponentVersions(
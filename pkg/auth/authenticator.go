@@ -36,6 +36,12 @@ type Config struct {
 	// Will be split with "," as separator
 	AllowedDomains string   `envconfig:"ALLOWED_DOMAINS" default:""`
 	AdminUsers     []string `envconfig:"ADMIN_USERS" default:""`
+	// Authorize the users of the local and none auth types with the roles bound to them
+	EnableLocalRBAC bool `envconfig:"ENABLE_LOCAL_RBAC" default:"false"`
+	// JSON encoded role bindings, in addition to those stored in the database
+	LocalRBACBindings string `envconfig:"LOCAL_RBAC_BINDINGS" default:""`
+	// The header holding the name of the user authenticated by a proxy, when the auth type is none
+	LocalRBACUserHeader string `envconfig:"LOCAL_RBAC_USER_HEADER" default:"X-Forwarded-User"`
 }
 
 func NewAuthenticator(cfg *Config, ocmClient *ocm.Client, log logrus.FieldLogger, db *gorm.DB) (a Authenticator, err error) {
@@ -43,7 +49,11 @@ func NewAuthenticator(cfg *Config, ocmClient *ocm.Client, log logrus.FieldLogger
 	case TypeRHSSO:
 		a = NewRHSSOAuthenticator(cfg, ocmClient, log, db)
 	case TypeNone:
-		a = NewNoneAuthenticator(log)
+		none := NewNoneAuthenticator(log)
+		if cfg.EnableLocalRBAC {
+			none.userHeader = cfg.LocalRBACUserHeader
+		}
+		a = none
 	case TypeLocal:
 		a, err = NewLocalAuthenticator(cfg, log, db)
 	default:
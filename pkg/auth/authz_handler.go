@@ -19,18 +19,25 @@ import (
 )
 
 type AuthzHandler struct {
-	Enabled bool
-	log     logrus.FieldLogger
-	client  *ocm.Client
-	db      *gorm.DB
+	Enabled  bool
+	log      logrus.FieldLogger
+	client   *ocm.Client
+	db       *gorm.DB
+	rbac     *localRBAC
+	authType AuthType
 }
 
 func NewAuthzHandler(cfg *Config, ocmCLient *ocm.Client, log logrus.FieldLogger, db *gorm.DB) *AuthzHandler {
 	a := &AuthzHandler{
-		Enabled: cfg.AuthType == TypeRHSSO,
-		client:  ocmCLient,
-		log:     log,
-		db:      db,
+		Enabled:  cfg.AuthType == TypeRHSSO,
+		client:   ocmCLient,
+		log:      log,
+		db:       db,
+		authType: cfg.AuthType,
+	}
+	if cfg.EnableLocalRBAC && (cfg.AuthType == TypeLocal || cfg.AuthType == TypeNone) {
+		a.Enabled = true
+		a.rbac = newLocalRBAC(cfg, db, log)
 	}
 	return a
 }
@@ -64,11 +71,21 @@ func (a *AuthzHandler) isObjectOwnedByUser(id string, obj interface{}, username
 
 func (a *AuthzHandler) Authorizer(request *http.Request) error {
 	route := middleware.MatchedRouteFrom(request)
-	switch authScheme := route.Authenticator.Schemes[0]; authScheme {
-	case "imageAuth":
+	// The authenticator of the route is the security requirement that authenticated the request
+	schemes := route.Authenticator.Schemes
+	switch {
+	case funk.ContainsString(schemes, "imageAuth"):
 		return a.imageTokenAuthorizer(request.Context())
-	default:
+	case a.rbac == nil:
 		return a.ocmAuthorizer(request)
+	case funk.ContainsString(schemes, userAuthScheme):
+		return a.rbac.authorize(request, ocm.PayloadFromContext(request.Context()))
+	case a.authType == TypeNone && routeAcceptsUsers(route):
+		// Without authentication the agents can't be told apart from anonymous users
+		return common.NewInfraError(http.StatusForbidden, fmt.Errorf("Unauthorized to access route (user required)"))
+	default:
+		// The agents are authorized by their tokens when the roles of the users are local
+		return nil
 	}
 }
 
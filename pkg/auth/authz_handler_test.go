@@ -48,6 +48,16 @@ var _ = Describe("NewAuthzHandler", func() {
 		handler = NewAuthzHandler(cfg, nil, logrus.New(), nil)
 		Expect(handler.Enabled).To(BeFalse())
 	})
+
+	It("Is enabled with local RBAC for the local and none auth types", func() {
+		cfg := &Config{AuthType: TypeNone, EnableLocalRBAC: true}
+		handler := NewAuthzHandler(cfg, nil, logrus.New(), nil)
+		Expect(handler.Enabled).To(BeTrue())
+
+		cfg = &Config{AuthType: TypeLocal, EnableLocalRBAC: true}
+		handler = NewAuthzHandler(cfg, nil, logrus.New(), nil)
+		Expect(handler.Enabled).To(BeTrue())
+	})
 })
 
 var _ = Describe("Authz email domain", func() {
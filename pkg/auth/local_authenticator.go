@@ -3,6 +3,7 @@ package auth
 import (
 	"crypto"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/runtime"
@@ -23,6 +24,8 @@ type LocalAuthenticator struct {
 	db        *gorm.DB
 	log       logrus.FieldLogger
 	publicKey crypto.PublicKey
+	// Users are authenticated, to be authorized with their roles, only when local RBAC is enabled
+	userAuthEnabled bool
 }
 
 func NewLocalAuthenticator(cfg *Config, log logrus.FieldLogger, db *gorm.DB) (*LocalAuthenticator, error) {
@@ -40,6 +43,8 @@ func NewLocalAuthenticator(cfg *Config, log logrus.FieldLogger, db *gorm.DB) (*L
 		db:        db,
 		log:       log,
 		publicKey: key,
+
+		userAuthEnabled: cfg.EnableLocalRBAC,
 	}
 
 	return a, nil
@@ -99,8 +104,30 @@ func (a *LocalAuthenticator) AuthAgentAuth(token string) (interface{}, error) {
 	return ocm.AdminPayload(), nil
 }
 
-func (a *LocalAuthenticator) AuthUserAuth(_ string) (interface{}, error) {
-	return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("User Authentication not allowed for local auth"))
+func (a *LocalAuthenticator) AuthUserAuth(token string) (interface{}, error) {
+	if !a.userAuthEnabled {
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("User Authentication not allowed for local auth"))
+	}
+
+	t, err := validateToken(strings.TrimPrefix(token, "Bearer "), a.publicKey)
+	if err != nil {
+		a.log.WithError(err).Error("failed to validate user token")
+		return nil, common.NewInfraError(http.StatusUnauthorized, err)
+	}
+	claims, ok := t.Claims.(jwt.MapClaims)
+	if !ok {
+		err = errors.Errorf("failed to parse JWT token claims")
+		a.log.Error(err)
+		return nil, common.NewInfraError(http.StatusUnauthorized, err)
+	}
+	userName, ok := claims["sub"].(string)
+	if !ok || userName == "" {
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("the user of the token is missing"))
+	}
+	orgID, _ := claims["org_id"].(string)
+
+	a.log.Debugf("Authenticating user %s JWT", userName)
+	return &ocm.AuthPayload{Username: userName, Organization: orgID, Role: ocm.UserRole}, nil
 }
 
 func (a *LocalAuthenticator) AuthURLAuth(token string) (interface{}, error) {
@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"strings"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
@@ -12,6 +13,7 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
@@ -125,3 +127,45 @@ var _ = Describe("AuthAgentAuth", func() {
 		validateErrorResponse(err)
 	})
 })
+
+var _ = Describe("AuthUserAuth with local RBAC", func() {
+	var (
+		a       *LocalAuthenticator
+		privKey string
+	)
+
+	BeforeEach(func() {
+		var pubKey string
+		var err error
+		pubKey, privKey, err = gencrypto.ECDSAKeyPairPEM()
+		Expect(err).ToNot(HaveOccurred())
+
+		a, err = NewLocalAuthenticator(&Config{ECPublicKeyPEM: pubKey, EnableLocalRBAC: true}, logrus.New(), nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("authenticates the user of the token", func() {
+		token, err := gencrypto.LocalUserJWTForKey("jdoe", "org1", privKey, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		payload, err := a.AuthUserAuth("Bearer " + token)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(payload).To(Equal(&ocm.AuthPayload{Username: "jdoe", Organization: "org1", Role: ocm.UserRole}))
+	})
+
+	It("fails an expired token", func() {
+		token, err := gencrypto.LocalUserJWTForKey("jdoe", "org1", privKey, -time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = a.AuthUserAuth("Bearer " + token)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails an agent token", func() {
+		token, err := gencrypto.LocalJWTForKey(uuid.New().String(), privKey, gencrypto.InfraEnvKey)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = a.AuthUserAuth("Bearer " + token)
+		Expect(err).To(HaveOccurred())
+	})
+})
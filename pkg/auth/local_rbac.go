@@ -0,0 +1,306 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	params "github.com/openshift/assisted-service/pkg/context"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+const userAuthScheme = "userAuth"
+
+// roleRanks orders the local roles, each role allowing everything that the lower ones allow
+var roleRanks = map[string]int{
+	models.RoleBindingRoleViewer:    1,
+	models.RoleBindingRoleEditor:    2,
+	models.RoleBindingRoleInstaller: 3,
+	models.RoleBindingRoleAdmin:     4,
+}
+
+// installerOperations are the operations that install or reset clusters and hosts
+var installerOperations = []string{
+	"InstallCluster", "v2InstallCluster", "v2DryRunInstallCluster",
+	"CancelInstallation", "V2CancelInstallation",
+	"ResetCluster", "v2ResetCluster",
+	"InstallHosts", "InstallHost", "v2InstallHost",
+	"ResetHost", "v2ResetHost",
+}
+
+// localRBAC authorizes the users of the local and none authentication types with the roles bound to them,
+// either in the configuration or in the database
+type localRBAC struct {
+	db       *gorm.DB
+	log      logrus.FieldLogger
+	bindings []*models.RoleBinding
+}
+
+func newLocalRBAC(cfg *Config, db *gorm.DB, log logrus.FieldLogger) *localRBAC {
+	r := &localRBAC{db: db, log: log}
+	if cfg.LocalRBACBindings == "" {
+		return r
+	}
+	// The users are left with the roles bound in the database rather than failing open
+	var bindings []*models.RoleBinding
+	if err := json.Unmarshal([]byte(cfg.LocalRBACBindings), &bindings); err != nil {
+		log.WithError(err).Error("failed to parse the role bindings of LOCAL_RBAC_BINDINGS")
+		return r
+	}
+	for _, binding := range bindings {
+		if err := ValidateRoleBinding(binding); err != nil {
+			log.WithError(err).Error("ignoring a role binding of LOCAL_RBAC_BINDINGS")
+			continue
+		}
+		r.bindings = append(r.bindings, binding)
+	}
+	return r
+}
+
+// ValidateRoleBinding checks that a role binding is either global, or bound on a single cluster or organization
+func ValidateRoleBinding(binding *models.RoleBinding) error {
+	if swag.StringValue(binding.UserName) == "" {
+		return errors.New("the user of the role binding is missing")
+	}
+	if _, ok := roleRanks[swag.StringValue(binding.Role)]; !ok {
+		return errors.Errorf("unknown role %q", swag.StringValue(binding.Role))
+	}
+	if binding.ClusterID != nil && binding.OrgID != "" {
+		return errors.New("a role can be bound either on a cluster or on an organization")
+	}
+	return nil
+}
+
+// rbacTarget is a cluster, infra-env or host that a request is made on or refers to
+type rbacTarget struct {
+	clusterID string
+	orgID     string
+}
+
+// rbacReferences are the resources that the body of a request can refer to
+type rbacReferences struct {
+	ClusterID  string `json:"cluster_id"`
+	InfraEnvID string `json:"infra_env_id"`
+}
+
+// authorize checks that the roles bound to the user allow the request on every resource it is made on or refers to.
+// The users other than the admins keep the user role, and the ownership checks of the handlers are only bypassed when
+// their role bindings granted access to all of these resources.
+func (r *localRBAC) authorize(request *http.Request, payload *ocm.AuthPayload) error {
+	route := middleware.MatchedRouteFrom(request)
+	required := requiredRole(route, request.Method)
+
+	bindings, err := r.userBindings(payload.Username)
+	if err != nil {
+		r.log.WithError(err).Errorf("failed to get the role bindings of user %s", payload.Username)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	// The admins are allowed everything, including the requests on the resources that were deleted
+	if globalRole(bindings) == models.RoleBindingRoleAdmin {
+		payload.IsAuthorized = true
+		payload.Role = ocm.AdminRole
+		return nil
+	}
+
+	targets, err := r.targets(request)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.NewApiError(http.StatusNotFound, fmt.Errorf("Object Not Found"))
+		}
+		r.log.WithError(err).Error("failed to get the targets of the request")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	// The requests without targets are authorized by the roles bound globally or on the organization of the user
+	authorizedByBinding := len(targets) > 0 || roleRanks[globalRole(bindings)] >= roleRanks[required]
+	if len(targets) == 0 {
+		targets = []*rbacTarget{nil}
+	}
+	for _, target := range targets {
+		if granted := grantedRole(bindings, target, payload); roleRanks[granted] < roleRanks[required] {
+			r.log.Warnf("Unauthorized user %s: operation %s requires role %s", payload.Username, route.Operation.ID, required)
+			return common.NewInfraError(
+				http.StatusForbidden,
+				fmt.Errorf(
+					"%s: Unauthorized to access route (role %s required)",
+					payload.Username, required))
+		}
+	}
+
+	payload.IsAuthorized = true
+	payload.AuthorizedByRoleBinding = authorizedByBinding
+	payload.Role = ocm.UserRole
+	return nil
+}
+
+// routeAcceptsUsers returns true if one of the security requirements of the route authenticates users
+func routeAcceptsUsers(route *middleware.MatchedRoute) bool {
+	if route == nil {
+		return false
+	}
+	for _, authenticator := range route.Authenticators {
+		if funk.ContainsString(authenticator.Schemes, userAuthScheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// requiredRole returns the lowest role allowed to call the operation of the route
+func requiredRole(route *middleware.MatchedRoute, method string) string {
+	if scopes := route.Authenticator.Scopes[userAuthScheme]; len(scopes) > 0 && !funk.ContainsString(scopes, string(ocm.UserRole)) {
+		return models.RoleBindingRoleAdmin
+	}
+	if funk.ContainsString(installerOperations, route.Operation.ID) {
+		return models.RoleBindingRoleInstaller
+	}
+	if method == http.MethodGet || method == http.MethodHead {
+		return models.RoleBindingRoleViewer
+	}
+	return models.RoleBindingRoleEditor
+}
+
+// targets returns the clusters, infra-envs and hosts of the path and the query of the request, and the ones that the
+// body of the request refers to, so that a role bound on one resource can't be used to reach another one
+func (r *localRBAC) targets(request *http.Request) ([]*rbacTarget, error) {
+	references, err := bodyReferences(request)
+	if err != nil {
+		return nil, err
+	}
+	query := request.URL.Query()
+	referencedIDs := func(key, bodyID string) []string {
+		return funk.UniqString(funk.FilterString([]string{params.GetParam(request.Context(), key), query.Get(key), bodyID},
+			func(id string) bool { return id != "" }))
+	}
+
+	var targets []*rbacTarget
+	for _, clusterID := range referencedIDs(params.ClusterId, references.ClusterID) {
+		var cluster common.Cluster
+		if err = r.db.Select("id", "org_id").Take(&cluster, "id = ?", clusterID).Error; err != nil {
+			return nil, err
+		}
+		targets = append(targets, &rbacTarget{clusterID: clusterID, orgID: cluster.OrgID})
+	}
+	for _, infraEnvID := range referencedIDs(params.InfraEnvId, references.InfraEnvID) {
+		target, err := r.infraEnvTarget(infraEnvID)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, target)
+	}
+	for _, hostID := range referencedIDs(params.HostId, "") {
+		// The hosts of the paths are the ones of the infra-env of the path
+		db := r.db
+		if infraEnvID := params.GetParam(request.Context(), params.InfraEnvId); infraEnvID != "" {
+			db = db.Where("infra_env_id = ?", infraEnvID)
+		}
+		var host common.Host
+		if err = db.Select("id", "infra_env_id", "cluster_id").Take(&host, "id = ?", hostID).Error; err != nil {
+			return nil, err
+		}
+		target, err := r.infraEnvTarget(host.InfraEnvID.String())
+		if err != nil {
+			return nil, err
+		}
+		if host.ClusterID != nil {
+			target.clusterID = host.ClusterID.String()
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+func (r *localRBAC) infraEnvTarget(infraEnvID string) (*rbacTarget, error) {
+	var infraEnv common.InfraEnv
+	if err := r.db.Select("id", "cluster_id", "org_id").Take(&infraEnv, "id = ?", infraEnvID).Error; err != nil {
+		return nil, err
+	}
+	return &rbacTarget{clusterID: infraEnv.ClusterID.String(), orgID: infraEnv.OrgID}, nil
+}
+
+// bodyReferences returns the resources that the JSON body of the request refers to, and restores the body for the
+// binding of the request parameters. The bodies that can't be decoded are left to the validation of the request.
+func bodyReferences(request *http.Request) (*rbacReferences, error) {
+	references := &rbacReferences{}
+	if request.Body == nil || request.Body == http.NoBody {
+		return references, nil
+	}
+	if mediaType, _, err := mime.ParseMediaType(request.Header.Get("Content-Type")); err != nil || mediaType != runtime.JSONMime {
+		return references, nil
+	}
+	body, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		return nil, err
+	}
+	request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	_ = json.Unmarshal(body, references)
+	return references, nil
+}
+
+func (r *localRBAC) userBindings(userName string) ([]*models.RoleBinding, error) {
+	var bindings []*models.RoleBinding
+	for _, binding := range r.bindings {
+		if swag.StringValue(binding.UserName) == userName {
+			bindings = append(bindings, binding)
+		}
+	}
+	var stored []*models.RoleBinding
+	if err := r.db.Where("user_name = ?", userName).Find(&stored).Error; err != nil {
+		return nil, err
+	}
+	return append(bindings, stored...), nil
+}
+
+func isGlobalBinding(binding *models.RoleBinding) bool {
+	return binding.ClusterID == nil && binding.OrgID == ""
+}
+
+// globalRole returns the highest role bound globally to the user
+func globalRole(bindings []*models.RoleBinding) string {
+	role := ""
+	for _, binding := range bindings {
+		if isGlobalBinding(binding) && roleRanks[swag.StringValue(binding.Role)] > roleRanks[role] {
+			role = swag.StringValue(binding.Role)
+		}
+	}
+	return role
+}
+
+// grantedRole returns the highest of the roles bound to the user that apply to the target
+func grantedRole(bindings []*models.RoleBinding, target *rbacTarget, payload *ocm.AuthPayload) string {
+	granted := ""
+	for _, binding := range bindings {
+		if bindingApplies(binding, target, payload) && roleRanks[swag.StringValue(binding.Role)] > roleRanks[granted] {
+			granted = swag.StringValue(binding.Role)
+		}
+	}
+	return granted
+}
+
+// bindingApplies returns true if the role binding applies to the target of the request. The roles bound on an
+// organization apply to the requests without a target when the user belongs to the organization.
+func bindingApplies(binding *models.RoleBinding, target *rbacTarget, payload *ocm.AuthPayload) bool {
+	switch {
+	case isGlobalBinding(binding):
+		return true
+	case binding.ClusterID != nil:
+		return target != nil && target.clusterID == binding.ClusterID.String()
+	case target != nil:
+		return target.orgID == binding.OrgID
+	default:
+		return payload.Organization == binding.OrgID
+	}
+}
@@ -0,0 +1,218 @@
+package auth
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	params "github.com/openshift/assisted-service/pkg/context"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("ValidateRoleBinding", func() {
+	clusterID := strfmt.UUID(uuid.New().String())
+
+	It("accepts global, organization and cluster bindings", func() {
+		Expect(ValidateRoleBinding(&models.RoleBinding{UserName: swag.String("jdoe"), Role: swag.String(models.RoleBindingRoleViewer)})).To(Succeed())
+		Expect(ValidateRoleBinding(&models.RoleBinding{UserName: swag.String("jdoe"), Role: swag.String(models.RoleBindingRoleEditor), OrgID: "org1"})).To(Succeed())
+		Expect(ValidateRoleBinding(&models.RoleBinding{UserName: swag.String("jdoe"), Role: swag.String(models.RoleBindingRoleInstaller), ClusterID: &clusterID})).To(Succeed())
+	})
+
+	It("rejects invalid bindings", func() {
+		Expect(ValidateRoleBinding(&models.RoleBinding{Role: swag.String(models.RoleBindingRoleViewer)})).NotTo(Succeed())
+		Expect(ValidateRoleBinding(&models.RoleBinding{UserName: swag.String("jdoe"), Role: swag.String("owner")})).NotTo(Succeed())
+		Expect(ValidateRoleBinding(&models.RoleBinding{UserName: swag.String("jdoe"), Role: swag.String(models.RoleBindingRoleViewer),
+			ClusterID: &clusterID, OrgID: "org1"})).NotTo(Succeed())
+	})
+})
+
+var _ = Describe("local RBAC", func() {
+	const userHeader = "X-Forwarded-User"
+
+	var (
+		db             *gorm.DB
+		dbName         string
+		server         *httptest.Server
+		clusterID      strfmt.UUID
+		otherClusterID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		otherClusterID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, OrgID: "org1", UserName: "owner"}}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &otherClusterID, OrgID: "org2", UserName: "owner"}}).Error).ShouldNot(HaveOccurred())
+
+		log := logrus.New()
+		log.SetOutput(ioutil.Discard)
+		cfg := &Config{
+			AuthType:            TypeNone,
+			EnableLocalRBAC:     true,
+			LocalRBACBindings:   `[{"user_name":"root","role":"admin"},{"user_name":"broken","role":"owner"}]`,
+			LocalRBACUserHeader: userHeader,
+		}
+		authenticator, err := NewAuthenticator(cfg, nil, log, db)
+		Expect(err).ToNot(HaveOccurred())
+		h, err := restapi.Handler(restapi.Config{
+			AuthAgentAuth:       authenticator.AuthAgentAuth,
+			AuthUserAuth:        authenticator.AuthUserAuth,
+			APIKeyAuthenticator: authenticator.CreateAuthenticator(),
+			Authorizer:          NewAuthzHandler(cfg, nil, log, db).CreateAuthorizer(),
+			InstallerAPI:        fakeInventory{},
+			EventsAPI:           fakeEventsAPI{},
+			Logger:              logrus.Printf,
+			InnerMiddleware:     params.ContextHandler(),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		server = httptest.NewServer(h)
+	})
+
+	AfterEach(func() {
+		server.Close()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	bind := func(userName, role string, clusterID *strfmt.UUID, orgID string) {
+		id := strfmt.UUID(uuid.New().String())
+		binding := &models.RoleBinding{ID: &id, UserName: swag.String(userName), Role: swag.String(role), ClusterID: clusterID, OrgID: orgID}
+		Expect(db.Create(binding).Error).ShouldNot(HaveOccurred())
+	}
+
+	call := func(userName, method, path, body string) int {
+		req, err := http.NewRequest(method, server.URL+"/api/assisted-install"+path, strings.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+		if userName != "" {
+			req.Header.Set(userHeader, userName)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	getCluster := func(userName string, id strfmt.UUID) int {
+		return call(userName, http.MethodGet, "/v2/clusters/"+id.String(), "")
+	}
+	updateCluster := func(userName string, id strfmt.UUID) int {
+		return call(userName, http.MethodPatch, "/v2/clusters/"+id.String(), "{}")
+	}
+	installCluster := func(userName string, id strfmt.UUID) int {
+		return call(userName, http.MethodPost, fmt.Sprintf("/v2/clusters/%s/actions/install", id), "")
+	}
+
+	It("forbids users without roles", func() {
+		Expect(getCluster("jdoe", clusterID)).To(Equal(http.StatusForbidden))
+		Expect(call("jdoe", http.MethodGet, "/v2/clusters", "")).To(Equal(http.StatusForbidden))
+	})
+
+	It("allows a viewer of a cluster to get it only", func() {
+		bind("jdoe", models.RoleBindingRoleViewer, &clusterID, "")
+
+		Expect(getCluster("jdoe", clusterID)).To(Equal(http.StatusOK))
+		Expect(updateCluster("jdoe", clusterID)).To(Equal(http.StatusForbidden))
+		Expect(getCluster("jdoe", otherClusterID)).To(Equal(http.StatusForbidden))
+	})
+
+	It("allows an editor of an organization to change its clusters but not to install them", func() {
+		bind("jdoe", models.RoleBindingRoleEditor, nil, "org1")
+
+		Expect(updateCluster("jdoe", clusterID)).To(Equal(http.StatusCreated))
+		Expect(installCluster("jdoe", clusterID)).To(Equal(http.StatusForbidden))
+		Expect(updateCluster("jdoe", otherClusterID)).To(Equal(http.StatusForbidden))
+	})
+
+	It("allows an installer to install the clusters", func() {
+		bind("jdoe", models.RoleBindingRoleInstaller, nil, "")
+
+		Expect(installCluster("jdoe", clusterID)).To(Equal(http.StatusAccepted))
+		Expect(installCluster("jdoe", otherClusterID)).To(Equal(http.StatusAccepted))
+		Expect(call("jdoe", http.MethodGet, "/v2/clusters", "")).To(Equal(http.StatusOK))
+	})
+
+	It("applies the highest of the roles of a user", func() {
+		bind("jdoe", models.RoleBindingRoleViewer, nil, "")
+		bind("jdoe", models.RoleBindingRoleInstaller, &clusterID, "")
+
+		Expect(installCluster("jdoe", clusterID)).To(Equal(http.StatusAccepted))
+		Expect(installCluster("jdoe", otherClusterID)).To(Equal(http.StatusForbidden))
+		Expect(getCluster("jdoe", otherClusterID)).To(Equal(http.StatusOK))
+	})
+
+	Context("with the resources referred to by the requests", func() {
+		var infraEnvID, hostID strfmt.UUID
+
+		BeforeEach(func() {
+			infraEnvID = strfmt.UUID(uuid.New().String())
+			hostID = strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID, ClusterID: clusterID, OrgID: "org1"}}).Error).ShouldNot(HaveOccurred())
+			Expect(db.Create(&common.Host{Host: models.Host{ID: &hostID, InfraEnvID: infraEnvID}}).Error).ShouldNot(HaveOccurred())
+			bind("jdoe", models.RoleBindingRoleEditor, &clusterID, "")
+		})
+
+		bindHost := func(id strfmt.UUID) int {
+			return call("jdoe", http.MethodPost, fmt.Sprintf("/v2/infra-envs/%s/hosts/%s/actions/bind", infraEnvID, hostID),
+				fmt.Sprintf(`{"cluster_id":"%s"}`, id))
+		}
+		registerInfraEnv := func(id strfmt.UUID) int {
+			return call("jdoe", http.MethodPost, "/v2/infra-envs",
+				fmt.Sprintf(`{"name":"infra-env","pull_secret":"secret","cluster_id":"%s"}`, id))
+		}
+
+		It("allows an editor of a cluster to bind hosts to it only", func() {
+			Expect(bindHost(clusterID)).To(Equal(http.StatusOK))
+			Expect(bindHost(otherClusterID)).To(Equal(http.StatusForbidden))
+		})
+
+		It("allows an editor of a cluster to register infra-envs for it only", func() {
+			Expect(registerInfraEnv(clusterID)).To(Equal(http.StatusCreated))
+			Expect(registerInfraEnv(otherClusterID)).To(Equal(http.StatusForbidden))
+		})
+
+		It("allows an editor of a cluster to list its events only", func() {
+			Expect(call("jdoe", http.MethodGet, "/v2/events?cluster_id="+clusterID.String(), "")).To(Equal(http.StatusOK))
+			Expect(call("jdoe", http.MethodGet, "/v2/events?host_id="+hostID.String(), "")).To(Equal(http.StatusOK))
+			Expect(call("jdoe", http.MethodGet, "/v2/events?cluster_id="+otherClusterID.String(), "")).To(Equal(http.StatusForbidden))
+		})
+
+		It("returns not found for a missing cluster in the body", func() {
+			Expect(bindHost(strfmt.UUID(uuid.New().String()))).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	It("applies the valid roles of the configuration", func() {
+		Expect(installCluster("root", clusterID)).To(Equal(http.StatusAccepted))
+		Expect(getCluster("broken", clusterID)).To(Equal(http.StatusForbidden))
+	})
+
+	It("returns not found for a missing cluster", func() {
+		bind("jdoe", models.RoleBindingRoleViewer, nil, "")
+		Expect(getCluster("jdoe", strfmt.UUID(uuid.New().String()))).To(Equal(http.StatusNotFound))
+	})
+
+	It("allows the agents to call the agent APIs", func() {
+		Expect(call("", http.MethodPost, fmt.Sprintf("/v2/infra-envs/%s/hosts", uuid.New()),
+			fmt.Sprintf(`{"host_id":"%s"}`, uuid.New()))).To(Equal(http.StatusCreated))
+		Expect(updateCluster("", clusterID)).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("rejects the requests without the user header on the APIs of the users and the agents", func() {
+		Expect(getCluster("", clusterID)).To(Equal(http.StatusUnauthorized))
+		Expect(call("", http.MethodGet, fmt.Sprintf("/v2/clusters/%s/downloads/credentials?file_name=kubeadmin-password", clusterID), "")).
+			To(Equal(http.StatusUnauthorized))
+		Expect(call("", http.MethodGet, fmt.Sprintf("/v2/clusters/%s/downloads/credentials?file_name=kubeadmin-password&api_key=token", clusterID), "")).
+			To(Equal(http.StatusUnauthorized))
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/runtime/security"
 	"github.com/openshift/assisted-service/pkg/ocm"
 	"github.com/sirupsen/logrus"
@@ -11,6 +12,8 @@ import (
 
 type NoneAuthenticator struct {
 	log logrus.FieldLogger
+	// The header holding the name of the user authenticated by a proxy, set when local RBAC is enabled
+	userHeader string
 }
 
 func NewNoneAuthenticator(log logrus.FieldLogger) *NoneAuthenticator {
@@ -28,7 +31,10 @@ func (a *NoneAuthenticator) AuthAgentAuth(_ string) (interface{}, error) {
 	return ocm.AdminPayload(), nil
 }
 
-func (a *NoneAuthenticator) AuthUserAuth(_ string) (interface{}, error) {
+func (a *NoneAuthenticator) AuthUserAuth(userName string) (interface{}, error) {
+	if a.userHeader != "" {
+		return &ocm.AuthPayload{Username: userName, Role: ocm.UserRole}, nil
+	}
 	a.log.Debug("User Authentication Disabled")
 	return ocm.AdminPayload(), nil
 }
@@ -43,9 +49,24 @@ func (a *NoneAuthenticator) AuthImageAuth(_ string) (interface{}, error) {
 	return ocm.AdminPayload(), nil
 }
 
-func (a *NoneAuthenticator) CreateAuthenticator() func(_, _ string, authenticate security.TokenAuthentication) runtime.Authenticator {
-	return func(_ string, _ string, authenticate security.TokenAuthentication) runtime.Authenticator {
-		return security.HttpAuthenticator(func(_ *http.Request) (bool, interface{}, error) {
+func (a *NoneAuthenticator) CreateAuthenticator() func(name, _ string, authenticate security.TokenAuthentication) runtime.Authenticator {
+	return func(name string, _ string, authenticate security.TokenAuthentication) runtime.Authenticator {
+		return security.HttpAuthenticator(func(r *http.Request) (bool, interface{}, error) {
+			if a.userHeader != "" {
+				// The requests of the users are the ones with the header set by the proxy, the agents don't
+				// send any credentials so they are only accepted on the routes that the users can't call
+				userName := r.Header.Get(a.userHeader)
+				if name == userAuthHeader {
+					if userName == "" {
+						return false, nil, nil
+					}
+					p, _ := authenticate(userName)
+					return true, p, nil
+				}
+				if userName != "" || routeAcceptsUsers(middleware.MatchedRouteFrom(r)) {
+					return false, nil, nil
+				}
+			}
 			p, _ := authenticate("")
 			return true, p, nil
 		})
@@ -1,24 +1,40 @@
 package generator
 
 import (
+	"archive/tar"
 	"context"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/ignition"
+	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 type InstallConfigGenerator interface {
 	GenerateInstallConfig(ctx context.Context, cluster common.Cluster, cfg []byte, releaseImage string) error
+	// DryRunInstallConfig creates the install config and ignition files like GenerateInstallConfig, but uploads them
+	// with the manifests of the cluster as a single tarball, which does not replace the files of the cluster. It returns
+	// the output of the installer, also when the files could not be created.
+	DryRunInstallConfig(ctx context.Context, cluster common.Cluster, cfg []byte, releaseImage string) (string, error)
+}
+
+// DryRunObjectName returns the name of the object the tarball of the last dry-run installation of the cluster is stored in
+func DryRunObjectName(clusterID strfmt.UUID) string {
+	return filepath.Join(clusterID.String(), "dry-run.tar")
 }
 
 //go:generate mockgen -package generator -destination mock_install_config.go . ISOInstallConfigGenerator
@@ -57,14 +73,31 @@ func New(log logrus.FieldLogger, s3Client s3wrapper.API, cfg Config, workDir str
 
 // GenerateInstallConfig creates install config and ignition files
 func (k *installGenerator) GenerateInstallConfig(ctx context.Context, cluster common.Cluster, cfg []byte, releaseImage string) error {
+	_, err := k.generate(ctx, cluster, cfg, releaseImage, func(generator ignition.Generator, _ string) error {
+		// upload files to S3
+		return generator.UploadToS3(ctx)
+	})
+	return err
+}
+
+func (k *installGenerator) DryRunInstallConfig(ctx context.Context, cluster common.Cluster, cfg []byte, releaseImage string) (string, error) {
+	return k.generate(ctx, cluster, cfg, releaseImage, func(_ ignition.Generator, clusterWorkDir string) error {
+		return k.uploadDryRun(ctx, cluster, clusterWorkDir)
+	})
+}
+
+// generate runs the generator in a new work directory and hands the generated files to store. It returns the output
+// of the installer.
+func (k *installGenerator) generate(ctx context.Context, cluster common.Cluster, cfg []byte, releaseImage string,
+	store func(generator ignition.Generator, clusterWorkDir string) error) (string, error) {
 	log := logutil.FromContext(ctx, k.log)
 	err := os.MkdirAll(k.workDir, 0o755)
 	if err != nil {
-		return err
+		return "", err
 	}
 	clusterWorkDir, err := ioutil.TempDir(k.workDir, cluster.ID.String()+".")
 	if err != nil {
-		return err
+		return "", err
 	}
 	installerCacheDir := filepath.Join(k.workDir, "installercache")
 	defer func() {
@@ -100,25 +133,105 @@ func (k *installGenerator) GenerateInstallConfig(ctx context.Context, cluster co
 	}
 	err = generator.Generate(ctx, cfg, k.getClusterPlatformType(cluster))
 	if err != nil {
-		return err
+		return generator.InstallerOutput(), err
 	}
 
 	if k.Config.ServiceIPs != "" {
 		err = generator.UpdateEtcHosts(k.Config.ServiceIPs)
 		if err != nil {
-			return err
+			return generator.InstallerOutput(), err
 		}
 	}
 
-	// upload files to S3
-	err = generator.UploadToS3(ctx)
+	err = store(generator, clusterWorkDir)
+	if err != nil {
+		return generator.InstallerOutput(), err
+	}
+
+	return generator.InstallerOutput(), nil
+}
+
+// uploadDryRun uploads the tarball of the files generated for the cluster, with the manifests laid out as they are in
+// the directory of the installer
+func (k *installGenerator) uploadDryRun(ctx context.Context, cluster common.Cluster, clusterWorkDir string) error {
+	log := logutil.FromContext(ctx, k.log)
+	manifestFiles, err := manifests.GetClusterManifests(ctx, cluster.ID, k.s3Client)
 	if err != nil {
 		return err
 	}
 
+	tarPath := filepath.Join(clusterWorkDir, "dry-run.tar")
+	f, err := os.Create(tarPath)
+	if err != nil {
+		return err
+	}
+	tarWriter := tar.NewWriter(f)
+	for _, fileName := range ignition.DryRunFileNames(&cluster) {
+		if err = addFileToTar(tarWriter, filepath.Join(clusterWorkDir, fileName), fileName); err != nil {
+			break
+		}
+	}
+	prefix := manifests.GetManifestObjectName(*cluster.ID, "")
+	for _, manifest := range manifestFiles {
+		if err != nil {
+			break
+		}
+		err = k.addObjectToTar(ctx, tarWriter, manifest, strings.TrimPrefix(manifest, prefix))
+	}
+	if closeErr := tarWriter.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to create the dry-run tarball")
+	}
+
+	objectName := DryRunObjectName(*cluster.ID)
+	if err = k.s3Client.UploadFile(ctx, tarPath, objectName); err != nil {
+		log.WithError(err).Errorf("Failed to upload file %s as object %s", tarPath, objectName)
+		return err
+	}
+	log.Infof("Uploaded file %s as object %s", tarPath, objectName)
 	return nil
 }
 
+func (k *installGenerator) addObjectToTar(ctx context.Context, tarWriter *tar.Writer, objectName, name string) error {
+	reader, size, err := k.s3Client.Download(ctx, objectName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to download %s", objectName)
+	}
+	defer reader.Close()
+	if err = tarWriter.WriteHeader(&tar.Header{Name: name, Size: size, Mode: 0o644, ModTime: time.Now()}); err != nil {
+		return err
+	}
+	_, err = io.Copy(tarWriter, reader)
+	return err
+}
+
+func addFileToTar(tarWriter *tar.Writer, path, name string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	if err = tarWriter.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err = io.Copy(tarWriter, f)
+	return err
+}
+
 func (k *installGenerator) getClusterPlatformType(cluster common.Cluster) models.PlatformType {
 	// Enabled UserManagedNetworking implies none platfrom.
 	if swag.BoolValue(cluster.UserManagedNetworking) {
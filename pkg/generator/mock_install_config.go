@@ -35,6 +35,21 @@ func (m *MockISOInstallConfigGenerator) EXPECT() *MockISOInstallConfigGeneratorM
 	return m.recorder
 }
 
+// DryRunInstallConfig mocks base method.
+func (m *MockISOInstallConfigGenerator) DryRunInstallConfig(arg0 context.Context, arg1 common.Cluster, arg2 []byte, arg3 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunInstallConfig", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRunInstallConfig indicates an expected call of DryRunInstallConfig.
+func (mr *MockISOInstallConfigGeneratorMockRecorder) DryRunInstallConfig(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunInstallConfig", reflect.TypeOf((*MockISOInstallConfigGenerator)(nil).DryRunInstallConfig), arg0, arg1, arg2, arg3)
+}
+
 // GenerateInstallConfig mocks base method.
 func (m *MockISOInstallConfigGenerator) GenerateInstallConfig(arg0 context.Context, arg1 common.Cluster, arg2 []byte, arg3 string) error {
 	m.ctrl.T.Helper()
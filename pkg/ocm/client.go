@@ -147,4 +147,7 @@ type AuthPayload struct {
 	ClientID     string   `json:"clientId"`
 	Role         RoleType `json:"scope"`
 	IsAuthorized bool     `json:"is_authorized"`
+	// AuthorizedByRoleBinding is set when the local role bindings of the user, rather than the ownership of the
+	// resources, grant access to every resource of the request
+	AuthorizedByRoleBinding bool `json:"-"`
 }
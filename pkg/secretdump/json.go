@@ -0,0 +1,52 @@
+package secretdump
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// secretJSONFieldNames are the substrings of JSON field names whose values are redacted
+var secretJSONFieldNames = []string{"secret", "password", "token"}
+
+// DumpSecretJSON generates a string representation of a JSON document with the values of
+// fields whose names contain "secret", "password" or "token" replaced by <SECRET>.
+// Objects nested in objects and arrays are redacted as well.
+func DumpSecretJSON(data []byte) (string, error) {
+	var obj interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return "", err
+	}
+	redacted, err := json.Marshal(redactSecretJSON(obj))
+	if err != nil {
+		return "", err
+	}
+	return string(redacted), nil
+}
+
+func redactSecretJSON(obj interface{}) interface{} {
+	switch v := obj.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSecretJSONField(key) && value != nil {
+				v[key] = "<SECRET>"
+			} else {
+				v[key] = redactSecretJSON(value)
+			}
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactSecretJSON(v[i])
+		}
+	}
+	return obj
+}
+
+func isSecretJSONField(name string) bool {
+	name = strings.ToLower(name)
+	for _, secret := range secretJSONFieldNames {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
@@ -82,4 +82,20 @@ struct Example {
 			Expect(actual).To(Equal(expected))
 		})
 	})
+
+	Context("Dump secret JSON", func() {
+		It("redacts secret fields at any depth", func() {
+			data := []byte(`{"name":"test","pull_secret":"{\"auths\":{}}","proxy":{"https_proxy":"http://proxy"},` +
+				`"hosts":[{"bmc_password":"pass","hostname":"h1"}],"access_token":null,"count":3}`)
+			actual, err := DumpSecretJSON(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(MatchJSON(`{"name":"test","pull_secret":"<SECRET>","proxy":{"https_proxy":"http://proxy"},` +
+				`"hosts":[{"bmc_password":"<SECRET>","hostname":"h1"}],"access_token":null,"count":3}`))
+		})
+
+		It("fails on invalid JSON", func() {
+			_, err := DumpSecretJSON([]byte("not json"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
@@ -22,6 +22,8 @@ import (
 
 type Config struct {
 	MaxConcurrentGenerations int64 `envconfig:"MAX_CONCURRENT_NMSTATECTL_GENERATIONS" default:"30"`
+	// NMStatectlFallback enables nmstatectl for the nmstate yaml files that cannot be rendered natively
+	NMStatectlFallback bool `envconfig:"NMSTATECTL_FALLBACK" default:"true"`
 }
 
 type StaticNetworkConfigData struct {
@@ -34,6 +36,7 @@ type StaticNetworkConfig interface {
 	GenerateStaticNetworkConfigData(ctx context.Context, hostsYAMLS string) ([]StaticNetworkConfigData, error)
 	FormatStaticNetworkConfigForDB(staticNetworkConfig []*models.HostStaticNetworkConfig) (string, error)
 	ValidateStaticConfigParams(ctx context.Context, staticNetworkConfig []*models.HostStaticNetworkConfig) error
+	RenderStaticNetworkConfigTemplate(ctx context.Context, staticNetworkConfigTemplate *models.StaticNetworkConfigTemplate) ([]*models.HostStaticNetworkConfig, []*models.StaticNetworkConfigTemplateRowError, error)
 }
 
 type StaticNetworkConfigGenerator struct {
@@ -70,13 +73,8 @@ func (s *StaticNetworkConfigGenerator) GenerateStaticNetworkConfigData(ctx conte
 }
 
 func (s *StaticNetworkConfigGenerator) generateHostStaticNetworkConfigData(ctx context.Context, hostConfig *models.HostStaticNetworkConfig, hostDir string) ([]StaticNetworkConfigData, error) {
-	hostYAML := hostConfig.NetworkYaml
 	macInterfaceMapping := s.formatMacInterfaceMap(hostConfig.MacInterfaceMap)
-	result, err := s.executeNMStatectl(ctx, hostYAML)
-	if err != nil {
-		return nil, err
-	}
-	filesList, err := s.createNMConnectionFiles(result, hostDir)
+	filesList, err := s.generateNMConnectionFiles(ctx, hostConfig.NetworkYaml, hostDir)
 	if err != nil {
 		s.log.WithError(err).Errorf("failed to create NM connection files")
 		return nil, err
@@ -89,6 +87,26 @@ func (s *StaticNetworkConfigGenerator) generateHostStaticNetworkConfigData(ctx c
 	return filesList, nil
 }
 
+// generateNMConnectionFiles renders the NetworkManager keyfiles of an nmstate yaml natively, and falls back to nmstatectl
+// for the yaml files using nmstate features that the native renderer does not support
+func (s *StaticNetworkConfigGenerator) generateNMConnectionFiles(ctx context.Context, hostYAML, hostDir string) ([]StaticNetworkConfigData, error) {
+	connections, err := renderNMConnections(hostYAML)
+	if err != nil {
+		if !isUnsupportedNMStateError(err) || !s.NMStatectlFallback {
+			return nil, err
+		}
+		s.log.WithError(err).Infof("Falling back to nmstatectl")
+		var result string
+		if result, err = s.executeNMStatectl(ctx, hostYAML); err != nil {
+			return nil, err
+		}
+		if connections, err = s.parseNMStatectlOutput(result); err != nil {
+			return nil, err
+		}
+	}
+	return s.createNMConnectionFiles(connections, hostDir)
+}
+
 func (s *StaticNetworkConfigGenerator) executeNMStatectl(ctx context.Context, hostYAML string) (string, error) {
 	err := s.sem.Acquire(ctx, 1)
 	if err != nil {
@@ -127,9 +145,8 @@ func (s *StaticNetworkConfigGenerator) executeNMStatectl(ctx context.Context, ho
 	return stdout, nil
 }
 
-// create NMConnectionFiles formats the nmstate output into a list of file data
-// Nothing is written to the local filesystem
-func (s *StaticNetworkConfigGenerator) createNMConnectionFiles(nmstateOutput, hostDir string) ([]StaticNetworkConfigData, error) {
+// parseNMStatectlOutput extracts the NetworkManager keyfiles from the output of nmstatectl gc
+func (s *StaticNetworkConfigGenerator) parseNMStatectlOutput(nmstateOutput string) ([]nmConnection, error) {
 	var hostNMConnections map[string]interface{}
 	err := yaml.Unmarshal([]byte(nmstateOutput), &hostNMConnections)
 	if err != nil {
@@ -139,18 +156,27 @@ func (s *StaticNetworkConfigGenerator) createNMConnectionFiles(nmstateOutput, ho
 	if _, found := hostNMConnections["NetworkManager"]; !found {
 		return nil, errors.Errorf("nmstate generated an empty NetworkManager config file content")
 	}
-	filesList := []StaticNetworkConfigData{}
+	connections := []nmConnection{}
 	connectionsList := hostNMConnections["NetworkManager"].([]interface{})
 	for _, connection := range connectionsList {
 		connectionElems := connection.([]interface{})
-		fileName := connectionElems[0].(string)
-		fileContents, err := s.formatNMConnection(connectionElems[1].(string))
+		connections = append(connections, nmConnection{fileName: connectionElems[0].(string), contents: connectionElems[1].(string)})
+	}
+	return connections, nil
+}
+
+// create NMConnectionFiles formats the NetworkManager keyfiles into a list of file data
+// Nothing is written to the local filesystem
+func (s *StaticNetworkConfigGenerator) createNMConnectionFiles(connections []nmConnection, hostDir string) ([]StaticNetworkConfigData, error) {
+	filesList := []StaticNetworkConfigData{}
+	for _, connection := range connections {
+		fileContents, err := s.formatNMConnection(connection.contents)
 		if err != nil {
 			return nil, err
 		}
-		s.log.Infof("Adding NMConnection file <%s>", fileName)
+		s.log.Infof("Adding NMConnection file <%s>", connection.fileName)
 		newFile := StaticNetworkConfigData{
-			FilePath:     filepath.Join(hostDir, fileName),
+			FilePath:     filepath.Join(hostDir, connection.fileName),
 			FileContents: fileContents,
 		}
 		filesList = append(filesList, newFile)
@@ -209,14 +235,9 @@ func (s *StaticNetworkConfigGenerator) validateMacInterfaceName(hostIdx int, mac
 }
 
 func (s *StaticNetworkConfigGenerator) validateNMStateYaml(ctx context.Context, networkYaml string) error {
-	result, err := s.executeNMStatectl(ctx, networkYaml)
-	if err != nil {
-		return err
-	}
-
 	// Check that the file content can be created
 	// This doesn't write anything to the local filesystem
-	_, err = s.createNMConnectionFiles(result, "temphostdir")
+	_, err := s.generateNMConnectionFiles(ctx, networkYaml, "temphostdir")
 	return err
 }
 
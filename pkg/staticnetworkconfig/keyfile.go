@@ -0,0 +1,266 @@
+package staticnetworkconfig
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// nmConnection is a NetworkManager keyfile named after the interface it configures
+type nmConnection struct {
+	fileName string
+	contents string
+}
+
+// keyfile holds the keys of a NetworkManager keyfile by section
+type keyfile map[string]map[string]string
+
+func (k keyfile) set(section, key, value string) {
+	if _, ok := k[section]; !ok {
+		k[section] = make(map[string]string)
+	}
+	if key != "" {
+		k[section][key] = value
+	}
+}
+
+// String writes the connection section first and the other sections, as well as the keys of every section, sorted by
+// name
+func (k keyfile) String() string {
+	sections := make([]string, 0, len(k))
+	for section := range k {
+		if section != "connection" {
+			sections = append(sections, section)
+		}
+	}
+	sort.Strings(sections)
+	sections = append([]string{"connection"}, sections...)
+
+	var builder strings.Builder
+	for i, section := range sections {
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+		fmt.Fprintf(&builder, "[%s]\n", section)
+		keys := make([]string, 0, len(k[section]))
+		for key := range k[section] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(&builder, "%s=%s\n", key, k[section][key])
+		}
+	}
+	return builder.String()
+}
+
+// connectionUUID returns a UUID derived from the interface, so that rendering the same yaml twice gives the same keyfiles
+func connectionUUID(iface *nmstateInterface) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(fmt.Sprintf("nmstate:%s:%s", iface.Type, iface.Name))).String()
+}
+
+func isDefaultRoute(route *nmstateRoute) bool {
+	_, destination, err := net.ParseCIDR(route.Destination)
+	if err != nil {
+		return false
+	}
+	ones, _ := destination.Mask.Size()
+	return ones == 0
+}
+
+func isIPv4Route(route *nmstateRoute) bool {
+	ip, _, err := net.ParseCIDR(route.Destination)
+	return err == nil && ip.To4() != nil
+}
+
+func hasStaticAddresses(ipConfig *nmstateIP) bool {
+	return ipConfig != nil && ipConfig.Enabled && !ipConfig.DHCP && len(ipConfig.Address) > 0
+}
+
+// getDNSInterface returns the interface that holds the DNS servers of an address family, which is the next hop
+// interface of the default route of the family, or else the first interface with static addresses of the family
+func getDNSInterface(state *nmstateState, ipv4 bool) (string, error) {
+	if state.Routes != nil {
+		for _, route := range state.Routes.Config {
+			if isDefaultRoute(route) && isIPv4Route(route) == ipv4 {
+				return route.NextHopInterface, nil
+			}
+		}
+	}
+	for _, iface := range state.Interfaces {
+		if (ipv4 && hasStaticAddresses(iface.IPv4)) || (!ipv4 && hasStaticAddresses(iface.IPv6)) {
+			return iface.Name, nil
+		}
+	}
+	return "", newUnsupportedNMStateError("no interface to hold the DNS servers")
+}
+
+func renderIPSection(k keyfile, section string, ipConfig *nmstateIP, routes []*nmstateRoute, dnsServers, dnsSearch []string) {
+	k.set(section, "", "")
+	if ipConfig == nil || !ipConfig.Enabled {
+		k.set(section, "method", "disabled")
+		return
+	}
+	if section == "ipv4" {
+		switch {
+		case ipConfig.DHCP:
+			k.set(section, "method", "auto")
+		default:
+			k.set(section, "method", "manual")
+		}
+		k.set(section, "dhcp-client-id", "mac")
+	} else {
+		switch {
+		case ipConfig.Autoconf:
+			k.set(section, "method", "auto")
+		case ipConfig.DHCP:
+			k.set(section, "method", "dhcp")
+		default:
+			k.set(section, "method", "manual")
+		}
+		k.set(section, "addr-gen-mode", "eui64")
+		k.set(section, "dhcp-duid", "ll")
+		k.set(section, "dhcp-iaid", "mac")
+	}
+	for i, address := range ipConfig.Address {
+		k.set(section, fmt.Sprintf("address%d", i), fmt.Sprintf("%s/%d", address.IP, address.PrefixLength))
+	}
+	for i, route := range routes {
+		value := route.Destination
+		if route.NextHopAddress != "" || route.Metric != nil {
+			nextHop := route.NextHopAddress
+			if nextHop == "" && section == "ipv4" {
+				nextHop = net.IPv4zero.String()
+			} else if nextHop == "" {
+				nextHop = net.IPv6zero.String()
+			}
+			value = fmt.Sprintf("%s,%s", value, nextHop)
+		}
+		if route.Metric != nil {
+			value = fmt.Sprintf("%s,%d", value, *route.Metric)
+		}
+		k.set(section, fmt.Sprintf("route%d", i), value)
+		if route.TableID != nil {
+			k.set(section, fmt.Sprintf("route%d_options", i), fmt.Sprintf("table=%d", *route.TableID))
+		}
+	}
+	if len(dnsServers) > 0 {
+		k.set(section, "dns", strings.Join(dnsServers, ";")+";")
+		k.set(section, "dns-priority", "40")
+		if len(dnsSearch) > 0 {
+			k.set(section, "dns-search", strings.Join(dnsSearch, ";")+";")
+		}
+	}
+}
+
+// renderNMConnections renders the NetworkManager keyfiles of an nmstate yaml without nmstatectl. It returns an
+// unsupportedNMStateError when the yaml uses nmstate features outside of the ethernet, bond, VLAN, static IP, route
+// and DNS subset.
+func renderNMConnections(networkYaml string) ([]nmConnection, error) {
+	state, err := parseNMState(networkYaml)
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces := append([]*nmstateInterface{}, state.Interfaces...)
+	definedInterfaces := make(map[string]bool, len(interfaces))
+	for _, iface := range interfaces {
+		definedInterfaces[iface.Name] = true
+	}
+	controllers := make(map[string]string)
+	for _, iface := range state.Interfaces {
+		if iface.LinkAggregation == nil {
+			continue
+		}
+		for _, port := range iface.LinkAggregation.ports() {
+			controllers[port] = iface.Name
+			// The ports that are only listed by their bond are ethernet interfaces
+			if !definedInterfaces[port] {
+				interfaces = append(interfaces, &nmstateInterface{Name: port, Type: nmstateInterfaceTypeEthernet, State: nmstateInterfaceStateUp})
+				definedInterfaces[port] = true
+			}
+		}
+	}
+
+	routes := make(map[string][]*nmstateRoute)
+	if state.Routes != nil {
+		for _, route := range state.Routes.Config {
+			routes[route.NextHopInterface] = append(routes[route.NextHopInterface], route)
+		}
+	}
+	dnsServers := map[bool][]string{}
+	var dnsSearch []string
+	if state.DNSResolver != nil && state.DNSResolver.Config != nil {
+		for _, server := range state.DNSResolver.Config.Server {
+			ipv4 := net.ParseIP(server).To4() != nil
+			dnsServers[ipv4] = append(dnsServers[ipv4], server)
+		}
+		dnsSearch = state.DNSResolver.Config.Search
+	}
+	dnsInterfaces := map[bool]string{}
+	for _, ipv4 := range []bool{true, false} {
+		if len(dnsServers[ipv4]) > 0 {
+			if dnsInterfaces[ipv4], err = getDNSInterface(state, ipv4); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	connections := make([]nmConnection, 0, len(interfaces))
+	for _, iface := range interfaces {
+		k := make(keyfile)
+		k.set("connection", "id", iface.Name)
+		k.set("connection", "interface-name", iface.Name)
+		k.set("connection", "type", iface.Type)
+		k.set("connection", "uuid", connectionUUID(iface))
+		if iface.MTU > 0 {
+			k.set("ethernet", "mtu", strconv.Itoa(iface.MTU))
+		}
+		switch iface.Type {
+		case nmstateInterfaceTypeEthernet:
+			k.set("ethernet", "", "")
+		case nmstateInterfaceTypeBond:
+			k.set("connection", "autoconnect-slaves", "1")
+			k.set("bond", "mode", iface.LinkAggregation.Mode)
+			for option, value := range iface.LinkAggregation.Options {
+				k.set("bond", option, value)
+			}
+		case nmstateInterfaceTypeVlan:
+			k.set("vlan", "id", strconv.Itoa(iface.VLAN.ID))
+			k.set("vlan", "parent", iface.VLAN.BaseIface)
+		default:
+			return nil, errors.Errorf("unexpected type %s of interface %s", iface.Type, iface.Name)
+		}
+
+		if controller, ok := controllers[iface.Name]; ok {
+			k.set("connection", "master", controller)
+			k.set("connection", "slave-type", nmstateInterfaceTypeBond)
+		} else {
+			var ipv4Routes, ipv6Routes []*nmstateRoute
+			for _, route := range routes[iface.Name] {
+				if isIPv4Route(route) {
+					ipv4Routes = append(ipv4Routes, route)
+				} else {
+					ipv6Routes = append(ipv6Routes, route)
+				}
+			}
+			var ipv4DNS, ipv6DNS []string
+			if dnsInterfaces[true] == iface.Name {
+				ipv4DNS = dnsServers[true]
+			}
+			if dnsInterfaces[false] == iface.Name {
+				ipv6DNS = dnsServers[false]
+			}
+			renderIPSection(k, "ipv4", iface.IPv4, ipv4Routes, ipv4DNS, dnsSearch)
+			renderIPSection(k, "ipv6", iface.IPv6, ipv6Routes, ipv6DNS, dnsSearch)
+		}
+		connections = append(connections, nmConnection{fileName: iface.Name + ".nmconnection", contents: k.String()})
+	}
+	sort.Slice(connections, func(i, j int) bool { return connections[i].fileName < connections[j].fileName })
+	return connections, nil
+}
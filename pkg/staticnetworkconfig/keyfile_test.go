@@ -0,0 +1,275 @@
+package staticnetworkconfig
+
+import (
+	"context"
+	"os/exec"
+	"regexp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("NetworkManager keyfiles", func() {
+	const staticIPv4Yaml = `interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: true
+    address:
+    - ip: 192.168.126.30
+      prefix-length: 24
+  ipv6:
+    enabled: false
+dns-resolver:
+  config:
+    server:
+    - 192.168.126.1
+routes:
+  config:
+  - destination: 0.0.0.0/0
+    next-hop-address: 192.168.126.1
+    next-hop-interface: eth0
+`
+
+	const vlanYaml = `interfaces:
+- name: eth0.404
+  type: vlan
+  state: up
+  vlan:
+    base-iface: eth0
+    id: 404
+  ipv4:
+    enabled: true
+    address:
+    - ip: 192.0.2.1
+      prefix-length: 24
+  ipv6:
+    enabled: false
+`
+
+	const bondYaml = `interfaces:
+- name: bond0
+  type: bond
+  state: up
+  mtu: 9000
+  ipv4:
+    enabled: true
+    dhcp: true
+  ipv6:
+    enabled: false
+  link-aggregation:
+    mode: active-backup
+    options:
+      miimon: "140"
+    slaves:
+    - eth0
+    - eth1
+`
+
+	const staticIPv6Yaml = `interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: false
+  ipv6:
+    enabled: true
+    address:
+    - ip: 2001:db8::30
+      prefix-length: 64
+dns-resolver:
+  config:
+    search:
+    - example.com
+    server:
+    - 2001:db8::1
+routes:
+  config:
+  - destination: ::/0
+    next-hop-address: 2001:db8::1
+    next-hop-interface: eth0
+    metric: 100
+    table-id: 254
+`
+
+	fileNames := func(connections []nmConnection) []string {
+		ret := make([]string, 0, len(connections))
+		for _, connection := range connections {
+			ret = append(ret, connection.fileName)
+		}
+		return ret
+	}
+
+	It("renders static IPv4 addresses, routes and DNS servers", func() {
+		connections, err := renderNMConnections(staticIPv4Yaml)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fileNames(connections)).To(Equal([]string{"eth0.nmconnection"}))
+		Expect(connections[0].contents).To(Equal(`[connection]
+id=eth0
+interface-name=eth0
+type=ethernet
+uuid=d958c65c-32cc-5df3-809b-10974819cc01
+
+[ethernet]
+
+[ipv4]
+address0=192.168.126.30/24
+dhcp-client-id=mac
+dns=192.168.126.1;
+dns-priority=40
+method=manual
+route0=0.0.0.0/0,192.168.126.1
+
+[ipv6]
+method=disabled
+`))
+	})
+
+	It("renders static IPv6 addresses, routes and DNS servers", func() {
+		connections, err := renderNMConnections(staticIPv6Yaml)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fileNames(connections)).To(Equal([]string{"eth0.nmconnection"}))
+		Expect(connections[0].contents).To(ContainSubstring(`[ipv4]
+method=disabled
+`))
+		Expect(connections[0].contents).To(ContainSubstring(`[ipv6]
+addr-gen-mode=eui64
+address0=2001:db8::30/64
+dhcp-duid=ll
+dhcp-iaid=mac
+dns=2001:db8::1;
+dns-priority=40
+dns-search=example.com;
+method=manual
+route0=::/0,2001:db8::1,100
+route0_options=table=254
+`))
+	})
+
+	It("renders VLAN interfaces", func() {
+		connections, err := renderNMConnections(vlanYaml)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fileNames(connections)).To(Equal([]string{"eth0.404.nmconnection"}))
+		Expect(connections[0].contents).To(ContainSubstring("type=vlan\n"))
+		Expect(connections[0].contents).To(ContainSubstring(`[vlan]
+id=404
+parent=eth0
+`))
+		Expect(connections[0].contents).To(ContainSubstring("address0=192.0.2.1/24\n"))
+	})
+
+	It("renders bonds and their ports", func() {
+		connections, err := renderNMConnections(bondYaml)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fileNames(connections)).To(Equal([]string{"bond0.nmconnection", "eth0.nmconnection", "eth1.nmconnection"}))
+		Expect(connections[0].contents).To(ContainSubstring("autoconnect-slaves=1\n"))
+		Expect(connections[0].contents).To(ContainSubstring(`[bond]
+miimon=140
+mode=active-backup
+`))
+		Expect(connections[0].contents).To(ContainSubstring(`[ethernet]
+mtu=9000
+`))
+		Expect(connections[0].contents).To(ContainSubstring(`[ipv4]
+dhcp-client-id=mac
+method=auto
+`))
+		Expect(connections[1].contents).To(Equal(`[connection]
+id=eth0
+interface-name=eth0
+master=bond0
+slave-type=bond
+type=ethernet
+uuid=d958c65c-32cc-5df3-809b-10974819cc01
+
+[ethernet]
+`))
+	})
+
+	It("renders the same keyfiles every time", func() {
+		first, err := renderNMConnections(bondYaml)
+		Expect(err).ToNot(HaveOccurred())
+		second, err := renderNMConnections(bondYaml)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(second).To(Equal(first))
+	})
+
+	It("rejects invalid yaml files", func() {
+		for _, networkYaml := range []string{
+			"interfaces: [",
+			"interfaces:\n- type: ethernet\n  state: up\n",
+			"interfaces:\n- name: eth0\n  type: ethernet\n- name: eth0\n  type: ethernet\n",
+			"interfaces:\n- name: bond0\n  type: bond\n  link-aggregation:\n    mode: fastest\n",
+			"interfaces:\n- name: eth0.5000\n  type: vlan\n  vlan:\n    base-iface: eth0\n    id: 5000\n",
+			"interfaces:\n- name: eth0\n  type: ethernet\n  ipv4:\n    enabled: true\n    address:\n    - ip: 2001:db8::30\n      prefix-length: 64\n",
+			"interfaces:\n- name: eth0\n  type: ethernet\n  ipv4:\n    enabled: true\n    address:\n    - ip: 192.0.2.1\n      prefix-length: 33\n",
+			"dns-resolver:\n  config:\n    server:\n    - dns.example.com\n",
+			"interfaces:\n- name: eth0\n  type: ethernet\nroutes:\n  config:\n  - destination: 0.0.0.0/0\n    next-hop-address: 192.0.2.254\n    next-hop-interface: eth1\n",
+		} {
+			_, err := renderNMConnections(networkYaml)
+			Expect(err).To(HaveOccurred(), networkYaml)
+			Expect(isUnsupportedNMStateError(err)).To(BeFalse(), networkYaml)
+		}
+	})
+
+	It("reports the nmstate features that are not supported", func() {
+		for _, networkYaml := range []string{
+			"interfaces:\n- name: br0\n  type: linux-bridge\n  state: up\n",
+			"interfaces:\n- name: eth0\n  type: ethernet\n  state: absent\n",
+			"interfaces:\n- name: eth0\n  type: ethernet\n  mac-address: 52:54:00:00:00:01\n",
+		} {
+			_, err := renderNMConnections(networkYaml)
+			Expect(err).To(HaveOccurred(), networkYaml)
+			Expect(isUnsupportedNMStateError(err)).To(BeTrue(), networkYaml)
+		}
+	})
+
+	Context("generator", func() {
+		const unsupportedYaml = "interfaces:\n- name: br0\n  type: linux-bridge\n  state: up\n"
+
+		It("validates the supported yaml files without nmstatectl", func() {
+			generator := New(logrus.New(), Config{MaxConcurrentGenerations: 1}).(*StaticNetworkConfigGenerator)
+			for _, networkYaml := range []string{staticIPv4Yaml, staticIPv6Yaml, vlanYaml, bondYaml} {
+				Expect(generator.validateNMStateYaml(context.Background(), networkYaml)).To(Succeed())
+			}
+		})
+
+		It("does not fall back to nmstatectl when disabled", func() {
+			generator := New(logrus.New(), Config{MaxConcurrentGenerations: 1, NMStatectlFallback: false}).(*StaticNetworkConfigGenerator)
+			err := generator.validateNMStateYaml(context.Background(), unsupportedYaml)
+			Expect(err).To(HaveOccurred())
+			Expect(isUnsupportedNMStateError(err)).To(BeTrue())
+		})
+
+		It("produces the same keyfiles as nmstatectl", func() {
+			if _, err := exec.LookPath("nmstatectl"); err != nil {
+				Skip("nmstatectl is not installed")
+			}
+			// nmstatectl generates random connection UUIDs
+			uuidRegex := regexp.MustCompile(`(?m)^uuid=.*$`)
+			generator := New(logrus.New(), Config{MaxConcurrentGenerations: 1}).(*StaticNetworkConfigGenerator)
+			for _, networkYaml := range []string{staticIPv4Yaml, staticIPv6Yaml, vlanYaml, bondYaml} {
+				connections, err := renderNMConnections(networkYaml)
+				Expect(err).ToNot(HaveOccurred())
+				native, err := generator.createNMConnectionFiles(connections, "hostdir")
+				Expect(err).ToNot(HaveOccurred())
+
+				result, err := generator.executeNMStatectl(context.Background(), networkYaml)
+				Expect(err).ToNot(HaveOccurred())
+				connections, err = generator.parseNMStatectlOutput(result)
+				Expect(err).ToNot(HaveOccurred())
+				nmstatectl, err := generator.createNMConnectionFiles(connections, "hostdir")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(native).To(HaveLen(len(nmstatectl)), networkYaml)
+				for i := range native {
+					Expect(native[i].FilePath).To(Equal(nmstatectl[i].FilePath))
+					Expect(uuidRegex.ReplaceAllString(native[i].FileContents, "uuid=")).To(
+						Equal(uuidRegex.ReplaceAllString(nmstatectl[i].FileContents, "uuid=")), networkYaml)
+				}
+			}
+		})
+	})
+})
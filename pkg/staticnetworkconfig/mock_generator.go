@@ -65,6 +65,22 @@ func (mr *MockStaticNetworkConfigMockRecorder) GenerateStaticNetworkConfigData(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateStaticNetworkConfigData", reflect.TypeOf((*MockStaticNetworkConfig)(nil).GenerateStaticNetworkConfigData), ctx, hostsYAMLS)
 }
 
+// RenderStaticNetworkConfigTemplate mocks base method.
+func (m *MockStaticNetworkConfig) RenderStaticNetworkConfigTemplate(ctx context.Context, staticNetworkConfigTemplate *models.StaticNetworkConfigTemplate) ([]*models.HostStaticNetworkConfig, []*models.StaticNetworkConfigTemplateRowError, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderStaticNetworkConfigTemplate", ctx, staticNetworkConfigTemplate)
+	ret0, _ := ret[0].([]*models.HostStaticNetworkConfig)
+	ret1, _ := ret[1].([]*models.StaticNetworkConfigTemplateRowError)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RenderStaticNetworkConfigTemplate indicates an expected call of RenderStaticNetworkConfigTemplate.
+func (mr *MockStaticNetworkConfigMockRecorder) RenderStaticNetworkConfigTemplate(ctx, staticNetworkConfigTemplate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderStaticNetworkConfigTemplate", reflect.TypeOf((*MockStaticNetworkConfig)(nil).RenderStaticNetworkConfigTemplate), ctx, staticNetworkConfigTemplate)
+}
+
 // ValidateStaticConfigParams mocks base method.
 func (m *MockStaticNetworkConfig) ValidateStaticConfigParams(ctx context.Context, staticNetworkConfig []*models.HostStaticNetworkConfig) error {
 	m.ctrl.T.Helper()
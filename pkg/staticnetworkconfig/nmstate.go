@@ -0,0 +1,238 @@
+package staticnetworkconfig
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	nmstateInterfaceTypeEthernet = "ethernet"
+	nmstateInterfaceTypeBond     = "bond"
+	nmstateInterfaceTypeVlan     = "vlan"
+
+	nmstateInterfaceStateUp = "up"
+)
+
+var nmstateBondModes = []string{"balance-rr", "active-backup", "balance-xor", "broadcast", "802.3ad", "balance-tlb", "balance-alb"}
+
+// The subset of the nmstate schema that can be rendered without nmstatectl. Unmarshalling strictly into these types
+// rejects the yaml files using any other nmstate feature.
+type nmstateState struct {
+	DNSResolver *nmstateDNSResolver `yaml:"dns-resolver"`
+	Interfaces  []*nmstateInterface `yaml:"interfaces"`
+	Routes      *nmstateRoutes      `yaml:"routes"`
+}
+
+type nmstateDNSResolver struct {
+	Config *nmstateDNSConfig `yaml:"config"`
+}
+
+type nmstateDNSConfig struct {
+	Search []string `yaml:"search"`
+	Server []string `yaml:"server"`
+}
+
+type nmstateInterface struct {
+	Name            string                  `yaml:"name"`
+	Type            string                  `yaml:"type"`
+	State           string                  `yaml:"state"`
+	MTU             int                     `yaml:"mtu"`
+	IPv4            *nmstateIP              `yaml:"ipv4"`
+	IPv6            *nmstateIP              `yaml:"ipv6"`
+	LinkAggregation *nmstateLinkAggregation `yaml:"link-aggregation"`
+	VLAN            *nmstateVLAN            `yaml:"vlan"`
+}
+
+type nmstateIP struct {
+	Enabled  bool              `yaml:"enabled"`
+	DHCP     bool              `yaml:"dhcp"`
+	Autoconf bool              `yaml:"autoconf"`
+	Address  []*nmstateAddress `yaml:"address"`
+}
+
+type nmstateAddress struct {
+	IP           string `yaml:"ip"`
+	PrefixLength int    `yaml:"prefix-length"`
+}
+
+type nmstateLinkAggregation struct {
+	Mode    string            `yaml:"mode"`
+	Options map[string]string `yaml:"options"`
+	Port    []string          `yaml:"port"`
+	Slaves  []string          `yaml:"slaves"`
+}
+
+type nmstateVLAN struct {
+	BaseIface string `yaml:"base-iface"`
+	ID        int    `yaml:"id"`
+}
+
+type nmstateRoutes struct {
+	Config []*nmstateRoute `yaml:"config"`
+}
+
+type nmstateRoute struct {
+	Destination      string `yaml:"destination"`
+	NextHopAddress   string `yaml:"next-hop-address"`
+	NextHopInterface string `yaml:"next-hop-interface"`
+	Metric           *int   `yaml:"metric"`
+	TableID          *int   `yaml:"table-id"`
+}
+
+// unsupportedNMStateError is returned for the nmstate yaml files that are valid for nmstatectl but use features
+// outside of the subset supported by the native renderer
+type unsupportedNMStateError struct {
+	reason string
+}
+
+func (e *unsupportedNMStateError) Error() string {
+	return fmt.Sprintf("unsupported nmstate configuration: %s", e.reason)
+}
+
+func isUnsupportedNMStateError(err error) bool {
+	var unsupported *unsupportedNMStateError
+	return errors.As(err, &unsupported)
+}
+
+func newUnsupportedNMStateError(format string, args ...interface{}) error {
+	return &unsupportedNMStateError{reason: fmt.Sprintf(format, args...)}
+}
+
+// ports returns the ports of a bond, nmstate accepting both the port and the older slaves keys
+func (l *nmstateLinkAggregation) ports() []string {
+	if len(l.Port) > 0 {
+		return l.Port
+	}
+	return l.Slaves
+}
+
+func parseNMState(networkYaml string) (*nmstateState, error) {
+	var state nmstateState
+	if strictErr := yaml.UnmarshalStrict([]byte(networkYaml), &state); strictErr != nil {
+		// The yaml may still be valid nmstate, only using fields that are not part of the supported subset
+		if err := yaml.Unmarshal([]byte(networkYaml), &map[string]interface{}{}); err != nil {
+			return nil, errors.Wrap(err, "failed to parse the nmstate yaml")
+		}
+		return nil, newUnsupportedNMStateError("%s", strictErr.Error())
+	}
+	if err := state.validate(); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+func validateIP(family string, ipConfig *nmstateIP) error {
+	if ipConfig == nil {
+		return nil
+	}
+	maxPrefixLength := 32
+	if family == "ipv6" {
+		maxPrefixLength = 128
+	}
+	for _, address := range ipConfig.Address {
+		ip := net.ParseIP(address.IP)
+		if ip == nil || (family == "ipv4") != (ip.To4() != nil) {
+			return errors.Errorf("%s is not a valid %s address", address.IP, family)
+		}
+		if address.PrefixLength < 0 || address.PrefixLength > maxPrefixLength {
+			return errors.Errorf("%d is not a valid prefix length for %s address %s", address.PrefixLength, family, address.IP)
+		}
+	}
+	return nil
+}
+
+func (i *nmstateInterface) validate() error {
+	if i.Name == "" {
+		return errors.New("interface name is missing")
+	}
+	if i.State != "" && i.State != nmstateInterfaceStateUp {
+		return newUnsupportedNMStateError("state %s of interface %s", i.State, i.Name)
+	}
+	switch i.Type {
+	case nmstateInterfaceTypeEthernet:
+	case nmstateInterfaceTypeBond:
+		if i.LinkAggregation == nil || !funk.ContainsString(nmstateBondModes, i.LinkAggregation.Mode) {
+			return errors.Errorf("bond %s must have one of the modes %v", i.Name, nmstateBondModes)
+		}
+	case nmstateInterfaceTypeVlan:
+		if i.VLAN == nil || i.VLAN.BaseIface == "" {
+			return errors.Errorf("VLAN interface %s must have a base interface", i.Name)
+		}
+		if i.VLAN.ID < 0 || i.VLAN.ID > 4094 {
+			return errors.Errorf("%d is not a valid ID for VLAN interface %s", i.VLAN.ID, i.Name)
+		}
+	default:
+		return newUnsupportedNMStateError("type %s of interface %s", i.Type, i.Name)
+	}
+	if i.LinkAggregation != nil && i.Type != nmstateInterfaceTypeBond {
+		return errors.Errorf("interface %s of type %s cannot have a link aggregation", i.Name, i.Type)
+	}
+	if i.VLAN != nil && i.Type != nmstateInterfaceTypeVlan {
+		return errors.Errorf("interface %s of type %s cannot have a VLAN", i.Name, i.Type)
+	}
+	if err := validateIP("ipv4", i.IPv4); err != nil {
+		return err
+	}
+	return validateIP("ipv6", i.IPv6)
+}
+
+func (r *nmstateRoute) validate(interfaces map[string]*nmstateInterface) error {
+	_, destination, err := net.ParseCIDR(r.Destination)
+	if err != nil {
+		return errors.Wrapf(err, "invalid destination of route")
+	}
+	if _, ok := interfaces[r.NextHopInterface]; !ok {
+		return errors.Errorf("next hop interface %s of route to %s is not defined", r.NextHopInterface, r.Destination)
+	}
+	if r.NextHopAddress != "" {
+		nextHop := net.ParseIP(r.NextHopAddress)
+		if nextHop == nil || (nextHop.To4() != nil) != (destination.IP.To4() != nil) {
+			return errors.Errorf("%s is not a valid next hop address for route to %s", r.NextHopAddress, r.Destination)
+		}
+	}
+	return nil
+}
+
+func (s *nmstateState) validate() error {
+	interfaces := make(map[string]*nmstateInterface, len(s.Interfaces))
+	for _, iface := range s.Interfaces {
+		if err := iface.validate(); err != nil {
+			return err
+		}
+		if _, ok := interfaces[iface.Name]; ok {
+			return errors.Errorf("interface %s is defined more than once", iface.Name)
+		}
+		interfaces[iface.Name] = iface
+	}
+	controllers := make(map[string]string)
+	for _, iface := range s.Interfaces {
+		if iface.LinkAggregation == nil {
+			continue
+		}
+		for _, port := range iface.LinkAggregation.ports() {
+			if controller, ok := controllers[port]; ok {
+				return errors.Errorf("interface %s is a port of both %s and %s", port, controller, iface.Name)
+			}
+			controllers[port] = iface.Name
+		}
+	}
+	if s.DNSResolver != nil && s.DNSResolver.Config != nil {
+		for _, server := range s.DNSResolver.Config.Server {
+			if net.ParseIP(server) == nil {
+				return errors.Errorf("DNS server %s is not a valid IP address", server)
+			}
+		}
+	}
+	if s.Routes != nil {
+		for _, route := range s.Routes.Config {
+			if err := route.validate(interfaces); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package staticnetworkconfig
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+var macAddressRegex = regexp.MustCompile(`^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$`)
+
+// hostsTableRow holds the values of a host in the hosts table, by column name
+type hostsTableRow struct {
+	number int
+	values map[string]string
+}
+
+func parseHostsTable(hostsCSV string) ([]string, []hostsTableRow, error) {
+	reader := csv.NewReader(strings.NewReader(hostsCSV))
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse the hosts table")
+	}
+	if len(records) < 2 {
+		return nil, nil, errors.New("the hosts table must have a header row and at least one host row")
+	}
+	header := make([]string, len(records[0]))
+	columns := make(map[string]struct{}, len(records[0]))
+	for i, column := range records[0] {
+		column = strings.TrimSpace(column)
+		if column == "" {
+			return nil, nil, errors.Errorf("column %d of the hosts table has no name", i+1)
+		}
+		if _, ok := columns[column]; ok {
+			return nil, nil, errors.Errorf("column %s appears more than once in the hosts table", column)
+		}
+		columns[column] = struct{}{}
+		header[i] = column
+	}
+	rows := make([]hostsTableRow, 0, len(records)-1)
+	for i, record := range records[1:] {
+		row := hostsTableRow{number: i + 2, values: make(map[string]string, len(header))}
+		for j, value := range record {
+			row.values[header[j]] = strings.TrimSpace(value)
+		}
+		rows = append(rows, row)
+	}
+	return header, rows, nil
+}
+
+func renderHostStaticNetworkConfig(networkYamlTemplate *template.Template, macInterfaceColumns map[string]string, row hostsTableRow) (*models.HostStaticNetworkConfig, error) {
+	var networkYaml bytes.Buffer
+	if err := networkYamlTemplate.Execute(&networkYaml, row.values); err != nil {
+		return nil, errors.Wrap(err, "failed to render the network yaml template")
+	}
+	nicNames := make([]string, 0, len(macInterfaceColumns))
+	for nicName := range macInterfaceColumns {
+		nicNames = append(nicNames, nicName)
+	}
+	sort.Strings(nicNames)
+	macInterfaceMap := make(models.MacInterfaceMap, 0, len(nicNames))
+	for _, nicName := range nicNames {
+		macAddress := row.values[macInterfaceColumns[nicName]]
+		if !macAddressRegex.MatchString(macAddress) {
+			return nil, errors.Errorf("%q is not a valid MAC address for interface %s", macAddress, nicName)
+		}
+		macInterfaceMap = append(macInterfaceMap, &models.MacInterfaceMapItems0{MacAddress: strings.ToLower(macAddress), LogicalNicName: nicName})
+	}
+	return &models.HostStaticNetworkConfig{NetworkYaml: networkYaml.String(), MacInterfaceMap: macInterfaceMap}, nil
+}
+
+// renderStaticNetworkConfigTemplate renders the static network configuration of every row of the hosts table. The errors
+// that only affect a row are returned as row errors, while the errors that affect the whole table are returned as error.
+func renderStaticNetworkConfigTemplate(staticNetworkConfigTemplate *models.StaticNetworkConfigTemplate) ([]*models.HostStaticNetworkConfig, []*models.StaticNetworkConfigTemplateRowError, error) {
+	networkYamlTemplate, err := template.New("network_yaml").Option("missingkey=error").Parse(*staticNetworkConfigTemplate.NetworkYamlTemplate)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse the network yaml template")
+	}
+	header, rows, err := parseHostsTable(*staticNetworkConfigTemplate.HostsCsv)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(staticNetworkConfigTemplate.MacInterfaceColumns) == 0 {
+		return nil, nil, errors.New("the columns of the MAC addresses of the interfaces must be set")
+	}
+	for nicName, column := range staticNetworkConfigTemplate.MacInterfaceColumns {
+		if !funk.ContainsString(header, column) {
+			return nil, nil, errors.Errorf("column %s of the MAC address of interface %s is missing from the hosts table", column, nicName)
+		}
+	}
+
+	var rowErrors []*models.StaticNetworkConfigTemplateRowError
+	hostsConfigs := make([]*models.HostStaticNetworkConfig, 0, len(rows))
+	macAddressRows := make(map[string]int)
+	for _, row := range rows {
+		hostConfig, err := renderHostStaticNetworkConfig(networkYamlTemplate, staticNetworkConfigTemplate.MacInterfaceColumns, row)
+		if err == nil {
+			for _, macInterface := range hostConfig.MacInterfaceMap {
+				if number, ok := macAddressRows[macInterface.MacAddress]; ok {
+					err = errors.Errorf("MAC address %s is already used on row %d", macInterface.MacAddress, number)
+					break
+				}
+				macAddressRows[macInterface.MacAddress] = row.number
+			}
+		}
+		if err != nil {
+			rowErrors = append(rowErrors, &models.StaticNetworkConfigTemplateRowError{Row: int64(row.number), Reason: err.Error()})
+			continue
+		}
+		hostsConfigs = append(hostsConfigs, hostConfig)
+	}
+	return hostsConfigs, rowErrors, nil
+}
+
+func (s *StaticNetworkConfigGenerator) RenderStaticNetworkConfigTemplate(ctx context.Context, staticNetworkConfigTemplate *models.StaticNetworkConfigTemplate) ([]*models.HostStaticNetworkConfig, []*models.StaticNetworkConfigTemplateRowError, error) {
+	hostsConfigs, rowErrors, err := renderStaticNetworkConfigTemplate(staticNetworkConfigTemplate)
+	if err != nil || len(rowErrors) > 0 {
+		return nil, rowErrors, err
+	}
+
+	// The rows are validated concurrently, the number of nmstatectl executions being bounded by the semaphore
+	validationErrors := make([]error, len(hostsConfigs))
+	var wg sync.WaitGroup
+	for i := range hostsConfigs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			validationErrors[i] = s.validateNMStateYaml(ctx, hostsConfigs[i].NetworkYaml)
+		}(i)
+	}
+	wg.Wait()
+	// Without row errors, there is a configuration for every row of the table, the first one being on row 2
+	for i, validationErr := range validationErrors {
+		if validationErr != nil {
+			rowErrors = append(rowErrors, &models.StaticNetworkConfigTemplateRowError{
+				Row:    int64(i + 2),
+				Reason: fmt.Sprintf("invalid network yaml: %s", validationErr.Error()),
+			})
+		}
+	}
+	if len(rowErrors) > 0 {
+		return nil, rowErrors, nil
+	}
+	return hostsConfigs, nil, nil
+}
@@ -0,0 +1,111 @@
+package staticnetworkconfig
+
+import (
+	"context"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("StaticNetworkConfigTemplate", func() {
+	const networkYamlTemplate = `interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+- name: eth0.{{ .vlan }}
+  type: vlan
+  state: up
+  vlan:
+    base-iface: eth0
+    id: {{ .vlan }}
+  ipv4:
+    enabled: true
+    address:
+    - ip: {{ .ip }}
+      prefix-length: 24
+routes:
+  config:
+  - destination: 0.0.0.0/0
+    next-hop-address: {{ .gateway }}
+    next-hop-interface: eth0.{{ .vlan }}
+`
+
+	newTemplate := func(hostsCSV string) *models.StaticNetworkConfigTemplate {
+		return &models.StaticNetworkConfigTemplate{
+			NetworkYamlTemplate: swag.String(networkYamlTemplate),
+			HostsCsv:            swag.String(hostsCSV),
+			MacInterfaceColumns: map[string]string{"eth0": "mac"},
+		}
+	}
+
+	It("renders the configuration of every host", func() {
+		hostsConfigs, rowErrors, err := renderStaticNetworkConfigTemplate(newTemplate(
+			"mac,ip,gateway,vlan\n" +
+				"52:54:00:00:00:01, 192.168.126.30, 192.168.126.1, 100\n" +
+				"52:54:00:00:00:02, 192.168.126.31, 192.168.126.1, 100\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rowErrors).To(BeEmpty())
+		Expect(hostsConfigs).To(HaveLen(2))
+		Expect(hostsConfigs[1].MacInterfaceMap).To(Equal(models.MacInterfaceMap{{MacAddress: "52:54:00:00:00:02", LogicalNicName: "eth0"}}))
+		Expect(hostsConfigs[1].NetworkYaml).To(ContainSubstring("- name: eth0.100\n"))
+		Expect(hostsConfigs[1].NetworkYaml).To(ContainSubstring("- ip: 192.168.126.31\n"))
+		Expect(hostsConfigs[1].NetworkYaml).To(ContainSubstring("next-hop-address: 192.168.126.1\n"))
+	})
+
+	It("reports the errors of the rows", func() {
+		hostsConfigs, rowErrors, err := renderStaticNetworkConfigTemplate(newTemplate(
+			"mac,ip,gateway,vlan\n" +
+				"52:54:00:00:00:01,192.168.126.30,192.168.126.1,100\n" +
+				"52:54:00:00:00:01,192.168.126.31,192.168.126.1,100\n" +
+				"not-a-mac,192.168.126.32,192.168.126.1,100\n" +
+				"52:54:00:00:00:04,192.168.126.33,192.168.126.1,100\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hostsConfigs).To(HaveLen(2))
+		Expect(rowErrors).To(HaveLen(2))
+		Expect(rowErrors[0].Row).To(Equal(int64(3)))
+		Expect(rowErrors[0].Reason).To(Equal("MAC address 52:54:00:00:00:01 is already used on row 2"))
+		Expect(rowErrors[1].Row).To(Equal(int64(4)))
+		Expect(rowErrors[1].Reason).To(ContainSubstring("is not a valid MAC address for interface eth0"))
+	})
+
+	It("fails on a placeholder without column", func() {
+		template := newTemplate("mac,ip,gateway,vlan\n52:54:00:00:00:01,192.168.126.30,192.168.126.1,100\n")
+		template.NetworkYamlTemplate = swag.String(networkYamlTemplate + "dns-resolver:\n  config:\n    server:\n    - {{ .dns }}\n")
+		_, rowErrors, err := renderStaticNetworkConfigTemplate(template)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rowErrors).To(HaveLen(1))
+		Expect(rowErrors[0].Reason).To(ContainSubstring(`map has no entry for key "dns"`))
+	})
+
+	It("fails on an invalid hosts table", func() {
+		for _, hostsCSV := range []string{
+			"",
+			"mac,ip,gateway,vlan\n",
+			"mac,ip,gateway,vlan\n52:54:00:00:00:01,192.168.126.30\n",
+			"mac,ip,ip,vlan\n52:54:00:00:00:01,192.168.126.30,192.168.126.1,100\n",
+			"nic,ip,gateway,vlan\n52:54:00:00:00:01,192.168.126.30,192.168.126.1,100\n",
+		} {
+			_, _, err := renderStaticNetworkConfigTemplate(newTemplate(hostsCSV))
+			Expect(err).To(HaveOccurred(), hostsCSV)
+		}
+	})
+
+	It("fails on an invalid template", func() {
+		template := newTemplate("mac,ip,gateway,vlan\n52:54:00:00:00:01,192.168.126.30,192.168.126.1,100\n")
+		template.NetworkYamlTemplate = swag.String("interfaces: {{ .ip ")
+		_, _, err := renderStaticNetworkConfigTemplate(template)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("does not validate the hosts when a row is invalid", func() {
+		generator := New(logrus.New(), Config{MaxConcurrentGenerations: 1})
+		hostsConfigs, rowErrors, err := generator.RenderStaticNetworkConfigTemplate(context.Background(),
+			newTemplate("mac,ip,gateway,vlan\nnot-a-mac,192.168.126.30,192.168.126.1,100\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hostsConfigs).To(BeNil())
+		Expect(rowErrors).To(HaveLen(1))
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -15,18 +16,45 @@ import (
 	"github.com/go-openapi/runtime/security"
 
 	"github.com/openshift/assisted-service/restapi/operations"
+	"github.com/openshift/assisted-service/restapi/operations/audit"
+	"github.com/openshift/assisted-service/restapi/operations/cluster_bundles"
 	"github.com/openshift/assisted-service/restapi/operations/events"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/openshift/assisted-service/restapi/operations/operators"
+	"github.com/openshift/assisted-service/restapi/operations/role_bindings"
 	"github.com/openshift/assisted-service/restapi/operations/versions"
+	"github.com/openshift/assisted-service/restapi/operations/webhooks"
 )
 
 type contextKey string
 
 const AuthKey contextKey = "Auth"
 
+//go:generate mockery -name AuditAPI -inpkg
+
+/* AuditAPI  */
+type AuditAPI interface {
+	/* V2ListAuditRecords Lists the records of the API calls that changed clusters, hosts and infra-envs, most recent first. */
+	V2ListAuditRecords(ctx context.Context, params audit.V2ListAuditRecordsParams) middleware.Responder
+}
+
+//go:generate mockery -name ClusterBundlesAPI -inpkg
+
+/* ClusterBundlesAPI  */
+type ClusterBundlesAPI interface {
+	/* V2ExportClusterBundle Exports the definition of the cluster as a versioned YAML bundle, which can be imported to recreate the
+	   cluster in another environment. The pull secret is not exported.
+	*/
+	V2ExportClusterBundle(ctx context.Context, params cluster_bundles.V2ExportClusterBundleParams) middleware.Responder
+
+	/* V2ImportClusterBundle Registers a new cluster from a YAML bundle exported by v2ExportClusterBundle. The hosts of the bundle are
+	   configured when hosts with matching MAC addresses register to the new cluster.
+	*/
+	V2ImportClusterBundle(ctx context.Context, params cluster_bundles.V2ImportClusterBundleParams) middleware.Responder
+}
+
 //go:generate mockery -name EventsAPI -inpkg
 
 /* EventsAPI  */
@@ -36,12 +64,22 @@ type EventsAPI interface {
 
 	/* V2ListEvents Lists events for a cluster. */
 	V2ListEvents(ctx context.Context, params events.V2ListEventsParams) middleware.Responder
+
+	/* V2StreamEvents Streams the events and the installation progress updates of a cluster, host or infra-env as Server-Sent Events.
+	   Events are sent with the 'event' type and carry their sequence number as the SSE id, so a client that reconnects
+	   with the Last-Event-ID header first receives the events it missed. Progress updates are sent with the
+	   'cluster-progress' and 'host-progress' types and are not replayed.
+	*/
+	V2StreamEvents(ctx context.Context, params events.V2StreamEventsParams) middleware.Responder
 }
 
 //go:generate mockery -name InstallerAPI -inpkg
 
 /* InstallerAPI  */
 type InstallerAPI interface {
+	/* ApplyStaticNetworkConfigTemplate Renders the static network configuration of the hosts of the infra-env from a template and a table of per-host values, validates it and stores it in the infra-env. */
+	ApplyStaticNetworkConfigTemplate(ctx context.Context, params installer.ApplyStaticNetworkConfigTemplateParams) middleware.Responder
+
 	/* BindHost Bind host to a cluster */
 	BindHost(ctx context.Context, params installer.BindHostParams) middleware.Responder
 
@@ -256,15 +294,35 @@ type InstallerAPI interface {
 	/* V2DeregisterHost Deregisters an OpenShift host. */
 	V2DeregisterHost(ctx context.Context, params installer.V2DeregisterHostParams) middleware.Responder
 
+	/* V2DownloadClusterConnectivityMatrix Downloads the connectivity matrix of the cluster as a CSV file, one row per connectivity check. */
+	V2DownloadClusterConnectivityMatrix(ctx context.Context, params installer.V2DownloadClusterConnectivityMatrixParams) middleware.Responder
+
+	/* V2DownloadClusterDryRun Downloads the tarball of the install config, manifests and ignition files rendered by the last dry-run installation of the cluster. */
+	V2DownloadClusterDryRun(ctx context.Context, params installer.V2DownloadClusterDryRunParams) middleware.Responder
+
 	/* V2DownloadHostIgnition Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned */
 	V2DownloadHostIgnition(ctx context.Context, params installer.V2DownloadHostIgnitionParams) middleware.Responder
 
-	/* V2DownloadInfraEnvFiles Downloads the customized ignition file for this host */
+	/* V2DownloadInfraEnvFiles Downloads the customized ignition file for this host, or the artifacts for booting the discovery image
+	   over the network. The initrd.img has the discovery ignition embedded, and the ipxe-script boots the
+	   vmlinuz, initrd.img and rootfs.img of this infra-env.
+	*/
 	V2DownloadInfraEnvFiles(ctx context.Context, params installer.V2DownloadInfraEnvFilesParams) middleware.Responder
 
+	/* V2DryRunInstallCluster Renders the install config, manifests and ignition files of the cluster as an installation would, without
+	   installing it. The hosts are not moved to installing and the state of the cluster is not changed. The rendered
+	   files can be downloaded with v2DownloadClusterDryRun once the dry run succeeded.
+	*/
+	V2DryRunInstallCluster(ctx context.Context, params installer.V2DryRunInstallClusterParams) middleware.Responder
+
 	/* V2GetCluster Retrieves the details of the OpenShift cluster. */
 	V2GetCluster(ctx context.Context, params installer.V2GetClusterParams) middleware.Responder
 
+	/* V2GetClusterConnectivityMatrix Retrieves the pairwise L2 and L3 connectivity between the hosts of the cluster, per outgoing interface and
+	   address family, as reported by the last connectivity checks of the hosts.
+	*/
+	V2GetClusterConnectivityMatrix(ctx context.Context, params installer.V2GetClusterConnectivityMatrixParams) middleware.Responder
+
 	/* V2GetClusterInstallConfig Get the cluster's install config YAML. */
 	V2GetClusterInstallConfig(ctx context.Context, params installer.V2GetClusterInstallConfigParams) middleware.Responder
 
@@ -274,6 +332,9 @@ type InstallerAPI interface {
 	/* V2GetHostIgnition Fetch the ignition file for this host as a string. In case of unbound host produces an error */
 	V2GetHostIgnition(ctx context.Context, params installer.V2GetHostIgnitionParams) middleware.Responder
 
+	/* V2GetHostInventoryDiff Compares two snapshots of the inventory of the host. */
+	V2GetHostInventoryDiff(ctx context.Context, params installer.V2GetHostInventoryDiffParams) middleware.Responder
+
 	/* V2GetNextSteps Retrieves the next operations that the host agent needs to perform. */
 	V2GetNextSteps(ctx context.Context, params installer.V2GetNextStepsParams) middleware.Responder
 
@@ -295,6 +356,9 @@ type InstallerAPI interface {
 	/* V2ListFeatureSupportLevels Retrieves the support levels for features for each OpenShift version. */
 	V2ListFeatureSupportLevels(ctx context.Context, params installer.V2ListFeatureSupportLevelsParams) middleware.Responder
 
+	/* V2ListHostInventoryHistory Retrieves the snapshots of the inventory of the host, a snapshot being recorded every time the hardware reported by the host changes. */
+	V2ListHostInventoryHistory(ctx context.Context, params installer.V2ListHostInventoryHistoryParams) middleware.Responder
+
 	/* V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env. */
 	V2ListHosts(ctx context.Context, params installer.V2ListHostsParams) middleware.Responder
 
@@ -339,6 +403,9 @@ type InstallerAPI interface {
 
 	/* V2UploadClusterIngressCert Transfer the ingress certificate for the cluster. */
 	V2UploadClusterIngressCert(ctx context.Context, params installer.V2UploadClusterIngressCertParams) middleware.Responder
+
+	/* V2ValidateClusterInstallConfig Validate install config overrides against the install config schema of the OpenShift version of the cluster, and preview the changes they make to the install config. The overrides are not saved. */
+	V2ValidateClusterInstallConfig(ctx context.Context, params installer.V2ValidateClusterInstallConfigParams) middleware.Responder
 }
 
 //go:generate mockery -name ManagedDomainsAPI -inpkg
@@ -410,6 +477,20 @@ type OperatorsAPI interface {
 	V2ReportMonitoredOperatorStatus(ctx context.Context, params operators.V2ReportMonitoredOperatorStatusParams) middleware.Responder
 }
 
+//go:generate mockery -name RoleBindingsAPI -inpkg
+
+/* RoleBindingsAPI  */
+type RoleBindingsAPI interface {
+	/* V2CreateRoleBinding Binds a role to a user, on all the clusters and infra-envs, on those of an organization or on a single cluster. */
+	V2CreateRoleBinding(ctx context.Context, params role_bindings.V2CreateRoleBindingParams) middleware.Responder
+
+	/* V2DeleteRoleBinding Removes a role from a user. */
+	V2DeleteRoleBinding(ctx context.Context, params role_bindings.V2DeleteRoleBindingParams) middleware.Responder
+
+	/* V2ListRoleBindings Lists the roles bound to the users when local role-based access control is enabled. */
+	V2ListRoleBindings(ctx context.Context, params role_bindings.V2ListRoleBindingsParams) middleware.Responder
+}
+
 //go:generate mockery -name VersionsAPI -inpkg
 
 /* VersionsAPI  */
@@ -427,14 +508,35 @@ type VersionsAPI interface {
 	V2ListSupportedOpenshiftVersions(ctx context.Context, params versions.V2ListSupportedOpenshiftVersionsParams) middleware.Responder
 }
 
+//go:generate mockery -name WebhooksAPI -inpkg
+
+/* WebhooksAPI  */
+type WebhooksAPI interface {
+	/* V2DeregisterWebhook Deletes a webhook subscription. */
+	V2DeregisterWebhook(ctx context.Context, params webhooks.V2DeregisterWebhookParams) middleware.Responder
+
+	/* V2ListWebhookDeliveries Lists the delivery history of a webhook subscription. */
+	V2ListWebhookDeliveries(ctx context.Context, params webhooks.V2ListWebhookDeliveriesParams) middleware.Responder
+
+	/* V2ListWebhooks Lists the webhook subscriptions of the user. */
+	V2ListWebhooks(ctx context.Context, params webhooks.V2ListWebhooksParams) middleware.Responder
+
+	/* V2RegisterWebhook Subscribes an HTTP endpoint to the events of the clusters, hosts and infra-envs owned by the user. */
+	V2RegisterWebhook(ctx context.Context, params webhooks.V2RegisterWebhookParams) middleware.Responder
+}
+
 // Config is configuration for Handler
 type Config struct {
+	AuditAPI
+	ClusterBundlesAPI
 	EventsAPI
 	InstallerAPI
 	ManagedDomainsAPI
 	ManifestsAPI
 	OperatorsAPI
+	RoleBindingsAPI
 	VersionsAPI
+	WebhooksAPI
 	Logger func(string, ...interface{})
 	// InnerMiddleware is for the handler executors. These do not apply to the swagger.json document.
 	// The middleware executes after routing but before authentication, binding and validation
@@ -497,6 +599,9 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 	api.MultipartformConsumer = runtime.DiscardConsumer
 	api.BinProducer = runtime.ByteStreamProducer()
 	api.JSONProducer = runtime.JSONProducer()
+	api.TextEventStreamProducer = runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+		return errors.NotImplemented("textEventStream producer has not yet been implemented")
+	})
 	api.AgentAuthAuth = func(token string) (interface{}, error) {
 		if c.AuthAgentAuth == nil {
 			return token, nil
@@ -526,6 +631,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 	}
 
 	api.APIAuthorizer = authorizer(c.Authorizer)
+	api.InstallerApplyStaticNetworkConfigTemplateHandler = installer.ApplyStaticNetworkConfigTemplateHandlerFunc(func(params installer.ApplyStaticNetworkConfigTemplateParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ApplyStaticNetworkConfigTemplate(ctx, params)
+	})
 	api.InstallerBindHostHandler = installer.BindHostHandlerFunc(func(params installer.BindHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -961,6 +1071,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2CompleteInstallation(ctx, params)
 	})
+	api.RoleBindingsV2CreateRoleBindingHandler = role_bindings.V2CreateRoleBindingHandlerFunc(func(params role_bindings.V2CreateRoleBindingParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.RoleBindingsAPI.V2CreateRoleBinding(ctx, params)
+	})
+	api.RoleBindingsV2DeleteRoleBindingHandler = role_bindings.V2DeleteRoleBindingHandlerFunc(func(params role_bindings.V2DeleteRoleBindingParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.RoleBindingsAPI.V2DeleteRoleBinding(ctx, params)
+	})
 	api.InstallerV2DeregisterClusterHandler = installer.V2DeregisterClusterHandlerFunc(func(params installer.V2DeregisterClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -971,6 +1091,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2DeregisterHost(ctx, params)
 	})
+	api.WebhooksV2DeregisterWebhookHandler = webhooks.V2DeregisterWebhookHandlerFunc(func(params webhooks.V2DeregisterWebhookParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.V2DeregisterWebhook(ctx, params)
+	})
+	api.InstallerV2DownloadClusterConnectivityMatrixHandler = installer.V2DownloadClusterConnectivityMatrixHandlerFunc(func(params installer.V2DownloadClusterConnectivityMatrixParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2DownloadClusterConnectivityMatrix(ctx, params)
+	})
+	api.InstallerV2DownloadClusterDryRunHandler = installer.V2DownloadClusterDryRunHandlerFunc(func(params installer.V2DownloadClusterDryRunParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2DownloadClusterDryRun(ctx, params)
+	})
 	api.ManifestsV2DownloadClusterManifestHandler = manifests.V2DownloadClusterManifestHandlerFunc(func(params manifests.V2DownloadClusterManifestParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -986,11 +1121,26 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2DownloadInfraEnvFiles(ctx, params)
 	})
+	api.InstallerV2DryRunInstallClusterHandler = installer.V2DryRunInstallClusterHandlerFunc(func(params installer.V2DryRunInstallClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2DryRunInstallCluster(ctx, params)
+	})
+	api.ClusterBundlesV2ExportClusterBundleHandler = cluster_bundles.V2ExportClusterBundleHandlerFunc(func(params cluster_bundles.V2ExportClusterBundleParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterBundlesAPI.V2ExportClusterBundle(ctx, params)
+	})
 	api.InstallerV2GetClusterHandler = installer.V2GetClusterHandlerFunc(func(params installer.V2GetClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetCluster(ctx, params)
 	})
+	api.InstallerV2GetClusterConnectivityMatrixHandler = installer.V2GetClusterConnectivityMatrixHandlerFunc(func(params installer.V2GetClusterConnectivityMatrixParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetClusterConnectivityMatrix(ctx, params)
+	})
 	api.InstallerV2GetClusterInstallConfigHandler = installer.V2GetClusterInstallConfigHandlerFunc(func(params installer.V2GetClusterInstallConfigParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -1006,6 +1156,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetHostIgnition(ctx, params)
 	})
+	api.InstallerV2GetHostInventoryDiffHandler = installer.V2GetHostInventoryDiffHandlerFunc(func(params installer.V2GetHostInventoryDiffParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetHostInventoryDiff(ctx, params)
+	})
 	api.InstallerV2GetNextStepsHandler = installer.V2GetNextStepsHandlerFunc(func(params installer.V2GetNextStepsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -1021,6 +1176,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2ImportCluster(ctx, params)
 	})
+	api.ClusterBundlesV2ImportClusterBundleHandler = cluster_bundles.V2ImportClusterBundleHandlerFunc(func(params cluster_bundles.V2ImportClusterBundleParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.ClusterBundlesAPI.V2ImportClusterBundle(ctx, params)
+	})
 	api.InstallerV2InstallClusterHandler = installer.V2InstallClusterHandlerFunc(func(params installer.V2InstallClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -1031,6 +1191,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2InstallHost(ctx, params)
 	})
+	api.AuditV2ListAuditRecordsHandler = audit.V2ListAuditRecordsHandlerFunc(func(params audit.V2ListAuditRecordsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.AuditAPI.V2ListAuditRecords(ctx, params)
+	})
 	api.InstallerV2ListClustersHandler = installer.V2ListClustersHandlerFunc(func(params installer.V2ListClustersParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -1051,16 +1216,36 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2ListFeatureSupportLevels(ctx, params)
 	})
+	api.InstallerV2ListHostInventoryHistoryHandler = installer.V2ListHostInventoryHistoryHandlerFunc(func(params installer.V2ListHostInventoryHistoryParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ListHostInventoryHistory(ctx, params)
+	})
 	api.InstallerV2ListHostsHandler = installer.V2ListHostsHandlerFunc(func(params installer.V2ListHostsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2ListHosts(ctx, params)
 	})
+	api.RoleBindingsV2ListRoleBindingsHandler = role_bindings.V2ListRoleBindingsHandlerFunc(func(params role_bindings.V2ListRoleBindingsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.RoleBindingsAPI.V2ListRoleBindings(ctx, params)
+	})
 	api.VersionsV2ListSupportedOpenshiftVersionsHandler = versions.V2ListSupportedOpenshiftVersionsHandlerFunc(func(params versions.V2ListSupportedOpenshiftVersionsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.VersionsAPI.V2ListSupportedOpenshiftVersions(ctx, params)
 	})
+	api.WebhooksV2ListWebhookDeliveriesHandler = webhooks.V2ListWebhookDeliveriesHandlerFunc(func(params webhooks.V2ListWebhookDeliveriesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.V2ListWebhookDeliveries(ctx, params)
+	})
+	api.WebhooksV2ListWebhooksHandler = webhooks.V2ListWebhooksHandlerFunc(func(params webhooks.V2ListWebhooksParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.V2ListWebhooks(ctx, params)
+	})
 	api.InstallerV2PostStepReplyHandler = installer.V2PostStepReplyHandlerFunc(func(params installer.V2PostStepReplyParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -1076,6 +1261,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2RegisterHost(ctx, params)
 	})
+	api.WebhooksV2RegisterWebhookHandler = webhooks.V2RegisterWebhookHandlerFunc(func(params webhooks.V2RegisterWebhookParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.WebhooksAPI.V2RegisterWebhook(ctx, params)
+	})
 	api.OperatorsV2ReportMonitoredOperatorStatusHandler = operators.V2ReportMonitoredOperatorStatusHandlerFunc(func(params operators.V2ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -1096,6 +1286,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2ResetHostValidation(ctx, params)
 	})
+	api.EventsV2StreamEventsHandler = events.V2StreamEventsHandlerFunc(func(params events.V2StreamEventsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventsAPI.V2StreamEvents(ctx, params)
+	})
 	api.InstallerV2UpdateClusterInstallConfigHandler = installer.V2UpdateClusterInstallConfigHandlerFunc(func(params installer.V2UpdateClusterInstallConfigParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -1136,6 +1331,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2UploadClusterIngressCert(ctx, params)
 	})
+	api.InstallerV2ValidateClusterInstallConfigHandler = installer.V2ValidateClusterInstallConfigHandlerFunc(func(params installer.V2ValidateClusterInstallConfigParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ValidateClusterInstallConfig(ctx, params)
+	})
 	api.ServerShutdown = func() {}
 	return api.Serve(c.InnerMiddleware), api, nil
 }
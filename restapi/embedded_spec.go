@@ -4376,6 +4376,119 @@ func init() {
         }
       }
     },
+    "/v2/audit-records": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Lists the records of the API calls that changed clusters, hosts and infra-envs, most recent first.",
+        "tags": [
+          "audit"
+        ],
+        "operationId": "v2ListAuditRecords",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Return only the records of calls targeting this cluster.",
+            "name": "cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Return only the records of calls targeting this host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Return only the records of calls targeting this infra-env.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Return only the records of calls made by this user.",
+            "name": "user_name",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Return only the records of calls to this operation, for example v2UpdateCluster.",
+            "name": "operation_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Return only the records of calls made at or after this time.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Return only the records of calls made at or before this time.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximal number of records to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "The number of matching records to skip before starting to return records.",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/audit-record-list"
+            },
+            "headers": {
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "The total number of records matching the filters."
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/clusters": {
       "get": {
         "security": [
@@ -4423,6 +4536,68 @@ func init() {
             "name": "with_hosts",
             "in": "query",
             "allowEmptyValue": true
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned Clusters are filtered to those in one of the given statuses.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If set, returned Clusters are filtered to those with the given OpenShift version.",
+            "name": "openshift_version",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If set, returned Clusters are filtered to those with the given platform type, one of the values of the platform_type definition.",
+            "name": "platform_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If set, returned Clusters are filtered to those owned by the given user name.",
+            "name": "owner",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "created_at",
+              "updated_at",
+              "status"
+            ],
+            "type": "string",
+            "description": "The field used to sort the returned Clusters.",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ascending",
+              "descending"
+            ],
+            "type": "string",
+            "description": "The direction in which the returned Clusters are sorted.",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximal number of Clusters to return. All matching Clusters are returned if not set.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The token returned in the X-Continue header of a previous response, used to retrieve the next page.",
+            "name": "continue",
+            "in": "query"
           }
         ],
         "responses": {
@@ -4430,6 +4605,16 @@ func init() {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster-list"
+            },
+            "headers": {
+              "X-Continue": {
+                "type": "string",
+                "description": "A token to retrieve the next page, empty if this is the last page."
+              },
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "The total number of Clusters matching the filters."
+              }
             }
           },
           "401": {
@@ -4521,6 +4706,66 @@ func init() {
         }
       }
     },
+    "/v2/clusters/bundle": {
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Registers a new cluster from a YAML bundle exported by v2ExportClusterBundle. The hosts of the bundle are\nconfigured when hosts with matching MAC addresses register to the new cluster.\n",
+        "tags": [
+          "cluster_bundles"
+        ],
+        "operationId": "v2ImportClusterBundle",
+        "parameters": [
+          {
+            "description": "The bundle and the values not carried by it.",
+            "name": "import-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-bundle-import-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/cluster-bundle-validation-error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/clusters/default-config": {
       "get": {
         "security": [
@@ -4991,28 +5236,28 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/install": {
+    "/v2/clusters/{cluster_id}/actions/dry-run-install": {
       "post": {
-        "description": "Installs the OpenShift cluster.",
+        "description": "Renders the install config, manifests and ignition files of the cluster as an installation would, without\ninstalling it. The hosts are not moved to installing and the state of the cluster is not changed. The rendered\nfiles can be downloaded with v2DownloadClusterDryRun once the dry run succeeded.\n",
         "tags": [
           "installer"
         ],
-        "operationId": "v2InstallCluster",
+        "operationId": "v2DryRunInstallCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be installed.",
+            "description": "The cluster to be installed in dry-run mode.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/dry-run-result"
             }
           },
           "400": {
@@ -5060,18 +5305,18 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/reset": {
+    "/v2/clusters/{cluster_id}/actions/install": {
       "post": {
-        "description": "Resets a failed installation.",
+        "description": "Installs the OpenShift cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ResetCluster",
+        "operationId": "v2InstallCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
+            "description": "The cluster to be installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -5084,6 +5329,12 @@ func init() {
               "$ref": "#/definitions/cluster"
             }
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -5123,35 +5374,28 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/credentials": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster admin credentials.",
+    "/v2/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "description": "Resets a failed installation.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetCredentials",
+        "operationId": "v2ResetCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster whose installation is to be reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/credentials"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -5193,49 +5437,33 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/credentials": {
+    "/v2/clusters/{cluster_id}/bundle": {
       "get": {
         "security": [
           {
             "userAuth": [
+              "admin",
+              "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "agentAuth": []
           }
         ],
-        "description": "Downloads credentials relating to the installed/installing cluster.",
+        "description": "Exports the definition of the cluster as a versioned YAML bundle, which can be imported to recreate the\ncluster in another environment. The pull secret is not exported.\n",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
-          "installer"
+          "cluster_bundles"
         ],
-        "operationId": "V2DownloadClusterCredentials",
+        "operationId": "v2ExportClusterBundle",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the credential file that should be downloaded.",
+            "description": "The cluster to be exported.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
-            ],
-            "type": "string",
-            "description": "The credential file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
@@ -5263,26 +5491,65 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
+          "500": {
+            "description": "Error.",
+            "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/connectivity-matrix": {
+      "get": {
+        "description": "Retrieves the pairwise L2 and L3 connectivity between the hosts of the cluster, per outgoing interface and\naddress family, as reported by the last connectivity checks of the hosts.\n",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetClusterConnectivityMatrix",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose connectivity matrix should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/connectivity-matrix"
+            }
           },
-          "409": {
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5290,7 +5557,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
+    "/v2/clusters/{cluster_id}/credentials": {
       "get": {
         "security": [
           {
@@ -5303,40 +5570,22 @@ func init() {
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetPresignedForClusterCredentials",
+        "operationId": "V2GetCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose admin credentials should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/credentials"
             }
           },
           "401": {
@@ -5378,7 +5627,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/files": {
+    "/v2/clusters/{cluster_id}/downloads/connectivity-matrix": {
       "get": {
         "security": [
           {
@@ -5389,50 +5638,110 @@ func init() {
             ]
           },
           {
-            "agentAuth": []
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads the connectivity matrix of the cluster as a CSV file, one row per connectivity check.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2DownloadClusterConnectivityMatrix",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose connectivity matrix should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/downloads/credentials": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "user"
+            ]
           },
           {
             "urlAuth": []
+          },
+          {
+            "agentAuth": []
           }
         ],
-        "description": "Downloads files relating to the installed/installing cluster.",
+        "description": "Downloads credentials relating to the installed/installing cluster.",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "V2DownloadClusterFiles",
+        "operationId": "V2DownloadClusterCredentials",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster that owns the credential file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "custom_manifests.json",
-              "custom_manifests.yaml"
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
             ],
             "type": "string",
-            "description": "The file to be downloaded.",
+            "description": "The credential file to be downloaded.",
             "name": "file_name",
             "in": "query",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
@@ -5487,22 +5796,20 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
+    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
       "get": {
         "security": [
           {
             "userAuth": [
-              "admin",
-              "read-only-admin",
               "user"
             ]
           }
         ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetPresignedForClusterFiles",
+        "operationId": "V2GetPresignedForClusterCredentials",
         "parameters": [
           {
             "type": "string",
@@ -5514,43 +5821,15 @@ func init() {
           },
           {
             "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "logs",
-              "manifests"
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
             ],
             "type": "string",
             "description": "The file to be downloaded.",
             "name": "file_name",
             "in": "query",
             "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
           }
         ],
         "responses": {
@@ -5605,7 +5884,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/install-config": {
+    "/v2/clusters/{cluster_id}/downloads/dry-run": {
       "get": {
         "security": [
           {
@@ -5614,18 +5893,24 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Get the cluster's install config YAML.",
+        "description": "Downloads the tarball of the install config, manifests and ignition files rendered by the last dry-run installation of the cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetClusterInstallConfig",
+        "operationId": "v2DownloadClusterDryRun",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
+            "description": "The cluster whose dry-run installation should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -5635,7 +5920,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "type": "file"
             }
           },
           "401": {
@@ -5669,40 +5954,70 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Override values in the install config.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/downloads/files": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads files relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterInstallConfig",
+        "operationId": "V2DownloadClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "string"
-            }
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "custom_manifests.json",
+              "custom_manifests.yaml"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -5729,16 +6044,28 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs": {
+    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
       "get": {
         "security": [
           {
@@ -5747,28 +6074,38 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Download cluster logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2DownloadClusterLogs",
+        "operationId": "V2GetPresignedForClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
           {
             "enum": [
               "host",
@@ -5776,23 +6113,35 @@ func init() {
               "all"
             ],
             "type": "string",
-            "description": "The type of logs to be downloaded.",
+            "description": "If downloading logs, the type of logs to download.",
             "name": "logs_type",
             "in": "query"
           },
           {
             "type": "string",
             "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "description": "If downloading a file related to a host, the relevant host.",
             "name": "host_id",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/presigned"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -5832,67 +6181,40 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v2/clusters/{cluster_id}/install-config": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "Get the cluster's install config YAML.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UploadLogs",
+        "operationId": "v2GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster whose install config is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "maxLength": 104857600,
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "enum": [
-              "host",
-              "controller"
-            ],
-            "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env ID of the host.",
-            "name": "infra_env_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -5918,61 +6240,48 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/logs-progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update log collection state and progress.",
+      },
+      "patch": {
+        "description": "Override values in the install config.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterLogsProgress",
+        "operationId": "v2UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster whose install config is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
+            "description": "Install config overrides.",
+            "name": "install-config-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "type": "string"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -5998,54 +6307,61 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/manifests": {
-      "get": {
+    "/v2/clusters/{cluster_id}/install-config/validate": {
+      "post": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists manifests for customizing cluster installation.",
+        "description": "Validate install config overrides against the install config schema of the OpenShift version of the cluster, and preview the changes they make to the install config. The overrides are not saved.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2ListClusterManifests",
+        "operationId": "v2ValidateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
+            "description": "The cluster whose install config overrides are validated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Install config overrides.",
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-manifests"
+              "$ref": "#/definitions/install-config-overrides-validation"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -6072,12 +6388,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -6085,48 +6395,63 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v2/clusters/{cluster_id}/logs": {
+      "get": {
         "security": [
           {
-            "userAuth": []
-          }
-        ],
-        "description": "Creates a manifest for customizing cluster installation.",
-        "tags": [
-          "manifests"
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
         ],
-        "operationId": "V2CreateClusterManifest",
+        "description": "Download cluster logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2DownloadClusterLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/create-manifest-params"
-            }
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -6167,42 +6492,65 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2DeleteClusterManifest",
+        "operationId": "V2UploadLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
+            "description": "The cluster whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "maxLength": 104857600,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
           {
             "enum": [
-              "manifests",
-              "openshift"
+              "host",
+              "controller"
             ],
             "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env ID of the host.",
+            "name": "infra_env_id",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
+          "204": {
             "description": "Success."
           },
           "401": {
@@ -6240,59 +6588,50 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/manifests/files": {
-      "get": {
+    "/v2/clusters/{cluster_id}/logs-progress": {
+      "put": {
         "security": [
           {
-            "userAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Downloads cluster manifest.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Update log collection state and progress.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "v2DownloadClusterManifest",
+        "operationId": "v2UpdateClusterLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -6329,44 +6668,43 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/monitored-operators": {
+    "/v2/clusters/{cluster_id}/manifests": {
       "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Lists operators to be monitored for a cluster.",
+        "description": "Lists manifests for customizing cluster installation.",
         "tags": [
-          "operators",
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2ListOfClusterOperators",
+        "operationId": "V2ListClusterManifests",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster for which the manifests should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "$ref": "#/definitions/list-manifests"
             }
           },
           "401": {
@@ -6393,6 +6731,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -6401,43 +6745,45 @@ func init() {
           }
         }
       },
-      "put": {
+      "post": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Controller API to report of monitored operators.",
+        "description": "Creates a manifest for customizing cluster installation.",
         "tags": [
-          "operators",
-          "installer"
+          "manifests"
         ],
-        "operationId": "v2ReportMonitoredOperatorStatus",
+        "operationId": "V2CreateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
+            "description": "The cluster for which a new manifest should be created.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The operators monitor report.",
-            "name": "report-params",
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
+              "$ref": "#/definitions/create-manifest-params"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/manifest"
+            }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -6477,48 +6823,46 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/preflight-requirements": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get preflight requirements for a cluster.",
+      },
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "v2GetPreflightRequirements",
+        "operationId": "V2DeleteClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return preflight requrements for.",
+            "description": "The cluster whose manifest should be deleted.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
-            }
+          },
+          {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to delete from the cluster.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -6544,6 +6888,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -6553,40 +6903,54 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/supported-platforms": {
+    "/v2/clusters/{cluster_id}/manifests/files": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "userAuth": []
           }
         ],
-        "description": "A list of platforms that this cluster can support in its current configuration.",
+        "description": "Downloads cluster manifest.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "GetClusterSupportedPlatforms",
+        "operationId": "v2DownloadClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose platform types should be retrieved.",
+            "description": "The cluster whose manifest should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
+            "type": "string",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/platform_type"
-              }
+              "type": "file"
             }
           },
           "401": {
@@ -6607,6 +6971,18 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -6616,49 +6992,111 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
+    "/v2/clusters/{cluster_id}/monitored-operators": {
+      "get": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Lists operators to be monitored for a cluster.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "v2UploadClusterIngressCert",
+        "operationId": "V2ListOfClusterOperators",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
-            "in": "body",
-            "required": true,
+            "type": "string",
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
+              "$ref": "#/definitions/monitored-operators-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Controller API to report of monitored operators.",
+        "tags": [
+          "operators",
+          "installer"
+        ],
+        "operationId": "v2ReportMonitoredOperatorStatus",
+        "parameters": [
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "format": "uuid",
+            "description": "The cluster whose operators are being monitored.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The operators monitor report.",
+            "name": "report-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/operator-monitor-report"
+            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success."
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -6687,6 +7125,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -6702,7 +7146,7 @@ func init() {
         }
       }
     },
-    "/v2/component-versions": {
+    "/v2/clusters/{cluster_id}/preflight-requirements": {
       "get": {
         "security": [
           {
@@ -6713,42 +7157,50 @@ func init() {
             ]
           }
         ],
-        "description": "List of component versions.",
+        "description": "Get preflight requirements for a cluster.",
         "tags": [
-          "versions"
+          "installer"
         ],
-        "operationId": "v2ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
-          }
-        }
-      }
-    },
-    "/v2/domains": {
-      "get": {
-        "security": [
+        "operationId": "v2GetPreflightRequirements",
+        "parameters": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to return preflight requrements for.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
         ],
-        "description": "List of managed DNS domains.",
-        "tags": [
-          "managed_domains"
-        ],
-        "operationId": "V2ListManagedDomains",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-managed-domains"
+              "$ref": "#/definitions/preflight-hardware-requirements"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -6760,7 +7212,7 @@ func init() {
         }
       }
     },
-    "/v2/events": {
+    "/v2/clusters/{cluster_id}/supported-platforms": {
       "get": {
         "security": [
           {
@@ -6769,53 +7221,105 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Lists events for a cluster.",
+        "description": "A list of platforms that this cluster can support in its current configuration.",
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "v2ListEvents",
+        "operationId": "GetClusterSupportedPlatforms",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
+            "description": "The cluster whose platform types should be retrieved.",
             "name": "cluster_id",
-            "in": "query"
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/platform_type"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Transfer the ingress certificate for the cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UploadClusterIngressCert",
+        "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "A host in the specified cluster to return events for.",
-            "name": "host_id",
-            "in": "query"
+            "description": "The cluster to associate with the ingress certificate.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env to return events for.",
-            "name": "infra_env_id",
-            "in": "query"
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ingress-cert-params"
+            }
           },
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
-            "in": "query"
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/event-list"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -6847,11 +7351,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/feature-support-levels": {
+    "/v2/component-versions": {
       "get": {
         "security": [
           {
@@ -6862,32 +7372,46 @@ func init() {
             ]
           }
         ],
-        "description": "Retrieves the support levels for features for each OpenShift version.",
+        "description": "List of component versions.",
         "tags": [
-          "installer"
+          "versions"
         ],
-        "operationId": "v2ListFeatureSupportLevels",
+        "operationId": "v2ListComponentVersions",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/feature-support-levels"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/list-versions"
             }
-          },
-          "403": {
-            "description": "Forbidden.",
+          }
+        }
+      }
+    },
+    "/v2/domains": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of managed DNS domains.",
+        "tags": [
+          "managed_domains"
+        ],
+        "operationId": "V2ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/list-managed-domains"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -6895,7 +7419,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
+    "/v2/events": {
       "get": {
         "security": [
           {
@@ -6906,40 +7430,125 @@ func init() {
             ]
           },
           {
-            "agentAuth": []
+            "urlAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Lists events for a cluster.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "v2DownloadHostIgnition",
+        "operationId": "v2ListEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be downloaded.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "description": "The cluster to return events for.",
+            "name": "cluster_id",
+            "in": "query"
           },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
+            "description": "A host in the specified cluster to return events for.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to return events for.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of event severities.",
+            "name": "severities",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event names.",
+            "name": "names",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Return only events that occurred at or after this time.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Return only events that occurred at or before this time.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Return only events whose message contains this text, ignoring case.",
+            "name": "message",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ascending",
+              "descending"
+            ],
+            "type": "string",
+            "description": "The order by which the events are sorted according to their time.",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "maximum": 5000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximal number of events to return. All matching events are returned if not set.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "The number of matching events to skip before starting to return events.",
+            "name": "offset",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/event-list"
+            },
+            "headers": {
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "The total number of events matching the filters."
+              }
             }
           },
           "401": {
@@ -6966,28 +7575,258 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs": {
+    "/v2/events/stream": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Streams the events and the installation progress updates of a cluster, host or infra-env as Server-Sent Events.\nEvents are sent with the 'event' type and carry their sequence number as the SSE id, so a client that reconnects\nwith the Last-Event-ID header first receives the events it missed. Progress updates are sent with the\n'cluster-progress' and 'host-progress' types and are not replayed.\n",
+        "produces": [
+          "text/event-stream"
+        ],
+        "tags": [
+          "events"
+        ],
+        "operationId": "v2StreamEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to stream events for.",
+            "name": "cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A host to stream events for.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to stream events for.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of event severities.",
+            "name": "severities",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "The id of the last event received by the client. The events that followed it are sent before any live update.",
+            "name": "Last-Event-ID",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/feature-support-levels": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the support levels for features for each OpenShift version.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListFeatureSupportLevels",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/feature-support-levels"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2DownloadHostIgnition",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose ignition file should be downloaded.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be downloaded.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs": {
       "get": {
         "security": [
           {
@@ -7379,7 +8218,7 @@ func init() {
             "imageAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this host",
+        "description": "Downloads the customized ignition file for this host, or the artifacts for booting the discovery image\nover the network. The initrd.img has the discovery ignition embedded, and the ipxe-script boots the\nvmlinuz, initrd.img and rootfs.img of this infra-env.\n",
         "produces": [
           "application/octet-stream"
         ],
@@ -7398,7 +8237,11 @@ func init() {
           },
           {
             "enum": [
-              "discovery.ign"
+              "discovery.ign",
+              "vmlinuz",
+              "initrd.img",
+              "rootfs.img",
+              "ipxe-script"
             ],
             "type": "string",
             "description": "The file to be downloaded.",
@@ -7652,30 +8495,96 @@ func init() {
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host-list"
-            }
           },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned Hosts are filtered to those in one of the given statuses.",
+            "name": "status",
+            "in": "query"
           },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
+          {
+            "enum": [
+              "auto-assign",
+              "master",
+              "worker",
+              "bootstrap"
+            ],
+            "type": "string",
+            "description": "If set, returned Hosts are filtered to those with the given role.",
+            "name": "role",
+            "in": "query"
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
+          {
+            "enum": [
+              "created_at",
+              "updated_at",
+              "status"
+            ],
+            "type": "string",
+            "description": "The field used to sort the returned Hosts.",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ascending",
+              "descending"
+            ],
+            "type": "string",
+            "description": "The direction in which the returned Hosts are sorted.",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximal number of Hosts to return. All matching Hosts are returned if not set.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The token returned in the X-Continue header of a previous response, used to retrieve the next page.",
+            "name": "continue",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-list"
+            },
+            "headers": {
+              "X-Continue": {
+                "type": "string",
+                "description": "A token to retrieve the next page, empty if this is the last page."
+              },
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "The total number of Hosts matching the filters."
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
               "$ref": "#/definitions/error"
             }
           },
@@ -8854,6 +9763,152 @@ func init() {
         }
       }
     },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/inventory-history": {
+      "get": {
+        "description": "Retrieves the snapshots of the inventory of the host, a snapshot being recorded every time the hardware reported by the host changes.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListHostInventoryHistory",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose inventory history should be retrieved.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-inventory-snapshot-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/inventory-history/diff": {
+      "get": {
+        "description": "Compares two snapshots of the inventory of the host.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetHostInventoryDiff",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose inventory snapshots should be compared.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The snapshot to compare from.",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The snapshot to compare to.",
+            "name": "to",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-inventory-diff"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
       "put": {
         "security": [
@@ -9090,53 +10145,306 @@ func init() {
         }
       }
     },
-    "/v2/openshift-versions": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the list of OpenShift supported versions.",
+    "/v2/infra-envs/{infra_env_id}/static-network-config-template": {
+      "post": {
+        "description": "Renders the static network configuration of the hosts of the infra-env from a template and a table of per-host values, validates it and stores it in the infra-env.",
         "tags": [
-          "versions"
+          "installer"
         ],
-        "operationId": "v2ListSupportedOpenshiftVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/openshift-versions"
-            }
+        "operationId": "ApplyStaticNetworkConfigTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to be updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
-          "503": {
-            "description": "Unavailable.",
+          {
+            "description": "The template and the per-host values to render it with.",
+            "name": "static-network-config-template",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/static-network-config-template"
             }
           }
-        }
-      }
-    },
-    "/v2/supported-operators": {
-      "get": {
-        "description": "Retrieves the list of supported operators.",
-        "tags": [
-          "operators"
         ],
-        "operationId": "V2ListSupportedOperators",
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/infra-env"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/static-network-config-template-error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/openshift-versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift supported versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListSupportedOpenshiftVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/openshift-versions"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/role-bindings": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Lists the roles bound to the users when local role-based access control is enabled.",
+        "tags": [
+          "role_bindings"
+        ],
+        "operationId": "v2ListRoleBindings",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Return only the roles bound to this user.",
+            "name": "user_name",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Return only the roles bound on this cluster.",
+            "name": "cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Return only the roles bound on this organization.",
+            "name": "org_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/role-binding-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Binds a role to a user, on all the clusters and infra-envs, on those of an organization or on a single cluster.",
+        "tags": [
+          "role_bindings"
+        ],
+        "operationId": "v2CreateRoleBinding",
+        "parameters": [
+          {
+            "name": "new-role-binding-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/role-binding-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/role-binding"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/role-bindings/{role_binding_id}": {
+      "delete": {
+        "security": [
+          {
+            "userAuth": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Removes a role from a user.",
+        "tags": [
+          "role_bindings"
+        ],
+        "operationId": "v2DeleteRoleBinding",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The role binding to be deleted.",
+            "name": "role_binding_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/supported-operators": {
+      "get": {
+        "description": "Retrieves the list of supported operators.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2ListSupportedOperators",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           },
           "401": {
@@ -9218,43 +10526,272 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "add-hosts-cluster-create-params": {
-      "type": "object",
-      "required": [
-        "id",
-        "name",
-        "api_vip_dnsname",
-        "openshift_version"
-      ],
-      "properties": {
-        "api_vip_dnsname": {
-          "description": "api vip domain.",
-          "type": "string"
-        },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
-        }
-      }
     },
-    "api_vip_connectivity_request": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
+    "/v2/webhooks": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the webhook subscriptions of the user.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "v2ListWebhooks",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Subscribes an HTTP endpoint to the events of the clusters, hosts and infra-envs owned by the user.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "v2RegisterWebhook",
+        "parameters": [
+          {
+            "description": "The properties describing the new webhook subscription.",
+            "name": "new-webhook-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/webhooks/{webhook_id}": {
+      "delete": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Deletes a webhook subscription.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "v2DeregisterWebhook",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The webhook subscription to be deleted.",
+            "name": "webhook_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/webhooks/{webhook_id}/deliveries": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the delivery history of a webhook subscription.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "v2ListWebhookDeliveries",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The webhook subscription whose deliveries should be listed.",
+            "name": "webhook_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "pending",
+              "delivered",
+              "failed"
+            ],
+            "type": "string",
+            "description": "If set, returned deliveries are filtered to those with the given status.",
+            "name": "status",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-delivery-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "add-hosts-cluster-create-params": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "api_vip_dnsname",
+        "openshift_version"
+      ],
+      "properties": {
+        "api_vip_dnsname": {
+          "description": "api vip domain.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        }
+      }
+    },
+    "api_vip_connectivity_request": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
         "ca_certificate": {
           "description": "A CA certficate to be used when contacting the URL via https.",
           "type": "string",
@@ -9288,22 +10825,132 @@ func init() {
         }
       }
     },
-    "bind-host-params": {
+    "audit-record": {
+      "type": "object",
       "required": [
-        "cluster_id"
+        "id",
+        "operation_id",
+        "method",
+        "path",
+        "status_code",
+        "result"
       ],
       "properties": {
+        "auth_type": {
+          "description": "The authentication type of the service when the call was made.",
+          "type": "string"
+        },
+        "changes": {
+          "description": "The JSON body of the request, with the values of secrets such as the pull secret redacted.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "cluster_id": {
+          "description": "The cluster targeted by the call.",
           "type": "string",
-          "format": "uuid"
-        }
-      }
-    },
-    "boot": {
-      "type": "object",
-      "properties": {
-        "current_boot_mode": {
-          "type": "string"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "created_at": {
+          "description": "The time the call was made.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "error": {
+          "description": "The reason the call failed.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "host_id": {
+          "description": "The host targeted by the call.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env targeted by the call.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "method": {
+          "type": "string"
+        },
+        "operation_id": {
+          "description": "The API operation that was called, for example v2UpdateCluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "org_id": {
+          "description": "The organization of the user that made the call.",
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "request_id": {
+          "description": "Unique identifier of the request, shared with the events it caused.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "result": {
+          "type": "string",
+          "enum": [
+            "succeeded",
+            "failed"
+          ]
+        },
+        "status_code": {
+          "description": "The HTTP status code of the response.",
+          "type": "integer"
+        },
+        "user_name": {
+          "description": "The user that made the call.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "audit-record-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/audit-record"
+      }
+    },
+    "bind-host-params": {
+      "required": [
+        "cluster_id"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "boot": {
+      "type": "object",
+      "properties": {
+        "current_boot_mode": {
+          "type": "string"
         },
         "pxe_interface": {
           "type": "string"
@@ -9433,6 +11080,11 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "fips": {
+          "description": "Install the cluster in FIPS mode. Requires a release and a CPU architecture that support FIPS.",
+          "type": "boolean",
+          "default": false
+        },
         "high_availability_mode": {
           "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
           "type": "string",
@@ -9610,6 +11262,14 @@ func init() {
           "format": "int64",
           "x-go-custom-tag": "gorm:\"-\""
         },
+        "role_assignment_strategy": {
+          "description": "Strategy used to assign roles to the hosts whose role is auto-assign. The first-fit strategy selects the first hosts that meet the control plane requirements, the scoring strategy selects the best hosts according to their hardware and failure domains.",
+          "type": "string",
+          "enum": [
+            "first-fit",
+            "scoring"
+          ]
+        },
         "schedulable_masters": {
           "description": "Schedule workloads on masters",
           "type": "boolean",
@@ -9702,6 +11362,74 @@ func init() {
         }
       }
     },
+    "cluster-bundle-field-error": {
+      "type": "object",
+      "required": [
+        "field",
+        "message"
+      ],
+      "properties": {
+        "field": {
+          "description": "The path of the field in the bundle, for example cluster.api_vip or hosts[52:54:00:aa:bb:cc].role.",
+          "type": "string"
+        },
+        "message": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-bundle-import-params": {
+      "type": "object",
+      "required": [
+        "bundle",
+        "pull_secret"
+      ],
+      "properties": {
+        "bundle": {
+          "description": "The YAML cluster bundle, as exported by v2ExportClusterBundle.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Overrides the name of the cluster in the bundle.",
+          "type": "string"
+        },
+        "platform_password": {
+          "description": "The password of the oVirt or Nutanix platform of the cluster. Platform passwords are not exported, so it is required when the bundle uses one of these platforms.",
+          "type": "string",
+          "format": "password"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-bundle-validation-error": {
+      "type": "object",
+      "required": [
+        "code",
+        "reason",
+        "field_errors"
+      ],
+      "properties": {
+        "code": {
+          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error.",
+          "type": "string"
+        },
+        "field_errors": {
+          "description": "The errors of the individual fields of the bundle.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-bundle-field-error"
+          }
+        },
+        "reason": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
     "cluster-create-params": {
       "type": "object",
       "required": [
@@ -9752,6 +11480,11 @@ func init() {
           "type": "object",
           "$ref": "#/definitions/disk-encryption"
         },
+        "fips": {
+          "description": "Install the cluster in FIPS mode. Requires a release and a CPU architecture that support FIPS.",
+          "type": "boolean",
+          "default": false
+        },
         "high_availability_mode": {
           "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
           "type": "string",
@@ -9845,6 +11578,15 @@ func init() {
           "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
           "type": "string"
         },
+        "role_assignment_strategy": {
+          "description": "Strategy used to assign roles to the hosts whose role is auto-assign. The first-fit strategy selects the first hosts that meet the control plane requirements, the scoring strategy selects the best hosts according to their hardware and failure domains.",
+          "type": "string",
+          "default": "first-fit",
+          "enum": [
+            "first-fit",
+            "scoring"
+          ]
+        },
         "schedulable_masters": {
           "description": "Schedule workloads on masters",
           "type": "boolean",
@@ -10046,6 +11788,11 @@ func init() {
           },
           "x-nullable": true
         },
+        "fips": {
+          "description": "Install the cluster in FIPS mode. Requires a release and a CPU architecture that support FIPS.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "hosts_machine_config_pool_names": {
           "description": "The desired machine config pool for hosts associated with the cluster.",
           "type": "array",
@@ -10181,6 +11928,15 @@ func init() {
           "type": "string",
           "x-nullable": true
         },
+        "role_assignment_strategy": {
+          "description": "Strategy used to assign roles to the hosts whose role is auto-assign. The first-fit strategy selects the first hosts that meet the control plane requirements, the scoring strategy selects the best hosts according to their hardware and failure domains.",
+          "type": "string",
+          "enum": [
+            "first-fit",
+            "scoring"
+          ],
+          "x-nullable": true
+        },
         "schedulable_masters": {
           "description": "Schedule workloads on masters",
           "type": "boolean",
@@ -10239,7 +11995,9 @@ func init() {
         "lso-requirements-satisfied",
         "ocs-requirements-satisfied",
         "cnv-requirements-satisfied",
-        "network-type-valid"
+        "network-type-valid",
+        "masters-spread-across-failure-domains",
+        "mtu-consistent"
       ]
     },
     "cluster_default_config": {
@@ -10330,6 +12088,10 @@ func init() {
         "mac": {
           "type": "string"
         },
+        "mtu": {
+          "description": "The MTU of the interface. The addresses of the interface are probed with packets of this size that must not\nbe fragmented, so that path MTU problems are detected.\n",
+          "type": "integer"
+        },
         "name": {
           "type": "string"
         }
@@ -10341,6 +12103,70 @@ func init() {
         "$ref": "#/definitions/connectivity-check-host"
       }
     },
+    "connectivity-matrix": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/connectivity-matrix-entry"
+      }
+    },
+    "connectivity-matrix-entry": {
+      "description": "The result of a connectivity check from a host to an address of another host.",
+      "type": "object",
+      "properties": {
+        "address_family": {
+          "type": "string",
+          "enum": [
+            "ipv4",
+            "ipv6"
+          ]
+        },
+        "average_rtt_ms": {
+          "description": "Average round trip time in milliseconds, for L3 checks.",
+          "type": "number",
+          "format": "double",
+          "x-go-name": "AverageRTTMs"
+        },
+        "layer": {
+          "type": "string",
+          "enum": [
+            "l2",
+            "l3"
+          ]
+        },
+        "outgoing_nic": {
+          "type": "string"
+        },
+        "packet_loss_percentage": {
+          "description": "Percentage of packets lost, for L3 checks.",
+          "type": "number",
+          "format": "double"
+        },
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "remote_mac": {
+          "description": "The MAC address the remote IP address was resolved to, for L2 checks.",
+          "type": "string"
+        },
+        "source_host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "source_host_name": {
+          "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
+        },
+        "target_host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "target_host_name": {
+          "type": "string"
+        }
+      }
+    },
     "connectivity-remote-host": {
       "type": "object",
       "properties": {
@@ -10818,6 +12644,38 @@ func init() {
         }
       }
     },
+    "dry-run-result": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "status"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "installer_output": {
+          "description": "The output of openshift-install, or the error that stopped the dry run.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Whether the installation files of the cluster were rendered.",
+          "type": "string",
+          "enum": [
+            "succeeded",
+            "failed"
+          ]
+        },
+        "validation_errors": {
+          "description": "The reasons the cluster is not ready for installation.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "error": {
       "type": "object",
       "required": [
@@ -10882,7 +12740,7 @@ func init() {
         "event_time": {
           "type": "string",
           "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
         },
         "host_id": {
           "description": "Unique identifier of the host this event relates to.",
@@ -10904,7 +12762,8 @@ func init() {
         },
         "name": {
           "description": "Event Name.",
-          "type": "string"
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
         },
         "props": {
           "description": "Additional properties for the event in JSON format.",
@@ -10923,7 +12782,8 @@ func init() {
             "warning",
             "error",
             "critical"
-          ]
+          ],
+          "x-go-custom-tag": "gorm:\"index\""
         }
       }
     },
@@ -10933,6 +12793,33 @@ func init() {
         "$ref": "#/definitions/event"
       }
     },
+    "failure-domain": {
+      "type": "object",
+      "properties": {
+        "chassis": {
+          "description": "The chassis the host is installed in.",
+          "type": "string"
+        },
+        "power_domain": {
+          "description": "The power domain (PDU or power feed) the host is connected to.",
+          "type": "string"
+        },
+        "rack": {
+          "description": "The rack the host is installed in.",
+          "type": "string"
+        },
+        "source": {
+          "description": "Whether the failure domain was set by the user or derived from the LLDP neighbors of the host.",
+          "type": "string",
+          "enum": [
+            "user",
+            "lldp"
+          ],
+          "readOnly": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:failure_domain_\""
+    },
     "feature-support-level": {
       "type": "object",
       "properties": {
@@ -11127,6 +13014,10 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "failure_domain": {
+          "description": "The failure domain of the host, used to spread the control plane nodes.",
+          "$ref": "#/definitions/failure-domain"
+        },
         "free_addresses": {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
@@ -11182,13 +13073,24 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
+        "inventory_drift": {
+          "description": "JSON-formatted list of the critical changes of the inventory of the host since the user last reviewed the host.",
           "type": "string",
-          "enum": [
-            "Host",
-            "AddToExistingClusterHost"
-          ]
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "inventory_reviewed_snapshot_id": {
+          "description": "The snapshot of the inventory of the host at the time the user last reviewed the host.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
+          "type": "string",
+          "enum": [
+            "Host",
+            "AddToExistingClusterHost"
+          ]
         },
         "logs_collected_at": {
           "type": "string",
@@ -11284,6 +13186,15 @@ func init() {
         "suggested_role": {
           "$ref": "#/definitions/host-role"
         },
+        "tang_connectivity": {
+          "description": "The result of fetching the advertisements of the Tang servers of the cluster from the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "tpm_ek_fingerprint": {
+          "description": "SHA-256 fingerprint of the endorsement key certificate of the host's TPM, recorded for attestation.",
+          "type": "string"
+        },
         "updated_at": {
           "type": "string",
           "format": "date-time",
@@ -11330,6 +13241,98 @@ func init() {
         }
       }
     },
+    "host-inventory-change": {
+      "type": "object",
+      "required": [
+        "attribute"
+      ],
+      "properties": {
+        "attribute": {
+          "description": "The changed attribute, for example cpu.count or disks[/dev/disk/by-id/wwn-0x5000c500a1b2c3d4].serial.",
+          "type": "string"
+        },
+        "critical": {
+          "description": "Whether the change affects the installation disk, the MAC addresses or the CPU count of the host.",
+          "type": "boolean"
+        },
+        "from": {
+          "description": "The value of the attribute in the first snapshot, empty if the attribute did not exist.",
+          "type": "string"
+        },
+        "to": {
+          "description": "The value of the attribute in the second snapshot, empty if the attribute no longer exists.",
+          "type": "string"
+        }
+      }
+    },
+    "host-inventory-diff": {
+      "type": "object",
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-inventory-change"
+          }
+        },
+        "from_snapshot_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "to_snapshot_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "host-inventory-snapshot": {
+      "type": "object",
+      "required": [
+        "id",
+        "host_id",
+        "infra_env_id"
+      ],
+      "properties": {
+        "created_at": {
+          "description": "The time the inventory was reported.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "infra_env_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "inventory": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        }
+      }
+    },
+    "host-inventory-snapshot-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host-inventory-snapshot"
+      }
+    },
     "host-list": {
       "type": "array",
       "items": {
@@ -11449,6 +13452,10 @@ func init() {
           },
           "x-nullable": true
         },
+        "failure_domain": {
+          "description": "The failure domain of the host, used to spread the control plane nodes.",
+          "$ref": "#/definitions/failure-domain"
+        },
         "host_name": {
           "type": "string",
           "x-nullable": true
@@ -11504,7 +13511,12 @@ func init() {
         "apps-domain-name-resolved-correctly",
         "compatible-with-cluster-platform",
         "dns-wildcard-not-configured",
-        "disk-encryption-requirements-satisfied"
+        "disk-encryption-requirements-satisfied",
+        "mtu-valid",
+        "tpm-valid",
+        "tang-connectivity-valid",
+        "critical-inventory-unchanged",
+        "compatible-with-fips"
       ]
     },
     "host_network": {
@@ -11948,6 +13960,46 @@ func init() {
     "ingress-cert-params": {
       "type": "string"
     },
+    "install-config-override-error": {
+      "type": "object",
+      "required": [
+        "field",
+        "message"
+      ],
+      "properties": {
+        "field": {
+          "description": "Path of the invalid field, for example controlPlane.hyperthreading.",
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "install-config-overrides-validation": {
+      "type": "object",
+      "properties": {
+        "diff": {
+          "description": "Unified diff between the generated install config and the install config with the overrides, set when the overrides are valid.",
+          "type": "string"
+        },
+        "errors": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/install-config-override-error"
+          }
+        },
+        "schema_version": {
+          "description": "The version of the install config schema the overrides were validated against.",
+          "type": "string"
+        },
+        "valid": {
+          "description": "Whether the overrides can be applied to the install config.",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
     "installer-args-params": {
       "type": "object",
       "properties": {
@@ -11976,6 +14028,10 @@ func init() {
         "client_id": {
           "type": "string"
         },
+        "controller": {
+          "description": "The name of the bond or bridge that the interface is a port of.",
+          "type": "string"
+        },
         "flags": {
           "type": "array",
           "items": {
@@ -11997,6 +14053,9 @@ func init() {
             "type": "string"
           }
         },
+        "lldp_neighbor": {
+          "$ref": "#/definitions/lldp-neighbor"
+        },
         "mac_address": {
           "type": "string"
         },
@@ -12006,14 +14065,32 @@ func init() {
         "name": {
           "type": "string"
         },
+        "parent": {
+          "description": "The name of the interface that a VLAN interface is defined on.",
+          "type": "string"
+        },
         "product": {
           "type": "string"
         },
         "speed_mbps": {
           "type": "integer"
         },
+        "type": {
+          "description": "The kind of the interface.",
+          "type": "string",
+          "enum": [
+            "physical",
+            "bond",
+            "vlan",
+            "bridge"
+          ]
+        },
         "vendor": {
           "type": "string"
+        },
+        "vlan_id": {
+          "description": "The VLAN ID of a VLAN interface.",
+          "type": "integer"
         }
       }
     },
@@ -12068,6 +14145,9 @@ func init() {
         "timestamp": {
           "type": "integer"
         },
+        "tpm": {
+          "$ref": "#/definitions/tpm"
+        },
         "tpm_version": {
           "type": "string",
           "enum": [
@@ -12116,6 +14196,14 @@ func init() {
           "format": "double",
           "x-go-name": "AverageRTTMs"
         },
+        "large_packet_size": {
+          "description": "Size in bytes of the packets sent with the don't-fragment bit set to probe the path MTU, 0 if it was not probed.",
+          "type": "integer"
+        },
+        "large_packet_successful": {
+          "description": "Whether the packets sent with the don't-fragment bit set reached the remote address.",
+          "type": "boolean"
+        },
         "outgoing_nic": {
           "type": "string"
         },
@@ -12155,6 +14243,24 @@ func init() {
         }
       }
     },
+    "lldp-neighbor": {
+      "description": "The link layer neighbor of a network interface, as advertised by LLDP.",
+      "type": "object",
+      "properties": {
+        "chassis_id": {
+          "type": "string"
+        },
+        "port_description": {
+          "type": "string"
+        },
+        "port_id": {
+          "type": "string"
+        },
+        "system_name": {
+          "type": "string"
+        }
+      }
+    },
     "logs-progress-params": {
       "type": "object",
       "required": [
@@ -12364,6 +14470,62 @@ func init() {
         }
       }
     },
+    "nutanix-platform": {
+      "description": "Nutanix platform-specific configuration upon which to perform the installation.",
+      "type": "object",
+      "properties": {
+        "password": {
+          "description": "The password for the Prism Central user name.",
+          "type": "string",
+          "format": "password",
+          "x-nullable": true
+        },
+        "prism_central_address": {
+          "description": "The Prism Central fully qualified domain name or IP address.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "prism_central_port": {
+          "description": "The Prism Central port.",
+          "type": "integer",
+          "default": 9440,
+          "maximum": 65535,
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "prism_element_address": {
+          "description": "The Prism Element fully qualified domain name or IP address.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "prism_element_port": {
+          "description": "The Prism Element port.",
+          "type": "integer",
+          "default": 9440,
+          "maximum": 65535,
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "prism_element_uuid": {
+          "description": "The UUID of the Prism Element cluster the VMs run on.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "subnet_uuid": {
+          "description": "The UUID of the Nutanix subnet the VMs are attached to.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "username": {
+          "description": "The user name to use to connect to Prism Central.",
+          "type": "string",
+          "x-nullable": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:nutanix_\""
+    },
     "openshift-version": {
       "type": "object",
       "required": [
@@ -12631,6 +14793,11 @@ func init() {
         "type"
       ],
       "properties": {
+        "nutanix": {
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/nutanix-platform"
+        },
         "ovirt": {
           "type": "object",
           "x-nullable": true,
@@ -12648,6 +14815,7 @@ func init() {
         "baremetal",
         "vsphere",
         "ovirt",
+        "nutanix",
         "none"
       ]
     },
@@ -12748,6 +14916,100 @@ func init() {
         "$ref": "#/definitions/release-image"
       }
     },
+    "role-binding": {
+      "type": "object",
+      "required": [
+        "id",
+        "user_name",
+        "role"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster the role is bound on, if any.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "org_id": {
+          "description": "The organization the role is bound on, if any.",
+          "type": "string"
+        },
+        "role": {
+          "description": "The role bound to the user.",
+          "type": "string",
+          "enum": [
+            "viewer",
+            "editor",
+            "installer",
+            "admin"
+          ]
+        },
+        "user_name": {
+          "description": "The user the role is bound to.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "role-binding-create-params": {
+      "type": "object",
+      "required": [
+        "user_name",
+        "role"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster the role is bound on. The role applies to all the clusters when neither a cluster nor an organization is set.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "org_id": {
+          "description": "The organization the role is bound on. The role applies to all the clusters when neither a cluster nor an organization is set.",
+          "type": "string"
+        },
+        "role": {
+          "description": "The role bound to the user. A viewer can get resources, an editor can also change them, an installer can\nalso install and reset clusters and hosts, and an admin can call any API.\n",
+          "type": "string",
+          "enum": [
+            "viewer",
+            "editor",
+            "installer",
+            "admin"
+          ]
+        },
+        "user_name": {
+          "description": "The user the role is bound to.",
+          "type": "string"
+        }
+      }
+    },
+    "role-binding-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/role-binding"
+      }
+    },
     "route": {
       "type": "object",
       "properties": {
@@ -12797,9 +15059,71 @@ func init() {
         "unreachable"
       ]
     },
-    "step": {
+    "static-network-config-template": {
       "type": "object",
-      "properties": {
+      "required": [
+        "network_yaml_template",
+        "hosts_csv",
+        "mac_interface_columns"
+      ],
+      "properties": {
+        "hosts_csv": {
+          "description": "A CSV table whose header row names the columns and whose other rows hold the values of a host, such as its MAC, IP, gateway and VLAN.",
+          "type": "string"
+        },
+        "mac_interface_columns": {
+          "description": "Maps the logical interface names used in the template to the columns holding their MAC addresses.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "network_yaml_template": {
+          "description": "An nmstate yaml in which {{ .\u003ccolumn\u003e }} placeholders are replaced by the values of the columns of each host.",
+          "type": "string"
+        }
+      }
+    },
+    "static-network-config-template-error": {
+      "type": "object",
+      "required": [
+        "code",
+        "reason"
+      ],
+      "properties": {
+        "code": {
+          "description": "Numeric identifier of the error.",
+          "type": "string"
+        },
+        "reason": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        },
+        "row_errors": {
+          "description": "The errors of the rows of the hosts table.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-template-row-error"
+          }
+        }
+      }
+    },
+    "static-network-config-template-row-error": {
+      "type": "object",
+      "properties": {
+        "reason": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        },
+        "row": {
+          "description": "The number of the row in the hosts table, the header being the first row.",
+          "type": "integer"
+        }
+      }
+    },
+    "step": {
+      "type": "object",
+      "properties": {
         "args": {
           "type": "array",
           "items": {
@@ -12851,7 +15175,8 @@ func init() {
         "ntp-synchronizer",
         "installation-disk-speed-check",
         "container-image-availability",
-        "domain-resolution"
+        "domain-resolution",
+        "tang-connectivity-check"
       ]
     },
     "steps": {
@@ -12906,6 +15231,90 @@ func init() {
         }
       }
     },
+    "tang_connectivity_request": {
+      "type": "object",
+      "required": [
+        "tang_servers"
+      ],
+      "properties": {
+        "tang_servers": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": [
+              "url"
+            ],
+            "properties": {
+              "thumbprint": {
+                "description": "The expected thumbprint of the signing key of the Tang server.",
+                "type": "string"
+              },
+              "url": {
+                "description": "The URL of the Tang server.",
+                "type": "string"
+              }
+            },
+            "x-go-name": "TangConnectivityRequestServer"
+          }
+        }
+      }
+    },
+    "tang_connectivity_response": {
+      "type": "object",
+      "required": [
+        "tang_servers"
+      ],
+      "properties": {
+        "tang_servers": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": [
+              "url"
+            ],
+            "properties": {
+              "error": {
+                "description": "The reason the advertisement could not be fetched, empty on success.",
+                "type": "string"
+              },
+              "thumbprints": {
+                "description": "The thumbprints of the signing keys of the advertisement fetched from the Tang server.",
+                "type": "array",
+                "items": {
+                  "type": "string"
+                }
+              },
+              "url": {
+                "description": "The URL of the Tang server.",
+                "type": "string"
+              }
+            },
+            "x-go-name": "TangConnectivityResponseServer"
+          }
+        }
+      }
+    },
+    "tpm": {
+      "description": "The attestation data of the host's TPM, as reported by the agent.",
+      "type": "object",
+      "properties": {
+        "ek_certificate": {
+          "description": "The PEM encoded certificate of the TPM endorsement key.",
+          "type": "string"
+        },
+        "enabled": {
+          "description": "Whether the TPM is enabled in the host's BIOS.",
+          "type": "boolean"
+        },
+        "pcr_banks": {
+          "description": "The hash algorithms of the active PCR banks, such as sha1 and sha256.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "usage": {
       "type": "object",
       "properties": {
@@ -12977,6 +15386,11 @@ func init() {
           "type": "object",
           "$ref": "#/definitions/disk-encryption"
         },
+        "fips": {
+          "description": "Install the cluster in FIPS mode. Requires a release and a CPU architecture that support FIPS.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "http_proxy": {
           "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
@@ -13061,6 +15475,15 @@ func init() {
           "type": "string",
           "x-nullable": true
         },
+        "role_assignment_strategy": {
+          "description": "Strategy used to assign roles to the hosts whose role is auto-assign. The first-fit strategy selects the first hosts that meet the control plane requirements, the scoring strategy selects the best hosts according to their hardware and failure domains.",
+          "type": "string",
+          "enum": [
+            "first-fit",
+            "scoring"
+          ],
+          "x-nullable": true
+        },
         "schedulable_masters": {
           "description": "Schedule workloads on masters",
           "type": "boolean",
@@ -13127,6 +15550,185 @@ func init() {
       "additionalProperties": {
         "type": "string"
       }
+    },
+    "webhook": {
+      "type": "object",
+      "required": [
+        "id",
+        "url"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "event_names": {
+          "description": "If non-empty, only events with one of these names are delivered.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "id": {
+          "description": "Unique identifier of the webhook subscription.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "severities": {
+          "description": "If non-empty, only events with one of these severities are delivered.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "info",
+              "warning",
+              "error",
+              "critical"
+            ]
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "url": {
+          "description": "The HTTP or HTTPS endpoint that the events are posted to.",
+          "type": "string"
+        },
+        "user_name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "webhook-create-params": {
+      "type": "object",
+      "required": [
+        "url",
+        "secret"
+      ],
+      "properties": {
+        "event_names": {
+          "description": "If non-empty, only events with one of these names are delivered.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "secret": {
+          "description": "The key used to sign the posted payloads with HMAC-SHA256. The signature is sent in the X-Assisted-Signature header.",
+          "type": "string",
+          "minLength": 16
+        },
+        "severities": {
+          "description": "If non-empty, only events with one of these severities are delivered.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "info",
+              "warning",
+              "error",
+              "critical"
+            ]
+          }
+        },
+        "url": {
+          "description": "The HTTP or HTTPS endpoint that the events are posted to.",
+          "type": "string"
+        }
+      }
+    },
+    "webhook-delivery": {
+      "type": "object",
+      "required": [
+        "id",
+        "webhook_id",
+        "status"
+      ],
+      "properties": {
+        "attempts": {
+          "description": "The number of delivery attempts made so far.",
+          "type": "integer"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "delivered_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "event_name": {
+          "description": "The name of the delivered event.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the delivery, sent in the X-Assisted-Delivery header.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "last_error": {
+          "description": "The reason the last attempt failed.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "last_response_code": {
+          "description": "The HTTP status code returned by the endpoint on the last attempt.",
+          "type": "integer"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "delivered",
+            "failed"
+          ],
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "webhook_id": {
+          "description": "The webhook subscription this delivery belongs to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "webhook-delivery-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/webhook-delivery"
+      }
+    },
+    "webhook-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/webhook"
+      }
     }
   },
   "securityDefinitions": {
@@ -13164,6 +15766,14 @@ func init() {
       "description": "Agent-driven installation",
       "name": "Assisted installation"
     },
+    {
+      "description": "Records of the changes made through the API.",
+      "name": "audit"
+    },
+    {
+      "description": "Export and import of cluster definitions between environments.",
+      "name": "cluster_bundles"
+    },
     {
       "description": "Events related to a cluster installation.",
       "name": "events"
@@ -13184,9 +15794,17 @@ func init() {
       "description": "Information regarding supported operators.",
       "name": "operators"
     },
+    {
+      "description": "Roles of the users of a deployment with local authentication.",
+      "name": "role_bindings"
+    },
     {
       "description": "Information regarding versions.",
       "name": "versions"
+    },
+    {
+      "description": "Notifications of cluster, host and infra-env events to external endpoints.",
+      "name": "webhooks"
     }
   ]
 }`))
@@ -13220,24 +15838,1322 @@ func init() {
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterAddHostsCluster",
+        "operationId": "RegisterAddHostsCluster",
+        "deprecated": true,
+        "parameters": [
+          {
+            "description": "Parameters for creating a new cluster for adding nodes.",
+            "name": "new-add-hosts-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/add-hosts-cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v1/clusters": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift clusters.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ListClusters",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific cluster to retrieve.",
+            "name": "openshift_cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
+            "name": "ams_subscription_ids",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include hosts in the returned list.",
+            "name": "with_hosts",
+            "in": "query",
+            "allowEmptyValue": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Creates a new OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RegisterCluster",
+        "deprecated": true,
+        "parameters": [
+          {
+            "description": "The properties describing the new cluster.",
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v1/clusters/default-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the default values for various cluster properties.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetClusterDefaultConfig",
+        "deprecated": true,
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster_default_config"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v1/clusters/{cluster_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the details of the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetCluster",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving the cluster details.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DeregisterCluster",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be deregistered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Updates an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateCluster",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v1/clusters/{cluster_id}/actions/cancel": {
+      "post": {
+        "description": "Cancels an ongoing installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "CancelInstallation",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be canceled.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v1/clusters/{cluster_id}/actions/complete_installation": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to mark a finalizing installation as complete.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "CompleteInstallation",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is being completing.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The final status of the cluster installation.",
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is completing the installation.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v1/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "InstallCluster",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be installed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v1/clusters/{cluster_id}/actions/install_hosts": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "InstallHosts",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The existing cluster whose hosts should be added.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v1/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "description": "Resets a failed installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "ResetCluster",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be reset.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v1/clusters/{cluster_id}/credentials": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster admin credentials.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetCredentials",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose admin credentials should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/credentials"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v1/clusters/{cluster_id}/discovery-ignition": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetDiscoveryIgnition",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which the discovery ignition config should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/discovery-ignition-params"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Override values in the discovery ignition config.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "UpdateDiscoveryIgnition",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which the discovery ignition config should be updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Overrides for the discovery ignition config.",
+            "name": "discovery-ignition-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/discovery-ignition-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v1/clusters/{cluster_id}/downloads/files": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads files relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DownloadClusterFiles",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "discovery.ign",
+              "custom_manifests.json"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v1/clusters/{cluster_id}/downloads/files-presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetPresignedForClusterFiles",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "If downloading a file related to a host, the relevant host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v1/clusters/{cluster_id}/downloads/image": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DownloadClusterISO",
         "deprecated": true,
         "parameters": [
           {
-            "description": "Parameters for creating a new cluster for adding nodes.",
-            "name": "new-add-hosts-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/add-hosts-cluster-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose ISO should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "301": {
+            "description": "Redirect.",
+            "headers": {
+              "Location": {
+                "type": "string"
+              }
             }
           },
           "400": {
@@ -13258,6 +17174,21 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed."
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13265,63 +17196,44 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v1/clusters": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the list of OpenShift clusters.",
+      },
+      "post": {
+        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
         "tags": [
           "installer"
         ],
-        "operationId": "ListClusters",
+        "operationId": "GenerateClusterISO",
         "deprecated": true,
         "parameters": [
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "A specific cluster to retrieve.",
-            "name": "openshift_cluster_id",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
-            "name": "ams_subscription_ids",
-            "in": "query"
+            "description": "The cluster whose ISO should be generated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Include hosts in the returned list.",
-            "name": "with_hosts",
-            "in": "query",
-            "allowEmptyValue": true
+            "description": "The parameters for the generated ISO.",
+            "name": "image-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/image-create-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -13336,49 +17248,69 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "post": {
-        "description": "Creates a new OpenShift cluster definition.",
+      "head": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterCluster",
+        "operationId": "DownloadClusterISOHeaders",
         "deprecated": true,
         "parameters": [
           {
-            "description": "The properties describing the new cluster.",
-            "name": "new-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose ISO headers should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
+            "headers": {
+              "Content-Length": {
+                "type": "integer",
+                "description": "Size of the ISO in bytes"
+              }
             }
           },
           "400": {
@@ -13406,7 +17338,10 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed.",
+            "description": "Method Not Allowed."
+          },
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -13420,28 +17355,43 @@ func init() {
         }
       }
     },
-    "/v1/clusters/default-config": {
+    "/v1/clusters/{cluster_id}/downloads/kubeconfig": {
       "get": {
         "security": [
           {
             "userAuth": [
-              "admin",
-              "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Get the default values for various cluster properties.",
+        "description": "Downloads the kubeconfig file for this cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterDefaultConfig",
+        "operationId": "DownloadClusterKubeconfig",
         "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose kubeconfig should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster_default_config"
+              "type": "string",
+              "format": "binary"
             }
           },
           "401": {
@@ -13456,6 +17406,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13465,7 +17433,7 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}": {
+    "/v1/clusters/{cluster_id}/events": {
       "get": {
         "security": [
           {
@@ -13476,43 +17444,46 @@ func init() {
             ]
           },
           {
-            "agentAuth": []
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift cluster.",
+        "description": "Lists events for a cluster.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "GetCluster",
+        "operationId": "ListEvents",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be retrieved.",
+            "description": "The cluster to return events for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is retrieving the cluster details.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "format": "uuid",
+            "description": "A host in the specified cluster to return events for.",
+            "name": "host_id",
+            "in": "query"
           },
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/event-list"
             }
           },
           "401": {
@@ -13544,35 +17515,66 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
-        }
-      },
-      "delete": {
-        "description": "Deletes an OpenShift cluster definition.",
+        }
+      }
+    },
+    "/v1/clusters/{cluster_id}/free_addresses": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the free address list for a network.",
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterCluster",
+        "operationId": "GetFreeAddresses",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be deregistered.",
+            "description": "The cluster to return free addresses for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
+            "type": "string",
+            "description": "The cluster network to return free addresses for.",
+            "name": "network",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 8000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 8000,
+            "description": "The maximum number of free addresses to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A prefix for the free addresses to return.",
+            "name": "prefix",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/free-addresses-list"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -13598,12 +17600,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13611,44 +17607,40 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Updates an OpenShift cluster definition.",
+      }
+    },
+    "/v1/clusters/{cluster_id}/host-requirements": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get host requirements of a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateCluster",
+        "operationId": "GetClusterHostRequirements",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be updated.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The properties to update.",
-            "name": "cluster-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-update-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster-host-requirements-list"
             }
           },
           "401": {
@@ -13675,12 +17667,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13690,29 +17676,47 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}/actions/cancel": {
-      "post": {
-        "description": "Cancels an ongoing installation.",
+    "/v1/clusters/{cluster_id}/hosts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the list of OpenShift hosts.",
         "tags": [
           "installer"
         ],
-        "operationId": "CancelInstallation",
+        "operationId": "ListHosts",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be canceled.",
+            "description": "The cluster whose hosts should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is listing hosts.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -13727,26 +17731,20 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -13754,49 +17752,46 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}/actions/complete_installation": {
-      "post": {
+    "/v1/clusters/{cluster_id}/hosts/{host_id}": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Agent API to mark a finalizing installation as complete.",
+        "description": "Retrieves the details of the OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "CompleteInstallation",
+        "operationId": "GetHost",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is being completing.",
+            "description": "The cluster of the host that should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "description": "The final status of the cluster installation.",
-            "name": "completion-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/completion-params"
-            }
-          },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is completing the installation.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "format": "uuid",
+            "description": "The host that should be retrieved.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -13823,51 +17818,42 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v1/clusters/{cluster_id}/actions/install": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
+      },
+      "delete": {
+        "description": "Deregisters an OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallCluster",
+        "operationId": "DeregisterHost",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be installed.",
+            "description": "The cluster of the host that should be deregistered.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be deregistered.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+          "204": {
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -13899,12 +17885,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13914,37 +17894,39 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}/actions/install_hosts": {
+    "/v1/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
       "post": {
-        "description": "Installs the OpenShift cluster.",
+        "description": "Enables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "InstallHosts",
+        "operationId": "EnableHost",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The existing cluster whose hosts should be added.",
+            "description": "The cluster of the host that is being enabled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being enabled.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
             }
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -13981,29 +17963,35 @@ func init() {
               "$ref": "#/definitions/error"
             }
           }
-        }
-      }
-    },
-    "/v1/clusters/{cluster_id}/actions/reset": {
-      "post": {
-        "description": "Resets a failed installation.",
+        }
+      },
+      "delete": {
+        "description": "Disables a host for inclusion in the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "ResetCluster",
+        "operationId": "DisableHost",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
+            "description": "The cluster of the host that is being disabled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being disabled.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
@@ -14048,36 +18036,37 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}/credentials": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster admin credentials.",
+    "/v1/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
+      "post": {
+        "description": "install specific host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetCredentials",
+        "operationId": "InstallHost",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster of the host that is being installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being installed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/credentials"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -14098,12 +18087,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -14119,38 +18102,37 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}/discovery-ignition": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the discovery ignition for the cluster based on its attributes and overridden ignition value before generating the discovery ISO.\nUsed to test the validity of the discovery ignition when it is being overridden.\nFor downloading the generated discovery ignition use /clusters/$CLUSTER_ID/downloads/files?file_name=discovery.ign\n",
+    "/v1/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
+      "post": {
+        "description": "reset a failed host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetDiscoveryIgnition",
+        "operationId": "ResetHost",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be retrieved.",
+            "description": "The cluster of the host that is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -14171,8 +18153,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14184,39 +18166,51 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/v1/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
       "patch": {
-        "description": "Override values in the discovery ignition config.",
+        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateDiscoveryIgnition",
+        "summary": "Reset failed host validation.",
+        "operationId": "ResetHostValidation",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the discovery ignition config should be updated.",
+            "description": "The cluster of the host that its validation is being reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Overrides for the discovery ignition config.",
-            "name": "discovery-ignition-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/discovery-ignition-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that its validation is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14239,8 +18233,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14254,7 +18248,7 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}/downloads/files": {
+    "/v1/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
       "get": {
         "security": [
           {
@@ -14266,53 +18260,33 @@ func init() {
           },
           {
             "agentAuth": []
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Downloads files relating to the installed/installing cluster.",
+        "description": "Downloads the customized ignition file for this host, produces octet string",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterFiles",
+        "operationId": "DownloadHostIgnition",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster of the host whose ignition file should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "discovery.ign",
-              "custom_manifests.json"
-            ],
             "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The host whose ignition file should be downloaded.",
+            "name": "host_id",
+            "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
@@ -14367,87 +18341,37 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}/downloads/files-presigned": {
+    "/v1/clusters/{cluster_id}/hosts/{host_id}/ignition": {
       "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+        "description": "Get the customized ignition file for this host as a string",
         "tags": [
           "installer"
         ],
-        "operationId": "GetPresignedForClusterFiles",
+        "operationId": "GetHostIgnition",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster of the host whose customized ignition file should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
+            "description": "The host whose customized ignition file should be retrieved.",
             "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host-ignition-params"
             }
           },
           "401": {
@@ -14483,60 +18407,48 @@ func init() {
           "500": {
             "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/v1/clusters/{cluster_id}/downloads/image": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO.",
-        "produces": [
-          "application/octet-stream"
-        ],
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Patch the ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterISO",
+        "operationId": "UpdateHostIgnition",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be downloaded.",
+            "description": "The cluster of the host whose ignition file should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "string",
-              "format": "binary"
-            }
-          },
-          "301": {
-            "description": "Redirect.",
-            "headers": {
-              "Location": {
-                "type": "string"
-              }
-            }
+          "201": {
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -14563,10 +18475,7 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed."
-          },
-          "409": {
-            "description": "Error.",
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14578,30 +18487,40 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "description": "Creates a new OpenShift per-cluster Discovery ISO.",
+      }
+    },
+    "/v1/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
         "tags": [
           "installer"
         ],
-        "operationId": "GenerateClusterISO",
+        "operationId": "UpdateHostInstallerArgs",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO should be generated.",
+            "description": "The cluster of the host whose installer arguments should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The parameters for the generated ISO.",
-            "name": "image-create-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose installer arguments should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/image-create-params"
+              "$ref": "#/definitions/installer-args-params"
             }
           }
         ],
@@ -14609,7 +18528,7 @@ func init() {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
@@ -14655,8 +18574,10 @@ func init() {
             }
           }
         }
-      },
-      "head": {
+      }
+    },
+    "/v1/clusters/{cluster_id}/hosts/{host_id}/logs": {
+      "get": {
         "security": [
           {
             "userAuth": [
@@ -14669,34 +18590,42 @@ func init() {
             "urlAuth": []
           }
         ],
-        "description": "Downloads the OpenShift per-cluster Discovery ISO Headers only.",
+        "description": "Download host logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterISOHeaders",
+        "operationId": "DownloadHostLogs",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose ISO headers should be downloaded.",
+            "description": "The cluster of the host whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
-            "headers": {
-              "Content-Length": {
-                "type": "integer",
-                "description": "Size of the ISO in bytes"
-              }
+            "schema": {
+              "type": "file"
             }
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14720,7 +18649,10 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed."
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "409": {
             "description": "Error.",
@@ -14735,45 +18667,61 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v1/clusters/{cluster_id}/downloads/kubeconfig": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Downloads the kubeconfig file for this cluster.",
-        "produces": [
-          "application/octet-stream"
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterKubeconfig",
+        "operationId": "UploadHostLogs",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose kubeconfig should be downloaded.",
+            "description": "The cluster of the host whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the logs.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Bad Request",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/infra_error"
             }
           },
           "401": {
@@ -14794,20 +18742,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14815,31 +18757,24 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}/events": {
-      "get": {
+    "/v1/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
+      "put": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Lists events for a cluster.",
+        "description": "Update log collection state and progress.",
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "ListEvents",
+        "operationId": "UpdateHostLogsProgress",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -14847,26 +18782,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "A host in the specified cluster to return events for.",
+            "description": "The host whose log progress is being updated.",
             "name": "host_id",
-            "in": "query"
+            "in": "path",
+            "required": true
           },
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
-            "in": "query"
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/event-list"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -14892,71 +18825,76 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v1/clusters/{cluster_id}/free_addresses": {
-      "get": {
+    "/v1/clusters/{cluster_id}/hosts/{host_id}/progress": {
+      "put": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Retrieves the free address list for a network.",
+        "description": "Update installation progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetFreeAddresses",
+        "operationId": "UpdateHostInstallProgress",
         "deprecated": true,
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return free addresses for.",
+            "description": "The ID of the cluster to retrieve.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
             "type": "string",
-            "description": "The cluster network to return free addresses for.",
-            "name": "network",
-            "in": "query",
+            "format": "uuid",
+            "description": "The ID of the host to retrieve.",
+            "name": "host_id",
+            "in": "path",
             "required": true
           },
           {
-            "maximum": 8000,
-            "minimum": 1,
-            "type": "integer",
-            "default": 8000,
-            "description": "The maximum number of free addresses to return.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "A prefix for the free addresses to return.",
-            "name": "prefix",
-            "in": "query"
+            "description": "New progress value.",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/free-addresses-list"
-            }
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -14987,11 +18925,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v1/clusters/{cluster_id}/host-requirements": {
+    "/v1/clusters/{cluster_id}/install-config": {
       "get": {
         "security": [
           {
@@ -15002,17 +18946,17 @@ func init() {
             ]
           }
         ],
-        "description": "Get host requirements of a cluster.",
+        "description": "Get the cluster's install config YAML.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterHostRequirements",
+        "operationId": "GetClusterInstallConfig",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster whose install config is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -15022,7 +18966,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-host-requirements-list"
+              "type": "string"
             }
           },
           "401": {
@@ -15056,49 +19000,41 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v1/clusters/{cluster_id}/hosts": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Retrieves the list of OpenShift hosts.",
+      },
+      "patch": {
+        "description": "Override values in the install config.",
         "tags": [
           "installer"
         ],
-        "operationId": "ListHosts",
+        "operationId": "UpdateClusterInstallConfig",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose hosts should be listed.",
+            "description": "The cluster whose install config is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "The software version of the discovery agent that is listing hosts.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "Install config overrides.",
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -15113,20 +19049,20 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15134,7 +19070,7 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}/hosts/{host_id}": {
+    "/v1/clusters/{cluster_id}/logs": {
       "get": {
         "security": [
           {
@@ -15143,37 +19079,53 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift host.",
+        "description": "Download cluster logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "GetHost",
+        "operationId": "DownloadClusterLogs",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be retrieved.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
+            "in": "query"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be retrieved.",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "file"
             }
           },
           "401": {
@@ -15200,6 +19152,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -15208,29 +19166,55 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Deregisters an OpenShift host.",
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterHost",
+        "operationId": "UploadLogs",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that should be deregistered.",
+            "description": "The cluster whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
+          {
+            "maxLength": 104857600,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be deregistered.",
+            "description": "The host whose logs should be uploaded.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           }
         ],
         "responses": {
@@ -15238,9 +19222,9 @@ func init() {
             "description": "Success."
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
           "401": {
@@ -15261,14 +19245,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15276,38 +19260,41 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
-      "post": {
-        "description": "Enables a host for inclusion in the cluster.",
+    "/v1/clusters/{cluster_id}/logs_progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "EnableHost",
+        "operationId": "UpdateClusterLogsProgress",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being enabled.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being enabled.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -15344,39 +19331,44 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "delete": {
-        "description": "Disables a host for inclusion in the cluster.",
+      }
+    },
+    "/v1/clusters/{cluster_id}/manifests": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Lists manifests for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "DisableHost",
+        "operationId": "ListClusterManifests",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being disabled.",
+            "description": "The cluster for which the manifests should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being disabled.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/list-manifests"
             }
           },
           "401": {
@@ -15416,39 +19408,49 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v1/clusters/{cluster_id}/hosts/{host_id}/actions/install": {
+      },
       "post": {
-        "description": "install specific host for day2 cluster.",
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Creates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "InstallHost",
+        "operationId": "CreateClusterManifest",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being installed.",
+            "description": "The cluster for which a new manifest should be created.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being installed.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/manifest"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -15469,6 +19471,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -15482,40 +19490,45 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v1/clusters/{cluster_id}/hosts/{host_id}/actions/reset": {
-      "post": {
-        "description": "reset a failed host for day2 cluster.",
+      },
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "ResetHost",
+        "operationId": "DeleteClusterManifest",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that is being reset.",
+            "description": "The cluster whose manifest should be deleted.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host that is being reset.",
-            "name": "host_id",
-            "in": "path",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The manifest file name to delete from the cluster.",
+            "name": "file_name",
+            "in": "query",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -15535,6 +19548,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -15550,37 +19569,47 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation.  It may be performed on any host validation with persistent validation result.",
+    "/v1/clusters/{cluster_id}/manifests/files": {
+      "get": {
+        "security": [
+          {
+            "userAuth": []
+          }
+        ],
+        "description": "Downloads cluster manifest.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "ResetHostValidation",
+        "operationId": "DownloadClusterManifest",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host that its validation is being reset.",
+            "description": "The cluster whose manifest should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "manifests",
+              "openshift"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host that its validation is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
             "required": true
           }
         ],
@@ -15588,13 +19617,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Bad Request",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -15615,6 +19638,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -15630,52 +19659,41 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}/hosts/{host_id}/downloads/ignition": {
+    "/v1/clusters/{cluster_id}/monitored_operators": {
       "get": {
         "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this host, produces octet string",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Lists operators to be monitored for a cluster.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "DownloadHostIgnition",
+        "operationId": "ListOfClusterOperators",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be downloaded.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/monitored-operators-list"
             }
           },
           "401": {
@@ -15702,58 +19720,54 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v1/clusters/{cluster_id}/hosts/{host_id}/ignition": {
-      "get": {
-        "description": "Get the customized ignition file for this host as a string",
+      },
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Controller API to report of monitored operators.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "GetHostIgnition",
+        "operationId": "ReportMonitoredOperatorStatus",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose customized ignition file should be retrieved.",
+            "description": "The cluster whose operators are being monitored.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose customized ignition file should be retrieved.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The operators monitor report.",
+            "name": "report-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/operator-monitor-report"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
+            "description": "Success."
+          },
+          "400": {
+            "description": "Bad Request",
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -15791,51 +19805,48 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
-      "patch": {
-        "description": "Patch the ignition file for this host",
+      }
+    },
+    "/v1/clusters/{cluster_id}/preflight-requirements": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get preflight requirements for a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostIgnition",
+        "operationId": "GetPreflightRequirements",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose ignition file should be updated.",
+            "description": "The cluster to return preflight requrements for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-ignition-params"
-            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/preflight-hardware-requirements"
             }
           },
           "401": {
@@ -15871,47 +19882,47 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+    "/v1/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Transfer the ingress certificate for the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostInstallerArgs",
+        "operationId": "UploadClusterIngressCert",
         "deprecated": true,
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster of the host whose installer arguments should be updated.",
+            "description": "The cluster to associate with the ingress certificate.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/installer-args-params"
+              "$ref": "#/definitions/ingress-cert-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -15943,14 +19954,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15958,7 +19969,7 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}/hosts/{host_id}/logs": {
+    "/v1/component_versions": {
       "get": {
         "security": [
           {
@@ -15967,79 +19978,124 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Download host logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "List of component versions.",
         "tags": [
-          "installer"
+          "versions"
         ],
-        "operationId": "DownloadHostLogs",
+        "operationId": "ListComponentVersions",
         "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster of the host whose logs should be downloaded.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/v1/domains": {
+      "get": {
+        "security": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be downloaded.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
+        "description": "List of managed DNS domains.",
+        "tags": [
+          "managed_domains"
+        ],
+        "operationId": "ListManagedDomains",
+        "deprecated": true,
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/list-managed-domains"
             }
           },
-          "400": {
-            "description": "Bad Request",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "401": {
-            "description": "Unauthorized.",
+          }
+        }
+      }
+    },
+    "/v1/openshift_versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift supported versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "ListSupportedOpenshiftVersions",
+        "deprecated": true,
+        "responses": {
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/openshift-versions"
             }
           },
-          "403": {
-            "description": "Forbidden.",
+          "500": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
-          "404": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v1/supported-operators": {
+      "get": {
+        "description": "Retrieves the list of supported operators.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "ListSupportedOperators",
+        "deprecated": true,
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "403": {
+            "description": "Forbidden.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
           "500": {
@@ -16049,61 +20105,39 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v1/supported-operators/{operator_name}": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
-        "tags": [
-          "installer"
-        ],
-        "operationId": "UploadHostLogs",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster of the host whose logs should be uploaded.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
+        "description": "Lists properties for an operator.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "ListOperatorProperties",
+        "deprecated": true,
+        "parameters": [
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is uploading the logs.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "The operator name.",
+            "name": "operator_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Bad Request",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/operator-properties"
             }
           },
           "401": {
@@ -16129,59 +20163,102 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v1/clusters/{cluster_id}/hosts/{host_id}/logs_progress": {
-      "put": {
+    "/v2/audit-records": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Lists the records of the API calls that changed clusters, hosts and infra-envs, most recent first.",
         "tags": [
-          "installer"
+          "audit"
         ],
-        "operationId": "UpdateHostLogsProgress",
-        "deprecated": true,
+        "operationId": "v2ListAuditRecords",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "Return only the records of calls targeting this cluster.",
             "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose log progress is being updated.",
+            "description": "Return only the records of calls targeting this host.",
             "name": "host_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logs-progress-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "Return only the records of calls targeting this infra-env.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Return only the records of calls made by this user.",
+            "name": "user_name",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Return only the records of calls to this operation, for example v2UpdateCluster.",
+            "name": "operation_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Return only the records of calls made at or after this time.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Return only the records of calls made at or before this time.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximal number of records to return.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "The number of matching records to skip before starting to return records.",
+            "name": "offset",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/audit-record-list"
+            },
+            "headers": {
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "The total number of records matching the filters."
+              }
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -16195,88 +20272,142 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v1/clusters/{cluster_id}/hosts/{host_id}/progress": {
-      "put": {
+    "/v2/clusters": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Update installation progress.",
+        "description": "Retrieves the list of OpenShift clusters.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateHostInstallProgress",
-        "deprecated": true,
+        "operationId": "v2ListClusters",
         "parameters": [
           {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
             "in": "header"
           },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the cluster to retrieve.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "description": "A specific cluster to retrieve.",
+            "name": "openshift_cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
+            "name": "ams_subscription_ids",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include hosts in the returned list.",
+            "name": "with_hosts",
+            "in": "query",
+            "allowEmptyValue": true
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned Clusters are filtered to those in one of the given statuses.",
+            "name": "status",
+            "in": "query"
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The ID of the host to retrieve.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "If set, returned Clusters are filtered to those with the given OpenShift version.",
+            "name": "openshift_version",
+            "in": "query"
           },
           {
-            "description": "New progress value.",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
+            "type": "string",
+            "description": "If set, returned Clusters are filtered to those with the given platform type, one of the values of the platform_type definition.",
+            "name": "platform_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If set, returned Clusters are filtered to those owned by the given user name.",
+            "name": "owner",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "created_at",
+              "updated_at",
+              "status"
+            ],
+            "type": "string",
+            "description": "The field used to sort the returned Clusters.",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ascending",
+              "descending"
+            ],
+            "type": "string",
+            "description": "The direction in which the returned Clusters are sorted.",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximal number of Clusters to return. All matching Clusters are returned if not set.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The token returned in the X-Continue header of a previous response, used to retrieve the next page.",
+            "name": "continue",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-list"
+            },
+            "headers": {
+              "X-Continue": {
+                "type": "string",
+                "description": "A token to retrieve the next page, empty if this is the last page."
+              },
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "The total number of Clusters matching the filters."
+              }
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -16290,12 +20421,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -16315,40 +20440,35 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v1/clusters/{cluster_id}/install-config": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Get the cluster's install config YAML.",
+      },
+      "post": {
+        "description": "Creates a new OpenShift cluster definition.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterInstallConfig",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+        "operationId": "v2RegisterCluster",
+        "parameters": [
+          {
+            "description": "The properties describing the new cluster.",
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-create-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -16363,12 +20483,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -16382,41 +20496,45 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Override values in the install config.",
+      }
+    },
+    "/v2/clusters/bundle": {
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Registers a new cluster from a YAML bundle exported by v2ExportClusterBundle. The hosts of the bundle are\nconfigured when hosts with matching MAC addresses register to the new cluster.\n",
         "tags": [
-          "installer"
+          "cluster_bundles"
         ],
-        "operationId": "UpdateClusterInstallConfig",
-        "deprecated": true,
+        "operationId": "v2ImportClusterBundle",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
+            "description": "The bundle and the values not carried by it.",
+            "name": "import-params",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/cluster-bundle-import-params"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
           },
           "400": {
             "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster-bundle-validation-error"
             }
           },
           "401": {
@@ -16431,18 +20549,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -16452,7 +20558,7 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}/logs": {
+    "/v2/clusters/default-config": {
       "get": {
         "security": [
           {
@@ -16461,53 +20567,18 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Download cluster logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Get the default values for various cluster properties.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadClusterLogs",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
-            "name": "host_id",
-            "in": "query"
-          }
-        ],
+        "operationId": "V2GetClusterDefaultConfig",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/cluster_default_config"
             }
           },
           "401": {
@@ -16522,24 +20593,58 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
+          }
+        }
+      }
+    },
+    "/v2/clusters/import": {
+      "post": {
+        "description": "Import an AI cluster using minimal data assosiated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ImportCluster",
+        "parameters": [
+          {
+            "description": "Parameters for importing a OCP cluster for adding nodes.",
+            "name": "new-import-cluster-params",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/import-cluster-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
             }
           },
-          "409": {
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -16547,66 +20652,55 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v2/clusters/{cluster_id}": {
+      "get": {
         "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
           {
             "agentAuth": []
           }
         ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "Retrieves the details of the OpenShift cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "UploadLogs",
-        "deprecated": true,
+        "operationId": "v2GetCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster to be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "maxLength": 104857600,
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "enum": [
-              "host",
-              "controller"
-            ],
             "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
-            "in": "query",
-            "required": true
+            "description": "The software version of the discovery agent that is retrieving the cluster details.",
+            "name": "discovery_agent_version",
+            "in": "header"
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "query"
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Bad Request",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -16627,6 +20721,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -16640,43 +20740,26 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v1/clusters/{cluster_id}/logs_progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update log collection state and progress.",
+      },
+      "delete": {
+        "description": "Deletes an OpenShift cluster definition.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateClusterLogsProgress",
-        "deprecated": true,
+        "operationId": "v2DeregisterCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster to be deregistered.",
             "name": "cluster_id",
             "in": "path",
-            "required": true
-          },
-          {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logs-progress-params"
-            }
+            "required": true
           }
         ],
         "responses": {
           "204": {
-            "description": "Update cluster install progress."
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -16713,44 +20796,45 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v1/clusters/{cluster_id}/manifests": {
-      "get": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
-        "description": "Lists manifests for customizing cluster installation.",
+      },
+      "patch": {
+        "description": "Updates an OpenShift cluster definition.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "ListClusterManifests",
-        "deprecated": true,
+        "operationId": "V2UpdateCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
+            "description": "The cluster to be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v2-cluster-update-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-manifests"
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -16790,49 +20874,30 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/v2/clusters/{cluster_id}/actions/cancel": {
       "post": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
-        "description": "Creates a manifest for customizing cluster installation.",
+        "description": "Cancels an ongoing installation.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "CreateClusterManifest",
-        "deprecated": true,
+        "operationId": "V2CancelInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The cluster whose installation is to be canceled.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/create-manifest-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -16872,45 +20937,51 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/actions/complete-installation": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to mark a finalizing installation as complete.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "DeleteClusterManifest",
-        "deprecated": true,
+        "operationId": "v2CompleteInstallation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
+            "description": "The cluster whose installation is being completing.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
+            "description": "The final status of the cluster installation.",
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
           },
           {
             "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The software version of the discovery agent that is completing the installation.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success."
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -16947,59 +21018,44 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v1/clusters/{cluster_id}/manifests/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
-        "description": "Downloads cluster manifest.",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/v2/clusters/{cluster_id}/actions/dry-run-install": {
+      "post": {
+        "description": "Renders the install config, manifests and ignition files of the cluster as an installation would, without\ninstalling it. The hosts are not moved to installing and the state of the cluster is not changed. The rendered\nfiles can be downloaded with v2DownloadClusterDryRun once the dry run succeeded.\n",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "DownloadClusterManifest",
-        "deprecated": true,
+        "operationId": "v2DryRunInstallCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
+            "description": "The cluster to be installed in dry-run mode.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/dry-run-result"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -17041,41 +21097,34 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}/monitored_operators": {
-      "get": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Lists operators to be monitored for a cluster.",
+    "/v2/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "ListOfClusterOperators",
-        "deprecated": true,
+        "operationId": "v2InstallCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster to be installed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
-            "in": "query"
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -17102,6 +21151,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -17109,47 +21164,30 @@ func init() {
             }
           }
         }
-      },
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Controller API to report of monitored operators.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "description": "Resets a failed installation.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "ReportMonitoredOperatorStatus",
-        "deprecated": true,
+        "operationId": "v2ResetCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
+            "description": "The cluster whose installation is to be reset.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The operators monitor report.",
-            "name": "report-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
-            }
           }
         ],
-        "responses": {
-          "200": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Bad Request",
+        "responses": {
+          "202": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/cluster"
             }
           },
           "401": {
@@ -17187,17 +21225,11 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v1/clusters/{cluster_id}/preflight-requirements": {
+    "/v2/clusters/{cluster_id}/bundle": {
       "get": {
         "security": [
           {
@@ -17208,17 +21240,19 @@ func init() {
             ]
           }
         ],
-        "description": "Get preflight requirements for a cluster.",
+        "description": "Exports the definition of the cluster as a versioned YAML bundle, which can be imported to recreate the\ncluster in another environment. The pull secret is not exported.\n",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "installer"
+          "cluster_bundles"
         ],
-        "operationId": "GetPreflightRequirements",
-        "deprecated": true,
+        "operationId": "v2ExportClusterBundle",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return preflight requrements for.",
+            "description": "The cluster to be exported.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -17228,7 +21262,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
+              "type": "file"
             }
           },
           "401": {
@@ -17249,12 +21283,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -17264,52 +21292,28 @@ func init() {
         }
       }
     },
-    "/v1/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Transfer the ingress certificate for the cluster.",
+    "/v2/clusters/{cluster_id}/connectivity-matrix": {
+      "get": {
+        "description": "Retrieves the pairwise L2 and L3 connectivity between the hosts of the cluster, per outgoing interface and\naddress family, as reported by the last connectivity checks of the hosts.\n",
         "tags": [
           "installer"
         ],
-        "operationId": "UploadClusterIngressCert",
-        "deprecated": true,
+        "operationId": "v2GetClusterConnectivityMatrix",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
+            "description": "The cluster whose connectivity matrix should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
-            }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/connectivity-matrix"
             }
           },
           "401": {
@@ -17341,143 +21345,69 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v1/component_versions": {
+    "/v2/clusters/{cluster_id}/credentials": {
       "get": {
         "security": [
           {
             "userAuth": [
-              "admin",
-              "read-only-admin",
               "user"
             ]
           }
         ],
-        "description": "List of component versions.",
+        "description": "Get the cluster admin credentials.",
         "tags": [
-          "versions"
+          "installer"
         ],
-        "operationId": "ListComponentVersions",
-        "deprecated": true,
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
-          }
-        }
-      }
-    },
-    "/v1/domains": {
-      "get": {
-        "security": [
+        "operationId": "V2GetCredentials",
+        "parameters": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose admin credentials should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
         ],
-        "description": "List of managed DNS domains.",
-        "tags": [
-          "managed_domains"
-        ],
-        "operationId": "ListManagedDomains",
-        "deprecated": true,
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-managed-domains"
+              "$ref": "#/definitions/credentials"
             }
           },
-          "500": {
-            "description": "Error.",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
-          }
-        }
-      }
-    },
-    "/v1/openshift_versions": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the list of OpenShift supported versions.",
-        "tags": [
-          "versions"
-        ],
-        "operationId": "ListSupportedOpenshiftVersions",
-        "deprecated": true,
-        "responses": {
-          "200": {
-            "description": "Success.",
+          },
+          "403": {
+            "description": "Forbidden.",
             "schema": {
-              "$ref": "#/definitions/openshift-versions"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "500": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v1/supported-operators": {
-      "get": {
-        "description": "Retrieves the list of supported operators.",
-        "tags": [
-          "operators"
-        ],
-        "operationId": "ListSupportedOperators",
-        "deprecated": true,
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
           },
-          "403": {
-            "description": "Forbidden.",
+          "409": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -17489,7 +21419,7 @@ func init() {
         }
       }
     },
-    "/v1/supported-operators/{operator_name}": {
+    "/v2/clusters/{cluster_id}/downloads/connectivity-matrix": {
       "get": {
         "security": [
           {
@@ -17498,19 +21428,25 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Lists properties for an operator.",
+        "description": "Downloads the connectivity matrix of the cluster as a CSV file, one row per connectivity check.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "operators"
+          "installer"
         ],
-        "operationId": "ListOperatorProperties",
-        "deprecated": true,
+        "operationId": "v2DownloadClusterConnectivityMatrix",
         "parameters": [
           {
             "type": "string",
-            "description": "The operator name.",
-            "name": "operator_name",
+            "format": "uuid",
+            "description": "The cluster whose connectivity matrix should be downloaded.",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           }
@@ -17519,7 +21455,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/operator-properties"
+              "type": "file"
             }
           },
           "401": {
@@ -17540,6 +21476,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -17549,60 +21491,56 @@ func init() {
         }
       }
     },
-    "/v2/clusters": {
+    "/v2/clusters/{cluster_id}/downloads/credentials": {
       "get": {
         "security": [
           {
             "userAuth": [
-              "admin",
-              "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "agentAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift clusters.",
+        "description": "Downloads credentials relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2ListClusters",
+        "operationId": "V2DownloadClusterCredentials",
         "parameters": [
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "A specific cluster to retrieve.",
-            "name": "openshift_cluster_id",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
-            "name": "ams_subscription_ids",
-            "in": "query"
+            "description": "The cluster that owns the credential file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Include hosts in the returned list.",
-            "name": "with_hosts",
+            "enum": [
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
+            ],
+            "type": "string",
+            "description": "The credential file to be downloaded.",
+            "name": "file_name",
             "in": "query",
-            "allowEmptyValue": true
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "type": "file"
             }
           },
           "401": {
@@ -17617,12 +21555,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -17636,29 +21586,49 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "description": "Creates a new OpenShift cluster definition.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2RegisterCluster",
+        "operationId": "V2GetPresignedForClusterCredentials",
         "parameters": [
           {
-            "description": "The properties describing the new cluster.",
-            "name": "new-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/presigned"
             }
           },
           "400": {
@@ -17679,12 +21649,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -17694,7 +21676,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/default-config": {
+    "/v2/clusters/{cluster_id}/downloads/dry-run": {
       "get": {
         "security": [
           {
@@ -17703,18 +21685,34 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Get the default values for various cluster properties.",
+        "description": "Downloads the tarball of the install config, manifests and ignition files rendered by the last dry-run installation of the cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetClusterDefaultConfig",
+        "operationId": "v2DownloadClusterDryRun",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose dry-run installation should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster_default_config"
+              "type": "file"
             }
           },
           "401": {
@@ -17729,6 +21727,18 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -17738,35 +21748,68 @@ func init() {
         }
       }
     },
-    "/v2/clusters/import": {
-      "post": {
-        "description": "Import an AI cluster using minimal data assosiated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
+    "/v2/clusters/{cluster_id}/downloads/files": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Downloads files relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2ImportCluster",
+        "operationId": "V2DownloadClusterFiles",
         "parameters": [
           {
-            "description": "Parameters for importing a OCP cluster for adding nodes.",
-            "name": "new-import-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/import-cluster-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "custom_manifests.json",
+              "custom_manifests.yaml"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -17781,16 +21824,40 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}": {
+    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
       "get": {
         "security": [
           {
@@ -17799,44 +21866,74 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift cluster.",
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetCluster",
+        "operationId": "V2GetPresignedForClusterFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be retrieved.",
+            "description": "The cluster that owns the file that should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
             "type": "string",
-            "description": "The software version of the discovery agent that is retrieving the cluster details.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
           },
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
+            "type": "string",
+            "format": "uuid",
+            "description": "If downloading a file related to a host, the relevant host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/presigned"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -17863,39 +21960,53 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes an OpenShift cluster definition.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/install-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster's install config YAML.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DeregisterCluster",
+        "operationId": "v2GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be deregistered.",
+            "description": "The cluster whose install config is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -17921,12 +22032,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -17936,36 +22041,33 @@ func init() {
         }
       },
       "patch": {
-        "description": "Updates an OpenShift cluster definition.",
+        "description": "Override values in the install config.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UpdateCluster",
+        "operationId": "v2UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be updated.",
+            "description": "The cluster whose install config is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "cluster-update-params",
+            "description": "Install config overrides.",
+            "name": "install-config-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/v2-cluster-update-params"
+              "type": "string"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -17997,12 +22099,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -18012,28 +22108,52 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/cancel": {
+    "/v2/clusters/{cluster_id}/install-config/validate": {
       "post": {
-        "description": "Cancels an ongoing installation.",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Validate install config overrides against the install config schema of the OpenShift version of the cluster, and preview the changes they make to the install config. The overrides are not saved.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2CancelInstallation",
+        "operationId": "v2ValidateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be canceled.",
+            "description": "The cluster whose install config overrides are validated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Install config overrides.",
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/install-config-overrides-validation"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -18060,12 +22180,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -18075,48 +22189,61 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/complete-installation": {
-      "post": {
+    "/v2/clusters/{cluster_id}/logs": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Agent API to mark a finalizing installation as complete.",
+        "description": "Download cluster logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2CompleteInstallation",
+        "operationId": "V2DownloadClusterLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is being completing.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The final status of the cluster installation.",
-            "name": "completion-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/completion-params"
-            }
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is completing the installation.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "format": "uuid",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "file"
             }
           },
           "401": {
@@ -18154,108 +22281,69 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/install": {
+      },
       "post": {
-        "description": "Installs the OpenShift cluster.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2InstallCluster",
+        "operationId": "V2UploadLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be installed.",
+            "description": "The cluster whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          {
+            "maxLength": 104857600,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
           },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/reset": {
-      "post": {
-        "description": "Resets a failed installation.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2ResetCluster",
-        "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "description": "The infra-env ID of the host.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -18292,40 +22380,50 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/credentials": {
-      "get": {
+    "/v2/clusters/{cluster_id}/logs-progress": {
+      "put": {
         "security": [
           {
-            "userAuth": [
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Get the cluster admin credentials.",
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetCredentials",
+        "operationId": "v2UpdateClusterLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/credentials"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -18362,60 +22460,43 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/credentials": {
+    "/v2/clusters/{cluster_id}/manifests": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "agentAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Downloads credentials relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Lists manifests for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2DownloadClusterCredentials",
+        "operationId": "V2ListClusterManifests",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the credential file that should be downloaded.",
+            "description": "The cluster for which the manifests should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
-            ],
-            "type": "string",
-            "description": "The credential file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/list-manifests"
             }
           },
           "401": {
@@ -18453,57 +22534,44 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "user"
-            ]
+            "userAuth": []
           }
         ],
-        "description": "Get the cluster admin credentials.",
+        "description": "Creates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2GetPresignedForClusterCredentials",
+        "operationId": "V2CreateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster for which a new manifest should be created.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned"
+              "$ref": "#/definitions/manifest"
             }
           },
           "400": {
@@ -18549,71 +22617,44 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/downloads/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads files relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2DownloadClusterFiles",
+        "operationId": "V2DeleteClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose manifest should be deleted.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "custom_manifests.json",
-              "custom_manifests.yaml"
+              "manifests",
+              "openshift"
             ],
             "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "The manifest file name to delete from the cluster.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -18650,93 +22691,58 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
+    "/v2/clusters/{cluster_id}/manifests/files": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "userAuth": []
           }
         ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
-        "tags": [
-          "installer"
+        "description": "Downloads cluster manifest.",
+        "produces": [
+          "application/octet-stream"
         ],
-        "operationId": "V2GetPresignedForClusterFiles",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
+        "tags": [
+          "manifests"
+        ],
+        "operationId": "v2DownloadClusterManifest",
+        "parameters": [
+          {
             "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The cluster whose manifest should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
             "required": true
           },
           {
             "enum": [
-              "host",
-              "controller",
-              "all"
+              "manifests",
+              "openshift"
             ],
             "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
-            "name": "host_id",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -18778,37 +22784,40 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/install-config": {
+    "/v2/clusters/{cluster_id}/monitored-operators": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Get the cluster's install config YAML.",
+        "description": "Lists operators to be monitored for a cluster.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "v2GetClusterInstallConfig",
+        "operationId": "V2ListOfClusterOperators",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/monitored-operators-list"
             }
           },
           "401": {
@@ -18843,37 +22852,43 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Override values in the install config.",
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Controller API to report of monitored operators.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "v2UpdateClusterInstallConfig",
+        "operationId": "v2ReportMonitoredOperatorStatus",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
+            "description": "The cluster whose operators are being monitored.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
+            "description": "The operators monitor report.",
+            "name": "report-params",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/operator-monitor-report"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success."
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -18902,16 +22917,28 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs": {
+    "/v2/clusters/{cluster_id}/preflight-requirements": {
       "get": {
         "security": [
           {
@@ -18920,52 +22947,28 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Download cluster logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Get preflight requirements for a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2DownloadClusterLogs",
+        "operationId": "v2GetPreflightRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
+            "description": "The cluster to return preflight requrements for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
-            "name": "host_id",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/preflight-hardware-requirements"
             }
           },
           "401": {
@@ -18992,12 +22995,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -19005,67 +23002,43 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v2/clusters/{cluster_id}/supported-platforms": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "A list of platforms that this cluster can support in its current configuration.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UploadLogs",
+        "operationId": "GetClusterSupportedPlatforms",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster whose platform types should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "maxLength": 104857600,
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "enum": [
-              "host",
-              "controller"
-            ],
-            "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env ID of the host.",
-            "name": "infra_env_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/platform_type"
+              }
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -19085,67 +23058,61 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs-progress": {
-      "put": {
+    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Transfer the ingress certificate for the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterLogsProgress",
+        "operationId": "v2UploadClusterIngressCert",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster to associate with the ingress certificate.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "$ref": "#/definitions/ingress-cert-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -19171,12 +23138,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -19192,63 +23153,53 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/manifests": {
+    "/v2/component-versions": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists manifests for customizing cluster installation.",
+        "description": "List of component versions.",
         "tags": [
-          "manifests"
+          "versions"
         ],
-        "operationId": "V2ListClusterManifests",
-        "parameters": [
+        "operationId": "v2ListComponentVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/v2/domains": {
+      "get": {
+        "security": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
+        "description": "List of managed DNS domains.",
+        "tags": [
+          "managed_domains"
+        ],
+        "operationId": "V2ListManagedDomains",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-manifests"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/list-managed-domains"
             }
           },
           "500": {
@@ -19258,48 +23209,139 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/v2/events": {
+      "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Creates a manifest for customizing cluster installation.",
+        "description": "Lists events for a cluster.",
         "tags": [
-          "manifests"
+          "events"
         ],
-        "operationId": "V2CreateClusterManifest",
+        "operationId": "v2ListEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The cluster to return events for.",
             "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           },
           {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/create-manifest-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "A host in the specified cluster to return events for.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to return events for.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of event severities.",
+            "name": "severities",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event names.",
+            "name": "names",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Return only events that occurred at or after this time.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Return only events that occurred at or before this time.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Return only events whose message contains this text, ignoring case.",
+            "name": "message",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ascending",
+              "descending"
+            ],
+            "type": "string",
+            "description": "The order by which the events are sorted according to their time.",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "maximum": 5000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximal number of events to return. All matching events are returned if not set.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "The number of matching events to skip before starting to return events.",
+            "name": "offset",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/event-list"
+            },
+            "headers": {
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "The total number of events matching the filters."
+              }
             }
           },
           "401": {
@@ -19326,12 +23368,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -19339,44 +23375,87 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
+      }
+    },
+    "/v2/events/stream": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Streams the events and the installation progress updates of a cluster, host or infra-env as Server-Sent Events.\nEvents are sent with the 'event' type and carry their sequence number as the SSE id, so a client that reconnects\nwith the Last-Event-ID header first receives the events it missed. Progress updates are sent with the\n'cluster-progress' and 'host-progress' types and are not replayed.\n",
+        "produces": [
+          "text/event-stream"
+        ],
         "tags": [
-          "manifests"
+          "events"
         ],
-        "operationId": "V2DeleteClusterManifest",
+        "operationId": "v2StreamEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
+            "description": "The cluster to stream events for.",
             "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           },
           {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
             "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
+            "format": "uuid",
+            "description": "A host to stream events for.",
+            "name": "host_id",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "format": "uuid",
+            "description": "The infra-env to stream events for.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "A comma-separated list of event severities.",
+            "name": "severities",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "The id of the last event received by the client. The events that followed it are sent before any live update.",
+            "name": "Last-Event-ID",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -19396,20 +23475,52 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/feature-support-levels": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the support levels for features for each OpenShift version.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListFeatureSupportLevels",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/feature-support-levels"
+            }
           },
-          "409": {
-            "description": "Error.",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -19417,46 +23528,43 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/manifests/files": {
+    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
           }
         ],
-        "description": "Downloads cluster manifest.",
+        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "v2DownloadClusterManifest",
+        "operationId": "v2DownloadHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
-            "name": "cluster_id",
+            "description": "The infra-env of the host whose ignition file should be downloaded.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           },
-          {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
           {
             "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The host whose ignition file should be downloaded.",
+            "name": "host_id",
+            "in": "path",
             "required": true
           }
         ],
@@ -19502,36 +23610,38 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/monitored-operators": {
+    "/v2/infra-envs": {
       "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists operators to be monitored for a cluster.",
+        "description": "Retrieves the list of infra-envs.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "V2ListOfClusterOperators",
+        "operationId": "ListInfraEnvs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "If provided, returns only infra-envs which directly reference this cluster.",
             "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
             "in": "query"
           }
         ],
@@ -19539,7 +23649,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "$ref": "#/definitions/infra-env-list"
             }
           },
           "401": {
@@ -19554,63 +23664,58 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Controller API to report of monitored operators.",
+      "post": {
+        "description": "Creates a new OpenShift Discovery ISO.",
         "tags": [
-          "operators",
           "installer"
         ],
-        "operationId": "v2ReportMonitoredOperatorStatus",
+        "operationId": "RegisterInfraEnv",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The operators monitor report.",
-            "name": "report-params",
+            "description": "The parameters for the generated ISO.",
+            "name": "infraenv-create-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
+              "$ref": "#/definitions/infra-env-create-params"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/infra-env"
+            }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -19651,8 +23756,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -19660,7 +23765,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/preflight-requirements": {
+    "/v2/infra-envs/{infra_env_id}": {
       "get": {
         "security": [
           {
@@ -19669,19 +23774,22 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
           }
         ],
-        "description": "Get preflight requirements for a cluster.",
+        "description": "Retrieves the details of the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetPreflightRequirements",
+        "operationId": "GetInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return preflight requrements for.",
-            "name": "cluster_id",
+            "description": "The infra-env to be retrieved.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           }
@@ -19690,7 +23798,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
+              "$ref": "#/definitions/infra-env"
             }
           },
           "401": {
@@ -19722,45 +23830,40 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/supported-platforms": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "A list of platforms that this cluster can support in its current configuration.",
+      },
+      "delete": {
+        "description": "Deletes an infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterSupportedPlatforms",
+        "operationId": "DeregisterInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose platform types should be retrieved.",
-            "name": "cluster_id",
+            "description": "The infra-env to be deleted.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/platform_type"
-              }
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -19774,7 +23877,19 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -19785,50 +23900,46 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Transfer the ingress certificate for the cluster.",
+      },
+      "patch": {
+        "description": "Updates an infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UploadClusterIngressCert",
+        "operationId": "UpdateInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
-            "name": "cluster_id",
+            "description": "The infra-env to be updated.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
+            "description": "The properties to update.",
+            "name": "infra-env-update-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
+              "$ref": "#/definitions/infra-env-update-params"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "201": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/infra-env"
+            }
           },
           "400": {
             "description": "Error.",
@@ -19860,72 +23971,20 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/component-versions": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "List of component versions.",
-        "tags": [
-          "versions"
-        ],
-        "operationId": "v2ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
-          }
-        }
-      }
-    },
-    "/v2/domains": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "List of managed DNS domains.",
-        "tags": [
-          "managed_domains"
-        ],
-        "operationId": "V2ListManagedDomains",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-managed-domains"
-            }
           },
-          "500": {
-            "description": "Error.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -19933,7 +23992,7 @@ func init() {
         }
       }
     },
-    "/v2/events": {
+    "/v2/infra-envs/{infra_env_id}/downloads/files": {
       "get": {
         "security": [
           {
@@ -19943,52 +24002,53 @@ func init() {
               "user"
             ]
           },
+          {
+            "agentAuth": []
+          },
           {
             "urlAuth": []
+          },
+          {
+            "imageAuth": []
           }
         ],
-        "description": "Lists events for a cluster.",
+        "description": "Downloads the customized ignition file for this host, or the artifacts for booting the discovery image\nover the network. The initrd.img has the discovery ignition embedded, and the ipxe-script boots the\nvmlinuz, initrd.img and rootfs.img of this infra-env.\n",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "v2ListEvents",
+        "operationId": "v2DownloadInfraEnvFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
-            "name": "cluster_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A host in the specified cluster to return events for.",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env to return events for.",
+            "description": "The infra-env whose file should be downloaded.",
             "name": "infra_env_id",
-            "in": "query"
+            "in": "path",
+            "required": true
           },
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
-            "in": "query"
+            "enum": [
+              "discovery.ign",
+              "vmlinuz",
+              "initrd.img",
+              "rootfs.img",
+              "ipxe-script"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/event-list"
+              "type": "file"
             }
           },
           "401": {
@@ -20015,36 +24075,61 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/feature-support-levels": {
+    "/v2/infra-envs/{infra_env_id}/downloads/image-url": {
       "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the support levels for features for each OpenShift version.",
+        "description": "Creates a new pre-signed image download URL for the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ListFeatureSupportLevels",
+        "operationId": "GetInfraEnvDownloadURL",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to be retrieved.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/feature-support-levels"
+              "$ref": "#/definitions/infra-env-image-url"
+            }
+          },
+          "400": {
+            "description": "Bad Request.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -20059,6 +24144,30 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "503": {
             "description": "Unavailable.",
             "schema": {
@@ -20068,7 +24177,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
+    "/v2/infra-envs/{infra_env_id}/downloads/minimal-initrd": {
       "get": {
         "security": [
           {
@@ -20079,42 +24188,41 @@ func init() {
             ]
           },
           {
-            "agentAuth": []
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
+        "description": "Get the initial ramdisk for minimal ISO based installations.\n",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2DownloadHostIgnition",
+        "operationId": "DownloadMinimalInitrd",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be downloaded.",
+            "description": "The infra-env of the host that should be retrieved.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "type": "string",
+              "format": "binary"
             }
           },
+          "204": {
+            "description": "Empty Success."
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -20140,7 +24248,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Error.",
+            "description": "Conflict.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -20150,17 +24258,11 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs": {
+    "/v2/infra-envs/{infra_env_id}/hosts": {
       "get": {
         "security": [
           {
@@ -20169,19 +24271,79 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
           }
         ],
-        "description": "Retrieves the list of infra-envs.",
+        "description": "Retrieves the list of OpenShift hosts that belong the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "ListInfraEnvs",
+        "operationId": "v2ListHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "If provided, returns only infra-envs which directly reference this cluster.",
-            "name": "cluster_id",
+            "description": "The infra-env that the hosts are asociated with.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned Hosts are filtered to those in one of the given statuses.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "auto-assign",
+              "master",
+              "worker",
+              "bootstrap"
+            ],
+            "type": "string",
+            "description": "If set, returned Hosts are filtered to those with the given role.",
+            "name": "role",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "created_at",
+              "updated_at",
+              "status"
+            ],
+            "type": "string",
+            "description": "The field used to sort the returned Hosts.",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ascending",
+              "descending"
+            ],
+            "type": "string",
+            "description": "The direction in which the returned Hosts are sorted.",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximal number of Hosts to return. All matching Hosts are returned if not set.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The token returned in the X-Continue header of a previous response, used to retrieve the next page.",
+            "name": "continue",
             "in": "query"
           }
         ],
@@ -20189,7 +24351,17 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env-list"
+              "$ref": "#/definitions/host-list"
+            },
+            "headers": {
+              "X-Continue": {
+                "type": "string",
+                "description": "A token to retrieve the next page, empty if this is the last page."
+              },
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "The total number of Hosts matching the filters."
+              }
             }
           },
           "401": {
@@ -20231,27 +24403,46 @@ func init() {
         }
       },
       "post": {
-        "description": "Creates a new OpenShift Discovery ISO.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Registers a new OpenShift agent.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterInfraEnv",
+        "operationId": "v2RegisterHost",
         "parameters": [
           {
-            "description": "The parameters for the generated ISO.",
-            "name": "infraenv-create-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env that the agent is associated with.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The description of the agent being registered.",
+            "name": "new-host-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/infra-env-create-params"
+              "$ref": "#/definitions/host-create-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is registering the agent.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/host_registration_response"
             }
           },
           "400": {
@@ -20285,7 +24476,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Error.",
+            "description": "Cluster cannot accept new agents due to its current state.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -20301,11 +24492,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}": {
       "get": {
         "security": [
           {
@@ -20314,31 +24511,36 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
           }
         ],
-        "description": "Retrieves the details of the infra-env.",
+        "description": "Retrieves the details of the OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetInfraEnv",
+        "operationId": "v2GetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be retrieved.",
+            "description": "The infra-env of the host that should be retrieved.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be retrieved.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -20376,35 +24578,43 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
       "delete": {
-        "description": "Deletes an infra-env.",
+        "description": "Deregisters an OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterInfraEnv",
+        "operationId": "v2DeregisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be deleted.",
+            "description": "The infra-env of the host that should be deregistered.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be deregistered.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "204": {
             "description": "Success."
           },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -20429,48 +24639,44 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
       "patch": {
-        "description": "Updates an infra-env.",
+        "description": "Update an Openshift host",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateInfraEnv",
+        "operationId": "v2UpdateHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be updated.",
+            "description": "The infra-env ID of the host to be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
           {
             "description": "The properties to update.",
-            "name": "infra-env-update-params",
+            "name": "host-update-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/infra-env-update-params"
+              "$ref": "#/definitions/host-update-params"
             }
           }
         ],
@@ -20478,7 +24684,7 @@ func init() {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
@@ -20522,69 +24728,55 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          }
-        ],
-        "description": "Downloads the customized ignition file for this host",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/bind": {
+      "post": {
+        "description": "Bind host to a cluster",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DownloadInfraEnvFiles",
+        "operationId": "BindHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env whose file should be downloaded.",
+            "description": "The infra-env of the host that is being bound.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "discovery.ign"
-            ],
             "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The host that is being bound.",
+            "name": "host_id",
+            "in": "path",
             "required": true
+          },
+          {
+            "description": "The parameters for the host binding.",
+            "name": "bind-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bind-host-params"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -20606,13 +24798,7 @@ func init() {
             }
           },
           "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -20638,34 +24824,36 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/image-url": {
-      "get": {
-        "description": "Creates a new pre-signed image download URL for the infra-env.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install": {
+      "post": {
+        "description": "install specific host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetInfraEnvDownloadURL",
+        "operationId": "v2InstallHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be retrieved.",
+            "description": "The infra-env of the host that is being installed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being installed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env-image-url"
-            }
-          },
-          "400": {
-            "description": "Bad Request.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -20686,8 +24874,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -20697,68 +24885,42 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/minimal-initrd": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          }
-        ],
-        "description": "Get the initial ramdisk for minimal ISO based installations.\n",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
+      "post": {
+        "description": "reset a failed host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadMinimalInitrd",
+        "operationId": "v2ResetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be retrieved.",
+            "description": "The infra-env of the host that is being reset.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/host"
             }
           },
-          "204": {
-            "description": "Empty Success."
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -20777,14 +24939,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
-            "description": "Conflict.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -20798,40 +24954,50 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Retrieves the list of OpenShift hosts that belong the infra-env.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
+      "patch": {
+        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ListHosts",
+        "summary": "Reset failed host validation.",
+        "operationId": "v2ResetHostValidation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env that the hosts are asociated with.",
+            "description": "The infra-env of the host that its validation is being reset.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that its validation is being reset.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -20846,73 +25012,57 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
       "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Registers a new OpenShift agent.",
+        "description": "Unbind host to a cluster",
         "tags": [
           "installer"
         ],
-        "operationId": "v2RegisterHost",
+        "operationId": "UnbindHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env that the agent is associated with.",
+            "description": "The infra-env of the host that is being bound.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
-          {
-            "description": "The description of the agent being registered.",
-            "name": "new-host-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-create-params"
-            }
-          },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is registering the agent.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "format": "uuid",
+            "description": "The host that is being bound.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host_registration_response"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
@@ -20946,7 +25096,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Cluster cannot accept new agents due to its current state.",
+            "description": "Conflict.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -20972,27 +25122,18 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
       "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the details of the OpenShift host.",
+        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetHost",
+        "operationId": "v2GetHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be retrieved.",
+            "description": "The infra-env of the host whose ignition file should be obtained.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -21000,7 +25141,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be retrieved.",
+            "description": "The host whose ignition file should be obtained.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -21010,7 +25151,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/host-ignition-params"
             }
           },
           "401": {
@@ -21048,20 +25189,26 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       },
-      "delete": {
-        "description": "Deregisters an OpenShift host.",
+      "patch": {
+        "description": "Patch the ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DeregisterHost",
+        "operationId": "v2UpdateHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be deregistered.",
+            "description": "The infra-env of the host whose ignition file should be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -21069,14 +25216,23 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be deregistered.",
+            "description": "The host whose ignition file should be updated.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
+            }
           }
         ],
         "responses": {
-          "204": {
+          "201": {
             "description": "Success."
           },
           "400": {
@@ -21114,20 +25270,28 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      },
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
       "patch": {
-        "description": "Update an Openshift host",
+        "description": "Updates a host's installer arguments.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHost",
+        "operationId": "v2UpdateHostInstallerArgs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env ID of the host to be updated.",
+            "description": "The infra-env of the host whose installer arguments should be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -21135,18 +25299,18 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be updated.",
+            "description": "The host whose installer arguments should be updated.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "host-update-params",
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-update-params"
+              "$ref": "#/definitions/installer-args-params"
             }
           }
         ],
@@ -21198,22 +25362,33 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/bind": {
-      "post": {
-        "description": "Bind host to a cluster",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
+      "get": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the next operations that the host agent needs to perform.",
         "tags": [
           "installer"
         ],
-        "operationId": "BindHost",
+        "operationId": "v2GetNextSteps",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being bound.",
+            "description": "The infra-env of the host that is retrieving instructions.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -21221,32 +25396,23 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being bound.",
+            "description": "The host that is retrieving instructions.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The parameters for the host binding.",
-            "name": "bind-host-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bind-host-params"
-            }
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving instructions.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/steps"
             }
           },
           "401": {
@@ -21292,20 +25458,29 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install": {
+      },
       "post": {
-        "description": "install specific host for day2 cluster.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Posts the result of the operations from the host agent.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2InstallHost",
+        "operationId": "v2PostStepReply",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is posting results.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being installed.",
+            "description": "The infra-env of the host that is posting results.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -21313,17 +25488,28 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being installed.",
+            "description": "The host that is posting results.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The results to be posted.",
+            "name": "reply",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/step-reply"
+            }
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -21344,8 +25530,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -21355,22 +25541,34 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
-      "post": {
-        "description": "reset a failed host for day2 cluster.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/inventory-history": {
+      "get": {
+        "description": "Retrieves the snapshots of the inventory of the host, a snapshot being recorded every time the hardware reported by the host changes.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ResetHost",
+        "operationId": "v2ListHostInventoryHistory",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being reset.",
+            "description": "The infra-env of the host.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -21378,7 +25576,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being reset.",
+            "description": "The host whose inventory history should be retrieved.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -21388,7 +25586,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/host-inventory-snapshot-list"
             }
           },
           "401": {
@@ -21409,8 +25607,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -21424,19 +25622,18 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/inventory-history/diff": {
+      "get": {
+        "description": "Compares two snapshots of the inventory of the host.",
         "tags": [
           "installer"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "v2ResetHostValidation",
+        "operationId": "v2GetHostInventoryDiff",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that its validation is being reset.",
+            "description": "The infra-env of the host.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -21444,16 +25641,25 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that its validation is being reset.",
+            "description": "The host whose inventory snapshots should be compared.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
+            "format": "uuid",
+            "description": "The snapshot to compare from.",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The snapshot to compare to.",
+            "name": "to",
+            "in": "query",
             "required": true
           }
         ],
@@ -21461,13 +25667,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Bad Request",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host-inventory-diff"
             }
           },
           "401": {
@@ -21488,8 +25688,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -21503,18 +25703,23 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
-      "post": {
-        "description": "Unbind host to a cluster",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "UnbindHost",
+        "operationId": "v2UpdateHostLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being bound.",
+            "description": "The infra-env whose log progress is being updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -21522,24 +25727,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being bound.",
+            "description": "The host whose log progress is being updated.",
             "name": "host_id",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
           },
-          "400": {
-            "description": "Error.",
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/logs-progress-params"
             }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -21566,7 +25771,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Conflict.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -21592,18 +25797,29 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
-      "get": {
-        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update installation progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetHostIgnition",
+        "operationId": "v2UpdateHostInstallProgress",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be obtained.",
+            "description": "The infra-env of the host being updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -21611,18 +25827,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be obtained.",
+            "description": "The ID of the host to update.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "New progress value.",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host-ignition-params"
-            }
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -21654,12 +25876,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "503": {
             "description": "Unavailable.",
             "schema": {
@@ -21667,50 +25883,29 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Patch the ignition file for this host",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
+      "post": {
+        "description": "Regenerate InfraEnv token signing key.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostIgnition",
+        "operationId": "RegenerateInfraEnvSigningKey",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be updated.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
-            "name": "host_id",
+            "format": "uuid",
+            "description": "The target InfraEnv.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-ignition-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "204": {
             "description": "Success."
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -21740,47 +25935,33 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+    "/v2/infra-envs/{infra_env_id}/static-network-config-template": {
+      "post": {
+        "description": "Renders the static network configuration of the hosts of the infra-env from a template and a table of per-host values, validates it and stores it in the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostInstallerArgs",
+        "operationId": "ApplyStaticNetworkConfigTemplate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose installer arguments should be updated.",
+            "description": "The infra-env to be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
+            "description": "The template and the per-host values to render it with.",
+            "name": "static-network-config-template",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/installer-args-params"
+              "$ref": "#/definitions/static-network-config-template"
             }
           }
         ],
@@ -21788,13 +25969,13 @@ func init() {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/infra-env"
             }
           },
           "400": {
             "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/static-network-config-template-error"
             }
           },
           "401": {
@@ -21832,9 +26013,35 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/v2/openshift-versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift supported versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListSupportedOpenshiftVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/openshift-versions"
+            }
           },
-          "501": {
-            "description": "Not implemented.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -21842,47 +26049,47 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
+    "/v2/role-bindings": {
       "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
           }
         ],
-        "description": "Retrieves the next operations that the host agent needs to perform.",
+        "description": "Lists the roles bound to the users when local role-based access control is enabled.",
         "tags": [
-          "installer"
+          "role_bindings"
         ],
-        "operationId": "v2GetNextSteps",
+        "operationId": "v2ListRoleBindings",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host that is retrieving instructions.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "description": "Return only the roles bound to this user.",
+            "name": "user_name",
+            "in": "query"
           },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is retrieving instructions.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "Return only the roles bound on this cluster.",
+            "name": "cluster_id",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is retrieving instructions.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "Return only the roles bound on this organization.",
+            "name": "org_id",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "$ref": "#/definitions/role-binding-list"
             }
           },
           "401": {
@@ -21897,84 +26104,43 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
       "post": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin"
+            ]
           }
         ],
-        "description": "Posts the result of the operations from the host agent.",
+        "description": "Binds a role to a user, on all the clusters and infra-envs, on those of an organization or on a single cluster.",
         "tags": [
-          "installer"
+          "role_bindings"
         ],
-        "operationId": "v2PostStepReply",
+        "operationId": "v2CreateRoleBinding",
         "parameters": [
           {
-            "type": "string",
-            "description": "The software version of the discovery agent that is posting results.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host that is posting results.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is posting results.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The results to be posted.",
-            "name": "reply",
+            "name": "new-role-binding-params",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/step-reply"
+              "$ref": "#/definitions/role-binding-create-params"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/role-binding"
+            }
           },
           "400": {
             "description": "Error.",
@@ -21994,81 +26160,86 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
-      "put": {
+    "/v2/role-bindings/{role_binding_id}": {
+      "delete": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin"
+            ]
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Removes a role from a user.",
         "tags": [
-          "installer"
+          "role_bindings"
         ],
-        "operationId": "v2UpdateHostLogsProgress",
+        "operationId": "v2DeleteRoleBinding",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env whose log progress is being updated.",
-            "name": "infra_env_id",
+            "description": "The role binding to be deleted.",
+            "name": "role_binding_id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
           },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose log progress is being updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
           },
-          {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
+          "403": {
+            "description": "Forbidden.",
             "schema": {
-              "$ref": "#/definitions/logs-progress-params"
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
+        }
+      }
+    },
+    "/v2/supported-operators": {
+      "get": {
+        "description": "Retrieves the list of supported operators.",
+        "tags": [
+          "operators"
         ],
+        "operationId": "V2ListSupportedOperators",
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -22082,93 +26253,46 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
-      "put": {
+    "/v2/supported-operators/{operator_name}": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Update installation progress.",
+        "description": "Lists properties for an operator.",
         "tags": [
-          "installer"
+          "operators"
         ],
-        "operationId": "v2UpdateHostInstallProgress",
+        "operationId": "V2ListOperatorProperties",
         "parameters": [
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host being updated.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The ID of the host to update.",
-            "name": "host_id",
+            "description": "The operator name.",
+            "name": "operator_name",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "New progress value.",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/operator-properties"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -22188,47 +26312,37 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
-      "post": {
-        "description": "Regenerate InfraEnv token signing key.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "RegenerateInfraEnvSigningKey",
-        "parameters": [
+    "/v2/webhooks": {
+      "get": {
+        "security": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The target InfraEnv.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
+        "description": "Lists the webhook subscriptions of the user.",
+        "tags": [
+          "webhooks"
+        ],
+        "operationId": "v2ListWebhooks",
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-list"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -22242,18 +26356,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -22261,33 +26363,59 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/openshift-versions": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
             "userAuth": [
               "admin",
-              "read-only-admin",
               "user"
             ]
           }
         ],
-        "description": "Retrieves the list of OpenShift supported versions.",
+        "description": "Subscribes an HTTP endpoint to the events of the clusters, hosts and infra-envs owned by the user.",
         "tags": [
-          "versions"
+          "webhooks"
+        ],
+        "operationId": "v2RegisterWebhook",
+        "parameters": [
+          {
+            "description": "The properties describing the new webhook subscription.",
+            "name": "new-webhook-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook-create-params"
+            }
+          }
         ],
-        "operationId": "v2ListSupportedOpenshiftVersions",
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/openshift-versions"
+              "$ref": "#/definitions/webhook"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -22295,22 +26423,34 @@ func init() {
         }
       }
     },
-    "/v2/supported-operators": {
-      "get": {
-        "description": "Retrieves the list of supported operators.",
+    "/v2/webhooks/{webhook_id}": {
+      "delete": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Deletes a webhook subscription.",
         "tags": [
-          "operators"
+          "webhooks"
+        ],
+        "operationId": "v2DeregisterWebhook",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The webhook subscription to be deleted.",
+            "name": "webhook_id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "operationId": "V2ListSupportedOperators",
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -22324,6 +26464,12 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -22333,7 +26479,7 @@ func init() {
         }
       }
     },
-    "/v2/supported-operators/{operator_name}": {
+    "/v2/webhooks/{webhook_id}/deliveries": {
       "get": {
         "security": [
           {
@@ -22344,25 +26490,37 @@ func init() {
             ]
           }
         ],
-        "description": "Lists properties for an operator.",
+        "description": "Lists the delivery history of a webhook subscription.",
         "tags": [
-          "operators"
+          "webhooks"
         ],
-        "operationId": "V2ListOperatorProperties",
+        "operationId": "v2ListWebhookDeliveries",
         "parameters": [
           {
             "type": "string",
-            "description": "The operator name.",
-            "name": "operator_name",
+            "format": "uuid",
+            "description": "The webhook subscription whose deliveries should be listed.",
+            "name": "webhook_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "pending",
+              "delivered",
+              "failed"
+            ],
+            "type": "string",
+            "description": "If set, returned deliveries are filtered to those with the given status.",
+            "name": "status",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/operator-properties"
+              "$ref": "#/definitions/webhook-delivery-list"
             }
           },
           "401": {
@@ -22574,6 +26732,47 @@ func init() {
         }
       }
     },
+    "TangConnectivityRequestTangServersItems0": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "thumbprint": {
+          "description": "The expected thumbprint of the signing key of the Tang server.",
+          "type": "string"
+        },
+        "url": {
+          "description": "The URL of the Tang server.",
+          "type": "string"
+        }
+      },
+      "x-go-name": "TangConnectivityRequestServer"
+    },
+    "TangConnectivityResponseTangServersItems0": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "error": {
+          "description": "The reason the advertisement could not be fetched, empty on success.",
+          "type": "string"
+        },
+        "thumbprints": {
+          "description": "The thumbprints of the signing keys of the advertisement fetched from the Tang server.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "url": {
+          "description": "The URL of the Tang server.",
+          "type": "string"
+        }
+      },
+      "x-go-name": "TangConnectivityResponseServer"
+    },
     "add-hosts-cluster-create-params": {
       "type": "object",
       "required": [
@@ -22641,6 +26840,116 @@ func init() {
         }
       }
     },
+    "audit-record": {
+      "type": "object",
+      "required": [
+        "id",
+        "operation_id",
+        "method",
+        "path",
+        "status_code",
+        "result"
+      ],
+      "properties": {
+        "auth_type": {
+          "description": "The authentication type of the service when the call was made.",
+          "type": "string"
+        },
+        "changes": {
+          "description": "The JSON body of the request, with the values of secrets such as the pull secret redacted.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "cluster_id": {
+          "description": "The cluster targeted by the call.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "created_at": {
+          "description": "The time the call was made.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "error": {
+          "description": "The reason the call failed.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "host_id": {
+          "description": "The host targeted by the call.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env targeted by the call.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "method": {
+          "type": "string"
+        },
+        "operation_id": {
+          "description": "The API operation that was called, for example v2UpdateCluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "org_id": {
+          "description": "The organization of the user that made the call.",
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "request_id": {
+          "description": "Unique identifier of the request, shared with the events it caused.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "result": {
+          "type": "string",
+          "enum": [
+            "succeeded",
+            "failed"
+          ]
+        },
+        "status_code": {
+          "description": "The HTTP status code of the response.",
+          "type": "integer"
+        },
+        "user_name": {
+          "description": "The user that made the call.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "audit-record-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/audit-record"
+      }
+    },
     "bind-host-params": {
       "required": [
         "cluster_id"
@@ -22786,6 +27095,11 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "fips": {
+          "description": "Install the cluster in FIPS mode. Requires a release and a CPU architecture that support FIPS.",
+          "type": "boolean",
+          "default": false
+        },
         "high_availability_mode": {
           "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
           "type": "string",
@@ -22963,6 +27277,14 @@ func init() {
           "format": "int64",
           "x-go-custom-tag": "gorm:\"-\""
         },
+        "role_assignment_strategy": {
+          "description": "Strategy used to assign roles to the hosts whose role is auto-assign. The first-fit strategy selects the first hosts that meet the control plane requirements, the scoring strategy selects the best hosts according to their hardware and failure domains.",
+          "type": "string",
+          "enum": [
+            "first-fit",
+            "scoring"
+          ]
+        },
         "schedulable_masters": {
           "description": "Schedule workloads on masters",
           "type": "boolean",
@@ -23055,6 +27377,74 @@ func init() {
         }
       }
     },
+    "cluster-bundle-field-error": {
+      "type": "object",
+      "required": [
+        "field",
+        "message"
+      ],
+      "properties": {
+        "field": {
+          "description": "The path of the field in the bundle, for example cluster.api_vip or hosts[52:54:00:aa:bb:cc].role.",
+          "type": "string"
+        },
+        "message": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-bundle-import-params": {
+      "type": "object",
+      "required": [
+        "bundle",
+        "pull_secret"
+      ],
+      "properties": {
+        "bundle": {
+          "description": "The YAML cluster bundle, as exported by v2ExportClusterBundle.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Overrides the name of the cluster in the bundle.",
+          "type": "string"
+        },
+        "platform_password": {
+          "description": "The password of the oVirt or Nutanix platform of the cluster. Platform passwords are not exported, so it is required when the bundle uses one of these platforms.",
+          "type": "string",
+          "format": "password"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-bundle-validation-error": {
+      "type": "object",
+      "required": [
+        "code",
+        "reason",
+        "field_errors"
+      ],
+      "properties": {
+        "code": {
+          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error.",
+          "type": "string"
+        },
+        "field_errors": {
+          "description": "The errors of the individual fields of the bundle.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster-bundle-field-error"
+          }
+        },
+        "reason": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
     "cluster-create-params": {
       "type": "object",
       "required": [
@@ -23105,6 +27495,11 @@ func init() {
           "type": "object",
           "$ref": "#/definitions/disk-encryption"
         },
+        "fips": {
+          "description": "Install the cluster in FIPS mode. Requires a release and a CPU architecture that support FIPS.",
+          "type": "boolean",
+          "default": false
+        },
         "high_availability_mode": {
           "description": "Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
           "type": "string",
@@ -23198,6 +27593,15 @@ func init() {
           "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
           "type": "string"
         },
+        "role_assignment_strategy": {
+          "description": "Strategy used to assign roles to the hosts whose role is auto-assign. The first-fit strategy selects the first hosts that meet the control plane requirements, the scoring strategy selects the best hosts according to their hardware and failure domains.",
+          "type": "string",
+          "default": "first-fit",
+          "enum": [
+            "first-fit",
+            "scoring"
+          ]
+        },
         "schedulable_masters": {
           "description": "Schedule workloads on masters",
           "type": "boolean",
@@ -23386,6 +27790,11 @@ func init() {
           },
           "x-nullable": true
         },
+        "fips": {
+          "description": "Install the cluster in FIPS mode. Requires a release and a CPU architecture that support FIPS.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "hosts_machine_config_pool_names": {
           "description": "The desired machine config pool for hosts associated with the cluster.",
           "type": "array",
@@ -23494,6 +27903,15 @@ func init() {
           "type": "string",
           "x-nullable": true
         },
+        "role_assignment_strategy": {
+          "description": "Strategy used to assign roles to the hosts whose role is auto-assign. The first-fit strategy selects the first hosts that meet the control plane requirements, the scoring strategy selects the best hosts according to their hardware and failure domains.",
+          "type": "string",
+          "enum": [
+            "first-fit",
+            "scoring"
+          ],
+          "x-nullable": true
+        },
         "schedulable_masters": {
           "description": "Schedule workloads on masters",
           "type": "boolean",
@@ -23552,7 +27970,9 @@ func init() {
         "lso-requirements-satisfied",
         "ocs-requirements-satisfied",
         "cnv-requirements-satisfied",
-        "network-type-valid"
+        "network-type-valid",
+        "masters-spread-across-failure-domains",
+        "mtu-consistent"
       ]
     },
     "cluster_default_config": {
@@ -23643,6 +28063,10 @@ func init() {
         "mac": {
           "type": "string"
         },
+        "mtu": {
+          "description": "The MTU of the interface. The addresses of the interface are probed with packets of this size that must not\nbe fragmented, so that path MTU problems are detected.\n",
+          "type": "integer"
+        },
         "name": {
           "type": "string"
         }
@@ -23654,6 +28078,70 @@ func init() {
         "$ref": "#/definitions/connectivity-check-host"
       }
     },
+    "connectivity-matrix": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/connectivity-matrix-entry"
+      }
+    },
+    "connectivity-matrix-entry": {
+      "description": "The result of a connectivity check from a host to an address of another host.",
+      "type": "object",
+      "properties": {
+        "address_family": {
+          "type": "string",
+          "enum": [
+            "ipv4",
+            "ipv6"
+          ]
+        },
+        "average_rtt_ms": {
+          "description": "Average round trip time in milliseconds, for L3 checks.",
+          "type": "number",
+          "format": "double",
+          "x-go-name": "AverageRTTMs"
+        },
+        "layer": {
+          "type": "string",
+          "enum": [
+            "l2",
+            "l3"
+          ]
+        },
+        "outgoing_nic": {
+          "type": "string"
+        },
+        "packet_loss_percentage": {
+          "description": "Percentage of packets lost, for L3 checks.",
+          "type": "number",
+          "format": "double"
+        },
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "remote_mac": {
+          "description": "The MAC address the remote IP address was resolved to, for L2 checks.",
+          "type": "string"
+        },
+        "source_host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "source_host_name": {
+          "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
+        },
+        "target_host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "target_host_name": {
+          "type": "string"
+        }
+      }
+    },
     "connectivity-remote-host": {
       "type": "object",
       "properties": {
@@ -24095,6 +28583,38 @@ func init() {
         }
       }
     },
+    "dry-run-result": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "status"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "installer_output": {
+          "description": "The output of openshift-install, or the error that stopped the dry run.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Whether the installation files of the cluster were rendered.",
+          "type": "string",
+          "enum": [
+            "succeeded",
+            "failed"
+          ]
+        },
+        "validation_errors": {
+          "description": "The reasons the cluster is not ready for installation.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "error": {
       "type": "object",
       "required": [
@@ -24159,7 +28679,7 @@ func init() {
         "event_time": {
           "type": "string",
           "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
         },
         "host_id": {
           "description": "Unique identifier of the host this event relates to.",
@@ -24181,7 +28701,8 @@ func init() {
         },
         "name": {
           "description": "Event Name.",
-          "type": "string"
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
         },
         "props": {
           "description": "Additional properties for the event in JSON format.",
@@ -24200,7 +28721,8 @@ func init() {
             "warning",
             "error",
             "critical"
-          ]
+          ],
+          "x-go-custom-tag": "gorm:\"index\""
         }
       }
     },
@@ -24210,6 +28732,33 @@ func init() {
         "$ref": "#/definitions/event"
       }
     },
+    "failure-domain": {
+      "type": "object",
+      "properties": {
+        "chassis": {
+          "description": "The chassis the host is installed in.",
+          "type": "string"
+        },
+        "power_domain": {
+          "description": "The power domain (PDU or power feed) the host is connected to.",
+          "type": "string"
+        },
+        "rack": {
+          "description": "The rack the host is installed in.",
+          "type": "string"
+        },
+        "source": {
+          "description": "Whether the failure domain was set by the user or derived from the LLDP neighbors of the host.",
+          "type": "string",
+          "enum": [
+            "user",
+            "lldp"
+          ],
+          "readOnly": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:failure_domain_\""
+    },
     "feature-support-level": {
       "type": "object",
       "properties": {
@@ -24371,6 +28920,10 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "failure_domain": {
+          "description": "The failure domain of the host, used to spread the control plane nodes.",
+          "$ref": "#/definitions/failure-domain"
+        },
         "free_addresses": {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
@@ -24426,6 +28979,17 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "inventory_drift": {
+          "description": "JSON-formatted list of the critical changes of the inventory of the host since the user last reviewed the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "inventory_reviewed_snapshot_id": {
+          "description": "The snapshot of the inventory of the host at the time the user last reviewed the host.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
         "kind": {
           "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
           "type": "string",
@@ -24528,6 +29092,15 @@ func init() {
         "suggested_role": {
           "$ref": "#/definitions/host-role"
         },
+        "tang_connectivity": {
+          "description": "The result of fetching the advertisements of the Tang servers of the cluster from the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "tpm_ek_fingerprint": {
+          "description": "SHA-256 fingerprint of the endorsement key certificate of the host's TPM, recorded for attestation.",
+          "type": "string"
+        },
         "updated_at": {
           "type": "string",
           "format": "date-time",
@@ -24574,6 +29147,98 @@ func init() {
         }
       }
     },
+    "host-inventory-change": {
+      "type": "object",
+      "required": [
+        "attribute"
+      ],
+      "properties": {
+        "attribute": {
+          "description": "The changed attribute, for example cpu.count or disks[/dev/disk/by-id/wwn-0x5000c500a1b2c3d4].serial.",
+          "type": "string"
+        },
+        "critical": {
+          "description": "Whether the change affects the installation disk, the MAC addresses or the CPU count of the host.",
+          "type": "boolean"
+        },
+        "from": {
+          "description": "The value of the attribute in the first snapshot, empty if the attribute did not exist.",
+          "type": "string"
+        },
+        "to": {
+          "description": "The value of the attribute in the second snapshot, empty if the attribute no longer exists.",
+          "type": "string"
+        }
+      }
+    },
+    "host-inventory-diff": {
+      "type": "object",
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-inventory-change"
+          }
+        },
+        "from_snapshot_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "to_snapshot_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "host-inventory-snapshot": {
+      "type": "object",
+      "required": [
+        "id",
+        "host_id",
+        "infra_env_id"
+      ],
+      "properties": {
+        "created_at": {
+          "description": "The time the inventory was reported.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "infra_env_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "inventory": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        }
+      }
+    },
+    "host-inventory-snapshot-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host-inventory-snapshot"
+      }
+    },
     "host-list": {
       "type": "array",
       "items": {
@@ -24693,6 +29358,10 @@ func init() {
           },
           "x-nullable": true
         },
+        "failure_domain": {
+          "description": "The failure domain of the host, used to spread the control plane nodes.",
+          "$ref": "#/definitions/failure-domain"
+        },
         "host_name": {
           "type": "string",
           "x-nullable": true
@@ -24748,7 +29417,12 @@ func init() {
         "apps-domain-name-resolved-correctly",
         "compatible-with-cluster-platform",
         "dns-wildcard-not-configured",
-        "disk-encryption-requirements-satisfied"
+        "disk-encryption-requirements-satisfied",
+        "mtu-valid",
+        "tpm-valid",
+        "tang-connectivity-valid",
+        "critical-inventory-unchanged",
+        "compatible-with-fips"
       ]
     },
     "host_network": {
@@ -25194,6 +29868,46 @@ func init() {
     "ingress-cert-params": {
       "type": "string"
     },
+    "install-config-override-error": {
+      "type": "object",
+      "required": [
+        "field",
+        "message"
+      ],
+      "properties": {
+        "field": {
+          "description": "Path of the invalid field, for example controlPlane.hyperthreading.",
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "install-config-overrides-validation": {
+      "type": "object",
+      "properties": {
+        "diff": {
+          "description": "Unified diff between the generated install config and the install config with the overrides, set when the overrides are valid.",
+          "type": "string"
+        },
+        "errors": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/install-config-override-error"
+          }
+        },
+        "schema_version": {
+          "description": "The version of the install config schema the overrides were validated against.",
+          "type": "string"
+        },
+        "valid": {
+          "description": "Whether the overrides can be applied to the install config.",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
     "installer-args-params": {
       "type": "object",
       "properties": {
@@ -25222,6 +29936,10 @@ func init() {
         "client_id": {
           "type": "string"
         },
+        "controller": {
+          "description": "The name of the bond or bridge that the interface is a port of.",
+          "type": "string"
+        },
         "flags": {
           "type": "array",
           "items": {
@@ -25243,6 +29961,9 @@ func init() {
             "type": "string"
           }
         },
+        "lldp_neighbor": {
+          "$ref": "#/definitions/lldp-neighbor"
+        },
         "mac_address": {
           "type": "string"
         },
@@ -25252,14 +29973,32 @@ func init() {
         "name": {
           "type": "string"
         },
+        "parent": {
+          "description": "The name of the interface that a VLAN interface is defined on.",
+          "type": "string"
+        },
         "product": {
           "type": "string"
         },
         "speed_mbps": {
           "type": "integer"
         },
+        "type": {
+          "description": "The kind of the interface.",
+          "type": "string",
+          "enum": [
+            "physical",
+            "bond",
+            "vlan",
+            "bridge"
+          ]
+        },
         "vendor": {
           "type": "string"
+        },
+        "vlan_id": {
+          "description": "The VLAN ID of a VLAN interface.",
+          "type": "integer"
         }
       }
     },
@@ -25314,6 +30053,9 @@ func init() {
         "timestamp": {
           "type": "integer"
         },
+        "tpm": {
+          "$ref": "#/definitions/tpm"
+        },
         "tpm_version": {
           "type": "string",
           "enum": [
@@ -25362,6 +30104,14 @@ func init() {
           "format": "double",
           "x-go-name": "AverageRTTMs"
         },
+        "large_packet_size": {
+          "description": "Size in bytes of the packets sent with the don't-fragment bit set to probe the path MTU, 0 if it was not probed.",
+          "type": "integer"
+        },
+        "large_packet_successful": {
+          "description": "Whether the packets sent with the don't-fragment bit set reached the remote address.",
+          "type": "boolean"
+        },
         "outgoing_nic": {
           "type": "string"
         },
@@ -25401,6 +30151,24 @@ func init() {
         }
       }
     },
+    "lldp-neighbor": {
+      "description": "The link layer neighbor of a network interface, as advertised by LLDP.",
+      "type": "object",
+      "properties": {
+        "chassis_id": {
+          "type": "string"
+        },
+        "port_description": {
+          "type": "string"
+        },
+        "port_id": {
+          "type": "string"
+        },
+        "system_name": {
+          "type": "string"
+        }
+      }
+    },
     "logs-progress-params": {
       "type": "object",
       "required": [
@@ -25599,6 +30367,62 @@ func init() {
         }
       }
     },
+    "nutanix-platform": {
+      "description": "Nutanix platform-specific configuration upon which to perform the installation.",
+      "type": "object",
+      "properties": {
+        "password": {
+          "description": "The password for the Prism Central user name.",
+          "type": "string",
+          "format": "password",
+          "x-nullable": true
+        },
+        "prism_central_address": {
+          "description": "The Prism Central fully qualified domain name or IP address.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "prism_central_port": {
+          "description": "The Prism Central port.",
+          "type": "integer",
+          "default": 9440,
+          "maximum": 65535,
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "prism_element_address": {
+          "description": "The Prism Element fully qualified domain name or IP address.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "prism_element_port": {
+          "description": "The Prism Element port.",
+          "type": "integer",
+          "default": 9440,
+          "maximum": 65535,
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "prism_element_uuid": {
+          "description": "The UUID of the Prism Element cluster the VMs run on.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "subnet_uuid": {
+          "description": "The UUID of the Nutanix subnet the VMs are attached to.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "username": {
+          "description": "The user name to use to connect to Prism Central.",
+          "type": "string",
+          "x-nullable": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:nutanix_\""
+    },
     "openshift-version": {
       "type": "object",
       "required": [
@@ -25866,6 +30690,11 @@ func init() {
         "type"
       ],
       "properties": {
+        "nutanix": {
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/nutanix-platform"
+        },
         "ovirt": {
           "type": "object",
           "x-nullable": true,
@@ -25883,6 +30712,7 @@ func init() {
         "baremetal",
         "vsphere",
         "ovirt",
+        "nutanix",
         "none"
       ]
     },
@@ -25947,40 +30777,134 @@ func init() {
         "cpu_architecture": {
           "description": "The CPU architecture of the image (x86_64/arm64/etc).",
           "type": "string",
-          "default": "x86_64",
-          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
-        },
-        "default": {
-          "description": "Indication that the version is the recommended one.",
-          "type": "boolean"
+          "default": "x86_64",
+          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
+        },
+        "default": {
+          "description": "Indication that the version is the recommended one.",
+          "type": "boolean"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "support_level": {
+          "description": "Level of support of the version.",
+          "type": "string",
+          "enum": [
+            "beta",
+            "production",
+            "maintenance"
+          ]
+        },
+        "url": {
+          "description": "The installation image of the OpenShift cluster.",
+          "type": "string"
+        },
+        "version": {
+          "description": "OCP version from the release metadata.",
+          "type": "string"
+        }
+      }
+    },
+    "release-images": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/release-image"
+      }
+    },
+    "role-binding": {
+      "type": "object",
+      "required": [
+        "id",
+        "user_name",
+        "role"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster the role is bound on, if any.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "org_id": {
+          "description": "The organization the role is bound on, if any.",
+          "type": "string"
+        },
+        "role": {
+          "description": "The role bound to the user.",
+          "type": "string",
+          "enum": [
+            "viewer",
+            "editor",
+            "installer",
+            "admin"
+          ]
+        },
+        "user_name": {
+          "description": "The user the role is bound to.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "role-binding-create-params": {
+      "type": "object",
+      "required": [
+        "user_name",
+        "role"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster the role is bound on. The role applies to all the clusters when neither a cluster nor an organization is set.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "org_id": {
+          "description": "The organization the role is bound on. The role applies to all the clusters when neither a cluster nor an organization is set.",
           "type": "string"
         },
-        "support_level": {
-          "description": "Level of support of the version.",
+        "role": {
+          "description": "The role bound to the user. A viewer can get resources, an editor can also change them, an installer can\nalso install and reset clusters and hosts, and an admin can call any API.\n",
           "type": "string",
           "enum": [
-            "beta",
-            "production",
-            "maintenance"
+            "viewer",
+            "editor",
+            "installer",
+            "admin"
           ]
         },
-        "url": {
-          "description": "The installation image of the OpenShift cluster.",
-          "type": "string"
-        },
-        "version": {
-          "description": "OCP version from the release metadata.",
+        "user_name": {
+          "description": "The user the role is bound to.",
           "type": "string"
         }
       }
     },
-    "release-images": {
+    "role-binding-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/release-image"
+        "$ref": "#/definitions/role-binding"
       }
     },
     "route": {
@@ -26032,6 +30956,68 @@ func init() {
         "unreachable"
       ]
     },
+    "static-network-config-template": {
+      "type": "object",
+      "required": [
+        "network_yaml_template",
+        "hosts_csv",
+        "mac_interface_columns"
+      ],
+      "properties": {
+        "hosts_csv": {
+          "description": "A CSV table whose header row names the columns and whose other rows hold the values of a host, such as its MAC, IP, gateway and VLAN.",
+          "type": "string"
+        },
+        "mac_interface_columns": {
+          "description": "Maps the logical interface names used in the template to the columns holding their MAC addresses.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "network_yaml_template": {
+          "description": "An nmstate yaml in which {{ .\u003ccolumn\u003e }} placeholders are replaced by the values of the columns of each host.",
+          "type": "string"
+        }
+      }
+    },
+    "static-network-config-template-error": {
+      "type": "object",
+      "required": [
+        "code",
+        "reason"
+      ],
+      "properties": {
+        "code": {
+          "description": "Numeric identifier of the error.",
+          "type": "string"
+        },
+        "reason": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        },
+        "row_errors": {
+          "description": "The errors of the rows of the hosts table.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/static-network-config-template-row-error"
+          }
+        }
+      }
+    },
+    "static-network-config-template-row-error": {
+      "type": "object",
+      "properties": {
+        "reason": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        },
+        "row": {
+          "description": "The number of the row in the hosts table, the header being the first row.",
+          "type": "integer"
+        }
+      }
+    },
     "step": {
       "type": "object",
       "properties": {
@@ -26086,7 +31072,8 @@ func init() {
         "ntp-synchronizer",
         "installation-disk-speed-check",
         "container-image-availability",
-        "domain-resolution"
+        "domain-resolution",
+        "tang-connectivity-check"
       ]
     },
     "steps": {
@@ -26141,6 +31128,55 @@ func init() {
         }
       }
     },
+    "tang_connectivity_request": {
+      "type": "object",
+      "required": [
+        "tang_servers"
+      ],
+      "properties": {
+        "tang_servers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TangConnectivityRequestTangServersItems0"
+          }
+        }
+      }
+    },
+    "tang_connectivity_response": {
+      "type": "object",
+      "required": [
+        "tang_servers"
+      ],
+      "properties": {
+        "tang_servers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TangConnectivityResponseTangServersItems0"
+          }
+        }
+      }
+    },
+    "tpm": {
+      "description": "The attestation data of the host's TPM, as reported by the agent.",
+      "type": "object",
+      "properties": {
+        "ek_certificate": {
+          "description": "The PEM encoded certificate of the TPM endorsement key.",
+          "type": "string"
+        },
+        "enabled": {
+          "description": "Whether the TPM is enabled in the host's BIOS.",
+          "type": "boolean"
+        },
+        "pcr_banks": {
+          "description": "The hash algorithms of the active PCR banks, such as sha1 and sha256.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "usage": {
       "type": "object",
       "properties": {
@@ -26212,6 +31248,11 @@ func init() {
           "type": "object",
           "$ref": "#/definitions/disk-encryption"
         },
+        "fips": {
+          "description": "Install the cluster in FIPS mode. Requires a release and a CPU architecture that support FIPS.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "http_proxy": {
           "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
@@ -26296,6 +31337,15 @@ func init() {
           "type": "string",
           "x-nullable": true
         },
+        "role_assignment_strategy": {
+          "description": "Strategy used to assign roles to the hosts whose role is auto-assign. The first-fit strategy selects the first hosts that meet the control plane requirements, the scoring strategy selects the best hosts according to their hardware and failure domains.",
+          "type": "string",
+          "enum": [
+            "first-fit",
+            "scoring"
+          ],
+          "x-nullable": true
+        },
         "schedulable_masters": {
           "description": "Schedule workloads on masters",
           "type": "boolean",
@@ -26362,6 +31412,185 @@ func init() {
       "additionalProperties": {
         "type": "string"
       }
+    },
+    "webhook": {
+      "type": "object",
+      "required": [
+        "id",
+        "url"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "event_names": {
+          "description": "If non-empty, only events with one of these names are delivered.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "id": {
+          "description": "Unique identifier of the webhook subscription.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "severities": {
+          "description": "If non-empty, only events with one of these severities are delivered.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "info",
+              "warning",
+              "error",
+              "critical"
+            ]
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "url": {
+          "description": "The HTTP or HTTPS endpoint that the events are posted to.",
+          "type": "string"
+        },
+        "user_name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "webhook-create-params": {
+      "type": "object",
+      "required": [
+        "url",
+        "secret"
+      ],
+      "properties": {
+        "event_names": {
+          "description": "If non-empty, only events with one of these names are delivered.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "secret": {
+          "description": "The key used to sign the posted payloads with HMAC-SHA256. The signature is sent in the X-Assisted-Signature header.",
+          "type": "string",
+          "minLength": 16
+        },
+        "severities": {
+          "description": "If non-empty, only events with one of these severities are delivered.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "info",
+              "warning",
+              "error",
+              "critical"
+            ]
+          }
+        },
+        "url": {
+          "description": "The HTTP or HTTPS endpoint that the events are posted to.",
+          "type": "string"
+        }
+      }
+    },
+    "webhook-delivery": {
+      "type": "object",
+      "required": [
+        "id",
+        "webhook_id",
+        "status"
+      ],
+      "properties": {
+        "attempts": {
+          "description": "The number of delivery attempts made so far.",
+          "type": "integer"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "delivered_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "event_name": {
+          "description": "The name of the delivered event.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the delivery, sent in the X-Assisted-Delivery header.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "last_error": {
+          "description": "The reason the last attempt failed.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "last_response_code": {
+          "description": "The HTTP status code returned by the endpoint on the last attempt.",
+          "type": "integer"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "delivered",
+            "failed"
+          ],
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "webhook_id": {
+          "description": "The webhook subscription this delivery belongs to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "webhook-delivery-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/webhook-delivery"
+      }
+    },
+    "webhook-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/webhook"
+      }
     }
   },
   "securityDefinitions": {
@@ -26399,6 +31628,14 @@ func init() {
       "description": "Agent-driven installation",
       "name": "Assisted installation"
     },
+    {
+      "description": "Records of the changes made through the API.",
+      "name": "audit"
+    },
+    {
+      "description": "Export and import of cluster definitions between environments.",
+      "name": "cluster_bundles"
+    },
     {
       "description": "Events related to a cluster installation.",
       "name": "events"
@@ -26419,9 +31656,17 @@ func init() {
       "description": "Information regarding supported operators.",
       "name": "operators"
     },
+    {
+      "description": "Roles of the users of a deployment with local authentication.",
+      "name": "role_bindings"
+    },
     {
       "description": "Information regarding versions.",
       "name": "versions"
+    },
+    {
+      "description": "Notifications of cluster, host and infra-env events to external endpoints.",
+      "name": "webhooks"
     }
   ]
 }`))
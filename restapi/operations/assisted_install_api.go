@@ -7,6 +7,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -19,12 +20,16 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/openshift/assisted-service/restapi/operations/audit"
+	"github.com/openshift/assisted-service/restapi/operations/cluster_bundles"
 	"github.com/openshift/assisted-service/restapi/operations/events"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
 	"github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/openshift/assisted-service/restapi/operations/operators"
+	"github.com/openshift/assisted-service/restapi/operations/role_bindings"
 	"github.com/openshift/assisted-service/restapi/operations/versions"
+	"github.com/openshift/assisted-service/restapi/operations/webhooks"
 )
 
 // NewAssistedInstallAPI creates a new AssistedInstall instance
@@ -50,7 +55,13 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 
 		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
+		TextEventStreamProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("textEventStream producer has not yet been implemented")
+		}),
 
+		InstallerApplyStaticNetworkConfigTemplateHandler: installer.ApplyStaticNetworkConfigTemplateHandlerFunc(func(params installer.ApplyStaticNetworkConfigTemplateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ApplyStaticNetworkConfigTemplate has not yet been implemented")
+		}),
 		InstallerBindHostHandler: installer.BindHostHandlerFunc(func(params installer.BindHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.BindHost has not yet been implemented")
 		}),
@@ -312,12 +323,27 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2CompleteInstallationHandler: installer.V2CompleteInstallationHandlerFunc(func(params installer.V2CompleteInstallationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2CompleteInstallation has not yet been implemented")
 		}),
+		RoleBindingsV2CreateRoleBindingHandler: role_bindings.V2CreateRoleBindingHandlerFunc(func(params role_bindings.V2CreateRoleBindingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation role_bindings.V2CreateRoleBinding has not yet been implemented")
+		}),
+		RoleBindingsV2DeleteRoleBindingHandler: role_bindings.V2DeleteRoleBindingHandlerFunc(func(params role_bindings.V2DeleteRoleBindingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation role_bindings.V2DeleteRoleBinding has not yet been implemented")
+		}),
 		InstallerV2DeregisterClusterHandler: installer.V2DeregisterClusterHandlerFunc(func(params installer.V2DeregisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DeregisterCluster has not yet been implemented")
 		}),
 		InstallerV2DeregisterHostHandler: installer.V2DeregisterHostHandlerFunc(func(params installer.V2DeregisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DeregisterHost has not yet been implemented")
 		}),
+		WebhooksV2DeregisterWebhookHandler: webhooks.V2DeregisterWebhookHandlerFunc(func(params webhooks.V2DeregisterWebhookParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.V2DeregisterWebhook has not yet been implemented")
+		}),
+		InstallerV2DownloadClusterConnectivityMatrixHandler: installer.V2DownloadClusterConnectivityMatrixHandlerFunc(func(params installer.V2DownloadClusterConnectivityMatrixParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2DownloadClusterConnectivityMatrix has not yet been implemented")
+		}),
+		InstallerV2DownloadClusterDryRunHandler: installer.V2DownloadClusterDryRunHandlerFunc(func(params installer.V2DownloadClusterDryRunParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2DownloadClusterDryRun has not yet been implemented")
+		}),
 		ManifestsV2DownloadClusterManifestHandler: manifests.V2DownloadClusterManifestHandlerFunc(func(params manifests.V2DownloadClusterManifestParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.V2DownloadClusterManifest has not yet been implemented")
 		}),
@@ -327,9 +353,18 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2DownloadInfraEnvFilesHandler: installer.V2DownloadInfraEnvFilesHandlerFunc(func(params installer.V2DownloadInfraEnvFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DownloadInfraEnvFiles has not yet been implemented")
 		}),
+		InstallerV2DryRunInstallClusterHandler: installer.V2DryRunInstallClusterHandlerFunc(func(params installer.V2DryRunInstallClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2DryRunInstallCluster has not yet been implemented")
+		}),
+		ClusterBundlesV2ExportClusterBundleHandler: cluster_bundles.V2ExportClusterBundleHandlerFunc(func(params cluster_bundles.V2ExportClusterBundleParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_bundles.V2ExportClusterBundle has not yet been implemented")
+		}),
 		InstallerV2GetClusterHandler: installer.V2GetClusterHandlerFunc(func(params installer.V2GetClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetCluster has not yet been implemented")
 		}),
+		InstallerV2GetClusterConnectivityMatrixHandler: installer.V2GetClusterConnectivityMatrixHandlerFunc(func(params installer.V2GetClusterConnectivityMatrixParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetClusterConnectivityMatrix has not yet been implemented")
+		}),
 		InstallerV2GetClusterInstallConfigHandler: installer.V2GetClusterInstallConfigHandlerFunc(func(params installer.V2GetClusterInstallConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetClusterInstallConfig has not yet been implemented")
 		}),
@@ -339,6 +374,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2GetHostIgnitionHandler: installer.V2GetHostIgnitionHandlerFunc(func(params installer.V2GetHostIgnitionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetHostIgnition has not yet been implemented")
 		}),
+		InstallerV2GetHostInventoryDiffHandler: installer.V2GetHostInventoryDiffHandlerFunc(func(params installer.V2GetHostInventoryDiffParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetHostInventoryDiff has not yet been implemented")
+		}),
 		InstallerV2GetNextStepsHandler: installer.V2GetNextStepsHandlerFunc(func(params installer.V2GetNextStepsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetNextSteps has not yet been implemented")
 		}),
@@ -348,12 +386,18 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2ImportClusterHandler: installer.V2ImportClusterHandlerFunc(func(params installer.V2ImportClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ImportCluster has not yet been implemented")
 		}),
+		ClusterBundlesV2ImportClusterBundleHandler: cluster_bundles.V2ImportClusterBundleHandlerFunc(func(params cluster_bundles.V2ImportClusterBundleParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cluster_bundles.V2ImportClusterBundle has not yet been implemented")
+		}),
 		InstallerV2InstallClusterHandler: installer.V2InstallClusterHandlerFunc(func(params installer.V2InstallClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2InstallCluster has not yet been implemented")
 		}),
 		InstallerV2InstallHostHandler: installer.V2InstallHostHandlerFunc(func(params installer.V2InstallHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2InstallHost has not yet been implemented")
 		}),
+		AuditV2ListAuditRecordsHandler: audit.V2ListAuditRecordsHandlerFunc(func(params audit.V2ListAuditRecordsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation audit.V2ListAuditRecords has not yet been implemented")
+		}),
 		InstallerV2ListClustersHandler: installer.V2ListClustersHandlerFunc(func(params installer.V2ListClustersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ListClusters has not yet been implemented")
 		}),
@@ -366,12 +410,24 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2ListFeatureSupportLevelsHandler: installer.V2ListFeatureSupportLevelsHandlerFunc(func(params installer.V2ListFeatureSupportLevelsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ListFeatureSupportLevels has not yet been implemented")
 		}),
+		InstallerV2ListHostInventoryHistoryHandler: installer.V2ListHostInventoryHistoryHandlerFunc(func(params installer.V2ListHostInventoryHistoryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ListHostInventoryHistory has not yet been implemented")
+		}),
 		InstallerV2ListHostsHandler: installer.V2ListHostsHandlerFunc(func(params installer.V2ListHostsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ListHosts has not yet been implemented")
 		}),
+		RoleBindingsV2ListRoleBindingsHandler: role_bindings.V2ListRoleBindingsHandlerFunc(func(params role_bindings.V2ListRoleBindingsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation role_bindings.V2ListRoleBindings has not yet been implemented")
+		}),
 		VersionsV2ListSupportedOpenshiftVersionsHandler: versions.V2ListSupportedOpenshiftVersionsHandlerFunc(func(params versions.V2ListSupportedOpenshiftVersionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation versions.V2ListSupportedOpenshiftVersions has not yet been implemented")
 		}),
+		WebhooksV2ListWebhookDeliveriesHandler: webhooks.V2ListWebhookDeliveriesHandlerFunc(func(params webhooks.V2ListWebhookDeliveriesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.V2ListWebhookDeliveries has not yet been implemented")
+		}),
+		WebhooksV2ListWebhooksHandler: webhooks.V2ListWebhooksHandlerFunc(func(params webhooks.V2ListWebhooksParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.V2ListWebhooks has not yet been implemented")
+		}),
 		InstallerV2PostStepReplyHandler: installer.V2PostStepReplyHandlerFunc(func(params installer.V2PostStepReplyParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2PostStepReply has not yet been implemented")
 		}),
@@ -381,6 +437,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2RegisterHostHandler: installer.V2RegisterHostHandlerFunc(func(params installer.V2RegisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2RegisterHost has not yet been implemented")
 		}),
+		WebhooksV2RegisterWebhookHandler: webhooks.V2RegisterWebhookHandlerFunc(func(params webhooks.V2RegisterWebhookParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhooks.V2RegisterWebhook has not yet been implemented")
+		}),
 		OperatorsV2ReportMonitoredOperatorStatusHandler: operators.V2ReportMonitoredOperatorStatusHandlerFunc(func(params operators.V2ReportMonitoredOperatorStatusParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.V2ReportMonitoredOperatorStatus has not yet been implemented")
 		}),
@@ -393,6 +452,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2ResetHostValidationHandler: installer.V2ResetHostValidationHandlerFunc(func(params installer.V2ResetHostValidationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ResetHostValidation has not yet been implemented")
 		}),
+		EventsV2StreamEventsHandler: events.V2StreamEventsHandlerFunc(func(params events.V2StreamEventsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation events.V2StreamEvents has not yet been implemented")
+		}),
 		InstallerV2UpdateClusterInstallConfigHandler: installer.V2UpdateClusterInstallConfigHandlerFunc(func(params installer.V2UpdateClusterInstallConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateClusterInstallConfig has not yet been implemented")
 		}),
@@ -417,6 +479,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2UploadClusterIngressCertHandler: installer.V2UploadClusterIngressCertHandlerFunc(func(params installer.V2UploadClusterIngressCertParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UploadClusterIngressCert has not yet been implemented")
 		}),
+		InstallerV2ValidateClusterInstallConfigHandler: installer.V2ValidateClusterInstallConfigHandlerFunc(func(params installer.V2ValidateClusterInstallConfigParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ValidateClusterInstallConfig has not yet been implemented")
+		}),
 
 		// Applies when the "X-Secret-Key" header is set
 		AgentAuthAuth: func(token string) (interface{}, error) {
@@ -477,6 +542,9 @@ type AssistedInstallAPI struct {
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
+	// TextEventStreamProducer registers a producer for the following mime types:
+	//   - text/event-stream
+	TextEventStreamProducer runtime.Producer
 
 	// AgentAuthAuth registers a function that takes a token and returns a principal
 	// it performs authentication based on an api key X-Secret-Key provided in the header
@@ -497,6 +565,8 @@ type AssistedInstallAPI struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// InstallerApplyStaticNetworkConfigTemplateHandler sets the operation handler for the apply static network config template operation
+	InstallerApplyStaticNetworkConfigTemplateHandler installer.ApplyStaticNetworkConfigTemplateHandler
 	// InstallerBindHostHandler sets the operation handler for the bind host operation
 	InstallerBindHostHandler installer.BindHostHandler
 	// InstallerCancelInstallationHandler sets the operation handler for the cancel installation operation
@@ -671,34 +741,56 @@ type AssistedInstallAPI struct {
 	InstallerV2UploadLogsHandler installer.V2UploadLogsHandler
 	// InstallerV2CompleteInstallationHandler sets the operation handler for the v2 complete installation operation
 	InstallerV2CompleteInstallationHandler installer.V2CompleteInstallationHandler
+	// RoleBindingsV2CreateRoleBindingHandler sets the operation handler for the v2 create role binding operation
+	RoleBindingsV2CreateRoleBindingHandler role_bindings.V2CreateRoleBindingHandler
+	// RoleBindingsV2DeleteRoleBindingHandler sets the operation handler for the v2 delete role binding operation
+	RoleBindingsV2DeleteRoleBindingHandler role_bindings.V2DeleteRoleBindingHandler
 	// InstallerV2DeregisterClusterHandler sets the operation handler for the v2 deregister cluster operation
 	InstallerV2DeregisterClusterHandler installer.V2DeregisterClusterHandler
 	// InstallerV2DeregisterHostHandler sets the operation handler for the v2 deregister host operation
 	InstallerV2DeregisterHostHandler installer.V2DeregisterHostHandler
+	// WebhooksV2DeregisterWebhookHandler sets the operation handler for the v2 deregister webhook operation
+	WebhooksV2DeregisterWebhookHandler webhooks.V2DeregisterWebhookHandler
+	// InstallerV2DownloadClusterConnectivityMatrixHandler sets the operation handler for the v2 download cluster connectivity matrix operation
+	InstallerV2DownloadClusterConnectivityMatrixHandler installer.V2DownloadClusterConnectivityMatrixHandler
+	// InstallerV2DownloadClusterDryRunHandler sets the operation handler for the v2 download cluster dry run operation
+	InstallerV2DownloadClusterDryRunHandler installer.V2DownloadClusterDryRunHandler
 	// ManifestsV2DownloadClusterManifestHandler sets the operation handler for the v2 download cluster manifest operation
 	ManifestsV2DownloadClusterManifestHandler manifests.V2DownloadClusterManifestHandler
 	// InstallerV2DownloadHostIgnitionHandler sets the operation handler for the v2 download host ignition operation
 	InstallerV2DownloadHostIgnitionHandler installer.V2DownloadHostIgnitionHandler
 	// InstallerV2DownloadInfraEnvFilesHandler sets the operation handler for the v2 download infra env files operation
 	InstallerV2DownloadInfraEnvFilesHandler installer.V2DownloadInfraEnvFilesHandler
+	// InstallerV2DryRunInstallClusterHandler sets the operation handler for the v2 dry run install cluster operation
+	InstallerV2DryRunInstallClusterHandler installer.V2DryRunInstallClusterHandler
+	// ClusterBundlesV2ExportClusterBundleHandler sets the operation handler for the v2 export cluster bundle operation
+	ClusterBundlesV2ExportClusterBundleHandler cluster_bundles.V2ExportClusterBundleHandler
 	// InstallerV2GetClusterHandler sets the operation handler for the v2 get cluster operation
 	InstallerV2GetClusterHandler installer.V2GetClusterHandler
+	// InstallerV2GetClusterConnectivityMatrixHandler sets the operation handler for the v2 get cluster connectivity matrix operation
+	InstallerV2GetClusterConnectivityMatrixHandler installer.V2GetClusterConnectivityMatrixHandler
 	// InstallerV2GetClusterInstallConfigHandler sets the operation handler for the v2 get cluster install config operation
 	InstallerV2GetClusterInstallConfigHandler installer.V2GetClusterInstallConfigHandler
 	// InstallerV2GetHostHandler sets the operation handler for the v2 get host operation
 	InstallerV2GetHostHandler installer.V2GetHostHandler
 	// InstallerV2GetHostIgnitionHandler sets the operation handler for the v2 get host ignition operation
 	InstallerV2GetHostIgnitionHandler installer.V2GetHostIgnitionHandler
+	// InstallerV2GetHostInventoryDiffHandler sets the operation handler for the v2 get host inventory diff operation
+	InstallerV2GetHostInventoryDiffHandler installer.V2GetHostInventoryDiffHandler
 	// InstallerV2GetNextStepsHandler sets the operation handler for the v2 get next steps operation
 	InstallerV2GetNextStepsHandler installer.V2GetNextStepsHandler
 	// InstallerV2GetPreflightRequirementsHandler sets the operation handler for the v2 get preflight requirements operation
 	InstallerV2GetPreflightRequirementsHandler installer.V2GetPreflightRequirementsHandler
 	// InstallerV2ImportClusterHandler sets the operation handler for the v2 import cluster operation
 	InstallerV2ImportClusterHandler installer.V2ImportClusterHandler
+	// ClusterBundlesV2ImportClusterBundleHandler sets the operation handler for the v2 import cluster bundle operation
+	ClusterBundlesV2ImportClusterBundleHandler cluster_bundles.V2ImportClusterBundleHandler
 	// InstallerV2InstallClusterHandler sets the operation handler for the v2 install cluster operation
 	InstallerV2InstallClusterHandler installer.V2InstallClusterHandler
 	// InstallerV2InstallHostHandler sets the operation handler for the v2 install host operation
 	InstallerV2InstallHostHandler installer.V2InstallHostHandler
+	// AuditV2ListAuditRecordsHandler sets the operation handler for the v2 list audit records operation
+	AuditV2ListAuditRecordsHandler audit.V2ListAuditRecordsHandler
 	// InstallerV2ListClustersHandler sets the operation handler for the v2 list clusters operation
 	InstallerV2ListClustersHandler installer.V2ListClustersHandler
 	// VersionsV2ListComponentVersionsHandler sets the operation handler for the v2 list component versions operation
@@ -707,16 +799,26 @@ type AssistedInstallAPI struct {
 	EventsV2ListEventsHandler events.V2ListEventsHandler
 	// InstallerV2ListFeatureSupportLevelsHandler sets the operation handler for the v2 list feature support levels operation
 	InstallerV2ListFeatureSupportLevelsHandler installer.V2ListFeatureSupportLevelsHandler
+	// InstallerV2ListHostInventoryHistoryHandler sets the operation handler for the v2 list host inventory history operation
+	InstallerV2ListHostInventoryHistoryHandler installer.V2ListHostInventoryHistoryHandler
 	// InstallerV2ListHostsHandler sets the operation handler for the v2 list hosts operation
 	InstallerV2ListHostsHandler installer.V2ListHostsHandler
+	// RoleBindingsV2ListRoleBindingsHandler sets the operation handler for the v2 list role bindings operation
+	RoleBindingsV2ListRoleBindingsHandler role_bindings.V2ListRoleBindingsHandler
 	// VersionsV2ListSupportedOpenshiftVersionsHandler sets the operation handler for the v2 list supported openshift versions operation
 	VersionsV2ListSupportedOpenshiftVersionsHandler versions.V2ListSupportedOpenshiftVersionsHandler
+	// WebhooksV2ListWebhookDeliveriesHandler sets the operation handler for the v2 list webhook deliveries operation
+	WebhooksV2ListWebhookDeliveriesHandler webhooks.V2ListWebhookDeliveriesHandler
+	// WebhooksV2ListWebhooksHandler sets the operation handler for the v2 list webhooks operation
+	WebhooksV2ListWebhooksHandler webhooks.V2ListWebhooksHandler
 	// InstallerV2PostStepReplyHandler sets the operation handler for the v2 post step reply operation
 	InstallerV2PostStepReplyHandler installer.V2PostStepReplyHandler
 	// InstallerV2RegisterClusterHandler sets the operation handler for the v2 register cluster operation
 	InstallerV2RegisterClusterHandler installer.V2RegisterClusterHandler
 	// InstallerV2RegisterHostHandler sets the operation handler for the v2 register host operation
 	InstallerV2RegisterHostHandler installer.V2RegisterHostHandler
+	// WebhooksV2RegisterWebhookHandler sets the operation handler for the v2 register webhook operation
+	WebhooksV2RegisterWebhookHandler webhooks.V2RegisterWebhookHandler
 	// OperatorsV2ReportMonitoredOperatorStatusHandler sets the operation handler for the v2 report monitored operator status operation
 	OperatorsV2ReportMonitoredOperatorStatusHandler operators.V2ReportMonitoredOperatorStatusHandler
 	// InstallerV2ResetClusterHandler sets the operation handler for the v2 reset cluster operation
@@ -725,6 +827,8 @@ type AssistedInstallAPI struct {
 	InstallerV2ResetHostHandler installer.V2ResetHostHandler
 	// InstallerV2ResetHostValidationHandler sets the operation handler for the v2 reset host validation operation
 	InstallerV2ResetHostValidationHandler installer.V2ResetHostValidationHandler
+	// EventsV2StreamEventsHandler sets the operation handler for the v2 stream events operation
+	EventsV2StreamEventsHandler events.V2StreamEventsHandler
 	// InstallerV2UpdateClusterInstallConfigHandler sets the operation handler for the v2 update cluster install config operation
 	InstallerV2UpdateClusterInstallConfigHandler installer.V2UpdateClusterInstallConfigHandler
 	// InstallerV2UpdateClusterLogsProgressHandler sets the operation handler for the v2 update cluster logs progress operation
@@ -741,6 +845,8 @@ type AssistedInstallAPI struct {
 	InstallerV2UpdateHostLogsProgressHandler installer.V2UpdateHostLogsProgressHandler
 	// InstallerV2UploadClusterIngressCertHandler sets the operation handler for the v2 upload cluster ingress cert operation
 	InstallerV2UploadClusterIngressCertHandler installer.V2UploadClusterIngressCertHandler
+	// InstallerV2ValidateClusterInstallConfigHandler sets the operation handler for the v2 validate cluster install config operation
+	InstallerV2ValidateClusterInstallConfigHandler installer.V2ValidateClusterInstallConfigHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -823,6 +929,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.TextEventStreamProducer == nil {
+		unregistered = append(unregistered, "TextEventStreamProducer")
+	}
 
 	if o.AgentAuthAuth == nil {
 		unregistered = append(unregistered, "XSecretKeyAuth")
@@ -837,6 +946,9 @@ func (o *AssistedInstallAPI) Validate() error {
 		unregistered = append(unregistered, "AuthorizationAuth")
 	}
 
+	if o.InstallerApplyStaticNetworkConfigTemplateHandler == nil {
+		unregistered = append(unregistered, "installer.ApplyStaticNetworkConfigTemplateHandler")
+	}
 	if o.InstallerBindHostHandler == nil {
 		unregistered = append(unregistered, "installer.BindHostHandler")
 	}
@@ -1098,12 +1210,27 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2CompleteInstallationHandler == nil {
 		unregistered = append(unregistered, "installer.V2CompleteInstallationHandler")
 	}
+	if o.RoleBindingsV2CreateRoleBindingHandler == nil {
+		unregistered = append(unregistered, "role_bindings.V2CreateRoleBindingHandler")
+	}
+	if o.RoleBindingsV2DeleteRoleBindingHandler == nil {
+		unregistered = append(unregistered, "role_bindings.V2DeleteRoleBindingHandler")
+	}
 	if o.InstallerV2DeregisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2DeregisterClusterHandler")
 	}
 	if o.InstallerV2DeregisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2DeregisterHostHandler")
 	}
+	if o.WebhooksV2DeregisterWebhookHandler == nil {
+		unregistered = append(unregistered, "webhooks.V2DeregisterWebhookHandler")
+	}
+	if o.InstallerV2DownloadClusterConnectivityMatrixHandler == nil {
+		unregistered = append(unregistered, "installer.V2DownloadClusterConnectivityMatrixHandler")
+	}
+	if o.InstallerV2DownloadClusterDryRunHandler == nil {
+		unregistered = append(unregistered, "installer.V2DownloadClusterDryRunHandler")
+	}
 	if o.ManifestsV2DownloadClusterManifestHandler == nil {
 		unregistered = append(unregistered, "manifests.V2DownloadClusterManifestHandler")
 	}
@@ -1113,9 +1240,18 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2DownloadInfraEnvFilesHandler == nil {
 		unregistered = append(unregistered, "installer.V2DownloadInfraEnvFilesHandler")
 	}
+	if o.InstallerV2DryRunInstallClusterHandler == nil {
+		unregistered = append(unregistered, "installer.V2DryRunInstallClusterHandler")
+	}
+	if o.ClusterBundlesV2ExportClusterBundleHandler == nil {
+		unregistered = append(unregistered, "cluster_bundles.V2ExportClusterBundleHandler")
+	}
 	if o.InstallerV2GetClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterHandler")
 	}
+	if o.InstallerV2GetClusterConnectivityMatrixHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetClusterConnectivityMatrixHandler")
+	}
 	if o.InstallerV2GetClusterInstallConfigHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterInstallConfigHandler")
 	}
@@ -1125,6 +1261,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2GetHostIgnitionHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetHostIgnitionHandler")
 	}
+	if o.InstallerV2GetHostInventoryDiffHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetHostInventoryDiffHandler")
+	}
 	if o.InstallerV2GetNextStepsHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetNextStepsHandler")
 	}
@@ -1134,12 +1273,18 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2ImportClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2ImportClusterHandler")
 	}
+	if o.ClusterBundlesV2ImportClusterBundleHandler == nil {
+		unregistered = append(unregistered, "cluster_bundles.V2ImportClusterBundleHandler")
+	}
 	if o.InstallerV2InstallClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2InstallClusterHandler")
 	}
 	if o.InstallerV2InstallHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2InstallHostHandler")
 	}
+	if o.AuditV2ListAuditRecordsHandler == nil {
+		unregistered = append(unregistered, "audit.V2ListAuditRecordsHandler")
+	}
 	if o.InstallerV2ListClustersHandler == nil {
 		unregistered = append(unregistered, "installer.V2ListClustersHandler")
 	}
@@ -1152,12 +1297,24 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2ListFeatureSupportLevelsHandler == nil {
 		unregistered = append(unregistered, "installer.V2ListFeatureSupportLevelsHandler")
 	}
+	if o.InstallerV2ListHostInventoryHistoryHandler == nil {
+		unregistered = append(unregistered, "installer.V2ListHostInventoryHistoryHandler")
+	}
 	if o.InstallerV2ListHostsHandler == nil {
 		unregistered = append(unregistered, "installer.V2ListHostsHandler")
 	}
+	if o.RoleBindingsV2ListRoleBindingsHandler == nil {
+		unregistered = append(unregistered, "role_bindings.V2ListRoleBindingsHandler")
+	}
 	if o.VersionsV2ListSupportedOpenshiftVersionsHandler == nil {
 		unregistered = append(unregistered, "versions.V2ListSupportedOpenshiftVersionsHandler")
 	}
+	if o.WebhooksV2ListWebhookDeliveriesHandler == nil {
+		unregistered = append(unregistered, "webhooks.V2ListWebhookDeliveriesHandler")
+	}
+	if o.WebhooksV2ListWebhooksHandler == nil {
+		unregistered = append(unregistered, "webhooks.V2ListWebhooksHandler")
+	}
 	if o.InstallerV2PostStepReplyHandler == nil {
 		unregistered = append(unregistered, "installer.V2PostStepReplyHandler")
 	}
@@ -1167,6 +1324,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2RegisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2RegisterHostHandler")
 	}
+	if o.WebhooksV2RegisterWebhookHandler == nil {
+		unregistered = append(unregistered, "webhooks.V2RegisterWebhookHandler")
+	}
 	if o.OperatorsV2ReportMonitoredOperatorStatusHandler == nil {
 		unregistered = append(unregistered, "operators.V2ReportMonitoredOperatorStatusHandler")
 	}
@@ -1179,6 +1339,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2ResetHostValidationHandler == nil {
 		unregistered = append(unregistered, "installer.V2ResetHostValidationHandler")
 	}
+	if o.EventsV2StreamEventsHandler == nil {
+		unregistered = append(unregistered, "events.V2StreamEventsHandler")
+	}
 	if o.InstallerV2UpdateClusterInstallConfigHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateClusterInstallConfigHandler")
 	}
@@ -1203,6 +1366,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2UploadClusterIngressCertHandler == nil {
 		unregistered = append(unregistered, "installer.V2UploadClusterIngressCertHandler")
 	}
+	if o.InstallerV2ValidateClusterInstallConfigHandler == nil {
+		unregistered = append(unregistered, "installer.V2ValidateClusterInstallConfigHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -1276,6 +1442,8 @@ func (o *AssistedInstallAPI) ProducersFor(mediaTypes []string) map[string]runtim
 			result["application/octet-stream"] = o.BinProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "text/event-stream":
+			result["text/event-stream"] = o.TextEventStreamProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -1316,6 +1484,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/static-network-config-template"] = installer.NewApplyStaticNetworkConfigTemplate(o.context, o.InstallerApplyStaticNetworkConfigTemplateHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1664,6 +1836,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/complete-installation"] = installer.NewV2CompleteInstallation(o.context, o.InstallerV2CompleteInstallationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/role-bindings"] = role_bindings.NewV2CreateRoleBinding(o.context, o.RoleBindingsV2CreateRoleBindingHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v2/role-bindings/{role_binding_id}"] = role_bindings.NewV2DeleteRoleBinding(o.context, o.RoleBindingsV2DeleteRoleBindingHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -1672,6 +1852,18 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}"] = installer.NewV2DeregisterHost(o.context, o.InstallerV2DeregisterHostHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v2/webhooks/{webhook_id}"] = webhooks.NewV2DeregisterWebhook(o.context, o.WebhooksV2DeregisterWebhookHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/downloads/connectivity-matrix"] = installer.NewV2DownloadClusterConnectivityMatrix(o.context, o.InstallerV2DownloadClusterConnectivityMatrixHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/downloads/dry-run"] = installer.NewV2DownloadClusterDryRun(o.context, o.InstallerV2DownloadClusterDryRunHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1684,6 +1876,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/downloads/files"] = installer.NewV2DownloadInfraEnvFiles(o.context, o.InstallerV2DownloadInfraEnvFilesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/dry-run-install"] = installer.NewV2DryRunInstallCluster(o.context, o.InstallerV2DryRunInstallClusterHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/bundle"] = cluster_bundles.NewV2ExportClusterBundle(o.context, o.ClusterBundlesV2ExportClusterBundleHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1691,6 +1891,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/connectivity-matrix"] = installer.NewV2GetClusterConnectivityMatrix(o.context, o.InstallerV2GetClusterConnectivityMatrixHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}/install-config"] = installer.NewV2GetClusterInstallConfig(o.context, o.InstallerV2GetClusterInstallConfigHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1703,6 +1907,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/inventory-history/diff"] = installer.NewV2GetHostInventoryDiff(o.context, o.InstallerV2GetHostInventoryDiffHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions"] = installer.NewV2GetNextSteps(o.context, o.InstallerV2GetNextStepsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1715,6 +1923,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/clusters/bundle"] = cluster_bundles.NewV2ImportClusterBundle(o.context, o.ClusterBundlesV2ImportClusterBundleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/install"] = installer.NewV2InstallCluster(o.context, o.InstallerV2InstallClusterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1723,6 +1935,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/audit-records"] = audit.NewV2ListAuditRecords(o.context, o.AuditV2ListAuditRecordsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters"] = installer.NewV2ListClusters(o.context, o.InstallerV2ListClustersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1739,11 +1955,27 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/inventory-history"] = installer.NewV2ListHostInventoryHistory(o.context, o.InstallerV2ListHostInventoryHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts"] = installer.NewV2ListHosts(o.context, o.InstallerV2ListHostsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/role-bindings"] = role_bindings.NewV2ListRoleBindings(o.context, o.RoleBindingsV2ListRoleBindingsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/openshift-versions"] = versions.NewV2ListSupportedOpenshiftVersions(o.context, o.VersionsV2ListSupportedOpenshiftVersionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/webhooks/{webhook_id}/deliveries"] = webhooks.NewV2ListWebhookDeliveries(o.context, o.WebhooksV2ListWebhookDeliveriesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/webhooks"] = webhooks.NewV2ListWebhooks(o.context, o.WebhooksV2ListWebhooksHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1756,6 +1988,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts"] = installer.NewV2RegisterHost(o.context, o.InstallerV2RegisterHostHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/webhooks"] = webhooks.NewV2RegisterWebhook(o.context, o.WebhooksV2RegisterWebhookHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1772,6 +2008,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
 	o.handlers["PATCH"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}"] = installer.NewV2ResetHostValidation(o.context, o.InstallerV2ResetHostValidationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/events/stream"] = events.NewV2StreamEvents(o.context, o.EventsV2StreamEventsHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -1804,6 +2044,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/uploads/ingress-cert"] = installer.NewV2UploadClusterIngressCert(o.context, o.InstallerV2UploadClusterIngressCertHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/install-config/validate"] = installer.NewV2ValidateClusterInstallConfig(o.context, o.InstallerV2ValidateClusterInstallConfigHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
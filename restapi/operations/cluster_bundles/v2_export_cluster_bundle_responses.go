@@ -0,0 +1,233 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_bundles
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ExportClusterBundleOKCode is the HTTP code returned for type V2ExportClusterBundleOK
+const V2ExportClusterBundleOKCode int = 200
+
+/*V2ExportClusterBundleOK Success.
+
+swagger:response v2ExportClusterBundleOK
+*/
+type V2ExportClusterBundleOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewV2ExportClusterBundleOK creates V2ExportClusterBundleOK with default headers values
+func NewV2ExportClusterBundleOK() *V2ExportClusterBundleOK {
+
+	return &V2ExportClusterBundleOK{}
+}
+
+// WithPayload adds the payload to the v2 export cluster bundle o k response
+func (o *V2ExportClusterBundleOK) WithPayload(payload io.ReadCloser) *V2ExportClusterBundleOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 export cluster bundle o k response
+func (o *V2ExportClusterBundleOK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ExportClusterBundleOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ExportClusterBundleUnauthorizedCode is the HTTP code returned for type V2ExportClusterBundleUnauthorized
+const V2ExportClusterBundleUnauthorizedCode int = 401
+
+/*V2ExportClusterBundleUnauthorized Unauthorized.
+
+swagger:response v2ExportClusterBundleUnauthorized
+*/
+type V2ExportClusterBundleUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ExportClusterBundleUnauthorized creates V2ExportClusterBundleUnauthorized with default headers values
+func NewV2ExportClusterBundleUnauthorized() *V2ExportClusterBundleUnauthorized {
+
+	return &V2ExportClusterBundleUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 export cluster bundle unauthorized response
+func (o *V2ExportClusterBundleUnauthorized) WithPayload(payload *models.InfraError) *V2ExportClusterBundleUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 export cluster bundle unauthorized response
+func (o *V2ExportClusterBundleUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ExportClusterBundleUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ExportClusterBundleForbiddenCode is the HTTP code returned for type V2ExportClusterBundleForbidden
+const V2ExportClusterBundleForbiddenCode int = 403
+
+/*V2ExportClusterBundleForbidden Forbidden.
+
+swagger:response v2ExportClusterBundleForbidden
+*/
+type V2ExportClusterBundleForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ExportClusterBundleForbidden creates V2ExportClusterBundleForbidden with default headers values
+func NewV2ExportClusterBundleForbidden() *V2ExportClusterBundleForbidden {
+
+	return &V2ExportClusterBundleForbidden{}
+}
+
+// WithPayload adds the payload to the v2 export cluster bundle forbidden response
+func (o *V2ExportClusterBundleForbidden) WithPayload(payload *models.InfraError) *V2ExportClusterBundleForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 export cluster bundle forbidden response
+func (o *V2ExportClusterBundleForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ExportClusterBundleForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ExportClusterBundleNotFoundCode is the HTTP code returned for type V2ExportClusterBundleNotFound
+const V2ExportClusterBundleNotFoundCode int = 404
+
+/*V2ExportClusterBundleNotFound Error.
+
+swagger:response v2ExportClusterBundleNotFound
+*/
+type V2ExportClusterBundleNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ExportClusterBundleNotFound creates V2ExportClusterBundleNotFound with default headers values
+func NewV2ExportClusterBundleNotFound() *V2ExportClusterBundleNotFound {
+
+	return &V2ExportClusterBundleNotFound{}
+}
+
+// WithPayload adds the payload to the v2 export cluster bundle not found response
+func (o *V2ExportClusterBundleNotFound) WithPayload(payload *models.Error) *V2ExportClusterBundleNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 export cluster bundle not found response
+func (o *V2ExportClusterBundleNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ExportClusterBundleNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ExportClusterBundleInternalServerErrorCode is the HTTP code returned for type V2ExportClusterBundleInternalServerError
+const V2ExportClusterBundleInternalServerErrorCode int = 500
+
+/*V2ExportClusterBundleInternalServerError Error.
+
+swagger:response v2ExportClusterBundleInternalServerError
+*/
+type V2ExportClusterBundleInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ExportClusterBundleInternalServerError creates V2ExportClusterBundleInternalServerError with default headers values
+func NewV2ExportClusterBundleInternalServerError() *V2ExportClusterBundleInternalServerError {
+
+	return &V2ExportClusterBundleInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 export cluster bundle internal server error response
+func (o *V2ExportClusterBundleInternalServerError) WithPayload(payload *models.Error) *V2ExportClusterBundleInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 export cluster bundle internal server error response
+func (o *V2ExportClusterBundleInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ExportClusterBundleInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,234 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster_bundles
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ImportClusterBundleCreatedCode is the HTTP code returned for type V2ImportClusterBundleCreated
+const V2ImportClusterBundleCreatedCode int = 201
+
+/*V2ImportClusterBundleCreated Success.
+
+swagger:response v2ImportClusterBundleCreated
+*/
+type V2ImportClusterBundleCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Cluster `json:"body,omitempty"`
+}
+
+// NewV2ImportClusterBundleCreated creates V2ImportClusterBundleCreated with default headers values
+func NewV2ImportClusterBundleCreated() *V2ImportClusterBundleCreated {
+
+	return &V2ImportClusterBundleCreated{}
+}
+
+// WithPayload adds the payload to the v2 import cluster bundle created response
+func (o *V2ImportClusterBundleCreated) WithPayload(payload *models.Cluster) *V2ImportClusterBundleCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 import cluster bundle created response
+func (o *V2ImportClusterBundleCreated) SetPayload(payload *models.Cluster) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ImportClusterBundleCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ImportClusterBundleBadRequestCode is the HTTP code returned for type V2ImportClusterBundleBadRequest
+const V2ImportClusterBundleBadRequestCode int = 400
+
+/*V2ImportClusterBundleBadRequest Error.
+
+swagger:response v2ImportClusterBundleBadRequest
+*/
+type V2ImportClusterBundleBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterBundleValidationError `json:"body,omitempty"`
+}
+
+// NewV2ImportClusterBundleBadRequest creates V2ImportClusterBundleBadRequest with default headers values
+func NewV2ImportClusterBundleBadRequest() *V2ImportClusterBundleBadRequest {
+
+	return &V2ImportClusterBundleBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 import cluster bundle bad request response
+func (o *V2ImportClusterBundleBadRequest) WithPayload(payload *models.ClusterBundleValidationError) *V2ImportClusterBundleBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 import cluster bundle bad request response
+func (o *V2ImportClusterBundleBadRequest) SetPayload(payload *models.ClusterBundleValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ImportClusterBundleBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ImportClusterBundleUnauthorizedCode is the HTTP code returned for type V2ImportClusterBundleUnauthorized
+const V2ImportClusterBundleUnauthorizedCode int = 401
+
+/*V2ImportClusterBundleUnauthorized Unauthorized.
+
+swagger:response v2ImportClusterBundleUnauthorized
+*/
+type V2ImportClusterBundleUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ImportClusterBundleUnauthorized creates V2ImportClusterBundleUnauthorized with default headers values
+func NewV2ImportClusterBundleUnauthorized() *V2ImportClusterBundleUnauthorized {
+
+	return &V2ImportClusterBundleUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 import cluster bundle unauthorized response
+func (o *V2ImportClusterBundleUnauthorized) WithPayload(payload *models.InfraError) *V2ImportClusterBundleUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 import cluster bundle unauthorized response
+func (o *V2ImportClusterBundleUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ImportClusterBundleUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ImportClusterBundleForbiddenCode is the HTTP code returned for type V2ImportClusterBundleForbidden
+const V2ImportClusterBundleForbiddenCode int = 403
+
+/*V2ImportClusterBundleForbidden Forbidden.
+
+swagger:response v2ImportClusterBundleForbidden
+*/
+type V2ImportClusterBundleForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ImportClusterBundleForbidden creates V2ImportClusterBundleForbidden with default headers values
+func NewV2ImportClusterBundleForbidden() *V2ImportClusterBundleForbidden {
+
+	return &V2ImportClusterBundleForbidden{}
+}
+
+// WithPayload adds the payload to the v2 import cluster bundle forbidden response
+func (o *V2ImportClusterBundleForbidden) WithPayload(payload *models.InfraError) *V2ImportClusterBundleForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 import cluster bundle forbidden response
+func (o *V2ImportClusterBundleForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ImportClusterBundleForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ImportClusterBundleInternalServerErrorCode is the HTTP code returned for type V2ImportClusterBundleInternalServerError
+const V2ImportClusterBundleInternalServerErrorCode int = 500
+
+/*V2ImportClusterBundleInternalServerError Error.
+
+swagger:response v2ImportClusterBundleInternalServerError
+*/
+type V2ImportClusterBundleInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ImportClusterBundleInternalServerError creates V2ImportClusterBundleInternalServerError with default headers values
+func NewV2ImportClusterBundleInternalServerError() *V2ImportClusterBundleInternalServerError {
+
+	return &V2ImportClusterBundleInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 import cluster bundle internal server error response
+func (o *V2ImportClusterBundleInternalServerError) WithPayload(payload *models.Error) *V2ImportClusterBundleInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 import cluster bundle internal server error response
+func (o *V2ImportClusterBundleInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ImportClusterBundleInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
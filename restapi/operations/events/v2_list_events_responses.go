@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/openshift/assisted-service/models"
 )
@@ -21,6 +22,10 @@ const V2ListEventsOKCode int = 200
 swagger:response v2ListEventsOK
 */
 type V2ListEventsOK struct {
+	/*The total number of events matching the filters.
+
+	 */
+	XTotalCount int64 `json:"X-Total-Count"`
 
 	/*
 	  In: Body
@@ -34,6 +39,17 @@ func NewV2ListEventsOK() *V2ListEventsOK {
 	return &V2ListEventsOK{}
 }
 
+// WithXTotalCount adds the xTotalCount to the v2 list events o k response
+func (o *V2ListEventsOK) WithXTotalCount(xTotalCount int64) *V2ListEventsOK {
+	o.XTotalCount = xTotalCount
+	return o
+}
+
+// SetXTotalCount sets the xTotalCount to the v2 list events o k response
+func (o *V2ListEventsOK) SetXTotalCount(xTotalCount int64) {
+	o.XTotalCount = xTotalCount
+}
+
 // WithPayload adds the payload to the v2 list events o k response
 func (o *V2ListEventsOK) WithPayload(payload models.EventList) *V2ListEventsOK {
 	o.Payload = payload
@@ -48,6 +64,13 @@ func (o *V2ListEventsOK) SetPayload(payload models.EventList) {
 // WriteResponse to the client
 func (o *V2ListEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Total-Count
+
+	xTotalCount := swag.FormatInt64(o.XTotalCount)
+	if xTotalCount != "" {
+		rw.Header().Set("X-Total-Count", xTotalCount)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
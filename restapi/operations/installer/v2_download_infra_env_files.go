@@ -31,7 +31,10 @@ func NewV2DownloadInfraEnvFiles(ctx *middleware.Context, handler V2DownloadInfra
 
 /* V2DownloadInfraEnvFiles swagger:route GET /v2/infra-envs/{infra_env_id}/downloads/files installer v2DownloadInfraEnvFiles
 
-Downloads the customized ignition file for this host
+Downloads the customized ignition file for this host, or the artifacts for booting the discovery image
+over the network. The initrd.img has the discovery ignition embedded, and the ipxe-script boots the
+vmlinuz, initrd.img and rootfs.img of this infra-env.
+
 
 */
 type V2DownloadInfraEnvFiles struct {
@@ -48,15 +48,49 @@ type V2ListClustersParams struct {
 	  In: query
 	*/
 	AmsSubscriptionIds []string
+	/*The token returned in the X-Continue header of a previous response, used to retrieve the next page.
+	  In: query
+	*/
+	Continue *string
 	/*Whether to return clusters that have been unregistered.
 	  In: header
 	  Default: false
 	*/
 	GetUnregisteredClusters *bool
+	/*The maximal number of Clusters to return. All matching Clusters are returned if not set.
+	  Maximum: 1000
+	  Minimum: 1
+	  In: query
+	*/
+	Limit *int64
 	/*A specific cluster to retrieve.
 	  In: query
 	*/
 	OpenshiftClusterID *strfmt.UUID
+	/*If set, returned Clusters are filtered to those with the given OpenShift version.
+	  In: query
+	*/
+	OpenshiftVersion *string
+	/*The direction in which the returned Clusters are sorted.
+	  In: query
+	*/
+	Order *string
+	/*If set, returned Clusters are filtered to those owned by the given user name.
+	  In: query
+	*/
+	Owner *string
+	/*If set, returned Clusters are filtered to those with the given platform type, one of the values of the platform_type definition.
+	  In: query
+	*/
+	PlatformType *string
+	/*The field used to sort the returned Clusters.
+	  In: query
+	*/
+	SortBy *string
+	/*If non-empty, returned Clusters are filtered to those in one of the given statuses.
+	  In: query
+	*/
+	Status []string
 	/*Include hosts in the returned list.
 	  In: query
 	  Default: false
@@ -80,15 +114,55 @@ func (o *V2ListClustersParams) BindRequest(r *http.Request, route *middleware.Ma
 		res = append(res, err)
 	}
 
+	qContinue, qhkContinue, _ := qs.GetOK("continue")
+	if err := o.bindContinue(qContinue, qhkContinue, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.bindGetUnregisteredClusters(r.Header[http.CanonicalHeaderKey("get_unregistered_clusters")], true, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qOpenshiftClusterID, qhkOpenshiftClusterID, _ := qs.GetOK("openshift_cluster_id")
 	if err := o.bindOpenshiftClusterID(qOpenshiftClusterID, qhkOpenshiftClusterID, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qOpenshiftVersion, qhkOpenshiftVersion, _ := qs.GetOK("openshift_version")
+	if err := o.bindOpenshiftVersion(qOpenshiftVersion, qhkOpenshiftVersion, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOrder, qhkOrder, _ := qs.GetOK("order")
+	if err := o.bindOrder(qOrder, qhkOrder, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOwner, qhkOwner, _ := qs.GetOK("owner")
+	if err := o.bindOwner(qOwner, qhkOwner, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPlatformType, qhkPlatformType, _ := qs.GetOK("platform_type")
+	if err := o.bindPlatformType(qPlatformType, qhkPlatformType, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSortBy, qhkSortBy, _ := qs.GetOK("sort_by")
+	if err := o.bindSortBy(qSortBy, qhkSortBy, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStatus, qhkStatus, _ := qs.GetOK("status")
+	if err := o.bindStatus(qStatus, qhkStatus, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qWithHosts, qhkWithHosts, _ := qs.GetOK("with_hosts")
 	if err := o.bindWithHosts(qWithHosts, qhkWithHosts, route.Formats); err != nil {
 		res = append(res, err)
@@ -126,6 +200,24 @@ func (o *V2ListClustersParams) bindAmsSubscriptionIds(rawData []string, hasKey b
 	return nil
 }
 
+// bindContinue binds and validates parameter Continue from query.
+func (o *V2ListClustersParams) bindContinue(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Continue = &raw
+
+	return nil
+}
+
 // bindGetUnregisteredClusters binds and validates parameter GetUnregisteredClusters from header.
 func (o *V2ListClustersParams) bindGetUnregisteredClusters(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -149,6 +241,47 @@ func (o *V2ListClustersParams) bindGetUnregisteredClusters(rawData []string, has
 	return nil
 }
 
+// bindLimit binds and validates parameter Limit from query.
+func (o *V2ListClustersParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *V2ListClustersParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", *o.Limit, 1000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // bindOpenshiftClusterID binds and validates parameter OpenshiftClusterID from query.
 func (o *V2ListClustersParams) bindOpenshiftClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -186,6 +319,151 @@ func (o *V2ListClustersParams) validateOpenshiftClusterID(formats strfmt.Registr
 	return nil
 }
 
+// bindOpenshiftVersion binds and validates parameter OpenshiftVersion from query.
+func (o *V2ListClustersParams) bindOpenshiftVersion(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.OpenshiftVersion = &raw
+
+	return nil
+}
+
+// bindOrder binds and validates parameter Order from query.
+func (o *V2ListClustersParams) bindOrder(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Order = &raw
+
+	if err := o.validateOrder(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOrder carries on validations for parameter Order
+func (o *V2ListClustersParams) validateOrder(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("order", "query", *o.Order, []interface{}{"ascending", "descending"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindOwner binds and validates parameter Owner from query.
+func (o *V2ListClustersParams) bindOwner(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Owner = &raw
+
+	return nil
+}
+
+// bindPlatformType binds and validates parameter PlatformType from query.
+func (o *V2ListClustersParams) bindPlatformType(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.PlatformType = &raw
+
+	return nil
+}
+
+// bindSortBy binds and validates parameter SortBy from query.
+func (o *V2ListClustersParams) bindSortBy(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.SortBy = &raw
+
+	if err := o.validateSortBy(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSortBy carries on validations for parameter SortBy
+func (o *V2ListClustersParams) validateSortBy(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("sort_by", "query", *o.SortBy, []interface{}{"created_at", "updated_at", "status"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindStatus binds and validates array parameter Status from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *V2ListClustersParams) bindStatus(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvStatus string
+	if len(rawData) > 0 {
+		qvStatus = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	statusIC := swag.SplitByFormat(qvStatus, "")
+	if len(statusIC) == 0 {
+		return nil
+	}
+
+	var statusIR []string
+	for _, statusIV := range statusIC {
+		statusI := statusIV
+
+		statusIR = append(statusIR, statusI)
+	}
+
+	o.Status = statusIR
+
+	return nil
+}
+
 // bindWithHosts binds and validates parameter WithHosts from query.
 func (o *V2ListClustersParams) bindWithHosts(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
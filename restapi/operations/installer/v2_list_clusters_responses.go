@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/openshift/assisted-service/models"
 )
@@ -21,6 +22,14 @@ const V2ListClustersOKCode int = 200
 swagger:response v2ListClustersOK
 */
 type V2ListClustersOK struct {
+	/*A token to retrieve the next page, empty if this is the last page.
+
+	 */
+	XContinue string `json:"X-Continue"`
+	/*The total number of Clusters matching the filters.
+
+	 */
+	XTotalCount int64 `json:"X-Total-Count"`
 
 	/*
 	  In: Body
@@ -34,6 +43,28 @@ func NewV2ListClustersOK() *V2ListClustersOK {
 	return &V2ListClustersOK{}
 }
 
+// WithXContinue adds the xContinue to the v2 list clusters o k response
+func (o *V2ListClustersOK) WithXContinue(xContinue string) *V2ListClustersOK {
+	o.XContinue = xContinue
+	return o
+}
+
+// SetXContinue sets the xContinue to the v2 list clusters o k response
+func (o *V2ListClustersOK) SetXContinue(xContinue string) {
+	o.XContinue = xContinue
+}
+
+// WithXTotalCount adds the xTotalCount to the v2 list clusters o k response
+func (o *V2ListClustersOK) WithXTotalCount(xTotalCount int64) *V2ListClustersOK {
+	o.XTotalCount = xTotalCount
+	return o
+}
+
+// SetXTotalCount sets the xTotalCount to the v2 list clusters o k response
+func (o *V2ListClustersOK) SetXTotalCount(xTotalCount int64) {
+	o.XTotalCount = xTotalCount
+}
+
 // WithPayload adds the payload to the v2 list clusters o k response
 func (o *V2ListClustersOK) WithPayload(payload models.ClusterList) *V2ListClustersOK {
 	o.Payload = payload
@@ -48,6 +79,20 @@ func (o *V2ListClustersOK) SetPayload(payload models.ClusterList) {
 // WriteResponse to the client
 func (o *V2ListClustersOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Continue
+
+	xContinue := o.XContinue
+	if xContinue != "" {
+		rw.Header().Set("X-Continue", xContinue)
+	}
+
+	// response header X-Total-Count
+
+	xTotalCount := swag.FormatInt64(o.XTotalCount)
+	if xTotalCount != "" {
+		rw.Header().Set("X-Total-Count", xTotalCount)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -17,7 +17,15 @@ import (
 // V2ListClustersURL generates an URL for the v2 list clusters operation
 type V2ListClustersURL struct {
 	AmsSubscriptionIds []string
+	Continue           *string
+	Limit              *int64
 	OpenshiftClusterID *strfmt.UUID
+	OpenshiftVersion   *string
+	Order              *string
+	Owner              *string
+	PlatformType       *string
+	SortBy             *string
+	Status             []string
 	WithHosts          bool
 
 	_basePath string
@@ -71,6 +79,22 @@ func (o *V2ListClustersURL) Build() (*url.URL, error) {
 		}
 	}
 
+	var continueVarQ string
+	if o.Continue != nil {
+		continueVarQ = *o.Continue
+	}
+	if continueVarQ != "" {
+		qs.Set("continue", continueVarQ)
+	}
+
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt64(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
 	var openshiftClusterIDQ string
 	if o.OpenshiftClusterID != nil {
 		openshiftClusterIDQ = o.OpenshiftClusterID.String()
@@ -79,6 +103,63 @@ func (o *V2ListClustersURL) Build() (*url.URL, error) {
 		qs.Set("openshift_cluster_id", openshiftClusterIDQ)
 	}
 
+	var openshiftVersionQ string
+	if o.OpenshiftVersion != nil {
+		openshiftVersionQ = *o.OpenshiftVersion
+	}
+	if openshiftVersionQ != "" {
+		qs.Set("openshift_version", openshiftVersionQ)
+	}
+
+	var orderQ string
+	if o.Order != nil {
+		orderQ = *o.Order
+	}
+	if orderQ != "" {
+		qs.Set("order", orderQ)
+	}
+
+	var ownerQ string
+	if o.Owner != nil {
+		ownerQ = *o.Owner
+	}
+	if ownerQ != "" {
+		qs.Set("owner", ownerQ)
+	}
+
+	var platformTypeQ string
+	if o.PlatformType != nil {
+		platformTypeQ = *o.PlatformType
+	}
+	if platformTypeQ != "" {
+		qs.Set("platform_type", platformTypeQ)
+	}
+
+	var sortByQ string
+	if o.SortBy != nil {
+		sortByQ = *o.SortBy
+	}
+	if sortByQ != "" {
+		qs.Set("sort_by", sortByQ)
+	}
+
+	var statusIR []string
+	for _, statusI := range o.Status {
+		statusIS := statusI
+		if statusIS != "" {
+			statusIR = append(statusIR, statusIS)
+		}
+	}
+
+	status := swag.JoinByFormat(statusIR, "")
+
+	if len(status) > 0 {
+		qsv := status[0]
+		if qsv != "" {
+			qs.Set("status", qsv)
+		}
+	}
+
 	withHostsQ := swag.FormatBool(o.WithHosts)
 	if withHostsQ != "" {
 		qs.Set("with_hosts", withHostsQ)
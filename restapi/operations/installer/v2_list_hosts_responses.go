@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/openshift/assisted-service/models"
 )
@@ -21,6 +22,14 @@ const V2ListHostsOKCode int = 200
 swagger:response v2ListHostsOK
 */
 type V2ListHostsOK struct {
+	/*A token to retrieve the next page, empty if this is the last page.
+
+	 */
+	XContinue string `json:"X-Continue"`
+	/*The total number of Hosts matching the filters.
+
+	 */
+	XTotalCount int64 `json:"X-Total-Count"`
 
 	/*
 	  In: Body
@@ -34,6 +43,28 @@ func NewV2ListHostsOK() *V2ListHostsOK {
 	return &V2ListHostsOK{}
 }
 
+// WithXContinue adds the xContinue to the v2 list hosts o k response
+func (o *V2ListHostsOK) WithXContinue(xContinue string) *V2ListHostsOK {
+	o.XContinue = xContinue
+	return o
+}
+
+// SetXContinue sets the xContinue to the v2 list hosts o k response
+func (o *V2ListHostsOK) SetXContinue(xContinue string) {
+	o.XContinue = xContinue
+}
+
+// WithXTotalCount adds the xTotalCount to the v2 list hosts o k response
+func (o *V2ListHostsOK) WithXTotalCount(xTotalCount int64) *V2ListHostsOK {
+	o.XTotalCount = xTotalCount
+	return o
+}
+
+// SetXTotalCount sets the xTotalCount to the v2 list hosts o k response
+func (o *V2ListHostsOK) SetXTotalCount(xTotalCount int64) {
+	o.XTotalCount = xTotalCount
+}
+
 // WithPayload adds the payload to the v2 list hosts o k response
 func (o *V2ListHostsOK) WithPayload(payload models.HostList) *V2ListHostsOK {
 	o.Payload = payload
@@ -48,6 +79,20 @@ func (o *V2ListHostsOK) SetPayload(payload models.HostList) {
 // WriteResponse to the client
 func (o *V2ListHostsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Continue
+
+	xContinue := o.XContinue
+	if xContinue != "" {
+		rw.Header().Set("X-Continue", xContinue)
+	}
+
+	// response header X-Total-Count
+
+	xTotalCount := swag.FormatInt64(o.XTotalCount)
+	if xTotalCount != "" {
+		rw.Header().Set("X-Total-Count", xTotalCount)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -12,12 +12,20 @@ import (
 	"strings"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // V2ListHostsURL generates an URL for the v2 list hosts operation
 type V2ListHostsURL struct {
 	InfraEnvID strfmt.UUID
 
+	Continue *string
+	Limit    *int64
+	Order    *string
+	Role     *string
+	SortBy   *string
+	Status   []string
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -57,6 +65,67 @@ func (o *V2ListHostsURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var continueVarQ string
+	if o.Continue != nil {
+		continueVarQ = *o.Continue
+	}
+	if continueVarQ != "" {
+		qs.Set("continue", continueVarQ)
+	}
+
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt64(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
+	var orderQ string
+	if o.Order != nil {
+		orderQ = *o.Order
+	}
+	if orderQ != "" {
+		qs.Set("order", orderQ)
+	}
+
+	var roleQ string
+	if o.Role != nil {
+		roleQ = *o.Role
+	}
+	if roleQ != "" {
+		qs.Set("role", roleQ)
+	}
+
+	var sortByQ string
+	if o.SortBy != nil {
+		sortByQ = *o.SortBy
+	}
+	if sortByQ != "" {
+		qs.Set("sort_by", sortByQ)
+	}
+
+	var statusIR []string
+	for _, statusI := range o.Status {
+		statusIS := statusI
+		if statusIS != "" {
+			statusIR = append(statusIR, statusIS)
+		}
+	}
+
+	status := swag.JoinByFormat(statusIR, "")
+
+	if len(status) > 0 {
+		qsv := status[0]
+		if qsv != "" {
+			qs.Set("status", qsv)
+		}
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -1,7 +1,9 @@
 package subsystem
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"time"
@@ -138,6 +140,14 @@ var _ = Describe("Infra_Env", func() {
 
 	})
 
+	It("download infra-env files iPXE script", func() {
+		buf := &bytes.Buffer{}
+		_, err := userBMClient.Installer.V2DownloadInfraEnvFiles(ctx, &installer.V2DownloadInfraEnvFilesParams{InfraEnvID: infraEnvID, FileName: "ipxe-script"}, buf)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(buf.String()).To(HavePrefix("#!ipxe"))
+		Expect(buf.String()).To(ContainSubstring(fmt.Sprintf("/v2/infra-envs/%s/downloads/files?file_name=initrd.img", infraEnvID)))
+	})
+
 	It("download infra-env files invalid filename option", func() {
 		file, err := ioutil.TempFile("", "tmp")
 		Expect(err).NotTo(HaveOccurred())
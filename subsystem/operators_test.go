@@ -40,7 +40,9 @@ var _ = Describe("Operators endpoint tests", func() {
 			reply, err := userBMClient.Operators.ListOperatorProperties(context.TODO(), params)
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(reply.Payload).To(BeEquivalentTo(models.OperatorProperties{}))
+			Expect(reply.Payload).To(HaveLen(2))
+			Expect(reply.Payload[0].Name).To(Equal("deployment_profile"))
+			Expect(reply.Payload[1].Name).To(Equal("external_cluster_details"))
 		})
 	})
 